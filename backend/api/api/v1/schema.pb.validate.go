@@ -160,6 +160,8 @@ func (m *Pattern) validate(all bool) error {
 
 	// no validation rules for Pattern
 
+	// no validation rules for WildcardEmptyFields
+
 	if len(errors) > 0 {
 		return PatternMultiError(errors)
 	}
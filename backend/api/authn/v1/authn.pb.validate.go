@@ -590,6 +590,8 @@ func (m *CreateTokenRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Description
+
 	if len(errors) > 0 {
 		return CreateTokenRequestMultiError(errors)
 	}
@@ -698,6 +700,8 @@ func (m *CreateTokenResponse) validate(all bool) error {
 
 	// no validation rules for AccessToken
 
+	// no validation rules for TokenId
+
 	if len(errors) > 0 {
 		return CreateTokenResponseMultiError(errors)
 	}
@@ -778,6 +782,684 @@ var _ interface {
 	ErrorName() string
 } = CreateTokenResponseValidationError{}
 
+// Validate checks the field values on ServiceToken with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ServiceToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServiceToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ServiceTokenMultiError, or
+// nil if none found.
+func (m *ServiceToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServiceToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Subject
+
+	// no validation rules for Creator
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceTokenValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceTokenValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceTokenValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceTokenValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceTokenValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceTokenValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUsedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceTokenValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceTokenValidationError{
+					field:  "LastUsedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUsedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceTokenValidationError{
+				field:  "LastUsedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRevokedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServiceTokenValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServiceTokenValidationError{
+					field:  "RevokedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevokedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServiceTokenValidationError{
+				field:  "RevokedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ServiceTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServiceTokenMultiError is an error wrapping multiple validation errors
+// returned by ServiceToken.ValidateAll() if the designated constraints aren't met.
+type ServiceTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceTokenMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceTokenMultiError) AllErrors() []error { return m }
+
+// ServiceTokenValidationError is the validation error returned by
+// ServiceToken.Validate if the designated constraints aren't met.
+type ServiceTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceTokenValidationError) ErrorName() string { return "ServiceTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ServiceTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServiceToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceTokenValidationError{}
+
+// Validate checks the field values on ListTokensRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListTokensRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTokensRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTokensRequestMultiError, or nil if none found.
+func (m *ListTokensRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTokensRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeRevoked
+
+	if len(errors) > 0 {
+		return ListTokensRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTokensRequestMultiError is an error wrapping multiple validation errors
+// returned by ListTokensRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListTokensRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTokensRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTokensRequestMultiError) AllErrors() []error { return m }
+
+// ListTokensRequestValidationError is the validation error returned by
+// ListTokensRequest.Validate if the designated constraints aren't met.
+type ListTokensRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTokensRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTokensRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTokensRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTokensRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTokensRequestValidationError) ErrorName() string {
+	return "ListTokensRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTokensRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTokensRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTokensRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTokensRequestValidationError{}
+
+// Validate checks the field values on ListTokensResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListTokensResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTokensResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTokensResponseMultiError, or nil if none found.
+func (m *ListTokensResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTokensResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTokens() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTokensResponseValidationError{
+						field:  fmt.Sprintf("Tokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTokensResponseValidationError{
+					field:  fmt.Sprintf("Tokens[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTokensResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTokensResponseMultiError is an error wrapping multiple validation errors
+// returned by ListTokensResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListTokensResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTokensResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTokensResponseMultiError) AllErrors() []error { return m }
+
+// ListTokensResponseValidationError is the validation error returned by
+// ListTokensResponse.Validate if the designated constraints aren't met.
+type ListTokensResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTokensResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTokensResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTokensResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTokensResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTokensResponseValidationError) ErrorName() string {
+	return "ListTokensResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTokensResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTokensResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTokensResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTokensResponseValidationError{}
+
+// Validate checks the field values on RevokeTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeTokenRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeTokenRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeTokenRequestMultiError, or nil if none found.
+func (m *RevokeTokenRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeTokenRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetId()) < 1 {
+		err := RevokeTokenRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeTokenRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeTokenRequestMultiError is an error wrapping multiple validation errors
+// returned by RevokeTokenRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RevokeTokenRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeTokenRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeTokenRequestMultiError) AllErrors() []error { return m }
+
+// RevokeTokenRequestValidationError is the validation error returned by
+// RevokeTokenRequest.Validate if the designated constraints aren't met.
+type RevokeTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeTokenRequestValidationError) ErrorName() string {
+	return "RevokeTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeTokenRequestValidationError{}
+
+// Validate checks the field values on RevokeTokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeTokenResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeTokenResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeTokenResponseMultiError, or nil if none found.
+func (m *RevokeTokenResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeTokenResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeTokenResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeTokenResponseMultiError is an error wrapping multiple validation
+// errors returned by RevokeTokenResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeTokenResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeTokenResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeTokenResponseMultiError) AllErrors() []error { return m }
+
+// RevokeTokenResponseValidationError is the validation error returned by
+// RevokeTokenResponse.Validate if the designated constraints aren't met.
+type RevokeTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeTokenResponseValidationError) ErrorName() string {
+	return "RevokeTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeTokenResponseValidationError{}
+
 // Validate checks the field values on LoginResponse_Token with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -83,7 +83,7 @@ func (x Logger_Level) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Logger_Level.Descriptor instead.
 func (Logger_Level) EnumDescriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{7, 0}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{11, 0}
 }
 
 type Config struct {
@@ -173,7 +173,10 @@ type TCPSocket struct {
 
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Port    uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	Secure  bool   `protobuf:"varint,3,opt,name=secure,proto3" json:"secure,omitempty"`
+	// If true, tls must also be configured.
+	Secure bool `protobuf:"varint,3,opt,name=secure,proto3" json:"secure,omitempty"`
+	// Serve TLS on the socket.
+	Tls *TLS `protobuf:"bytes,4,opt,name=tls,proto3" json:"tls,omitempty"`
 }
 
 func (x *TCPSocket) Reset() {
@@ -229,21 +232,164 @@ func (x *TCPSocket) GetSecure() bool {
 	return false
 }
 
+func (x *TCPSocket) GetTls() *TLS {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+type TLS struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Paths to the PEM encoded certificate chain and private key. The files are reloaded when they change.
+	CertFile string `protobuf:"bytes,1,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	KeyFile  string `protobuf:"bytes,2,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	// Path to a PEM encoded CA bundle used to verify client certificates. If unset, client certificates are not
+	// requested. The file is reloaded when it changes.
+	//
+	// The identity of a verified client certificate (the first URI SAN, or the subject common name if there are none)
+	// is made available to the authn middleware, which authenticates the caller as "mtls:<identity>" if no token is
+	// present.
+	ClientCaFile string `protobuf:"bytes,3,opt,name=client_ca_file,json=clientCaFile,proto3" json:"client_ca_file,omitempty"`
+	// Reject connections without a valid client certificate. Requires client_ca_file.
+	RequireClientCert bool `protobuf:"varint,4,opt,name=require_client_cert,json=requireClientCert,proto3" json:"require_client_cert,omitempty"`
+}
+
+func (x *TLS) Reset() {
+	*x = TLS{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TLS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TLS) ProtoMessage() {}
+
+func (x *TLS) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TLS.ProtoReflect.Descriptor instead.
+func (*TLS) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TLS) GetCertFile() string {
+	if x != nil {
+		return x.CertFile
+	}
+	return ""
+}
+
+func (x *TLS) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *TLS) GetClientCaFile() string {
+	if x != nil {
+		return x.ClientCaFile
+	}
+	return ""
+}
+
+func (x *TLS) GetRequireClientCert() bool {
+	if x != nil {
+		return x.RequireClientCert
+	}
+	return false
+}
+
+type UnixSocket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path of the socket. A stale socket left behind at the path is removed before listening.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// File permissions of the socket, e.g. 0660. If unset, the permissions are determined by the process umask.
+	Mode uint32 `protobuf:"varint,2,opt,name=mode,proto3" json:"mode,omitempty"`
+}
+
+func (x *UnixSocket) Reset() {
+	*x = UnixSocket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnixSocket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnixSocket) ProtoMessage() {}
+
+func (x *UnixSocket) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnixSocket.ProtoReflect.Descriptor instead.
+func (*UnixSocket) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UnixSocket) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *UnixSocket) GetMode() uint32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
 type Listener struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Socket:
-	//
 	//	*Listener_Tcp
+	//	*Listener_Unix
 	Socket isListener_Socket `protobuf_oneof:"socket"`
 }
 
 func (x *Listener) Reset() {
 	*x = Listener{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[2]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -256,7 +402,7 @@ func (x *Listener) String() string {
 func (*Listener) ProtoMessage() {}
 
 func (x *Listener) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[2]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -269,7 +415,7 @@ func (x *Listener) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Listener.ProtoReflect.Descriptor instead.
 func (*Listener) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{2}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{4}
 }
 
 func (m *Listener) GetSocket() isListener_Socket {
@@ -286,6 +432,13 @@ func (x *Listener) GetTcp() *TCPSocket {
 	return nil
 }
 
+func (x *Listener) GetUnix() *UnixSocket {
+	if x, ok := x.GetSocket().(*Listener_Unix); ok {
+		return x.Unix
+	}
+	return nil
+}
+
 type isListener_Socket interface {
 	isListener_Socket()
 }
@@ -294,8 +447,14 @@ type Listener_Tcp struct {
 	Tcp *TCPSocket `protobuf:"bytes,1,opt,name=tcp,proto3,oneof"`
 }
 
+type Listener_Unix struct {
+	Unix *UnixSocket `protobuf:"bytes,2,opt,name=unix,proto3,oneof"`
+}
+
 func (*Listener_Tcp) isListener_Socket() {}
 
+func (*Listener_Unix) isListener_Socket() {}
+
 type Stats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -306,7 +465,6 @@ type Stats struct {
 	// The reporter to emit stats. If none specified, then stats will not be reported.
 	//
 	// Types that are assignable to Reporter:
-	//
 	//	*Stats_LogReporter_
 	//	*Stats_StatsdReporter_
 	//	*Stats_PrometheusReporter_
@@ -320,7 +478,7 @@ type Stats struct {
 func (x *Stats) Reset() {
 	*x = Stats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[3]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -333,7 +491,7 @@ func (x *Stats) String() string {
 func (*Stats) ProtoMessage() {}
 
 func (x *Stats) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[3]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -346,7 +504,7 @@ func (x *Stats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Stats.ProtoReflect.Descriptor instead.
 func (*Stats) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{3}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Stats) GetFlushInterval() *durationpb.Duration {
@@ -433,7 +591,7 @@ type Timeouts struct {
 func (x *Timeouts) Reset() {
 	*x = Timeouts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[4]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -446,7 +604,7 @@ func (x *Timeouts) String() string {
 func (*Timeouts) ProtoMessage() {}
 
 func (x *Timeouts) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[4]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -459,7 +617,7 @@ func (x *Timeouts) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Timeouts.ProtoReflect.Descriptor instead.
 func (*Timeouts) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{4}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Timeouts) GetDefault() *durationpb.Duration {
@@ -481,7 +639,11 @@ type GatewayOptions struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Listener                 *Listener     `protobuf:"bytes,1,opt,name=listener,proto3" json:"listener,omitempty"`
+	Listener *Listener `protobuf:"bytes,1,opt,name=listener,proto3" json:"listener,omitempty"`
+	// A private listener used by the JSON gateway to reach the gRPC server. TLS is not supported on this listener, so it
+	// must be a unix socket or a TCP socket on a loopback address such as 127.0.0.1. Since other local processes can
+	// connect to it, the client certificate identities of JSON requests are only forwarded to the gRPC server when this
+	// is unset and the JSON gateway connects to the gRPC server in-process.
 	JsonGrpcLoopbackListener *Listener     `protobuf:"bytes,2,opt,name=json_grpc_loopback_listener,json=jsonGrpcLoopbackListener,proto3" json:"json_grpc_loopback_listener,omitempty"`
 	Logger                   *Logger       `protobuf:"bytes,3,opt,name=logger,proto3" json:"logger,omitempty"`
 	Stats                    *Stats        `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
@@ -494,12 +656,16 @@ type GatewayOptions struct {
 	MaxResponseSizeBytes uint32 `protobuf:"varint,10,opt,name=max_response_size_bytes,json=maxResponseSizeBytes,proto3" json:"max_response_size_bytes,omitempty"`
 	// Whether or not cookies should be sent with the 'Secure' flag. Default value is true.
 	SecureCookies *wrapperspb.BoolValue `protobuf:"bytes,11,opt,name=secure_cookies,json=secureCookies,proto3" json:"secure_cookies,omitempty"`
+	// Distributed tracing of requests. If unset, no spans are recorded.
+	Tracing *Tracing `protobuf:"bytes,12,opt,name=tracing,proto3" json:"tracing,omitempty"`
+	// Reloading of the configuration without a restart. The configuration is always reloaded on SIGHUP.
+	ConfigReload *ConfigReload `protobuf:"bytes,13,opt,name=config_reload,json=configReload,proto3" json:"config_reload,omitempty"`
 }
 
 func (x *GatewayOptions) Reset() {
 	*x = GatewayOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[5]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -512,7 +678,7 @@ func (x *GatewayOptions) String() string {
 func (*GatewayOptions) ProtoMessage() {}
 
 func (x *GatewayOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[5]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +691,7 @@ func (x *GatewayOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewayOptions.ProtoReflect.Descriptor instead.
 func (*GatewayOptions) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{5}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GatewayOptions) GetListener() *Listener {
@@ -605,6 +771,153 @@ func (x *GatewayOptions) GetSecureCookies() *wrapperspb.BoolValue {
 	return nil
 }
 
+func (x *GatewayOptions) GetTracing() *Tracing {
+	if x != nil {
+		return x.Tracing
+	}
+	return nil
+}
+
+func (x *GatewayOptions) GetConfigReload() *ConfigReload {
+	if x != nil {
+		return x.ConfigReload
+	}
+	return nil
+}
+
+type ConfigReload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How often the configuration files are checked for changes. If unset, the files are not watched.
+	WatchInterval *durationpb.Duration `protobuf:"bytes,1,opt,name=watch_interval,json=watchInterval,proto3" json:"watch_interval,omitempty"`
+}
+
+func (x *ConfigReload) Reset() {
+	*x = ConfigReload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigReload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigReload) ProtoMessage() {}
+
+func (x *ConfigReload) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigReload.ProtoReflect.Descriptor instead.
+func (*ConfigReload) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConfigReload) GetWatchInterval() *durationpb.Duration {
+	if x != nil {
+		return x.WatchInterval
+	}
+	return nil
+}
+
+type Tracing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The service name reported on spans. Defaults to "clutch".
+	ServiceName string `protobuf:"bytes,1,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// The fraction of new traces to sample, between 0 and 1. Requests that are part of a sampled trace are always
+	// sampled.
+	SampleRatio float64 `protobuf:"fixed64,2,opt,name=sample_ratio,json=sampleRatio,proto3" json:"sample_ratio,omitempty"`
+	// Types that are assignable to Exporter:
+	//	*Tracing_Otlp
+	Exporter isTracing_Exporter `protobuf_oneof:"exporter"`
+}
+
+func (x *Tracing) Reset() {
+	*x = Tracing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tracing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tracing) ProtoMessage() {}
+
+func (x *Tracing) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tracing.ProtoReflect.Descriptor instead.
+func (*Tracing) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Tracing) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *Tracing) GetSampleRatio() float64 {
+	if x != nil {
+		return x.SampleRatio
+	}
+	return 0
+}
+
+func (m *Tracing) GetExporter() isTracing_Exporter {
+	if m != nil {
+		return m.Exporter
+	}
+	return nil
+}
+
+func (x *Tracing) GetOtlp() *Tracing_OTLPExporter {
+	if x, ok := x.GetExporter().(*Tracing_Otlp); ok {
+		return x.Otlp
+	}
+	return nil
+}
+
+type isTracing_Exporter interface {
+	isTracing_Exporter()
+}
+
+type Tracing_Otlp struct {
+	Otlp *Tracing_OTLPExporter `protobuf:"bytes,3,opt,name=otlp,proto3,oneof"`
+}
+
+func (*Tracing_Otlp) isTracing_Exporter() {}
+
 // Assets configuration provide a passthrough host for frontend static assets.
 // This is useful if you dont have the ability to enable sticky sessions or a blue/green deploy system in place.
 type Assets struct {
@@ -613,7 +926,6 @@ type Assets struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Provider:
-	//
 	//	*Assets_S3
 	Provider isAssets_Provider `protobuf_oneof:"provider"`
 }
@@ -621,7 +933,7 @@ type Assets struct {
 func (x *Assets) Reset() {
 	*x = Assets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[6]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -634,7 +946,7 @@ func (x *Assets) String() string {
 func (*Assets) ProtoMessage() {}
 
 func (x *Assets) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[6]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -647,7 +959,7 @@ func (x *Assets) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Assets.ProtoReflect.Descriptor instead.
 func (*Assets) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{6}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{10}
 }
 
 func (m *Assets) GetProvider() isAssets_Provider {
@@ -681,7 +993,6 @@ type Logger struct {
 
 	Level Logger_Level `protobuf:"varint,1,opt,name=level,proto3,enum=clutch.config.gateway.v1.Logger_Level" json:"level,omitempty"`
 	// Types that are assignable to Format:
-	//
 	//	*Logger_Pretty
 	Format isLogger_Format `protobuf_oneof:"format"`
 	// Namespace will set a zap.Namespace for your logging fields to be nested in.
@@ -692,7 +1003,7 @@ type Logger struct {
 func (x *Logger) Reset() {
 	*x = Logger{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[7]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -705,7 +1016,7 @@ func (x *Logger) String() string {
 func (*Logger) ProtoMessage() {}
 
 func (x *Logger) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[7]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +1029,7 @@ func (x *Logger) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Logger.ProtoReflect.Descriptor instead.
 func (*Logger) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{7}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Logger) GetLevel() Logger_Level {
@@ -766,12 +1077,16 @@ type Middleware struct {
 
 	Name        string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	TypedConfig *anypb.Any `protobuf:"bytes,2,opt,name=typed_config,json=typedConfig,proto3" json:"typed_config,omitempty"`
+	// Allow middleware that doesn't support streaming RPCs, which then reach the API without passing through it. The
+	// gateway fails to start if the middleware doesn't support streaming RPCs and this isn't set, since streaming RPCs
+	// would otherwise silently bypass e.g. authorization.
+	UnaryOnly bool `protobuf:"varint,3,opt,name=unary_only,json=unaryOnly,proto3" json:"unary_only,omitempty"`
 }
 
 func (x *Middleware) Reset() {
 	*x = Middleware{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[8]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -784,7 +1099,7 @@ func (x *Middleware) String() string {
 func (*Middleware) ProtoMessage() {}
 
 func (x *Middleware) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[8]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -797,7 +1112,7 @@ func (x *Middleware) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Middleware.ProtoReflect.Descriptor instead.
 func (*Middleware) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{8}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Middleware) GetName() string {
@@ -814,6 +1129,13 @@ func (x *Middleware) GetTypedConfig() *anypb.Any {
 	return nil
 }
 
+func (x *Middleware) GetUnaryOnly() bool {
+	if x != nil {
+		return x.UnaryOnly
+	}
+	return false
+}
+
 type Service struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -826,7 +1148,7 @@ type Service struct {
 func (x *Service) Reset() {
 	*x = Service{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[9]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -839,7 +1161,7 @@ func (x *Service) String() string {
 func (*Service) ProtoMessage() {}
 
 func (x *Service) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[9]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -852,7 +1174,7 @@ func (x *Service) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Service.ProtoReflect.Descriptor instead.
 func (*Service) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{9}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Service) GetName() string {
@@ -881,7 +1203,7 @@ type Resolver struct {
 func (x *Resolver) Reset() {
 	*x = Resolver{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[10]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -894,7 +1216,7 @@ func (x *Resolver) String() string {
 func (*Resolver) ProtoMessage() {}
 
 func (x *Resolver) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[10]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -907,7 +1229,7 @@ func (x *Resolver) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Resolver.ProtoReflect.Descriptor instead.
 func (*Resolver) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{10}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *Resolver) GetName() string {
@@ -936,7 +1258,7 @@ type Module struct {
 func (x *Module) Reset() {
 	*x = Module{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[11]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -949,7 +1271,7 @@ func (x *Module) String() string {
 func (*Module) ProtoMessage() {}
 
 func (x *Module) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[11]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -962,7 +1284,7 @@ func (x *Module) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Module.ProtoReflect.Descriptor instead.
 func (*Module) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{11}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Module) GetName() string {
@@ -988,7 +1310,7 @@ type Stats_LogReporter struct {
 func (x *Stats_LogReporter) Reset() {
 	*x = Stats_LogReporter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[12]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1001,7 +1323,7 @@ func (x *Stats_LogReporter) String() string {
 func (*Stats_LogReporter) ProtoMessage() {}
 
 func (x *Stats_LogReporter) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[12]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1014,7 +1336,7 @@ func (x *Stats_LogReporter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Stats_LogReporter.ProtoReflect.Descriptor instead.
 func (*Stats_LogReporter) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{3, 0}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{5, 0}
 }
 
 type Stats_StatsdReporter struct {
@@ -1024,7 +1346,6 @@ type Stats_StatsdReporter struct {
 
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// Types that are assignable to TagMode:
-	//
 	//	*Stats_StatsdReporter_PointTags_
 	TagMode isStats_StatsdReporter_TagMode `protobuf_oneof:"tag_mode"`
 }
@@ -1032,7 +1353,7 @@ type Stats_StatsdReporter struct {
 func (x *Stats_StatsdReporter) Reset() {
 	*x = Stats_StatsdReporter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[13]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1045,7 +1366,7 @@ func (x *Stats_StatsdReporter) String() string {
 func (*Stats_StatsdReporter) ProtoMessage() {}
 
 func (x *Stats_StatsdReporter) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[13]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1058,7 +1379,7 @@ func (x *Stats_StatsdReporter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Stats_StatsdReporter.ProtoReflect.Descriptor instead.
 func (*Stats_StatsdReporter) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{3, 1}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{5, 1}
 }
 
 func (x *Stats_StatsdReporter) GetAddress() string {
@@ -1103,7 +1424,7 @@ type Stats_PrometheusReporter struct {
 func (x *Stats_PrometheusReporter) Reset() {
 	*x = Stats_PrometheusReporter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[14]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1116,7 +1437,7 @@ func (x *Stats_PrometheusReporter) String() string {
 func (*Stats_PrometheusReporter) ProtoMessage() {}
 
 func (x *Stats_PrometheusReporter) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[14]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1129,7 +1450,7 @@ func (x *Stats_PrometheusReporter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Stats_PrometheusReporter.ProtoReflect.Descriptor instead.
 func (*Stats_PrometheusReporter) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{3, 2}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{5, 2}
 }
 
 func (x *Stats_PrometheusReporter) GetHandlerPath() string {
@@ -1151,7 +1472,7 @@ type Stats_GoRuntimeStats struct {
 func (x *Stats_GoRuntimeStats) Reset() {
 	*x = Stats_GoRuntimeStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[15]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1164,7 +1485,7 @@ func (x *Stats_GoRuntimeStats) String() string {
 func (*Stats_GoRuntimeStats) ProtoMessage() {}
 
 func (x *Stats_GoRuntimeStats) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[15]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1177,7 +1498,7 @@ func (x *Stats_GoRuntimeStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Stats_GoRuntimeStats.ProtoReflect.Descriptor instead.
 func (*Stats_GoRuntimeStats) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{3, 3}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{5, 3}
 }
 
 func (x *Stats_GoRuntimeStats) GetCollectionInterval() *durationpb.Duration {
@@ -1198,7 +1519,7 @@ type Stats_StatsdReporter_PointTags struct {
 func (x *Stats_StatsdReporter_PointTags) Reset() {
 	*x = Stats_StatsdReporter_PointTags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[16]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1211,7 +1532,7 @@ func (x *Stats_StatsdReporter_PointTags) String() string {
 func (*Stats_StatsdReporter_PointTags) ProtoMessage() {}
 
 func (x *Stats_StatsdReporter_PointTags) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[16]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1224,7 +1545,7 @@ func (x *Stats_StatsdReporter_PointTags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Stats_StatsdReporter_PointTags.ProtoReflect.Descriptor instead.
 func (*Stats_StatsdReporter_PointTags) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{3, 1, 0}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{5, 1, 0}
 }
 
 func (x *Stats_StatsdReporter_PointTags) GetSeparator() string {
@@ -1239,15 +1560,16 @@ type Timeouts_Entry struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Service string               `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
-	Method  string               `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Method  string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// A timeout of 0 disables the timeout, e.g. for streams that last until the client disconnects such as watches.
 	Timeout *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
 func (x *Timeouts_Entry) Reset() {
 	*x = Timeouts_Entry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[17]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1260,7 +1582,7 @@ func (x *Timeouts_Entry) String() string {
 func (*Timeouts_Entry) ProtoMessage() {}
 
 func (x *Timeouts_Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[17]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1273,7 +1595,7 @@ func (x *Timeouts_Entry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Timeouts_Entry.ProtoReflect.Descriptor instead.
 func (*Timeouts_Entry) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{4, 0}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{6, 0}
 }
 
 func (x *Timeouts_Entry) GetService() string {
@@ -1297,6 +1619,73 @@ func (x *Timeouts_Entry) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+// Exports spans to an OpenTelemetry collector using OTLP over gRPC.
+type Tracing_OTLPExporter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The address of the collector, e.g. "localhost:4317".
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// Connect to the collector without TLS.
+	Insecure bool `protobuf:"varint,2,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	// Headers sent with each export request, e.g. for authentication.
+	Headers map[string]string `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Tracing_OTLPExporter) Reset() {
+	*x = Tracing_OTLPExporter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tracing_OTLPExporter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tracing_OTLPExporter) ProtoMessage() {}
+
+func (x *Tracing_OTLPExporter) ProtoReflect() protoreflect.Message {
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tracing_OTLPExporter.ProtoReflect.Descriptor instead.
+func (*Tracing_OTLPExporter) Descriptor() ([]byte, []int) {
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *Tracing_OTLPExporter) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Tracing_OTLPExporter) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
+	}
+	return false
+}
+
+func (x *Tracing_OTLPExporter) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
 // To use the S3Provider you must have the AWS service configured
 type Assets_S3Provider struct {
 	state         protoimpl.MessageState
@@ -1312,7 +1701,7 @@ type Assets_S3Provider struct {
 func (x *Assets_S3Provider) Reset() {
 	*x = Assets_S3Provider{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_gateway_v1_gateway_proto_msgTypes[18]
+		mi := &file_config_gateway_v1_gateway_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1325,7 +1714,7 @@ func (x *Assets_S3Provider) String() string {
 func (*Assets_S3Provider) ProtoMessage() {}
 
 func (x *Assets_S3Provider) ProtoReflect() protoreflect.Message {
-	mi := &file_config_gateway_v1_gateway_proto_msgTypes[18]
+	mi := &file_config_gateway_v1_gateway_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1338,7 +1727,7 @@ func (x *Assets_S3Provider) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Assets_S3Provider.ProtoReflect.Descriptor instead.
 func (*Assets_S3Provider) Descriptor() ([]byte, []int) {
-	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{6, 0}
+	return file_config_gateway_v1_gateway_proto_rawDescGZIP(), []int{10, 0}
 }
 
 func (x *Assets_S3Provider) GetRegion() string {
@@ -1398,17 +1787,39 @@ var file_config_gateway_v1_gateway_proto_rawDesc = []byte{
 	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x07, 0x6d, 0x6f,
 	0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73,
 	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x73, 0x22,
-	0x65, 0x0a, 0x09, 0x54, 0x43, 0x50, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa,
-	0x42, 0x06, 0x2a, 0x04, 0x18, 0xff, 0xff, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x22, 0x52, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x65, 0x72, 0x12, 0x37, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x43, 0x50, 0x53, 0x6f,
-	0x63, 0x6b, 0x65, 0x74, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x70, 0x42, 0x0d, 0x0a, 0x06, 0x73,
+	0x96, 0x01, 0x0a, 0x09, 0x54, 0x43, 0x50, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x21, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09,
+	0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0xff, 0xff, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x2f, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x4c, 0x53, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x03, 0x54, 0x4c, 0x53,
+	0x12, 0x24, 0x0a, 0x09, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x08, 0x63, 0x65,
+	0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20,
+	0x01, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x65, 0x72, 0x74,
+	0x22, 0x47, 0x0a, 0x0a, 0x55, 0x6e, 0x69, 0x78, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1b,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x2a, 0x03,
+	0x18, 0xff, 0x03, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x08, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x43, 0x50, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12,
+	0x3a, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x6e, 0x69, 0x78, 0x53, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x48, 0x00, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x78, 0x42, 0x0d, 0x0a, 0x06, 0x73,
 	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0xe7, 0x06, 0x0a, 0x05, 0x53,
 	0x74, 0x61, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x0e, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f, 0x69, 0x6e,
 	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
@@ -1464,7 +1875,7 @@ var file_config_gateway_v1_gateway_proto_rawDesc = []byte{
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0xfa, 0x42, 0x0a, 0xaa, 0x01, 0x07, 0x32, 0x05, 0x10,
 	0x80, 0xc2, 0xd7, 0x2f, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x72, 0x22, 0x93, 0x02, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x72, 0x74, 0x65, 0x72, 0x22, 0x91, 0x02, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
 	0x73, 0x12, 0x41, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0xfa,
@@ -1473,116 +1884,159 @@ var file_config_gateway_v1_gateway_proto_rawDesc = []byte{
 	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
 	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
 	0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x2e, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x1a, 0x7c, 0x0a, 0x05,
+	0x79, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x1a, 0x7a, 0x0a, 0x05,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
 	0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x41, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x3f, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
 	0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0xaa, 0x01, 0x06, 0x08, 0x01, 0x32, 0x02, 0x08,
-	0x01, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xec, 0x05, 0x0a, 0x0e, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x48, 0x0a,
-	0x08, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x65, 0x72, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x61, 0x0a, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f,
-	0x67, 0x72, 0x70, 0x63, 0x5f, 0x6c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63,
-	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-	0x52, 0x18, 0x6a, 0x73, 0x6f, 0x6e, 0x47, 0x72, 0x70, 0x63, 0x4c, 0x6f, 0x6f, 0x70, 0x62, 0x61,
-	0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x06, 0x6c, 0x6f,
-	0x67, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x75,
+	0x69, 0x6f, 0x6e, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0xaa, 0x01, 0x04, 0x08, 0x01, 0x32, 0x00, 0x52,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0xf6, 0x06, 0x0a, 0x0e, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x48, 0x0a, 0x08, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x61, 0x0a, 0x1b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x5f, 0x6c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x75,
 	0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
-	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x42, 0x08, 0xfa, 0x42,
-	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x3f,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x18,
+	0x6a, 0x73, 0x6f, 0x6e, 0x47, 0x72, 0x70, 0x63, 0x4c, 0x6f, 0x6f, 0x70, 0x62, 0x61, 0x63, 0x6b,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x67,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3e, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x73, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x44, 0x0a,
+	0x0a, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x64,
+	0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x52, 0x0a, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+	0x61, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x70, 0x72, 0x6f, 0x66, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x70, 0x72, 0x6f, 0x66,
+	0x12, 0x4b, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6c, 0x6f, 0x67, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x6c, 0x6f, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6c, 0x6f, 0x67, 0x12, 0x35, 0x0a,
+	0x17, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14,
+	0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x63,
+	0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65,
+	0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x63, 0x69,
+	0x6e, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x74, 0x72, 0x61,
+	0x63, 0x69, 0x6e, 0x67, 0x12, 0x4b, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x72,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x22, 0x5c, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x4c, 0x0a, 0x0e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0xaa, 0x01, 0x04, 0x32, 0x02, 0x08, 0x01,
+	0x52, 0x0d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22,
+	0xa4, 0x03, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a,
+	0x0a, 0x0c, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0xf0, 0x3f, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x0b, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x44, 0x0a, 0x04, 0x6f, 0x74,
+	0x6c, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x54, 0x4c, 0x50,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x04, 0x6f, 0x74, 0x6c, 0x70,
+	0x1a, 0xe2, 0x01, 0x0a, 0x0c, 0x4f, 0x54, 0x4c, 0x50, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x12, 0x23, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x08, 0x65, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x65, 0x12, 0x55, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x2e, 0x4f, 0x54, 0x4c, 0x50, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0f, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0xa3, 0x01, 0x0a, 0x06, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x3d, 0x0a, 0x02, 0x73, 0x33, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
 	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x08,
-	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12,
-	0x3e, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12,
-	0x44, 0x0a, 0x0a, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x52, 0x0a, 0x6d, 0x69, 0x64, 0x64, 0x6c,
-	0x65, 0x77, 0x61, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12,
-	0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70, 0x70, 0x72, 0x6f, 0x66, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x70, 0x72,
-	0x6f, 0x66, 0x12, 0x4b, 0x0a, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6c, 0x6f, 0x67, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
-	0x2e, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6c, 0x6f, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x6c, 0x6f, 0x67, 0x12,
-	0x35, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
-	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x14, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65,
-	0x5f, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x73, 0x65, 0x63, 0x75,
-	0x72, 0x65, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x06, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x73, 0x12, 0x3d, 0x0a, 0x02, 0x73, 0x33, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2b, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x73, 0x2e, 0x53, 0x33, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52,
-	0x02, 0x73, 0x33, 0x1a, 0x4e, 0x0a, 0x0a, 0x53, 0x33, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x42, 0x0a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22,
-	0xe2, 0x01, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x05, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x63, 0x6c, 0x75, 0x74,
-	0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x76, 0x65,
-	0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x74,
-	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x70, 0x72, 0x65, 0x74,
-	0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x22, 0x58, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45,
-	0x42, 0x55, 0x47, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x02, 0x12,
-	0x08, 0x0a, 0x04, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x4e, 0x49, 0x43, 0x10, 0x05, 0x12,
-	0x09, 0x0a, 0x05, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x06, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x22, 0x62, 0x0a, 0x0a, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61,
-	0x72, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2e,
+	0x53, 0x33, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x00, 0x52, 0x02, 0x73, 0x33,
+	0x1a, 0x4e, 0x0a, 0x0a, 0x53, 0x33, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x42, 0x0a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0xe2, 0x01, 0x0a,
+	0x06, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x74, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x70, 0x72, 0x65, 0x74, 0x74, 0x79, 0x12,
+	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x58, 0x0a,
+	0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47,
+	0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
+	0x57, 0x41, 0x52, 0x4e, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x04, 0x12, 0x09, 0x0a, 0x05, 0x50, 0x41, 0x4e, 0x49, 0x43, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05,
+	0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x06, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x22, 0x81, 0x01, 0x0a, 0x0a, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65,
+	0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a,
+	0x0c, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x64,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x6e, 0x61, 0x72, 0x79, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x6e, 0x61, 0x72,
+	0x79, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x5f, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a,
+	0x0c, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x64,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x60, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
 	0x37, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
 	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x74, 0x79, 0x70,
-	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x5f, 0x0a, 0x07, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x37, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x74, 0x79,
-	0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x60, 0x0a, 0x08, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b,
-	0x74, 0x79, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x5e, 0x0a, 0x06, 0x4d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b,
-	0x74, 0x79, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x40, 0x5a, 0x3e, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66, 0x74, 0x2f, 0x63,
-	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
-	0x2f, 0x76, 0x31, 0x3b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x76, 0x31, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x5e, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x37, 0x0a, 0x0c, 0x74, 0x79, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0b, 0x74, 0x79, 0x70,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x76, 0x31,
+	0x3b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1598,69 +2052,82 @@ func file_config_gateway_v1_gateway_proto_rawDescGZIP() []byte {
 }
 
 var file_config_gateway_v1_gateway_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_config_gateway_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_config_gateway_v1_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_config_gateway_v1_gateway_proto_goTypes = []interface{}{
 	(Logger_Level)(0),                      // 0: clutch.config.gateway.v1.Logger.Level
 	(*Config)(nil),                         // 1: clutch.config.gateway.v1.Config
 	(*TCPSocket)(nil),                      // 2: clutch.config.gateway.v1.TCPSocket
-	(*Listener)(nil),                       // 3: clutch.config.gateway.v1.Listener
-	(*Stats)(nil),                          // 4: clutch.config.gateway.v1.Stats
-	(*Timeouts)(nil),                       // 5: clutch.config.gateway.v1.Timeouts
-	(*GatewayOptions)(nil),                 // 6: clutch.config.gateway.v1.GatewayOptions
-	(*Assets)(nil),                         // 7: clutch.config.gateway.v1.Assets
-	(*Logger)(nil),                         // 8: clutch.config.gateway.v1.Logger
-	(*Middleware)(nil),                     // 9: clutch.config.gateway.v1.Middleware
-	(*Service)(nil),                        // 10: clutch.config.gateway.v1.Service
-	(*Resolver)(nil),                       // 11: clutch.config.gateway.v1.Resolver
-	(*Module)(nil),                         // 12: clutch.config.gateway.v1.Module
-	(*Stats_LogReporter)(nil),              // 13: clutch.config.gateway.v1.Stats.LogReporter
-	(*Stats_StatsdReporter)(nil),           // 14: clutch.config.gateway.v1.Stats.StatsdReporter
-	(*Stats_PrometheusReporter)(nil),       // 15: clutch.config.gateway.v1.Stats.PrometheusReporter
-	(*Stats_GoRuntimeStats)(nil),           // 16: clutch.config.gateway.v1.Stats.GoRuntimeStats
-	(*Stats_StatsdReporter_PointTags)(nil), // 17: clutch.config.gateway.v1.Stats.StatsdReporter.PointTags
-	(*Timeouts_Entry)(nil),                 // 18: clutch.config.gateway.v1.Timeouts.Entry
-	(*Assets_S3Provider)(nil),              // 19: clutch.config.gateway.v1.Assets.S3Provider
-	(*durationpb.Duration)(nil),            // 20: google.protobuf.Duration
-	(*v1.Config)(nil),                      // 21: clutch.config.middleware.accesslog.v1.Config
-	(*wrapperspb.BoolValue)(nil),           // 22: google.protobuf.BoolValue
-	(*anypb.Any)(nil),                      // 23: google.protobuf.Any
+	(*TLS)(nil),                            // 3: clutch.config.gateway.v1.TLS
+	(*UnixSocket)(nil),                     // 4: clutch.config.gateway.v1.UnixSocket
+	(*Listener)(nil),                       // 5: clutch.config.gateway.v1.Listener
+	(*Stats)(nil),                          // 6: clutch.config.gateway.v1.Stats
+	(*Timeouts)(nil),                       // 7: clutch.config.gateway.v1.Timeouts
+	(*GatewayOptions)(nil),                 // 8: clutch.config.gateway.v1.GatewayOptions
+	(*ConfigReload)(nil),                   // 9: clutch.config.gateway.v1.ConfigReload
+	(*Tracing)(nil),                        // 10: clutch.config.gateway.v1.Tracing
+	(*Assets)(nil),                         // 11: clutch.config.gateway.v1.Assets
+	(*Logger)(nil),                         // 12: clutch.config.gateway.v1.Logger
+	(*Middleware)(nil),                     // 13: clutch.config.gateway.v1.Middleware
+	(*Service)(nil),                        // 14: clutch.config.gateway.v1.Service
+	(*Resolver)(nil),                       // 15: clutch.config.gateway.v1.Resolver
+	(*Module)(nil),                         // 16: clutch.config.gateway.v1.Module
+	(*Stats_LogReporter)(nil),              // 17: clutch.config.gateway.v1.Stats.LogReporter
+	(*Stats_StatsdReporter)(nil),           // 18: clutch.config.gateway.v1.Stats.StatsdReporter
+	(*Stats_PrometheusReporter)(nil),       // 19: clutch.config.gateway.v1.Stats.PrometheusReporter
+	(*Stats_GoRuntimeStats)(nil),           // 20: clutch.config.gateway.v1.Stats.GoRuntimeStats
+	(*Stats_StatsdReporter_PointTags)(nil), // 21: clutch.config.gateway.v1.Stats.StatsdReporter.PointTags
+	(*Timeouts_Entry)(nil),                 // 22: clutch.config.gateway.v1.Timeouts.Entry
+	(*Tracing_OTLPExporter)(nil),           // 23: clutch.config.gateway.v1.Tracing.OTLPExporter
+	nil,                                    // 24: clutch.config.gateway.v1.Tracing.OTLPExporter.HeadersEntry
+	(*Assets_S3Provider)(nil),              // 25: clutch.config.gateway.v1.Assets.S3Provider
+	(*durationpb.Duration)(nil),            // 26: google.protobuf.Duration
+	(*v1.Config)(nil),                      // 27: clutch.config.middleware.accesslog.v1.Config
+	(*wrapperspb.BoolValue)(nil),           // 28: google.protobuf.BoolValue
+	(*anypb.Any)(nil),                      // 29: google.protobuf.Any
 }
 var file_config_gateway_v1_gateway_proto_depIdxs = []int32{
-	6,  // 0: clutch.config.gateway.v1.Config.gateway:type_name -> clutch.config.gateway.v1.GatewayOptions
-	10, // 1: clutch.config.gateway.v1.Config.services:type_name -> clutch.config.gateway.v1.Service
-	11, // 2: clutch.config.gateway.v1.Config.resolvers:type_name -> clutch.config.gateway.v1.Resolver
-	12, // 3: clutch.config.gateway.v1.Config.modules:type_name -> clutch.config.gateway.v1.Module
-	2,  // 4: clutch.config.gateway.v1.Listener.tcp:type_name -> clutch.config.gateway.v1.TCPSocket
-	20, // 5: clutch.config.gateway.v1.Stats.flush_interval:type_name -> google.protobuf.Duration
-	13, // 6: clutch.config.gateway.v1.Stats.log_reporter:type_name -> clutch.config.gateway.v1.Stats.LogReporter
-	14, // 7: clutch.config.gateway.v1.Stats.statsd_reporter:type_name -> clutch.config.gateway.v1.Stats.StatsdReporter
-	15, // 8: clutch.config.gateway.v1.Stats.prometheus_reporter:type_name -> clutch.config.gateway.v1.Stats.PrometheusReporter
-	16, // 9: clutch.config.gateway.v1.Stats.go_runtime_stats:type_name -> clutch.config.gateway.v1.Stats.GoRuntimeStats
-	20, // 10: clutch.config.gateway.v1.Timeouts.default:type_name -> google.protobuf.Duration
-	18, // 11: clutch.config.gateway.v1.Timeouts.overrides:type_name -> clutch.config.gateway.v1.Timeouts.Entry
-	3,  // 12: clutch.config.gateway.v1.GatewayOptions.listener:type_name -> clutch.config.gateway.v1.Listener
-	3,  // 13: clutch.config.gateway.v1.GatewayOptions.json_grpc_loopback_listener:type_name -> clutch.config.gateway.v1.Listener
-	8,  // 14: clutch.config.gateway.v1.GatewayOptions.logger:type_name -> clutch.config.gateway.v1.Logger
-	4,  // 15: clutch.config.gateway.v1.GatewayOptions.stats:type_name -> clutch.config.gateway.v1.Stats
-	5,  // 16: clutch.config.gateway.v1.GatewayOptions.timeouts:type_name -> clutch.config.gateway.v1.Timeouts
-	9,  // 17: clutch.config.gateway.v1.GatewayOptions.middleware:type_name -> clutch.config.gateway.v1.Middleware
-	7,  // 18: clutch.config.gateway.v1.GatewayOptions.assets:type_name -> clutch.config.gateway.v1.Assets
-	21, // 19: clutch.config.gateway.v1.GatewayOptions.accesslog:type_name -> clutch.config.middleware.accesslog.v1.Config
-	22, // 20: clutch.config.gateway.v1.GatewayOptions.secure_cookies:type_name -> google.protobuf.BoolValue
-	19, // 21: clutch.config.gateway.v1.Assets.s3:type_name -> clutch.config.gateway.v1.Assets.S3Provider
-	0,  // 22: clutch.config.gateway.v1.Logger.level:type_name -> clutch.config.gateway.v1.Logger.Level
-	23, // 23: clutch.config.gateway.v1.Middleware.typed_config:type_name -> google.protobuf.Any
-	23, // 24: clutch.config.gateway.v1.Service.typed_config:type_name -> google.protobuf.Any
-	23, // 25: clutch.config.gateway.v1.Resolver.typed_config:type_name -> google.protobuf.Any
-	23, // 26: clutch.config.gateway.v1.Module.typed_config:type_name -> google.protobuf.Any
-	17, // 27: clutch.config.gateway.v1.Stats.StatsdReporter.point_tags:type_name -> clutch.config.gateway.v1.Stats.StatsdReporter.PointTags
-	20, // 28: clutch.config.gateway.v1.Stats.GoRuntimeStats.collection_interval:type_name -> google.protobuf.Duration
-	20, // 29: clutch.config.gateway.v1.Timeouts.Entry.timeout:type_name -> google.protobuf.Duration
-	30, // [30:30] is the sub-list for method output_type
-	30, // [30:30] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	8,  // 0: clutch.config.gateway.v1.Config.gateway:type_name -> clutch.config.gateway.v1.GatewayOptions
+	14, // 1: clutch.config.gateway.v1.Config.services:type_name -> clutch.config.gateway.v1.Service
+	15, // 2: clutch.config.gateway.v1.Config.resolvers:type_name -> clutch.config.gateway.v1.Resolver
+	16, // 3: clutch.config.gateway.v1.Config.modules:type_name -> clutch.config.gateway.v1.Module
+	3,  // 4: clutch.config.gateway.v1.TCPSocket.tls:type_name -> clutch.config.gateway.v1.TLS
+	2,  // 5: clutch.config.gateway.v1.Listener.tcp:type_name -> clutch.config.gateway.v1.TCPSocket
+	4,  // 6: clutch.config.gateway.v1.Listener.unix:type_name -> clutch.config.gateway.v1.UnixSocket
+	26, // 7: clutch.config.gateway.v1.Stats.flush_interval:type_name -> google.protobuf.Duration
+	17, // 8: clutch.config.gateway.v1.Stats.log_reporter:type_name -> clutch.config.gateway.v1.Stats.LogReporter
+	18, // 9: clutch.config.gateway.v1.Stats.statsd_reporter:type_name -> clutch.config.gateway.v1.Stats.StatsdReporter
+	19, // 10: clutch.config.gateway.v1.Stats.prometheus_reporter:type_name -> clutch.config.gateway.v1.Stats.PrometheusReporter
+	20, // 11: clutch.config.gateway.v1.Stats.go_runtime_stats:type_name -> clutch.config.gateway.v1.Stats.GoRuntimeStats
+	26, // 12: clutch.config.gateway.v1.Timeouts.default:type_name -> google.protobuf.Duration
+	22, // 13: clutch.config.gateway.v1.Timeouts.overrides:type_name -> clutch.config.gateway.v1.Timeouts.Entry
+	5,  // 14: clutch.config.gateway.v1.GatewayOptions.listener:type_name -> clutch.config.gateway.v1.Listener
+	5,  // 15: clutch.config.gateway.v1.GatewayOptions.json_grpc_loopback_listener:type_name -> clutch.config.gateway.v1.Listener
+	12, // 16: clutch.config.gateway.v1.GatewayOptions.logger:type_name -> clutch.config.gateway.v1.Logger
+	6,  // 17: clutch.config.gateway.v1.GatewayOptions.stats:type_name -> clutch.config.gateway.v1.Stats
+	7,  // 18: clutch.config.gateway.v1.GatewayOptions.timeouts:type_name -> clutch.config.gateway.v1.Timeouts
+	13, // 19: clutch.config.gateway.v1.GatewayOptions.middleware:type_name -> clutch.config.gateway.v1.Middleware
+	11, // 20: clutch.config.gateway.v1.GatewayOptions.assets:type_name -> clutch.config.gateway.v1.Assets
+	27, // 21: clutch.config.gateway.v1.GatewayOptions.accesslog:type_name -> clutch.config.middleware.accesslog.v1.Config
+	28, // 22: clutch.config.gateway.v1.GatewayOptions.secure_cookies:type_name -> google.protobuf.BoolValue
+	10, // 23: clutch.config.gateway.v1.GatewayOptions.tracing:type_name -> clutch.config.gateway.v1.Tracing
+	9,  // 24: clutch.config.gateway.v1.GatewayOptions.config_reload:type_name -> clutch.config.gateway.v1.ConfigReload
+	26, // 25: clutch.config.gateway.v1.ConfigReload.watch_interval:type_name -> google.protobuf.Duration
+	23, // 26: clutch.config.gateway.v1.Tracing.otlp:type_name -> clutch.config.gateway.v1.Tracing.OTLPExporter
+	25, // 27: clutch.config.gateway.v1.Assets.s3:type_name -> clutch.config.gateway.v1.Assets.S3Provider
+	0,  // 28: clutch.config.gateway.v1.Logger.level:type_name -> clutch.config.gateway.v1.Logger.Level
+	29, // 29: clutch.config.gateway.v1.Middleware.typed_config:type_name -> google.protobuf.Any
+	29, // 30: clutch.config.gateway.v1.Service.typed_config:type_name -> google.protobuf.Any
+	29, // 31: clutch.config.gateway.v1.Resolver.typed_config:type_name -> google.protobuf.Any
+	29, // 32: clutch.config.gateway.v1.Module.typed_config:type_name -> google.protobuf.Any
+	21, // 33: clutch.config.gateway.v1.Stats.StatsdReporter.point_tags:type_name -> clutch.config.gateway.v1.Stats.StatsdReporter.PointTags
+	26, // 34: clutch.config.gateway.v1.Stats.GoRuntimeStats.collection_interval:type_name -> google.protobuf.Duration
+	26, // 35: clutch.config.gateway.v1.Timeouts.Entry.timeout:type_name -> google.protobuf.Duration
+	24, // 36: clutch.config.gateway.v1.Tracing.OTLPExporter.headers:type_name -> clutch.config.gateway.v1.Tracing.OTLPExporter.HeadersEntry
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_config_gateway_v1_gateway_proto_init() }
@@ -1694,7 +2161,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Listener); i {
+			switch v := v.(*TLS); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1706,7 +2173,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stats); i {
+			switch v := v.(*UnixSocket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1718,7 +2185,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Timeouts); i {
+			switch v := v.(*Listener); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1730,7 +2197,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewayOptions); i {
+			switch v := v.(*Stats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1742,7 +2209,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Assets); i {
+			switch v := v.(*Timeouts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1754,7 +2221,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Logger); i {
+			switch v := v.(*GatewayOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1766,7 +2233,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Middleware); i {
+			switch v := v.(*ConfigReload); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1778,7 +2245,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Service); i {
+			switch v := v.(*Tracing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1790,7 +2257,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Resolver); i {
+			switch v := v.(*Assets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1802,7 +2269,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Module); i {
+			switch v := v.(*Logger); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1814,7 +2281,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stats_LogReporter); i {
+			switch v := v.(*Middleware); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1826,7 +2293,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stats_StatsdReporter); i {
+			switch v := v.(*Service); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1838,7 +2305,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stats_PrometheusReporter); i {
+			switch v := v.(*Resolver); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1850,7 +2317,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stats_GoRuntimeStats); i {
+			switch v := v.(*Module); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1862,7 +2329,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Stats_StatsdReporter_PointTags); i {
+			switch v := v.(*Stats_LogReporter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1874,7 +2341,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Timeouts_Entry); i {
+			switch v := v.(*Stats_StatsdReporter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1886,6 +2353,66 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 		file_config_gateway_v1_gateway_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stats_PrometheusReporter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_gateway_v1_gateway_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stats_GoRuntimeStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_gateway_v1_gateway_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stats_StatsdReporter_PointTags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_gateway_v1_gateway_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Timeouts_Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_gateway_v1_gateway_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tracing_OTLPExporter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_gateway_v1_gateway_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Assets_S3Provider); i {
 			case 0:
 				return &v.state
@@ -1898,21 +2425,25 @@ func file_config_gateway_v1_gateway_proto_init() {
 			}
 		}
 	}
-	file_config_gateway_v1_gateway_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_config_gateway_v1_gateway_proto_msgTypes[4].OneofWrappers = []interface{}{
 		(*Listener_Tcp)(nil),
+		(*Listener_Unix)(nil),
 	}
-	file_config_gateway_v1_gateway_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_config_gateway_v1_gateway_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*Stats_LogReporter_)(nil),
 		(*Stats_StatsdReporter_)(nil),
 		(*Stats_PrometheusReporter_)(nil),
 	}
-	file_config_gateway_v1_gateway_proto_msgTypes[6].OneofWrappers = []interface{}{
+	file_config_gateway_v1_gateway_proto_msgTypes[9].OneofWrappers = []interface{}{
+		(*Tracing_Otlp)(nil),
+	}
+	file_config_gateway_v1_gateway_proto_msgTypes[10].OneofWrappers = []interface{}{
 		(*Assets_S3)(nil),
 	}
-	file_config_gateway_v1_gateway_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_config_gateway_v1_gateway_proto_msgTypes[11].OneofWrappers = []interface{}{
 		(*Logger_Pretty)(nil),
 	}
-	file_config_gateway_v1_gateway_proto_msgTypes[13].OneofWrappers = []interface{}{
+	file_config_gateway_v1_gateway_proto_msgTypes[17].OneofWrappers = []interface{}{
 		(*Stats_StatsdReporter_PointTags_)(nil),
 	}
 	type x struct{}
@@ -1921,7 +2452,7 @@ func file_config_gateway_v1_gateway_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_gateway_v1_gateway_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   19,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
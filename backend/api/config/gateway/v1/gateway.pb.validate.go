@@ -321,6 +321,35 @@ func (m *TCPSocket) validate(all bool) error {
 
 	// no validation rules for Secure
 
+	if all {
+		switch v := interface{}(m.GetTls()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TCPSocketValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TCPSocketValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TCPSocketValidationError{
+				field:  "Tls",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return TCPSocketMultiError(errors)
 	}
@@ -398,6 +427,251 @@ var _ interface {
 	ErrorName() string
 } = TCPSocketValidationError{}
 
+// Validate checks the field values on TLS with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *TLS) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TLS with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TLSMultiError, or nil if none found.
+func (m *TLS) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TLS) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetCertFile()) < 1 {
+		err := TLSValidationError{
+			field:  "CertFile",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetKeyFile()) < 1 {
+		err := TLSValidationError{
+			field:  "KeyFile",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ClientCaFile
+
+	// no validation rules for RequireClientCert
+
+	if len(errors) > 0 {
+		return TLSMultiError(errors)
+	}
+
+	return nil
+}
+
+// TLSMultiError is an error wrapping multiple validation errors returned by
+// TLS.ValidateAll() if the designated constraints aren't met.
+type TLSMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TLSMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TLSMultiError) AllErrors() []error { return m }
+
+// TLSValidationError is the validation error returned by TLS.Validate if the
+// designated constraints aren't met.
+type TLSValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TLSValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TLSValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TLSValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TLSValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TLSValidationError) ErrorName() string { return "TLSValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TLSValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTLS.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TLSValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TLSValidationError{}
+
+// Validate checks the field values on UnixSocket with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UnixSocket) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnixSocket with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UnixSocketMultiError, or
+// nil if none found.
+func (m *UnixSocket) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnixSocket) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetPath()) < 1 {
+		err := UnixSocketValidationError{
+			field:  "Path",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMode() > 511 {
+		err := UnixSocketValidationError{
+			field:  "Mode",
+			reason: "value must be less than or equal to 511",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnixSocketMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnixSocketMultiError is an error wrapping multiple validation errors
+// returned by UnixSocket.ValidateAll() if the designated constraints aren't met.
+type UnixSocketMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnixSocketMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnixSocketMultiError) AllErrors() []error { return m }
+
+// UnixSocketValidationError is the validation error returned by
+// UnixSocket.Validate if the designated constraints aren't met.
+type UnixSocketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnixSocketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnixSocketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnixSocketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnixSocketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnixSocketValidationError) ErrorName() string { return "UnixSocketValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnixSocketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnixSocket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnixSocketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnixSocketValidationError{}
+
 // Validate checks the field values on Listener with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -464,6 +738,48 @@ func (m *Listener) validate(all bool) error {
 			}
 		}
 
+	case *Listener_Unix:
+		if v == nil {
+			err := ListenerValidationError{
+				field:  "Socket",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSocketPresent = true
+
+		if all {
+			switch v := interface{}(m.GetUnix()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListenerValidationError{
+						field:  "Unix",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListenerValidationError{
+						field:  "Unix",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnix()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListenerValidationError{
+					field:  "Unix",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -1177,184 +1493,540 @@ func (m *GatewayOptions) validate(all bool) error {
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GatewayOptionsValidationError{
-				field:  "Stats",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+	} else if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GatewayOptionsValidationError{
+				field:  "Stats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTimeouts()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GatewayOptionsValidationError{
+					field:  "Timeouts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GatewayOptionsValidationError{
+					field:  "Timeouts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeouts()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GatewayOptionsValidationError{
+				field:  "Timeouts",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetMiddleware() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GatewayOptionsValidationError{
+						field:  fmt.Sprintf("Middleware[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GatewayOptionsValidationError{
+						field:  fmt.Sprintf("Middleware[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GatewayOptionsValidationError{
+					field:  fmt.Sprintf("Middleware[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAssets()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GatewayOptionsValidationError{
+					field:  "Assets",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GatewayOptionsValidationError{
+					field:  "Assets",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAssets()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GatewayOptionsValidationError{
+				field:  "Assets",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for EnablePprof
+
+	if all {
+		switch v := interface{}(m.GetAccesslog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GatewayOptionsValidationError{
+					field:  "Accesslog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GatewayOptionsValidationError{
+					field:  "Accesslog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAccesslog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GatewayOptionsValidationError{
+				field:  "Accesslog",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxResponseSizeBytes
+
+	if all {
+		switch v := interface{}(m.GetSecureCookies()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GatewayOptionsValidationError{
+					field:  "SecureCookies",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GatewayOptionsValidationError{
+					field:  "SecureCookies",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecureCookies()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GatewayOptionsValidationError{
+				field:  "SecureCookies",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTracing()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GatewayOptionsValidationError{
+					field:  "Tracing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GatewayOptionsValidationError{
+					field:  "Tracing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTracing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GatewayOptionsValidationError{
+				field:  "Tracing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConfigReload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GatewayOptionsValidationError{
+					field:  "ConfigReload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GatewayOptionsValidationError{
+					field:  "ConfigReload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfigReload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GatewayOptionsValidationError{
+				field:  "ConfigReload",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GatewayOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// GatewayOptionsMultiError is an error wrapping multiple validation errors
+// returned by GatewayOptions.ValidateAll() if the designated constraints
+// aren't met.
+type GatewayOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GatewayOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GatewayOptionsMultiError) AllErrors() []error { return m }
+
+// GatewayOptionsValidationError is the validation error returned by
+// GatewayOptions.Validate if the designated constraints aren't met.
+type GatewayOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayOptionsValidationError) ErrorName() string { return "GatewayOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GatewayOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayOptionsValidationError{}
+
+// Validate checks the field values on ConfigReload with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConfigReload) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfigReload with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConfigReloadMultiError, or
+// nil if none found.
+func (m *ConfigReload) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfigReload) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if d := m.GetWatchInterval(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ConfigReloadValidationError{
+				field:  "WatchInterval",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(1*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := ConfigReloadValidationError{
+					field:  "WatchInterval",
+					reason: "value must be greater than or equal to 1s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return ConfigReloadMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfigReloadMultiError is an error wrapping multiple validation errors
+// returned by ConfigReload.ValidateAll() if the designated constraints aren't met.
+type ConfigReloadMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfigReloadMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfigReloadMultiError) AllErrors() []error { return m }
+
+// ConfigReloadValidationError is the validation error returned by
+// ConfigReload.Validate if the designated constraints aren't met.
+type ConfigReloadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigReloadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigReloadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigReloadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigReloadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigReloadValidationError) ErrorName() string { return "ConfigReloadValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfigReloadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfigReload.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigReloadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigReloadValidationError{}
+
+// Validate checks the field values on Tracing with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Tracing) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tracing with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TracingMultiError, or nil if none found.
+func (m *Tracing) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tracing) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceName
+
+	if val := m.GetSampleRatio(); val < 0 || val > 1 {
+		err := TracingValidationError{
+			field:  "SampleRatio",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetTimeouts()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GatewayOptionsValidationError{
-					field:  "Timeouts",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GatewayOptionsValidationError{
-					field:  "Timeouts",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	oneofExporterPresent := false
+	switch v := m.Exporter.(type) {
+	case *Tracing_Otlp:
+		if v == nil {
+			err := TracingValidationError{
+				field:  "Exporter",
+				reason: "oneof value cannot be a typed-nil",
 			}
-		}
-	} else if v, ok := interface{}(m.GetTimeouts()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GatewayOptionsValidationError{
-				field:  "Timeouts",
-				reason: "embedded message failed validation",
-				cause:  err,
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
-	}
-
-	for idx, item := range m.GetMiddleware() {
-		_, _ = idx, item
+		oneofExporterPresent = true
 
 		if all {
-			switch v := interface{}(item).(type) {
+			switch v := interface{}(m.GetOtlp()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GatewayOptionsValidationError{
-						field:  fmt.Sprintf("Middleware[%v]", idx),
+					errors = append(errors, TracingValidationError{
+						field:  "Otlp",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GatewayOptionsValidationError{
-						field:  fmt.Sprintf("Middleware[%v]", idx),
+					errors = append(errors, TracingValidationError{
+						field:  "Otlp",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetOtlp()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GatewayOptionsValidationError{
-					field:  fmt.Sprintf("Middleware[%v]", idx),
+				return TracingValidationError{
+					field:  "Otlp",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
+	default:
+		_ = v // ensures v is used
 	}
-
-	if all {
-		switch v := interface{}(m.GetAssets()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GatewayOptionsValidationError{
-					field:  "Assets",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GatewayOptionsValidationError{
-					field:  "Assets",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAssets()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GatewayOptionsValidationError{
-				field:  "Assets",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for EnablePprof
-
-	if all {
-		switch v := interface{}(m.GetAccesslog()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GatewayOptionsValidationError{
-					field:  "Accesslog",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GatewayOptionsValidationError{
-					field:  "Accesslog",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAccesslog()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GatewayOptionsValidationError{
-				field:  "Accesslog",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for MaxResponseSizeBytes
-
-	if all {
-		switch v := interface{}(m.GetSecureCookies()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GatewayOptionsValidationError{
-					field:  "SecureCookies",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GatewayOptionsValidationError{
-					field:  "SecureCookies",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if !oneofExporterPresent {
+		err := TracingValidationError{
+			field:  "Exporter",
+			reason: "value is required",
 		}
-	} else if v, ok := interface{}(m.GetSecureCookies()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GatewayOptionsValidationError{
-				field:  "SecureCookies",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GatewayOptionsMultiError(errors)
+		return TracingMultiError(errors)
 	}
 
 	return nil
 }
 
-// GatewayOptionsMultiError is an error wrapping multiple validation errors
-// returned by GatewayOptions.ValidateAll() if the designated constraints
-// aren't met.
-type GatewayOptionsMultiError []error
+// TracingMultiError is an error wrapping multiple validation errors returned
+// by Tracing.ValidateAll() if the designated constraints aren't met.
+type TracingMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GatewayOptionsMultiError) Error() string {
+func (m TracingMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1363,11 +2035,11 @@ func (m GatewayOptionsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GatewayOptionsMultiError) AllErrors() []error { return m }
+func (m TracingMultiError) AllErrors() []error { return m }
 
-// GatewayOptionsValidationError is the validation error returned by
-// GatewayOptions.Validate if the designated constraints aren't met.
-type GatewayOptionsValidationError struct {
+// TracingValidationError is the validation error returned by Tracing.Validate
+// if the designated constraints aren't met.
+type TracingValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1375,22 +2047,22 @@ type GatewayOptionsValidationError struct {
 }
 
 // Field function returns field value.
-func (e GatewayOptionsValidationError) Field() string { return e.field }
+func (e TracingValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GatewayOptionsValidationError) Reason() string { return e.reason }
+func (e TracingValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GatewayOptionsValidationError) Cause() error { return e.cause }
+func (e TracingValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GatewayOptionsValidationError) Key() bool { return e.key }
+func (e TracingValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GatewayOptionsValidationError) ErrorName() string { return "GatewayOptionsValidationError" }
+func (e TracingValidationError) ErrorName() string { return "TracingValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GatewayOptionsValidationError) Error() string {
+func (e TracingValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1402,14 +2074,14 @@ func (e GatewayOptionsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGatewayOptions.%s: %s%s",
+		"invalid %sTracing.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GatewayOptionsValidationError{}
+var _ error = TracingValidationError{}
 
 var _ interface {
 	Field() string
@@ -1417,7 +2089,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GatewayOptionsValidationError{}
+} = TracingValidationError{}
 
 // Validate checks the field values on Assets with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -1744,6 +2416,8 @@ func (m *Middleware) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for UnaryOnly
+
 	if len(errors) > 0 {
 		return MiddlewareMultiError(errors)
 	}
@@ -2889,12 +3563,12 @@ func (m *Timeouts_Entry) validate(all bool) error {
 			errors = append(errors, err)
 		} else {
 
-			gte := time.Duration(1*time.Second + 0*time.Nanosecond)
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
 
 			if dur < gte {
 				err := Timeouts_EntryValidationError{
 					field:  "Timeout",
-					reason: "value must be greater than or equal to 1s",
+					reason: "value must be greater than or equal to 0s",
 				}
 				if !all {
 					return err
@@ -2983,6 +3657,123 @@ var _ interface {
 	ErrorName() string
 } = Timeouts_EntryValidationError{}
 
+// Validate checks the field values on Tracing_OTLPExporter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Tracing_OTLPExporter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Tracing_OTLPExporter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Tracing_OTLPExporterMultiError, or nil if none found.
+func (m *Tracing_OTLPExporter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Tracing_OTLPExporter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetEndpoint()) < 1 {
+		err := Tracing_OTLPExporterValidationError{
+			field:  "Endpoint",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Insecure
+
+	// no validation rules for Headers
+
+	if len(errors) > 0 {
+		return Tracing_OTLPExporterMultiError(errors)
+	}
+
+	return nil
+}
+
+// Tracing_OTLPExporterMultiError is an error wrapping multiple validation
+// errors returned by Tracing_OTLPExporter.ValidateAll() if the designated
+// constraints aren't met.
+type Tracing_OTLPExporterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Tracing_OTLPExporterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Tracing_OTLPExporterMultiError) AllErrors() []error { return m }
+
+// Tracing_OTLPExporterValidationError is the validation error returned by
+// Tracing_OTLPExporter.Validate if the designated constraints aren't met.
+type Tracing_OTLPExporterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Tracing_OTLPExporterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Tracing_OTLPExporterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Tracing_OTLPExporterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Tracing_OTLPExporterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Tracing_OTLPExporterValidationError) ErrorName() string {
+	return "Tracing_OTLPExporterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Tracing_OTLPExporterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTracing_OTLPExporter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Tracing_OTLPExporterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Tracing_OTLPExporterValidationError{}
+
 // Validate checks the field values on Assets_S3Provider with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
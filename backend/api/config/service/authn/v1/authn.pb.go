@@ -10,6 +10,7 @@ import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -110,39 +111,675 @@ func (x *OIDC) GetSubjectClaimNameOverride() string {
 	return ""
 }
 
+// Authenticates users with a username and password entered in a login form served by the gateway, for environments
+// without an identity provider. The subject of a user's token is their username.
+//
+// The code passed from the login form to the callback can only be exchanged once. Exchanged codes are recorded in the
+// authn_local_codes table if the authn storage service is configured, otherwise by each gateway replica separately,
+// so running more than one replica requires the storage service.
+type Local struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*Local_User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// A YAML or JSON file containing a list of users in the same format as the users field. The file is read at
+	// startup.
+	UsersFile string `protobuf:"bytes,2,opt,name=users_file,json=usersFile,proto3" json:"users_file,omitempty"`
+	// Whether to look up users in the authn_local_users table when they are not found in the configuration. Requires
+	// the authn storage service.
+	DatabaseUsers bool                 `protobuf:"varint,3,opt,name=database_users,json=databaseUsers,proto3" json:"database_users,omitempty"`
+	StaticTokens  []*Local_StaticToken `protobuf:"bytes,4,rep,name=static_tokens,json=staticTokens,proto3" json:"static_tokens,omitempty"`
+	LoginLimits   *Local_LoginLimits   `protobuf:"bytes,5,opt,name=login_limits,json=loginLimits,proto3" json:"login_limits,omitempty"`
+}
+
+func (x *Local) Reset() {
+	*x = Local{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Local) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Local) ProtoMessage() {}
+
+func (x *Local) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Local.ProtoReflect.Descriptor instead.
+func (*Local) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Local) GetUsers() []*Local_User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *Local) GetUsersFile() string {
+	if x != nil {
+		return x.UsersFile
+	}
+	return ""
+}
+
+func (x *Local) GetDatabaseUsers() bool {
+	if x != nil {
+		return x.DatabaseUsers
+	}
+	return false
+}
+
+func (x *Local) GetStaticTokens() []*Local_StaticToken {
+	if x != nil {
+		return x.StaticTokens
+	}
+	return nil
+}
+
+func (x *Local) GetLoginLimits() *Local_LoginLimits {
+	if x != nil {
+		return x.LoginLimits
+	}
+	return nil
+}
+
 type Config struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Used to sign the nonce or any other JWT secrets.
-	SessionSecret string `protobuf:"bytes,1,opt,name=session_secret,json=sessionSecret,proto3" json:"session_secret,omitempty"`
-	// Types that are assignable to Type:
-	//
-	//	*Config_Oidc
-	Type isConfig_Type `protobuf_oneof:"type"`
-	// Whether to permit service tokens to be issued. In addition to setting this flag
-	// a token store must be configured.
-	EnableServiceTokenCreation bool `protobuf:"varint,3,opt,name=enable_service_token_creation,json=enableServiceTokenCreation,proto3" json:"enable_service_token_creation,omitempty"`
+	// Used to sign the nonce or any other JWT secrets.
+	SessionSecret string `protobuf:"bytes,1,opt,name=session_secret,json=sessionSecret,proto3" json:"session_secret,omitempty"`
+	// Types that are assignable to Type:
+	//	*Config_Oidc
+	//	*Config_Local
+	Type isConfig_Type `protobuf_oneof:"type"`
+	// Whether to permit service tokens to be issued. In addition to setting this flag
+	// a token store must be configured.
+	//
+	// The subject of a service token is "service:<name>". Callers authenticated by a TLS client certificate instead of a
+	// token (see client_ca_file in the gateway config) have the subject "mtls:<identity>", so authz bindings for one
+	// never apply to the other.
+	EnableServiceTokenCreation bool `protobuf:"varint,3,opt,name=enable_service_token_creation,json=enableServiceTokenCreation,proto3" json:"enable_service_token_creation,omitempty"`
+	// Resolves group membership from external directories when users log in or refresh their token. The resolved
+	// groups are embedded in the issued token along with any groups from the provider's token.
+	GroupResolver *GroupResolver `protobuf:"bytes,4,opt,name=group_resolver,json=groupResolver,proto3" json:"group_resolver,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Config) GetSessionSecret() string {
+	if x != nil {
+		return x.SessionSecret
+	}
+	return ""
+}
+
+func (m *Config) GetType() isConfig_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *Config) GetOidc() *OIDC {
+	if x, ok := x.GetType().(*Config_Oidc); ok {
+		return x.Oidc
+	}
+	return nil
+}
+
+func (x *Config) GetLocal() *Local {
+	if x, ok := x.GetType().(*Config_Local); ok {
+		return x.Local
+	}
+	return nil
+}
+
+func (x *Config) GetEnableServiceTokenCreation() bool {
+	if x != nil {
+		return x.EnableServiceTokenCreation
+	}
+	return false
+}
+
+func (x *Config) GetGroupResolver() *GroupResolver {
+	if x != nil {
+		return x.GroupResolver
+	}
+	return nil
+}
+
+type isConfig_Type interface {
+	isConfig_Type()
+}
+
+type Config_Oidc struct {
+	Oidc *OIDC `protobuf:"bytes,2,opt,name=oidc,proto3,oneof"`
+}
+
+type Config_Local struct {
+	Local *Local `protobuf:"bytes,5,opt,name=local,proto3,oneof"`
+}
+
+func (*Config_Oidc) isConfig_Type() {}
+
+func (*Config_Local) isConfig_Type() {}
+
+type GroupResolver struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How long the groups resolved for a subject are cached. Defaults to 5 minutes. If resolving fails, expired
+	// groups continue to be used for up to max_staleness.
+	CacheTtl *durationpb.Duration `protobuf:"bytes,1,opt,name=cache_ttl,json=cacheTtl,proto3" json:"cache_ttl,omitempty"`
+	// The groups from all sources are combined.
+	Sources []*GroupSource `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	// How long after expiring cached groups may still be used when resolving fails, so that removing a user from a
+	// group takes effect even while the directory is unavailable. Defaults to 1 hour.
+	MaxStaleness *durationpb.Duration `protobuf:"bytes,3,opt,name=max_staleness,json=maxStaleness,proto3" json:"max_staleness,omitempty"`
+	// The maximum number of subjects whose groups are cached. Defaults to 10000.
+	MaxCacheEntries uint32 `protobuf:"varint,4,opt,name=max_cache_entries,json=maxCacheEntries,proto3" json:"max_cache_entries,omitempty"`
+}
+
+func (x *GroupResolver) Reset() {
+	*x = GroupResolver{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupResolver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupResolver) ProtoMessage() {}
+
+func (x *GroupResolver) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupResolver.ProtoReflect.Descriptor instead.
+func (*GroupResolver) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GroupResolver) GetCacheTtl() *durationpb.Duration {
+	if x != nil {
+		return x.CacheTtl
+	}
+	return nil
+}
+
+func (x *GroupResolver) GetSources() []*GroupSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *GroupResolver) GetMaxStaleness() *durationpb.Duration {
+	if x != nil {
+		return x.MaxStaleness
+	}
+	return nil
+}
+
+func (x *GroupResolver) GetMaxCacheEntries() uint32 {
+	if x != nil {
+		return x.MaxCacheEntries
+	}
+	return 0
+}
+
+type GroupSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Type:
+	//	*GroupSource_Github
+	//	*GroupSource_Static
+	//	*GroupSource_Http
+	Type isGroupSource_Type `protobuf_oneof:"type"`
+}
+
+func (x *GroupSource) Reset() {
+	*x = GroupSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupSource) ProtoMessage() {}
+
+func (x *GroupSource) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupSource.ProtoReflect.Descriptor instead.
+func (*GroupSource) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *GroupSource) GetType() isGroupSource_Type {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (x *GroupSource) GetGithub() *GitHubGroupSource {
+	if x, ok := x.GetType().(*GroupSource_Github); ok {
+		return x.Github
+	}
+	return nil
+}
+
+func (x *GroupSource) GetStatic() *StaticGroupSource {
+	if x, ok := x.GetType().(*GroupSource_Static); ok {
+		return x.Static
+	}
+	return nil
+}
+
+func (x *GroupSource) GetHttp() *HTTPGroupSource {
+	if x, ok := x.GetType().(*GroupSource_Http); ok {
+		return x.Http
+	}
+	return nil
+}
+
+type isGroupSource_Type interface {
+	isGroupSource_Type()
+}
+
+type GroupSource_Github struct {
+	Github *GitHubGroupSource `protobuf:"bytes,1,opt,name=github,proto3,oneof"`
+}
+
+type GroupSource_Static struct {
+	Static *StaticGroupSource `protobuf:"bytes,2,opt,name=static,proto3,oneof"`
+}
+
+type GroupSource_Http struct {
+	Http *HTTPGroupSource `protobuf:"bytes,3,opt,name=http,proto3,oneof"`
+}
+
+func (*GroupSource_Github) isGroupSource_Type() {}
+
+func (*GroupSource_Static) isGroupSource_Type() {}
+
+func (*GroupSource_Http) isGroupSource_Type() {}
+
+// Resolves groups from GitHub organization and team membership using the GitHub service, which must be configured
+// before the authn service. Organization membership produces the group "<org>" and team membership produces the
+// group "<org>/<team>", both prefixed with group_prefix.
+type GitHubGroupSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organizations []string                  `protobuf:"bytes,1,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	Teams         []*GitHubGroupSource_Team `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
+	// Removed from the end of the subject to produce the GitHub username, e.g. "@example.com". Subjects without the
+	// suffix are not looked up. Required, so that only subjects of the expected domain are mapped to GitHub users.
+	SubjectSuffix string `protobuf:"bytes,3,opt,name=subject_suffix,json=subjectSuffix,proto3" json:"subject_suffix,omitempty"`
+	// Defaults to "github:".
+	GroupPrefix string `protobuf:"bytes,4,opt,name=group_prefix,json=groupPrefix,proto3" json:"group_prefix,omitempty"`
+}
+
+func (x *GitHubGroupSource) Reset() {
+	*x = GitHubGroupSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GitHubGroupSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubGroupSource) ProtoMessage() {}
+
+func (x *GitHubGroupSource) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitHubGroupSource.ProtoReflect.Descriptor instead.
+func (*GitHubGroupSource) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GitHubGroupSource) GetOrganizations() []string {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+func (x *GitHubGroupSource) GetTeams() []*GitHubGroupSource_Team {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *GitHubGroupSource) GetSubjectSuffix() string {
+	if x != nil {
+		return x.SubjectSuffix
+	}
+	return ""
+}
+
+func (x *GitHubGroupSource) GetGroupPrefix() string {
+	if x != nil {
+		return x.GroupPrefix
+	}
+	return ""
+}
+
+// Resolves groups from a static mapping. Either path or groups must be set.
+type StaticGroupSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A YAML or JSON file mapping group names to the subjects in each group, e.g. `{"oncall": ["user@example.com"]}`.
+	// The file is read at startup.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Maps group names to their members.
+	Groups map[string]*StaticGroupSource_Members `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *StaticGroupSource) Reset() {
+	*x = StaticGroupSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StaticGroupSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaticGroupSource) ProtoMessage() {}
+
+func (x *StaticGroupSource) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaticGroupSource.ProtoReflect.Descriptor instead.
+func (*StaticGroupSource) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *StaticGroupSource) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *StaticGroupSource) GetGroups() map[string]*StaticGroupSource_Members {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// Resolves groups with a GET request to the URL with the subject as the "subject" query parameter. The endpoint must
+// respond with a JSON object containing the subject's groups, e.g. `{"groups": ["oncall"]}`.
+type HTTPGroupSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Headers added to each request, e.g. for authorization.
+	Headers map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Defaults to 5 seconds.
+	Timeout *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *HTTPGroupSource) Reset() {
+	*x = HTTPGroupSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HTTPGroupSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPGroupSource) ProtoMessage() {}
+
+func (x *HTTPGroupSource) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPGroupSource.ProtoReflect.Descriptor instead.
+func (*HTTPGroupSource) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HTTPGroupSource) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *HTTPGroupSource) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HTTPGroupSource) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+type Local_User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// A bcrypt hash of the user's password, e.g. the output of `htpasswd -nbBC 10 "" PASSWORD | cut -d: -f2`.
+	PasswordHash string   `protobuf:"bytes,2,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
+	Groups       []string `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *Local_User) Reset() {
+	*x = Local_User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Local_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Local_User) ProtoMessage() {}
+
+func (x *Local_User) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Local_User.ProtoReflect.Descriptor instead.
+func (*Local_User) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Local_User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Local_User) GetPasswordHash() string {
+	if x != nil {
+		return x.PasswordHash
+	}
+	return ""
+}
+
+func (x *Local_User) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// A token that is accepted as-is for the given subject, e.g. for scripts in environments where service tokens
+// can't be stored. Static tokens can't be revoked without changing the configuration.
+type Local_StaticToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token   string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Subject string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Groups  []string `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
 }
 
-func (x *Config) Reset() {
-	*x = Config{}
+func (x *Local_StaticToken) Reset() {
+	*x = Local_StaticToken{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_service_authn_v1_authn_proto_msgTypes[1]
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Config) String() string {
+func (x *Local_StaticToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config) ProtoMessage() {}
+func (*Local_StaticToken) ProtoMessage() {}
 
-func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_config_service_authn_v1_authn_proto_msgTypes[1]
+func (x *Local_StaticToken) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -153,48 +790,215 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config.ProtoReflect.Descriptor instead.
-func (*Config) Descriptor() ([]byte, []int) {
-	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Local_StaticToken.ProtoReflect.Descriptor instead.
+func (*Local_StaticToken) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{1, 1}
 }
 
-func (x *Config) GetSessionSecret() string {
+func (x *Local_StaticToken) GetToken() string {
 	if x != nil {
-		return x.SessionSecret
+		return x.Token
 	}
 	return ""
 }
 
-func (m *Config) GetType() isConfig_Type {
-	if m != nil {
-		return m.Type
+func (x *Local_StaticToken) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Local_StaticToken) GetGroups() []string {
+	if x != nil {
+		return x.Groups
 	}
 	return nil
 }
 
-func (x *Config) GetOidc() *OIDC {
-	if x, ok := x.GetType().(*Config_Oidc); ok {
-		return x.Oidc
+// Limits failed login attempts per username and per client IP address. Failures are counted by each gateway
+// replica separately.
+type Local_LoginLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Defaults to 5.
+	MaxFailuresPerUsername uint32 `protobuf:"varint,1,opt,name=max_failures_per_username,json=maxFailuresPerUsername,proto3" json:"max_failures_per_username,omitempty"`
+	// If unset, failures aren't limited per client IP address. Unless trusted_proxy_hops is set, the client IP address
+	// is the address of the connection, so every client behind a load balancer shares the limit.
+	MaxFailuresPerIp uint32 `protobuf:"varint,2,opt,name=max_failures_per_ip,json=maxFailuresPerIp,proto3" json:"max_failures_per_ip,omitempty"`
+	// How long failures are counted for. Defaults to 15 minutes.
+	Window *durationpb.Duration `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	// The number of proxies in front of the gateway that append the address they received a request from to the
+	// X-Forwarded-For header, e.g. 1 for a load balancer. The client IP address is taken from that many addresses from
+	// the end of the header, since the addresses before it are set by the client. If the header has fewer addresses,
+	// the address of the connection is used.
+	TrustedProxyHops uint32 `protobuf:"varint,4,opt,name=trusted_proxy_hops,json=trustedProxyHops,proto3" json:"trusted_proxy_hops,omitempty"`
+}
+
+func (x *Local_LoginLimits) Reset() {
+	*x = Local_LoginLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Local_LoginLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Local_LoginLimits) ProtoMessage() {}
+
+func (x *Local_LoginLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Local_LoginLimits.ProtoReflect.Descriptor instead.
+func (*Local_LoginLimits) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *Local_LoginLimits) GetMaxFailuresPerUsername() uint32 {
+	if x != nil {
+		return x.MaxFailuresPerUsername
+	}
+	return 0
+}
+
+func (x *Local_LoginLimits) GetMaxFailuresPerIp() uint32 {
+	if x != nil {
+		return x.MaxFailuresPerIp
+	}
+	return 0
+}
+
+func (x *Local_LoginLimits) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
 	}
 	return nil
 }
 
-func (x *Config) GetEnableServiceTokenCreation() bool {
+func (x *Local_LoginLimits) GetTrustedProxyHops() uint32 {
 	if x != nil {
-		return x.EnableServiceTokenCreation
+		return x.TrustedProxyHops
 	}
-	return false
+	return 0
 }
 
-type isConfig_Type interface {
-	isConfig_Type()
+type GitHubGroupSource_Team struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Organization string `protobuf:"bytes,1,opt,name=organization,proto3" json:"organization,omitempty"`
+	// The team's slug, e.g. "platform-eng".
+	Slug string `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
 }
 
-type Config_Oidc struct {
-	Oidc *OIDC `protobuf:"bytes,2,opt,name=oidc,proto3,oneof"`
+func (x *GitHubGroupSource_Team) Reset() {
+	*x = GitHubGroupSource_Team{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (*Config_Oidc) isConfig_Type() {}
+func (x *GitHubGroupSource_Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GitHubGroupSource_Team) ProtoMessage() {}
+
+func (x *GitHubGroupSource_Team) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GitHubGroupSource_Team.ProtoReflect.Descriptor instead.
+func (*GitHubGroupSource_Team) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *GitHubGroupSource_Team) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *GitHubGroupSource_Team) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type StaticGroupSource_Members struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subjects []string `protobuf:"bytes,1,rep,name=subjects,proto3" json:"subjects,omitempty"`
+}
+
+func (x *StaticGroupSource_Members) Reset() {
+	*x = StaticGroupSource_Members{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_authn_v1_authn_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StaticGroupSource_Members) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaticGroupSource_Members) ProtoMessage() {}
+
+func (x *StaticGroupSource_Members) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_authn_v1_authn_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaticGroupSource_Members.ProtoReflect.Descriptor instead.
+func (*StaticGroupSource_Members) Descriptor() ([]byte, []int) {
+	return file_config_service_authn_v1_authn_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *StaticGroupSource_Members) GetSubjects() []string {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
 
 var File_config_service_authn_v1_authn_proto protoreflect.FileDescriptor
 
@@ -203,7 +1007,9 @@ var file_config_service_authn_v1_authn_proto_rawDesc = []byte{
 	0x2f, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f,
+	0x68, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f,
 	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfe,
 	0x01, 0x0a, 0x04, 0x4f, 0x49, 0x44, 0x43, 0x12, 0x1f, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01,
@@ -221,23 +1027,167 @@ var file_config_service_authn_v1_authn_proto_rawDesc = []byte{
 	0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18,
 	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6c,
 	0x61, 0x69, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x22,
-	0xbf, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x0e, 0x73, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x0d, 0x73, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x04, 0x6f, 0x69,
-	0x64, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0xfe, 0x05, 0x0a, 0x05, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x40, 0x0a, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
 	0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x48, 0x00,
-	0x52, 0x04, 0x6f, 0x69, 0x64, 0x63, 0x12, 0x41, 0x0a, 0x1d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61,
+	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x12, 0x56, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0c, 0x73, 0x74, 0x61,
+	0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x54, 0x0a, 0x0c, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a,
+	0x71, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x20, 0x01, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x0d,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x0c, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x1a, 0x67, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1d, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x20, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x21, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0xe2, 0x01, 0x0a, 0x0b,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x6d,
+	0x61, 0x78, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16,
+	0x6d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x50, 0x65, 0x72, 0x49, 0x70, 0x12, 0x3b, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x5f, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x48, 0x6f, 0x70, 0x73,
+	0x22, 0xd4, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x0e, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x0d, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x04, 0x6f,
+	0x69, 0x64, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x48,
+	0x00, 0x52, 0x04, 0x6f, 0x69, 0x64, 0x63, 0x12, 0x3d, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x48, 0x00, 0x52,
+	0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x1d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
 	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x63,
 	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x65,
 	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6c, 0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b,
-	0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2f, 0x76, 0x31, 0x3b,
-	0x61, 0x75, 0x74, 0x68, 0x6e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x0e, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72,
+	0x52, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x42,
+	0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x98, 0x02, 0x0a, 0x0d, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x09, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x32,
+	0x00, 0x52, 0x08, 0x63, 0x61, 0x63, 0x68, 0x65, 0x54, 0x74, 0x6c, 0x12, 0x4f, 0x0a, 0x07, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01,
+	0x02, 0x08, 0x01, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0d,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61,
+	0x6c, 0x65, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x63, 0x68, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0xfb, 0x01, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x06, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x12,
+	0x4b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x45, 0x0a, 0x04,
+	0x68, 0x74, 0x74, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x63, 0x6c, 0x75,
+	0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x54, 0x54, 0x50,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x48, 0x00, 0x52, 0x04, 0x68,
+	0x74, 0x74, 0x70, 0x42, 0x0b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01,
+	0x22, 0xac, 0x02, 0x0a, 0x11, 0x47, 0x69, 0x74, 0x48, 0x75, 0x62, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4c, 0x0a, 0x05,
+	0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x74,
+	0x48, 0x75, 0x62, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x54,
+	0x65, 0x61, 0x6d, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x0e, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x0d, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x1a, 0x50, 0x0a,
+	0x04, 0x54, 0x65, 0x61, 0x6d, 0x12, 0x2b, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x20, 0x01, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22,
+	0x9b, 0x02, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x55, 0x0a, 0x06, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x63, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x1a, 0x25, 0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x74, 0x0a, 0x0b, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x4f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x80, 0x02,
+	0x0a, 0x0f, 0x48, 0x54, 0x54, 0x50, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x1a, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x72, 0x03, 0x88, 0x01, 0x01, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x56, 0x0a,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c,
+	0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x54, 0x54, 0x50, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
+	0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x61,
+	0x75, 0x74, 0x68, 0x6e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -252,18 +1202,49 @@ func file_config_service_authn_v1_authn_proto_rawDescGZIP() []byte {
 	return file_config_service_authn_v1_authn_proto_rawDescData
 }
 
-var file_config_service_authn_v1_authn_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_config_service_authn_v1_authn_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_config_service_authn_v1_authn_proto_goTypes = []interface{}{
-	(*OIDC)(nil),   // 0: clutch.config.service.authn.v1.OIDC
-	(*Config)(nil), // 1: clutch.config.service.authn.v1.Config
+	(*OIDC)(nil),                      // 0: clutch.config.service.authn.v1.OIDC
+	(*Local)(nil),                     // 1: clutch.config.service.authn.v1.Local
+	(*Config)(nil),                    // 2: clutch.config.service.authn.v1.Config
+	(*GroupResolver)(nil),             // 3: clutch.config.service.authn.v1.GroupResolver
+	(*GroupSource)(nil),               // 4: clutch.config.service.authn.v1.GroupSource
+	(*GitHubGroupSource)(nil),         // 5: clutch.config.service.authn.v1.GitHubGroupSource
+	(*StaticGroupSource)(nil),         // 6: clutch.config.service.authn.v1.StaticGroupSource
+	(*HTTPGroupSource)(nil),           // 7: clutch.config.service.authn.v1.HTTPGroupSource
+	(*Local_User)(nil),                // 8: clutch.config.service.authn.v1.Local.User
+	(*Local_StaticToken)(nil),         // 9: clutch.config.service.authn.v1.Local.StaticToken
+	(*Local_LoginLimits)(nil),         // 10: clutch.config.service.authn.v1.Local.LoginLimits
+	(*GitHubGroupSource_Team)(nil),    // 11: clutch.config.service.authn.v1.GitHubGroupSource.Team
+	(*StaticGroupSource_Members)(nil), // 12: clutch.config.service.authn.v1.StaticGroupSource.Members
+	nil,                               // 13: clutch.config.service.authn.v1.StaticGroupSource.GroupsEntry
+	nil,                               // 14: clutch.config.service.authn.v1.HTTPGroupSource.HeadersEntry
+	(*durationpb.Duration)(nil),       // 15: google.protobuf.Duration
 }
 var file_config_service_authn_v1_authn_proto_depIdxs = []int32{
-	0, // 0: clutch.config.service.authn.v1.Config.oidc:type_name -> clutch.config.service.authn.v1.OIDC
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	8,  // 0: clutch.config.service.authn.v1.Local.users:type_name -> clutch.config.service.authn.v1.Local.User
+	9,  // 1: clutch.config.service.authn.v1.Local.static_tokens:type_name -> clutch.config.service.authn.v1.Local.StaticToken
+	10, // 2: clutch.config.service.authn.v1.Local.login_limits:type_name -> clutch.config.service.authn.v1.Local.LoginLimits
+	0,  // 3: clutch.config.service.authn.v1.Config.oidc:type_name -> clutch.config.service.authn.v1.OIDC
+	1,  // 4: clutch.config.service.authn.v1.Config.local:type_name -> clutch.config.service.authn.v1.Local
+	3,  // 5: clutch.config.service.authn.v1.Config.group_resolver:type_name -> clutch.config.service.authn.v1.GroupResolver
+	15, // 6: clutch.config.service.authn.v1.GroupResolver.cache_ttl:type_name -> google.protobuf.Duration
+	4,  // 7: clutch.config.service.authn.v1.GroupResolver.sources:type_name -> clutch.config.service.authn.v1.GroupSource
+	15, // 8: clutch.config.service.authn.v1.GroupResolver.max_staleness:type_name -> google.protobuf.Duration
+	5,  // 9: clutch.config.service.authn.v1.GroupSource.github:type_name -> clutch.config.service.authn.v1.GitHubGroupSource
+	6,  // 10: clutch.config.service.authn.v1.GroupSource.static:type_name -> clutch.config.service.authn.v1.StaticGroupSource
+	7,  // 11: clutch.config.service.authn.v1.GroupSource.http:type_name -> clutch.config.service.authn.v1.HTTPGroupSource
+	11, // 12: clutch.config.service.authn.v1.GitHubGroupSource.teams:type_name -> clutch.config.service.authn.v1.GitHubGroupSource.Team
+	13, // 13: clutch.config.service.authn.v1.StaticGroupSource.groups:type_name -> clutch.config.service.authn.v1.StaticGroupSource.GroupsEntry
+	14, // 14: clutch.config.service.authn.v1.HTTPGroupSource.headers:type_name -> clutch.config.service.authn.v1.HTTPGroupSource.HeadersEntry
+	15, // 15: clutch.config.service.authn.v1.HTTPGroupSource.timeout:type_name -> google.protobuf.Duration
+	15, // 16: clutch.config.service.authn.v1.Local.LoginLimits.window:type_name -> google.protobuf.Duration
+	12, // 17: clutch.config.service.authn.v1.StaticGroupSource.GroupsEntry.value:type_name -> clutch.config.service.authn.v1.StaticGroupSource.Members
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_config_service_authn_v1_authn_proto_init() }
@@ -285,6 +1266,18 @@ func file_config_service_authn_v1_authn_proto_init() {
 			}
 		}
 		file_config_service_authn_v1_authn_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Local); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config); i {
 			case 0:
 				return &v.state
@@ -296,9 +1289,135 @@ func file_config_service_authn_v1_authn_proto_init() {
 				return nil
 			}
 		}
+		file_config_service_authn_v1_authn_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupResolver); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitHubGroupSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StaticGroupSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPGroupSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Local_User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Local_StaticToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Local_LoginLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GitHubGroupSource_Team); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_authn_v1_authn_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StaticGroupSource_Members); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_config_service_authn_v1_authn_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_config_service_authn_v1_authn_proto_msgTypes[2].OneofWrappers = []interface{}{
 		(*Config_Oidc)(nil),
+		(*Config_Local)(nil),
+	}
+	file_config_service_authn_v1_authn_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*GroupSource_Github)(nil),
+		(*GroupSource_Static)(nil),
+		(*GroupSource_Http)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -306,7 +1425,7 @@ func file_config_service_authn_v1_authn_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_service_authn_v1_authn_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
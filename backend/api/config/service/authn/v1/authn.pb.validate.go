@@ -179,6 +179,205 @@ var _ interface {
 	ErrorName() string
 } = OIDCValidationError{}
 
+// Validate checks the field values on Local with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Local) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Local with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LocalMultiError, or nil if none found.
+func (m *Local) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Local) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LocalValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LocalValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LocalValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for UsersFile
+
+	// no validation rules for DatabaseUsers
+
+	for idx, item := range m.GetStaticTokens() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LocalValidationError{
+						field:  fmt.Sprintf("StaticTokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LocalValidationError{
+						field:  fmt.Sprintf("StaticTokens[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LocalValidationError{
+					field:  fmt.Sprintf("StaticTokens[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetLoginLimits()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LocalValidationError{
+					field:  "LoginLimits",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LocalValidationError{
+					field:  "LoginLimits",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLoginLimits()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LocalValidationError{
+				field:  "LoginLimits",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LocalMultiError(errors)
+	}
+
+	return nil
+}
+
+// LocalMultiError is an error wrapping multiple validation errors returned by
+// Local.ValidateAll() if the designated constraints aren't met.
+type LocalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LocalMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LocalMultiError) AllErrors() []error { return m }
+
+// LocalValidationError is the validation error returned by Local.Validate if
+// the designated constraints aren't met.
+type LocalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocalValidationError) ErrorName() string { return "LocalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LocalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocal.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocalValidationError{}
+
 // Validate checks the field values on Config with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -213,6 +412,35 @@ func (m *Config) validate(all bool) error {
 
 	// no validation rules for EnableServiceTokenCreation
 
+	if all {
+		switch v := interface{}(m.GetGroupResolver()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "GroupResolver",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "GroupResolver",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGroupResolver()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "GroupResolver",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	switch v := m.Type.(type) {
 	case *Config_Oidc:
 		if v == nil {
@@ -255,6 +483,47 @@ func (m *Config) validate(all bool) error {
 			}
 		}
 
+	case *Config_Local:
+		if v == nil {
+			err := ConfigValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetLocal()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "Local",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  "Local",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLocal()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  "Local",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -335,3 +604,1512 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ConfigValidationError{}
+
+// Validate checks the field values on GroupResolver with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GroupResolver) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupResolver with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GroupResolverMultiError, or
+// nil if none found.
+func (m *GroupResolver) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupResolver) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if d := m.GetCacheTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = GroupResolverValidationError{
+				field:  "CacheTtl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := GroupResolverValidationError{
+					field:  "CacheTtl",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(m.GetSources()) < 1 {
+		err := GroupResolverValidationError{
+			field:  "Sources",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GroupResolverValidationError{
+						field:  fmt.Sprintf("Sources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GroupResolverValidationError{
+						field:  fmt.Sprintf("Sources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GroupResolverValidationError{
+					field:  fmt.Sprintf("Sources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if d := m.GetMaxStaleness(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = GroupResolverValidationError{
+				field:  "MaxStaleness",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := GroupResolverValidationError{
+					field:  "MaxStaleness",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for MaxCacheEntries
+
+	if len(errors) > 0 {
+		return GroupResolverMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupResolverMultiError is an error wrapping multiple validation errors
+// returned by GroupResolver.ValidateAll() if the designated constraints
+// aren't met.
+type GroupResolverMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupResolverMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupResolverMultiError) AllErrors() []error { return m }
+
+// GroupResolverValidationError is the validation error returned by
+// GroupResolver.Validate if the designated constraints aren't met.
+type GroupResolverValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupResolverValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupResolverValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupResolverValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupResolverValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupResolverValidationError) ErrorName() string { return "GroupResolverValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupResolverValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupResolver.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupResolverValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupResolverValidationError{}
+
+// Validate checks the field values on GroupSource with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GroupSource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GroupSource with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GroupSourceMultiError, or
+// nil if none found.
+func (m *GroupSource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GroupSource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofTypePresent := false
+	switch v := m.Type.(type) {
+	case *GroupSource_Github:
+		if v == nil {
+			err := GroupSourceValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTypePresent = true
+
+		if all {
+			switch v := interface{}(m.GetGithub()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GroupSourceValidationError{
+						field:  "Github",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GroupSourceValidationError{
+						field:  "Github",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGithub()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GroupSourceValidationError{
+					field:  "Github",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GroupSource_Static:
+		if v == nil {
+			err := GroupSourceValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTypePresent = true
+
+		if all {
+			switch v := interface{}(m.GetStatic()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GroupSourceValidationError{
+						field:  "Static",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GroupSourceValidationError{
+						field:  "Static",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStatic()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GroupSourceValidationError{
+					field:  "Static",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GroupSource_Http:
+		if v == nil {
+			err := GroupSourceValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTypePresent = true
+
+		if all {
+			switch v := interface{}(m.GetHttp()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GroupSourceValidationError{
+						field:  "Http",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GroupSourceValidationError{
+						field:  "Http",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHttp()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GroupSourceValidationError{
+					field:  "Http",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofTypePresent {
+		err := GroupSourceValidationError{
+			field:  "Type",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GroupSourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// GroupSourceMultiError is an error wrapping multiple validation errors
+// returned by GroupSource.ValidateAll() if the designated constraints aren't met.
+type GroupSourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GroupSourceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GroupSourceMultiError) AllErrors() []error { return m }
+
+// GroupSourceValidationError is the validation error returned by
+// GroupSource.Validate if the designated constraints aren't met.
+type GroupSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GroupSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GroupSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GroupSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GroupSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GroupSourceValidationError) ErrorName() string { return "GroupSourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GroupSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGroupSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GroupSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GroupSourceValidationError{}
+
+// Validate checks the field values on GitHubGroupSource with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GitHubGroupSource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GitHubGroupSource with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GitHubGroupSourceMultiError, or nil if none found.
+func (m *GitHubGroupSource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GitHubGroupSource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTeams() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GitHubGroupSourceValidationError{
+						field:  fmt.Sprintf("Teams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GitHubGroupSourceValidationError{
+						field:  fmt.Sprintf("Teams[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GitHubGroupSourceValidationError{
+					field:  fmt.Sprintf("Teams[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetSubjectSuffix()) < 1 {
+		err := GitHubGroupSourceValidationError{
+			field:  "SubjectSuffix",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for GroupPrefix
+
+	if len(errors) > 0 {
+		return GitHubGroupSourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// GitHubGroupSourceMultiError is an error wrapping multiple validation errors
+// returned by GitHubGroupSource.ValidateAll() if the designated constraints
+// aren't met.
+type GitHubGroupSourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GitHubGroupSourceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GitHubGroupSourceMultiError) AllErrors() []error { return m }
+
+// GitHubGroupSourceValidationError is the validation error returned by
+// GitHubGroupSource.Validate if the designated constraints aren't met.
+type GitHubGroupSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GitHubGroupSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GitHubGroupSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GitHubGroupSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GitHubGroupSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GitHubGroupSourceValidationError) ErrorName() string {
+	return "GitHubGroupSourceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GitHubGroupSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGitHubGroupSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GitHubGroupSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GitHubGroupSourceValidationError{}
+
+// Validate checks the field values on StaticGroupSource with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StaticGroupSource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StaticGroupSource with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StaticGroupSourceMultiError, or nil if none found.
+func (m *StaticGroupSource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StaticGroupSource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	{
+		sorted_keys := make([]string, len(m.GetGroups()))
+		i := 0
+		for key := range m.GetGroups() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetGroups()[key]
+			_ = val
+
+			// no validation rules for Groups[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, StaticGroupSourceValidationError{
+							field:  fmt.Sprintf("Groups[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, StaticGroupSourceValidationError{
+							field:  fmt.Sprintf("Groups[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return StaticGroupSourceValidationError{
+						field:  fmt.Sprintf("Groups[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return StaticGroupSourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// StaticGroupSourceMultiError is an error wrapping multiple validation errors
+// returned by StaticGroupSource.ValidateAll() if the designated constraints
+// aren't met.
+type StaticGroupSourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StaticGroupSourceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StaticGroupSourceMultiError) AllErrors() []error { return m }
+
+// StaticGroupSourceValidationError is the validation error returned by
+// StaticGroupSource.Validate if the designated constraints aren't met.
+type StaticGroupSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StaticGroupSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StaticGroupSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StaticGroupSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StaticGroupSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StaticGroupSourceValidationError) ErrorName() string {
+	return "StaticGroupSourceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StaticGroupSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStaticGroupSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StaticGroupSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StaticGroupSourceValidationError{}
+
+// Validate checks the field values on HTTPGroupSource with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HTTPGroupSource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HTTPGroupSource with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HTTPGroupSourceMultiError, or nil if none found.
+func (m *HTTPGroupSource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HTTPGroupSource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = HTTPGroupSourceValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := HTTPGroupSourceValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Headers
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = HTTPGroupSourceValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := HTTPGroupSourceValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return HTTPGroupSourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// HTTPGroupSourceMultiError is an error wrapping multiple validation errors
+// returned by HTTPGroupSource.ValidateAll() if the designated constraints
+// aren't met.
+type HTTPGroupSourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HTTPGroupSourceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HTTPGroupSourceMultiError) AllErrors() []error { return m }
+
+// HTTPGroupSourceValidationError is the validation error returned by
+// HTTPGroupSource.Validate if the designated constraints aren't met.
+type HTTPGroupSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HTTPGroupSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HTTPGroupSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HTTPGroupSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HTTPGroupSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HTTPGroupSourceValidationError) ErrorName() string { return "HTTPGroupSourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HTTPGroupSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHTTPGroupSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HTTPGroupSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HTTPGroupSourceValidationError{}
+
+// Validate checks the field values on Local_User with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Local_User) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Local_User with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Local_UserMultiError, or
+// nil if none found.
+func (m *Local_User) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Local_User) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUsername()) < 1 {
+		err := Local_UserValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetPasswordHash()) < 1 {
+		err := Local_UserValidationError{
+			field:  "PasswordHash",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return Local_UserMultiError(errors)
+	}
+
+	return nil
+}
+
+// Local_UserMultiError is an error wrapping multiple validation errors
+// returned by Local_User.ValidateAll() if the designated constraints aren't met.
+type Local_UserMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Local_UserMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Local_UserMultiError) AllErrors() []error { return m }
+
+// Local_UserValidationError is the validation error returned by
+// Local_User.Validate if the designated constraints aren't met.
+type Local_UserValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Local_UserValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Local_UserValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Local_UserValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Local_UserValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Local_UserValidationError) ErrorName() string { return "Local_UserValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Local_UserValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocal_User.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Local_UserValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Local_UserValidationError{}
+
+// Validate checks the field values on Local_StaticToken with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Local_StaticToken) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Local_StaticToken with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Local_StaticTokenMultiError, or nil if none found.
+func (m *Local_StaticToken) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Local_StaticToken) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetToken()) < 32 {
+		err := Local_StaticTokenValidationError{
+			field:  "Token",
+			reason: "value length must be at least 32 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSubject()) < 1 {
+		err := Local_StaticTokenValidationError{
+			field:  "Subject",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return Local_StaticTokenMultiError(errors)
+	}
+
+	return nil
+}
+
+// Local_StaticTokenMultiError is an error wrapping multiple validation errors
+// returned by Local_StaticToken.ValidateAll() if the designated constraints
+// aren't met.
+type Local_StaticTokenMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Local_StaticTokenMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Local_StaticTokenMultiError) AllErrors() []error { return m }
+
+// Local_StaticTokenValidationError is the validation error returned by
+// Local_StaticToken.Validate if the designated constraints aren't met.
+type Local_StaticTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Local_StaticTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Local_StaticTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Local_StaticTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Local_StaticTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Local_StaticTokenValidationError) ErrorName() string {
+	return "Local_StaticTokenValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Local_StaticTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocal_StaticToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Local_StaticTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Local_StaticTokenValidationError{}
+
+// Validate checks the field values on Local_LoginLimits with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Local_LoginLimits) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Local_LoginLimits with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Local_LoginLimitsMultiError, or nil if none found.
+func (m *Local_LoginLimits) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Local_LoginLimits) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxFailuresPerUsername
+
+	// no validation rules for MaxFailuresPerIp
+
+	if d := m.GetWindow(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = Local_LoginLimitsValidationError{
+				field:  "Window",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := Local_LoginLimitsValidationError{
+					field:  "Window",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for TrustedProxyHops
+
+	if len(errors) > 0 {
+		return Local_LoginLimitsMultiError(errors)
+	}
+
+	return nil
+}
+
+// Local_LoginLimitsMultiError is an error wrapping multiple validation errors
+// returned by Local_LoginLimits.ValidateAll() if the designated constraints
+// aren't met.
+type Local_LoginLimitsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Local_LoginLimitsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Local_LoginLimitsMultiError) AllErrors() []error { return m }
+
+// Local_LoginLimitsValidationError is the validation error returned by
+// Local_LoginLimits.Validate if the designated constraints aren't met.
+type Local_LoginLimitsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Local_LoginLimitsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Local_LoginLimitsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Local_LoginLimitsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Local_LoginLimitsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Local_LoginLimitsValidationError) ErrorName() string {
+	return "Local_LoginLimitsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Local_LoginLimitsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocal_LoginLimits.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Local_LoginLimitsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Local_LoginLimitsValidationError{}
+
+// Validate checks the field values on GitHubGroupSource_Team with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GitHubGroupSource_Team) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GitHubGroupSource_Team with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GitHubGroupSource_TeamMultiError, or nil if none found.
+func (m *GitHubGroupSource_Team) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GitHubGroupSource_Team) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetOrganization()) < 1 {
+		err := GitHubGroupSource_TeamValidationError{
+			field:  "Organization",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSlug()) < 1 {
+		err := GitHubGroupSource_TeamValidationError{
+			field:  "Slug",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GitHubGroupSource_TeamMultiError(errors)
+	}
+
+	return nil
+}
+
+// GitHubGroupSource_TeamMultiError is an error wrapping multiple validation
+// errors returned by GitHubGroupSource_Team.ValidateAll() if the designated
+// constraints aren't met.
+type GitHubGroupSource_TeamMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GitHubGroupSource_TeamMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GitHubGroupSource_TeamMultiError) AllErrors() []error { return m }
+
+// GitHubGroupSource_TeamValidationError is the validation error returned by
+// GitHubGroupSource_Team.Validate if the designated constraints aren't met.
+type GitHubGroupSource_TeamValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GitHubGroupSource_TeamValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GitHubGroupSource_TeamValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GitHubGroupSource_TeamValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GitHubGroupSource_TeamValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GitHubGroupSource_TeamValidationError) ErrorName() string {
+	return "GitHubGroupSource_TeamValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GitHubGroupSource_TeamValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGitHubGroupSource_Team.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GitHubGroupSource_TeamValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GitHubGroupSource_TeamValidationError{}
+
+// Validate checks the field values on StaticGroupSource_Members with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StaticGroupSource_Members) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StaticGroupSource_Members with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StaticGroupSource_MembersMultiError, or nil if none found.
+func (m *StaticGroupSource_Members) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StaticGroupSource_Members) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return StaticGroupSource_MembersMultiError(errors)
+	}
+
+	return nil
+}
+
+// StaticGroupSource_MembersMultiError is an error wrapping multiple validation
+// errors returned by StaticGroupSource_Members.ValidateAll() if the
+// designated constraints aren't met.
+type StaticGroupSource_MembersMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StaticGroupSource_MembersMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StaticGroupSource_MembersMultiError) AllErrors() []error { return m }
+
+// StaticGroupSource_MembersValidationError is the validation error returned by
+// StaticGroupSource_Members.Validate if the designated constraints aren't met.
+type StaticGroupSource_MembersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StaticGroupSource_MembersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StaticGroupSource_MembersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StaticGroupSource_MembersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StaticGroupSource_MembersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StaticGroupSource_MembersValidationError) ErrorName() string {
+	return "StaticGroupSource_MembersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StaticGroupSource_MembersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStaticGroupSource_Members.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StaticGroupSource_MembersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StaticGroupSource_MembersValidationError{}
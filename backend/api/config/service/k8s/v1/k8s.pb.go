@@ -37,6 +37,20 @@ type Config struct {
 	// https://github.com/kubernetes/client-go/tree/master/examples/in-cluster-client-configuration
 	Kubeconfigs      []string          `protobuf:"bytes,1,rep,name=kubeconfigs,proto3" json:"kubeconfigs,omitempty"`
 	RestClientConfig *RestClientConfig `protobuf:"bytes,2,opt,name=rest_client_config,json=restClientConfig,proto3" json:"rest_client_config,omitempty"`
+	// The resources that can be accessed with the dynamic resource APIs, e.g. custom resources. No resources are
+	// exposed if the list is empty. Secrets are never exposed, and entries that would match them are rejected.
+	DynamicResources []*DynamicResource `protobuf:"bytes,3,rep,name=dynamic_resources,json=dynamicResources,proto3" json:"dynamic_resources,omitempty"`
+	// Fields that can't be changed with PatchResource or the patches of UpdateDeployment and UpdateStatefulSet, as
+	// dot-separated field names matched anywhere in the path of a changed field, e.g. "securityContext" or
+	// "spec.hostNetwork". List indices are ignored when matching.
+	PatchDeniedFields []string `protobuf:"bytes,4,rep,name=patch_denied_fields,json=patchDeniedFields,proto3" json:"patch_denied_fields,omitempty"`
+	// Namespaces in which workloads can't be scaled to zero replicas with ScaleWorkload. "*" matches every namespace.
+	ScaleToZeroProtectedNamespaces []string              `protobuf:"bytes,5,rep,name=scale_to_zero_protected_namespaces,json=scaleToZeroProtectedNamespaces,proto3" json:"scale_to_zero_protected_namespaces,omitempty"`
+	Exec                           *ExecConfig           `protobuf:"bytes,6,opt,name=exec,proto3" json:"exec,omitempty"`
+	DebugContainers                *DebugContainerConfig `protobuf:"bytes,7,opt,name=debug_containers,json=debugContainers,proto3" json:"debug_containers,omitempty"`
+	// The key of the HMAC-SHA256 digests of secret values returned by the secret APIs. If unset, a random key is
+	// generated at startup, so digests can only be compared between responses of the same gateway instance.
+	SecretDigestKey string `protobuf:"bytes,8,opt,name=secret_digest_key,json=secretDigestKey,proto3" json:"secret_digest_key,omitempty"`
 }
 
 func (x *Config) Reset() {
@@ -85,6 +99,307 @@ func (x *Config) GetRestClientConfig() *RestClientConfig {
 	return nil
 }
 
+func (x *Config) GetDynamicResources() []*DynamicResource {
+	if x != nil {
+		return x.DynamicResources
+	}
+	return nil
+}
+
+func (x *Config) GetPatchDeniedFields() []string {
+	if x != nil {
+		return x.PatchDeniedFields
+	}
+	return nil
+}
+
+func (x *Config) GetScaleToZeroProtectedNamespaces() []string {
+	if x != nil {
+		return x.ScaleToZeroProtectedNamespaces
+	}
+	return nil
+}
+
+func (x *Config) GetExec() *ExecConfig {
+	if x != nil {
+		return x.Exec
+	}
+	return nil
+}
+
+func (x *Config) GetDebugContainers() *DebugContainerConfig {
+	if x != nil {
+		return x.DebugContainers
+	}
+	return nil
+}
+
+func (x *Config) GetSecretDigestKey() string {
+	if x != nil {
+		return x.SecretDigestKey
+	}
+	return ""
+}
+
+// A command that can be run in a container.
+type AllowedCommand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The executable, matched exactly against the first element of the command, e.g. "ps" or "/bin/cat".
+	Command string `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	// Regular expressions, one of which must match the whole of each argument, e.g. "/etc/[a-z.]+". No arguments are
+	// allowed if the list is empty.
+	Args []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+}
+
+func (x *AllowedCommand) Reset() {
+	*x = AllowedCommand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_k8s_v1_k8s_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllowedCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowedCommand) ProtoMessage() {}
+
+func (x *AllowedCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_k8s_v1_k8s_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllowedCommand.ProtoReflect.Descriptor instead.
+func (*AllowedCommand) Descriptor() ([]byte, []int) {
+	return file_config_service_k8s_v1_k8s_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AllowedCommand) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *AllowedCommand) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+type ExecConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The commands that can be run with ExecPod. No commands can be run if the list is empty.
+	AllowedCommands []*AllowedCommand `protobuf:"bytes,1,rep,name=allowed_commands,json=allowedCommands,proto3" json:"allowed_commands,omitempty"`
+	// How long a command can run, 30 seconds by default.
+	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// The maximum number of bytes of stdout and of stderr that are returned, 1 MiB by default.
+	MaxOutputBytes uint32 `protobuf:"varint,3,opt,name=max_output_bytes,json=maxOutputBytes,proto3" json:"max_output_bytes,omitempty"`
+	// How many commands can run at the same time on each gateway instance, 10 by default. Further commands are rejected
+	// until one of the running commands finishes or times out.
+	MaxConcurrent uint32 `protobuf:"varint,4,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
+}
+
+func (x *ExecConfig) Reset() {
+	*x = ExecConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_k8s_v1_k8s_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecConfig) ProtoMessage() {}
+
+func (x *ExecConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_k8s_v1_k8s_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecConfig.ProtoReflect.Descriptor instead.
+func (*ExecConfig) Descriptor() ([]byte, []int) {
+	return file_config_service_k8s_v1_k8s_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExecConfig) GetAllowedCommands() []*AllowedCommand {
+	if x != nil {
+		return x.AllowedCommands
+	}
+	return nil
+}
+
+func (x *ExecConfig) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *ExecConfig) GetMaxOutputBytes() uint32 {
+	if x != nil {
+		return x.MaxOutputBytes
+	}
+	return 0
+}
+
+func (x *ExecConfig) GetMaxConcurrent() uint32 {
+	if x != nil {
+		return x.MaxConcurrent
+	}
+	return 0
+}
+
+type DebugContainerConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The images that debug containers can run, e.g. "busybox:1.36". No debug containers can be added if the list is
+	// empty.
+	AllowedImages []string `protobuf:"bytes,1,rep,name=allowed_images,json=allowedImages,proto3" json:"allowed_images,omitempty"`
+	// The commands that debug containers can run. Only the entrypoint of the image can be run if the list is empty.
+	AllowedCommands []*AllowedCommand `protobuf:"bytes,2,rep,name=allowed_commands,json=allowedCommands,proto3" json:"allowed_commands,omitempty"`
+}
+
+func (x *DebugContainerConfig) Reset() {
+	*x = DebugContainerConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_k8s_v1_k8s_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugContainerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugContainerConfig) ProtoMessage() {}
+
+func (x *DebugContainerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_k8s_v1_k8s_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugContainerConfig.ProtoReflect.Descriptor instead.
+func (*DebugContainerConfig) Descriptor() ([]byte, []int) {
+	return file_config_service_k8s_v1_k8s_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DebugContainerConfig) GetAllowedImages() []string {
+	if x != nil {
+		return x.AllowedImages
+	}
+	return nil
+}
+
+func (x *DebugContainerConfig) GetAllowedCommands() []*AllowedCommand {
+	if x != nil {
+		return x.AllowedCommands
+	}
+	return nil
+}
+
+// A group, version and resource, e.g. group "argoproj.io", version "v1alpha1" and resource "rollouts". Any field may
+// be "*" to match every value.
+type DynamicResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Empty for the core group.
+	Group    string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Version  string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Resource string `protobuf:"bytes,3,opt,name=resource,proto3" json:"resource,omitempty"`
+}
+
+func (x *DynamicResource) Reset() {
+	*x = DynamicResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_k8s_v1_k8s_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DynamicResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicResource) ProtoMessage() {}
+
+func (x *DynamicResource) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_k8s_v1_k8s_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicResource.ProtoReflect.Descriptor instead.
+func (*DynamicResource) Descriptor() ([]byte, []int) {
+	return file_config_service_k8s_v1_k8s_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DynamicResource) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *DynamicResource) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *DynamicResource) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
 // These configuration values are passed directly through to the rest config object.
 type RestClientConfig struct {
 	state         protoimpl.MessageState
@@ -108,7 +423,7 @@ type RestClientConfig struct {
 func (x *RestClientConfig) Reset() {
 	*x = RestClientConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_service_k8s_v1_k8s_proto_msgTypes[1]
+		mi := &file_config_service_k8s_v1_k8s_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -121,7 +436,7 @@ func (x *RestClientConfig) String() string {
 func (*RestClientConfig) ProtoMessage() {}
 
 func (x *RestClientConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_service_k8s_v1_k8s_proto_msgTypes[1]
+	mi := &file_config_service_k8s_v1_k8s_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -134,7 +449,7 @@ func (x *RestClientConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestClientConfig.ProtoReflect.Descriptor instead.
 func (*RestClientConfig) Descriptor() ([]byte, []int) {
-	return file_config_service_k8s_v1_k8s_proto_rawDescGZIP(), []int{1}
+	return file_config_service_k8s_v1_k8s_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *RestClientConfig) GetTimeout() *durationpb.Duration {
@@ -168,7 +483,7 @@ var file_config_service_k8s_v1_k8s_proto_rawDesc = []byte{
 	0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
 	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd3, 0x04, 0x0a, 0x06, 0x43, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x0b, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02,
 	0x18, 0x01, 0x52, 0x0b, 0x6b, 0x75, 0x62, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12,
@@ -177,21 +492,87 @@ var file_config_service_k8s_v1_k8s_proto_rawDesc = []byte{
 	0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76,
 	0x69, 0x63, 0x65, 0x2e, 0x6b, 0x38, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x43,
 	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x10, 0x72, 0x65, 0x73,
-	0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x90, 0x01,
-	0x0a, 0x10, 0x52, 0x65, 0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x3f, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a,
-	0xfa, 0x42, 0x07, 0xaa, 0x01, 0x04, 0x32, 0x02, 0x08, 0x00, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x71, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
-	0x42, 0x0a, 0xfa, 0x42, 0x07, 0x0a, 0x05, 0x2d, 0x00, 0x00, 0x00, 0x00, 0x52, 0x03, 0x71, 0x70,
-	0x73, 0x12, 0x1d, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x28, 0x00, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74,
-	0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
-	0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x6b, 0x38, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x6b, 0x38, 0x73,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5a, 0x0a,
+	0x11, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x6b, 0x38, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x10, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x13, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x92, 0x01, 0x08, 0x18, 0x01,
+	0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x11, 0x70, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e,
+	0x69, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x5a, 0x0a, 0x22, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x92, 0x01, 0x08, 0x18, 0x01, 0x22,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x1e, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x54, 0x6f, 0x5a, 0x65,
+	0x72, 0x6f, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x04, 0x65, 0x78, 0x65, 0x63, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6b, 0x38, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x04, 0x65,
+	0x78, 0x65, 0x63, 0x12, 0x5d, 0x0a, 0x10, 0x64, 0x65, 0x62, 0x75, 0x67, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6b, 0x38, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x0f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x64, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x22, 0x47,
+	0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x12, 0x21, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0xf5, 0x01, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x57, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6b, 0x38, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x0f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12,
+	0x3d, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x28,
+	0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f,
+	0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22,
+	0xa6, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x62, 0x75, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x92, 0x01, 0x08, 0x18, 0x01, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x57, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x6b, 0x38, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x6f, 0x0a, 0x0f, 0x44, 0x79, 0x6e, 0x61,
+	0x6d, 0x69, 0x63, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x21, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x10, 0x52, 0x65,
+	0x73, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3d,
+	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa,
+	0x01, 0x02, 0x32, 0x00, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a,
+	0x03, 0x71, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x0a,
+	0x05, 0x2d, 0x00, 0x00, 0x00, 0x00, 0x52, 0x03, 0x71, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x05, 0x62,
+	0x75, 0x72, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a,
+	0x02, 0x28, 0x00, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
+	0x6b, 0x38, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x6b, 0x38, 0x73, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -206,20 +587,30 @@ func file_config_service_k8s_v1_k8s_proto_rawDescGZIP() []byte {
 	return file_config_service_k8s_v1_k8s_proto_rawDescData
 }
 
-var file_config_service_k8s_v1_k8s_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_config_service_k8s_v1_k8s_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_config_service_k8s_v1_k8s_proto_goTypes = []interface{}{
-	(*Config)(nil),              // 0: clutch.config.service.k8s.v1.Config
-	(*RestClientConfig)(nil),    // 1: clutch.config.service.k8s.v1.RestClientConfig
-	(*durationpb.Duration)(nil), // 2: google.protobuf.Duration
+	(*Config)(nil),               // 0: clutch.config.service.k8s.v1.Config
+	(*AllowedCommand)(nil),       // 1: clutch.config.service.k8s.v1.AllowedCommand
+	(*ExecConfig)(nil),           // 2: clutch.config.service.k8s.v1.ExecConfig
+	(*DebugContainerConfig)(nil), // 3: clutch.config.service.k8s.v1.DebugContainerConfig
+	(*DynamicResource)(nil),      // 4: clutch.config.service.k8s.v1.DynamicResource
+	(*RestClientConfig)(nil),     // 5: clutch.config.service.k8s.v1.RestClientConfig
+	(*durationpb.Duration)(nil),  // 6: google.protobuf.Duration
 }
 var file_config_service_k8s_v1_k8s_proto_depIdxs = []int32{
-	1, // 0: clutch.config.service.k8s.v1.Config.rest_client_config:type_name -> clutch.config.service.k8s.v1.RestClientConfig
-	2, // 1: clutch.config.service.k8s.v1.RestClientConfig.timeout:type_name -> google.protobuf.Duration
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 0: clutch.config.service.k8s.v1.Config.rest_client_config:type_name -> clutch.config.service.k8s.v1.RestClientConfig
+	4, // 1: clutch.config.service.k8s.v1.Config.dynamic_resources:type_name -> clutch.config.service.k8s.v1.DynamicResource
+	2, // 2: clutch.config.service.k8s.v1.Config.exec:type_name -> clutch.config.service.k8s.v1.ExecConfig
+	3, // 3: clutch.config.service.k8s.v1.Config.debug_containers:type_name -> clutch.config.service.k8s.v1.DebugContainerConfig
+	1, // 4: clutch.config.service.k8s.v1.ExecConfig.allowed_commands:type_name -> clutch.config.service.k8s.v1.AllowedCommand
+	6, // 5: clutch.config.service.k8s.v1.ExecConfig.timeout:type_name -> google.protobuf.Duration
+	1, // 6: clutch.config.service.k8s.v1.DebugContainerConfig.allowed_commands:type_name -> clutch.config.service.k8s.v1.AllowedCommand
+	6, // 7: clutch.config.service.k8s.v1.RestClientConfig.timeout:type_name -> google.protobuf.Duration
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_config_service_k8s_v1_k8s_proto_init() }
@@ -241,6 +632,54 @@ func file_config_service_k8s_v1_k8s_proto_init() {
 			}
 		}
 		file_config_service_k8s_v1_k8s_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllowedCommand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_k8s_v1_k8s_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_k8s_v1_k8s_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugContainerConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_k8s_v1_k8s_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DynamicResource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_k8s_v1_k8s_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RestClientConfig); i {
 			case 0:
 				return &v.state
@@ -259,7 +698,7 @@ func file_config_service_k8s_v1_k8s_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_service_k8s_v1_k8s_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -106,6 +106,162 @@ func (m *Config) validate(all bool) error {
 		}
 	}
 
+	for idx, item := range m.GetDynamicResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  fmt.Sprintf("DynamicResources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigValidationError{
+						field:  fmt.Sprintf("DynamicResources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigValidationError{
+					field:  fmt.Sprintf("DynamicResources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	_Config_PatchDeniedFields_Unique := make(map[string]struct{}, len(m.GetPatchDeniedFields()))
+
+	for idx, item := range m.GetPatchDeniedFields() {
+		_, _ = idx, item
+
+		if _, exists := _Config_PatchDeniedFields_Unique[item]; exists {
+			err := ConfigValidationError{
+				field:  fmt.Sprintf("PatchDeniedFields[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_Config_PatchDeniedFields_Unique[item] = struct{}{}
+		}
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := ConfigValidationError{
+				field:  fmt.Sprintf("PatchDeniedFields[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	_Config_ScaleToZeroProtectedNamespaces_Unique := make(map[string]struct{}, len(m.GetScaleToZeroProtectedNamespaces()))
+
+	for idx, item := range m.GetScaleToZeroProtectedNamespaces() {
+		_, _ = idx, item
+
+		if _, exists := _Config_ScaleToZeroProtectedNamespaces_Unique[item]; exists {
+			err := ConfigValidationError{
+				field:  fmt.Sprintf("ScaleToZeroProtectedNamespaces[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_Config_ScaleToZeroProtectedNamespaces_Unique[item] = struct{}{}
+		}
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := ConfigValidationError{
+				field:  fmt.Sprintf("ScaleToZeroProtectedNamespaces[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetExec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Exec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "Exec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "Exec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDebugContainers()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "DebugContainers",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "DebugContainers",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDebugContainers()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "DebugContainers",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for SecretDigestKey
+
 	if len(errors) > 0 {
 		return ConfigMultiError(errors)
 	}
@@ -183,6 +339,575 @@ var _ interface {
 	ErrorName() string
 } = ConfigValidationError{}
 
+// Validate checks the field values on AllowedCommand with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AllowedCommand) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AllowedCommand with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AllowedCommandMultiError,
+// or nil if none found.
+func (m *AllowedCommand) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AllowedCommand) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetCommand()) < 1 {
+		err := AllowedCommandValidationError{
+			field:  "Command",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AllowedCommandMultiError(errors)
+	}
+
+	return nil
+}
+
+// AllowedCommandMultiError is an error wrapping multiple validation errors
+// returned by AllowedCommand.ValidateAll() if the designated constraints
+// aren't met.
+type AllowedCommandMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AllowedCommandMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AllowedCommandMultiError) AllErrors() []error { return m }
+
+// AllowedCommandValidationError is the validation error returned by
+// AllowedCommand.Validate if the designated constraints aren't met.
+type AllowedCommandValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AllowedCommandValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AllowedCommandValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AllowedCommandValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AllowedCommandValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AllowedCommandValidationError) ErrorName() string { return "AllowedCommandValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AllowedCommandValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAllowedCommand.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AllowedCommandValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AllowedCommandValidationError{}
+
+// Validate checks the field values on ExecConfig with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExecConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExecConfigMultiError, or
+// nil if none found.
+func (m *ExecConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAllowedCommands() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExecConfigValidationError{
+						field:  fmt.Sprintf("AllowedCommands[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExecConfigValidationError{
+						field:  fmt.Sprintf("AllowedCommands[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExecConfigValidationError{
+					field:  fmt.Sprintf("AllowedCommands[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ExecConfigValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := ExecConfigValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for MaxOutputBytes
+
+	// no validation rules for MaxConcurrent
+
+	if len(errors) > 0 {
+		return ExecConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecConfigMultiError is an error wrapping multiple validation errors
+// returned by ExecConfig.ValidateAll() if the designated constraints aren't met.
+type ExecConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecConfigMultiError) AllErrors() []error { return m }
+
+// ExecConfigValidationError is the validation error returned by
+// ExecConfig.Validate if the designated constraints aren't met.
+type ExecConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecConfigValidationError) ErrorName() string { return "ExecConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExecConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecConfigValidationError{}
+
+// Validate checks the field values on DebugContainerConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DebugContainerConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DebugContainerConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DebugContainerConfigMultiError, or nil if none found.
+func (m *DebugContainerConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DebugContainerConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	_DebugContainerConfig_AllowedImages_Unique := make(map[string]struct{}, len(m.GetAllowedImages()))
+
+	for idx, item := range m.GetAllowedImages() {
+		_, _ = idx, item
+
+		if _, exists := _DebugContainerConfig_AllowedImages_Unique[item]; exists {
+			err := DebugContainerConfigValidationError{
+				field:  fmt.Sprintf("AllowedImages[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_DebugContainerConfig_AllowedImages_Unique[item] = struct{}{}
+		}
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := DebugContainerConfigValidationError{
+				field:  fmt.Sprintf("AllowedImages[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetAllowedCommands() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DebugContainerConfigValidationError{
+						field:  fmt.Sprintf("AllowedCommands[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DebugContainerConfigValidationError{
+						field:  fmt.Sprintf("AllowedCommands[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DebugContainerConfigValidationError{
+					field:  fmt.Sprintf("AllowedCommands[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DebugContainerConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// DebugContainerConfigMultiError is an error wrapping multiple validation
+// errors returned by DebugContainerConfig.ValidateAll() if the designated
+// constraints aren't met.
+type DebugContainerConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DebugContainerConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DebugContainerConfigMultiError) AllErrors() []error { return m }
+
+// DebugContainerConfigValidationError is the validation error returned by
+// DebugContainerConfig.Validate if the designated constraints aren't met.
+type DebugContainerConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DebugContainerConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DebugContainerConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DebugContainerConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DebugContainerConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DebugContainerConfigValidationError) ErrorName() string {
+	return "DebugContainerConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DebugContainerConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDebugContainerConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DebugContainerConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DebugContainerConfigValidationError{}
+
+// Validate checks the field values on DynamicResource with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DynamicResource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DynamicResource with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DynamicResourceMultiError, or nil if none found.
+func (m *DynamicResource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DynamicResource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Group
+
+	if utf8.RuneCountInString(m.GetVersion()) < 1 {
+		err := DynamicResourceValidationError{
+			field:  "Version",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetResource()) < 1 {
+		err := DynamicResourceValidationError{
+			field:  "Resource",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DynamicResourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// DynamicResourceMultiError is an error wrapping multiple validation errors
+// returned by DynamicResource.ValidateAll() if the designated constraints
+// aren't met.
+type DynamicResourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DynamicResourceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DynamicResourceMultiError) AllErrors() []error { return m }
+
+// DynamicResourceValidationError is the validation error returned by
+// DynamicResource.Validate if the designated constraints aren't met.
+type DynamicResourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DynamicResourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DynamicResourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DynamicResourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DynamicResourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DynamicResourceValidationError) ErrorName() string { return "DynamicResourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DynamicResourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDynamicResource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DynamicResourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DynamicResourceValidationError{}
+
 // Validate checks the field values on RestClientConfig with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -0,0 +1,1045 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.17.3
+// source: envoytriage/v1/diff.proto
+
+package envoytriagev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RuntimeDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*RuntimeDiff_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *RuntimeDiff) Reset() {
+	*x = RuntimeDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_diff_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuntimeDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeDiff) ProtoMessage() {}
+
+func (x *RuntimeDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_diff_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeDiff.ProtoReflect.Descriptor instead.
+func (*RuntimeDiff) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_diff_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RuntimeDiff) GetEntries() []*RuntimeDiff_Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ClustersDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Clusters that are only known to one of the hosts.
+	TargetOnly   []string `protobuf:"bytes,1,rep,name=target_only,json=targetOnly,proto3" json:"target_only,omitempty"`
+	BaselineOnly []string `protobuf:"bytes,2,rep,name=baseline_only,json=baselineOnly,proto3" json:"baseline_only,omitempty"`
+	// Clusters known to both hosts whose membership or health differs.
+	Clusters []*ClustersDiff_Cluster `protobuf:"bytes,3,rep,name=clusters,proto3" json:"clusters,omitempty"`
+}
+
+func (x *ClustersDiff) Reset() {
+	*x = ClustersDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_diff_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClustersDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClustersDiff) ProtoMessage() {}
+
+func (x *ClustersDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_diff_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClustersDiff.ProtoReflect.Descriptor instead.
+func (*ClustersDiff) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_diff_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClustersDiff) GetTargetOnly() []string {
+	if x != nil {
+		return x.TargetOnly
+	}
+	return nil
+}
+
+func (x *ClustersDiff) GetBaselineOnly() []string {
+	if x != nil {
+		return x.BaselineOnly
+	}
+	return nil
+}
+
+func (x *ClustersDiff) GetClusters() []*ClustersDiff_Cluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+type ListenersDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetOnly   []*ListenerStatus `protobuf:"bytes,1,rep,name=target_only,json=targetOnly,proto3" json:"target_only,omitempty"`
+	BaselineOnly []*ListenerStatus `protobuf:"bytes,2,rep,name=baseline_only,json=baselineOnly,proto3" json:"baseline_only,omitempty"`
+	// Listeners present on both hosts but bound to different addresses.
+	AddressMismatches []*ListenersDiff_AddressMismatch `protobuf:"bytes,3,rep,name=address_mismatches,json=addressMismatches,proto3" json:"address_mismatches,omitempty"`
+}
+
+func (x *ListenersDiff) Reset() {
+	*x = ListenersDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_diff_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListenersDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListenersDiff) ProtoMessage() {}
+
+func (x *ListenersDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_diff_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListenersDiff.ProtoReflect.Descriptor instead.
+func (*ListenersDiff) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_diff_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListenersDiff) GetTargetOnly() []*ListenerStatus {
+	if x != nil {
+		return x.TargetOnly
+	}
+	return nil
+}
+
+func (x *ListenersDiff) GetBaselineOnly() []*ListenerStatus {
+	if x != nil {
+		return x.BaselineOnly
+	}
+	return nil
+}
+
+func (x *ListenersDiff) GetAddressMismatches() []*ListenersDiff_AddressMismatch {
+	if x != nil {
+		return x.AddressMismatches
+	}
+	return nil
+}
+
+type StatsDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*StatsDiff_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *StatsDiff) Reset() {
+	*x = StatsDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_diff_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsDiff) ProtoMessage() {}
+
+func (x *StatsDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_diff_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsDiff.ProtoReflect.Descriptor instead.
+func (*StatsDiff) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_diff_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StatsDiff) GetEntries() []*StatsDiff_Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type ConfigDumpDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The type URL of the config dump section, e.g. "type.googleapis.com/envoy.admin.v3.ListenersConfigDump".
+	TypeUrl string                  `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	Fields  []*ConfigDumpDiff_Field `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *ConfigDumpDiff) Reset() {
+	*x = ConfigDumpDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_diff_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigDumpDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigDumpDiff) ProtoMessage() {}
+
+func (x *ConfigDumpDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_diff_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigDumpDiff.ProtoReflect.Descriptor instead.
+func (*ConfigDumpDiff) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_diff_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConfigDumpDiff) GetTypeUrl() string {
+	if x != nil {
+		return x.TypeUrl
+	}
+	return ""
+}
+
+func (x *ConfigDumpDiff) GetFields() []*ConfigDumpDiff_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type RuntimeDiff_Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Unset if the key is not present on the host.
+	Target   *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Baseline *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=baseline,proto3" json:"baseline,omitempty"`
+}
+
+func (x *RuntimeDiff_Entry) Reset() {
+	*x = RuntimeDiff_Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_diff_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuntimeDiff_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuntimeDiff_Entry) ProtoMessage() {}
+
+func (x *RuntimeDiff_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_diff_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuntimeDiff_Entry.ProtoReflect.Descriptor instead.
+func (*RuntimeDiff_Entry) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_diff_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *RuntimeDiff_Entry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RuntimeDiff_Entry) GetTarget() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *RuntimeDiff_Entry) GetBaseline() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+type ClustersDiff_HostHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address         string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	TargetHealthy   bool   `protobuf:"varint,2,opt,name=target_healthy,json=targetHealthy,proto3" json:"target_healthy,omitempty"`
+	BaselineHealthy bool   `protobuf:"varint,3,opt,name=baseline_healthy,json=baselineHealthy,proto3" json:"baseline_healthy,omitempty"`
+}
+
+func (x *ClustersDiff_HostHealth) Reset() {
+	*x = ClustersDiff_HostHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_diff_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClustersDiff_HostHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClustersDiff_HostHealth) ProtoMessage() {}
+
+func (x *ClustersDiff_HostHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_diff_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClustersDiff_HostHealth.ProtoReflect.Descriptor instead.
+func (*ClustersDiff_HostHealth) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_diff_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ClustersDiff_HostHealth) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ClustersDiff_HostHealth) GetTargetHealthy() bool {
+	if x != nil {
+		return x.TargetHealthy
+	}
+	return false
+}
+
+func (x *ClustersDiff_HostHealth) GetBaselineHealthy() bool {
+	if x != nil {
+		return x.BaselineHealthy
+	}
+	return false
+}
+
+type ClustersDiff_Cluster struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Upstream hosts that are only members of the cluster on one of the hosts.
+	TargetOnlyHosts   []*HostStatus `protobuf:"bytes,2,rep,name=target_only_hosts,json=targetOnlyHosts,proto3" json:"target_only_hosts,omitempty"`
+	BaselineOnlyHosts []*HostStatus `protobuf:"bytes,3,rep,name=baseline_only_hosts,json=baselineOnlyHosts,proto3" json:"baseline_only_hosts,omitempty"`
+	// Upstream hosts that are members on both hosts but disagree on health.
+	HealthMismatches []*ClustersDiff_HostHealth `protobuf:"bytes,4,rep,name=health_mismatches,json=healthMismatches,proto3" json:"health_mismatches,omitempty"`
+}
+
+func (x *ClustersDiff_Cluster) Reset() {
+	*x = ClustersDiff_Cluster{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_diff_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClustersDiff_Cluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClustersDiff_Cluster) ProtoMessage() {}
+
+func (x *ClustersDiff_Cluster) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_diff_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClustersDiff_Cluster.ProtoReflect.Descriptor instead.
+func (*ClustersDiff_Cluster) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_diff_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *ClustersDiff_Cluster) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClustersDiff_Cluster) GetTargetOnlyHosts() []*HostStatus {
+	if x != nil {
+		return x.TargetOnlyHosts
+	}
+	return nil
+}
+
+func (x *ClustersDiff_Cluster) GetBaselineOnlyHosts() []*HostStatus {
+	if x != nil {
+		return x.BaselineOnlyHosts
+	}
+	return nil
+}
+
+func (x *ClustersDiff_Cluster) GetHealthMismatches() []*ClustersDiff_HostHealth {
+	if x != nil {
+		return x.HealthMismatches
+	}
+	return nil
+}
+
+type ListenersDiff_AddressMismatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                 string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TargetLocalAddress   string `protobuf:"bytes,2,opt,name=target_local_address,json=targetLocalAddress,proto3" json:"target_local_address,omitempty"`
+	BaselineLocalAddress string `protobuf:"bytes,3,opt,name=baseline_local_address,json=baselineLocalAddress,proto3" json:"baseline_local_address,omitempty"`
+}
+
+func (x *ListenersDiff_AddressMismatch) Reset() {
+	*x = ListenersDiff_AddressMismatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_diff_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListenersDiff_AddressMismatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListenersDiff_AddressMismatch) ProtoMessage() {}
+
+func (x *ListenersDiff_AddressMismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_diff_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListenersDiff_AddressMismatch.ProtoReflect.Descriptor instead.
+func (*ListenersDiff_AddressMismatch) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_diff_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *ListenersDiff_AddressMismatch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListenersDiff_AddressMismatch) GetTargetLocalAddress() string {
+	if x != nil {
+		return x.TargetLocalAddress
+	}
+	return ""
+}
+
+func (x *ListenersDiff_AddressMismatch) GetBaselineLocalAddress() string {
+	if x != nil {
+		return x.BaselineLocalAddress
+	}
+	return ""
+}
+
+type StatsDiff_Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Unset if the stat is not present on the host.
+	Target   *wrapperspb.UInt64Value `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Baseline *wrapperspb.UInt64Value `protobuf:"bytes,3,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	// The target value minus the baseline value, treating a missing stat as zero.
+	Delta int64 `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
+}
+
+func (x *StatsDiff_Entry) Reset() {
+	*x = StatsDiff_Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_diff_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsDiff_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsDiff_Entry) ProtoMessage() {}
+
+func (x *StatsDiff_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_diff_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsDiff_Entry.ProtoReflect.Descriptor instead.
+func (*StatsDiff_Entry) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_diff_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *StatsDiff_Entry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StatsDiff_Entry) GetTarget() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *StatsDiff_Entry) GetBaseline() *wrapperspb.UInt64Value {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+func (x *StatsDiff_Entry) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+type ConfigDumpDiff_Field struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Path to the differing value. List elements that carry a name (directly or in a wrapped resource such as
+	// "dynamic_active_clusters[].cluster.name") are addressed by name, e.g.
+	// "dynamic_active_clusters[foo].cluster.connect_timeout", otherwise by index.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Unset if the value is not present on the host.
+	Target   *structpb.Value `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	Baseline *structpb.Value `protobuf:"bytes,3,opt,name=baseline,proto3" json:"baseline,omitempty"`
+}
+
+func (x *ConfigDumpDiff_Field) Reset() {
+	*x = ConfigDumpDiff_Field{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_diff_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigDumpDiff_Field) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigDumpDiff_Field) ProtoMessage() {}
+
+func (x *ConfigDumpDiff_Field) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_diff_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigDumpDiff_Field.ProtoReflect.Descriptor instead.
+func (*ConfigDumpDiff_Field) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_diff_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *ConfigDumpDiff_Field) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ConfigDumpDiff_Field) GetTarget() *structpb.Value {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *ConfigDumpDiff_Field) GetBaseline() *structpb.Value {
+	if x != nil {
+		return x.Baseline
+	}
+	return nil
+}
+
+var File_envoytriage_v1_diff_proto protoreflect.FileDescriptor
+
+var file_envoytriage_v1_diff_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31,
+	0x2f, 0x64, 0x69, 0x66, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x63, 0x6c, 0x75,
+	0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x1a, 0x1b, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdd, 0x01,
+	0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x44, 0x69, 0x66, 0x66, 0x12, 0x42, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x44, 0x69,
+	0x66, 0x66, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x1a, 0x89, 0x01, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0xb6, 0x04,
+	0x0a, 0x0c, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x44, 0x69, 0x66, 0x66, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x12,
+	0x23, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x47, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x78, 0x0a,
+	0x0a, 0x48, 0x6f, 0x73, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x29, 0x0a, 0x10,
+	0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x1a, 0x9c, 0x02, 0x0a, 0x07, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4f, 0x6e, 0x6c,
+	0x79, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x51, 0x0a, 0x13, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76,
+	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x11, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x4f, 0x6e, 0x6c, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x5b, 0x0a, 0x11, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x5f, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e,
+	0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x52, 0x10, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x4d, 0x69, 0x73, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0x98, 0x03, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x73, 0x44, 0x69, 0x66, 0x66, 0x12, 0x46, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4f, 0x6e, 0x6c, 0x79,
+	0x12, 0x4a, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6f, 0x6e, 0x6c,
+	0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
+	0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c,
+	0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x63, 0x0a, 0x12,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x11,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x73, 0x1a, 0x8d, 0x01, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4d, 0x69, 0x73,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x62,
+	0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x62, 0x61, 0x73,
+	0x65, 0x6c, 0x69, 0x6e, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0xef, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x73, 0x44, 0x69, 0x66, 0x66, 0x12,
+	0x40, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74,
+	0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x44, 0x69,
+	0x66, 0x66, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x1a, 0x9f, 0x01, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x22, 0xf1, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x75,
+	0x6d, 0x70, 0x44, 0x69, 0x66, 0x66, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x79, 0x70, 0x65, 0x55, 0x72,
+	0x6c, 0x12, 0x43, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79,
+	0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x44, 0x75, 0x6d, 0x70, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x7f, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x32, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x62,
+	0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e,
+	0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x76,
+	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_envoytriage_v1_diff_proto_rawDescOnce sync.Once
+	file_envoytriage_v1_diff_proto_rawDescData = file_envoytriage_v1_diff_proto_rawDesc
+)
+
+func file_envoytriage_v1_diff_proto_rawDescGZIP() []byte {
+	file_envoytriage_v1_diff_proto_rawDescOnce.Do(func() {
+		file_envoytriage_v1_diff_proto_rawDescData = protoimpl.X.CompressGZIP(file_envoytriage_v1_diff_proto_rawDescData)
+	})
+	return file_envoytriage_v1_diff_proto_rawDescData
+}
+
+var file_envoytriage_v1_diff_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_envoytriage_v1_diff_proto_goTypes = []interface{}{
+	(*RuntimeDiff)(nil),                   // 0: clutch.envoytriage.v1.RuntimeDiff
+	(*ClustersDiff)(nil),                  // 1: clutch.envoytriage.v1.ClustersDiff
+	(*ListenersDiff)(nil),                 // 2: clutch.envoytriage.v1.ListenersDiff
+	(*StatsDiff)(nil),                     // 3: clutch.envoytriage.v1.StatsDiff
+	(*ConfigDumpDiff)(nil),                // 4: clutch.envoytriage.v1.ConfigDumpDiff
+	(*RuntimeDiff_Entry)(nil),             // 5: clutch.envoytriage.v1.RuntimeDiff.Entry
+	(*ClustersDiff_HostHealth)(nil),       // 6: clutch.envoytriage.v1.ClustersDiff.HostHealth
+	(*ClustersDiff_Cluster)(nil),          // 7: clutch.envoytriage.v1.ClustersDiff.Cluster
+	(*ListenersDiff_AddressMismatch)(nil), // 8: clutch.envoytriage.v1.ListenersDiff.AddressMismatch
+	(*StatsDiff_Entry)(nil),               // 9: clutch.envoytriage.v1.StatsDiff.Entry
+	(*ConfigDumpDiff_Field)(nil),          // 10: clutch.envoytriage.v1.ConfigDumpDiff.Field
+	(*ListenerStatus)(nil),                // 11: clutch.envoytriage.v1.ListenerStatus
+	(*wrapperspb.StringValue)(nil),        // 12: google.protobuf.StringValue
+	(*HostStatus)(nil),                    // 13: clutch.envoytriage.v1.HostStatus
+	(*wrapperspb.UInt64Value)(nil),        // 14: google.protobuf.UInt64Value
+	(*structpb.Value)(nil),                // 15: google.protobuf.Value
+}
+var file_envoytriage_v1_diff_proto_depIdxs = []int32{
+	5,  // 0: clutch.envoytriage.v1.RuntimeDiff.entries:type_name -> clutch.envoytriage.v1.RuntimeDiff.Entry
+	7,  // 1: clutch.envoytriage.v1.ClustersDiff.clusters:type_name -> clutch.envoytriage.v1.ClustersDiff.Cluster
+	11, // 2: clutch.envoytriage.v1.ListenersDiff.target_only:type_name -> clutch.envoytriage.v1.ListenerStatus
+	11, // 3: clutch.envoytriage.v1.ListenersDiff.baseline_only:type_name -> clutch.envoytriage.v1.ListenerStatus
+	8,  // 4: clutch.envoytriage.v1.ListenersDiff.address_mismatches:type_name -> clutch.envoytriage.v1.ListenersDiff.AddressMismatch
+	9,  // 5: clutch.envoytriage.v1.StatsDiff.entries:type_name -> clutch.envoytriage.v1.StatsDiff.Entry
+	10, // 6: clutch.envoytriage.v1.ConfigDumpDiff.fields:type_name -> clutch.envoytriage.v1.ConfigDumpDiff.Field
+	12, // 7: clutch.envoytriage.v1.RuntimeDiff.Entry.target:type_name -> google.protobuf.StringValue
+	12, // 8: clutch.envoytriage.v1.RuntimeDiff.Entry.baseline:type_name -> google.protobuf.StringValue
+	13, // 9: clutch.envoytriage.v1.ClustersDiff.Cluster.target_only_hosts:type_name -> clutch.envoytriage.v1.HostStatus
+	13, // 10: clutch.envoytriage.v1.ClustersDiff.Cluster.baseline_only_hosts:type_name -> clutch.envoytriage.v1.HostStatus
+	6,  // 11: clutch.envoytriage.v1.ClustersDiff.Cluster.health_mismatches:type_name -> clutch.envoytriage.v1.ClustersDiff.HostHealth
+	14, // 12: clutch.envoytriage.v1.StatsDiff.Entry.target:type_name -> google.protobuf.UInt64Value
+	14, // 13: clutch.envoytriage.v1.StatsDiff.Entry.baseline:type_name -> google.protobuf.UInt64Value
+	15, // 14: clutch.envoytriage.v1.ConfigDumpDiff.Field.target:type_name -> google.protobuf.Value
+	15, // 15: clutch.envoytriage.v1.ConfigDumpDiff.Field.baseline:type_name -> google.protobuf.Value
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_envoytriage_v1_diff_proto_init() }
+func file_envoytriage_v1_diff_proto_init() {
+	if File_envoytriage_v1_diff_proto != nil {
+		return
+	}
+	file_envoytriage_v1_output_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_envoytriage_v1_diff_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_diff_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClustersDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_diff_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListenersDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_diff_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_diff_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigDumpDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_diff_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuntimeDiff_Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_diff_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClustersDiff_HostHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_diff_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClustersDiff_Cluster); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_diff_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListenersDiff_AddressMismatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_diff_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsDiff_Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_diff_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigDumpDiff_Field); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_envoytriage_v1_diff_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_envoytriage_v1_diff_proto_goTypes,
+		DependencyIndexes: file_envoytriage_v1_diff_proto_depIdxs,
+		MessageInfos:      file_envoytriage_v1_diff_proto_msgTypes,
+	}.Build()
+	File_envoytriage_v1_diff_proto = out.File
+	file_envoytriage_v1_diff_proto_rawDesc = nil
+	file_envoytriage_v1_diff_proto_goTypes = nil
+	file_envoytriage_v1_diff_proto_depIdxs = nil
+}
@@ -0,0 +1,1682 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: envoytriage/v1/diff.proto
+
+package envoytriagev1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on RuntimeDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RuntimeDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RuntimeDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RuntimeDiffMultiError, or
+// nil if none found.
+func (m *RuntimeDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RuntimeDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RuntimeDiffValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RuntimeDiffValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RuntimeDiffValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RuntimeDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// RuntimeDiffMultiError is an error wrapping multiple validation errors
+// returned by RuntimeDiff.ValidateAll() if the designated constraints aren't met.
+type RuntimeDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RuntimeDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RuntimeDiffMultiError) AllErrors() []error { return m }
+
+// RuntimeDiffValidationError is the validation error returned by
+// RuntimeDiff.Validate if the designated constraints aren't met.
+type RuntimeDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RuntimeDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RuntimeDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RuntimeDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RuntimeDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RuntimeDiffValidationError) ErrorName() string { return "RuntimeDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RuntimeDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRuntimeDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RuntimeDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RuntimeDiffValidationError{}
+
+// Validate checks the field values on ClustersDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClustersDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClustersDiff with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClustersDiffMultiError, or
+// nil if none found.
+func (m *ClustersDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClustersDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetClusters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClustersDiffValidationError{
+						field:  fmt.Sprintf("Clusters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClustersDiffValidationError{
+						field:  fmt.Sprintf("Clusters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClustersDiffValidationError{
+					field:  fmt.Sprintf("Clusters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ClustersDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClustersDiffMultiError is an error wrapping multiple validation errors
+// returned by ClustersDiff.ValidateAll() if the designated constraints aren't met.
+type ClustersDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClustersDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClustersDiffMultiError) AllErrors() []error { return m }
+
+// ClustersDiffValidationError is the validation error returned by
+// ClustersDiff.Validate if the designated constraints aren't met.
+type ClustersDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClustersDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClustersDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClustersDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClustersDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClustersDiffValidationError) ErrorName() string { return "ClustersDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClustersDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClustersDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClustersDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClustersDiffValidationError{}
+
+// Validate checks the field values on ListenersDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ListenersDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListenersDiff with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListenersDiffMultiError, or
+// nil if none found.
+func (m *ListenersDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListenersDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTargetOnly() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListenersDiffValidationError{
+						field:  fmt.Sprintf("TargetOnly[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListenersDiffValidationError{
+						field:  fmt.Sprintf("TargetOnly[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListenersDiffValidationError{
+					field:  fmt.Sprintf("TargetOnly[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetBaselineOnly() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListenersDiffValidationError{
+						field:  fmt.Sprintf("BaselineOnly[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListenersDiffValidationError{
+						field:  fmt.Sprintf("BaselineOnly[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListenersDiffValidationError{
+					field:  fmt.Sprintf("BaselineOnly[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAddressMismatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListenersDiffValidationError{
+						field:  fmt.Sprintf("AddressMismatches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListenersDiffValidationError{
+						field:  fmt.Sprintf("AddressMismatches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListenersDiffValidationError{
+					field:  fmt.Sprintf("AddressMismatches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListenersDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListenersDiffMultiError is an error wrapping multiple validation errors
+// returned by ListenersDiff.ValidateAll() if the designated constraints
+// aren't met.
+type ListenersDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListenersDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListenersDiffMultiError) AllErrors() []error { return m }
+
+// ListenersDiffValidationError is the validation error returned by
+// ListenersDiff.Validate if the designated constraints aren't met.
+type ListenersDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListenersDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListenersDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListenersDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListenersDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListenersDiffValidationError) ErrorName() string { return "ListenersDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListenersDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListenersDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListenersDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListenersDiffValidationError{}
+
+// Validate checks the field values on StatsDiff with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatsDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatsDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatsDiffMultiError, or nil
+// if none found.
+func (m *StatsDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatsDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StatsDiffValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StatsDiffValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StatsDiffValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StatsDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatsDiffMultiError is an error wrapping multiple validation errors returned
+// by StatsDiff.ValidateAll() if the designated constraints aren't met.
+type StatsDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatsDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatsDiffMultiError) AllErrors() []error { return m }
+
+// StatsDiffValidationError is the validation error returned by
+// StatsDiff.Validate if the designated constraints aren't met.
+type StatsDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatsDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatsDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatsDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatsDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatsDiffValidationError) ErrorName() string { return "StatsDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatsDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatsDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatsDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatsDiffValidationError{}
+
+// Validate checks the field values on ConfigDumpDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConfigDumpDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfigDumpDiff with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConfigDumpDiffMultiError,
+// or nil if none found.
+func (m *ConfigDumpDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfigDumpDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TypeUrl
+
+	for idx, item := range m.GetFields() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigDumpDiffValidationError{
+						field:  fmt.Sprintf("Fields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigDumpDiffValidationError{
+						field:  fmt.Sprintf("Fields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigDumpDiffValidationError{
+					field:  fmt.Sprintf("Fields[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ConfigDumpDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfigDumpDiffMultiError is an error wrapping multiple validation errors
+// returned by ConfigDumpDiff.ValidateAll() if the designated constraints
+// aren't met.
+type ConfigDumpDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfigDumpDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfigDumpDiffMultiError) AllErrors() []error { return m }
+
+// ConfigDumpDiffValidationError is the validation error returned by
+// ConfigDumpDiff.Validate if the designated constraints aren't met.
+type ConfigDumpDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigDumpDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigDumpDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigDumpDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigDumpDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigDumpDiffValidationError) ErrorName() string { return "ConfigDumpDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfigDumpDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfigDumpDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigDumpDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigDumpDiffValidationError{}
+
+// Validate checks the field values on RuntimeDiff_Entry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RuntimeDiff_Entry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RuntimeDiff_Entry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RuntimeDiff_EntryMultiError, or nil if none found.
+func (m *RuntimeDiff_Entry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RuntimeDiff_Entry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if all {
+		switch v := interface{}(m.GetTarget()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RuntimeDiff_EntryValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RuntimeDiff_EntryValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTarget()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuntimeDiff_EntryValidationError{
+				field:  "Target",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBaseline()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RuntimeDiff_EntryValidationError{
+					field:  "Baseline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RuntimeDiff_EntryValidationError{
+					field:  "Baseline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBaseline()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuntimeDiff_EntryValidationError{
+				field:  "Baseline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RuntimeDiff_EntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// RuntimeDiff_EntryMultiError is an error wrapping multiple validation errors
+// returned by RuntimeDiff_Entry.ValidateAll() if the designated constraints
+// aren't met.
+type RuntimeDiff_EntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RuntimeDiff_EntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RuntimeDiff_EntryMultiError) AllErrors() []error { return m }
+
+// RuntimeDiff_EntryValidationError is the validation error returned by
+// RuntimeDiff_Entry.Validate if the designated constraints aren't met.
+type RuntimeDiff_EntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RuntimeDiff_EntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RuntimeDiff_EntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RuntimeDiff_EntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RuntimeDiff_EntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RuntimeDiff_EntryValidationError) ErrorName() string {
+	return "RuntimeDiff_EntryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RuntimeDiff_EntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRuntimeDiff_Entry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RuntimeDiff_EntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RuntimeDiff_EntryValidationError{}
+
+// Validate checks the field values on ClustersDiff_HostHealth with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClustersDiff_HostHealth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClustersDiff_HostHealth with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClustersDiff_HostHealthMultiError, or nil if none found.
+func (m *ClustersDiff_HostHealth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClustersDiff_HostHealth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for TargetHealthy
+
+	// no validation rules for BaselineHealthy
+
+	if len(errors) > 0 {
+		return ClustersDiff_HostHealthMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClustersDiff_HostHealthMultiError is an error wrapping multiple validation
+// errors returned by ClustersDiff_HostHealth.ValidateAll() if the designated
+// constraints aren't met.
+type ClustersDiff_HostHealthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClustersDiff_HostHealthMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClustersDiff_HostHealthMultiError) AllErrors() []error { return m }
+
+// ClustersDiff_HostHealthValidationError is the validation error returned by
+// ClustersDiff_HostHealth.Validate if the designated constraints aren't met.
+type ClustersDiff_HostHealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClustersDiff_HostHealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClustersDiff_HostHealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClustersDiff_HostHealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClustersDiff_HostHealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClustersDiff_HostHealthValidationError) ErrorName() string {
+	return "ClustersDiff_HostHealthValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClustersDiff_HostHealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClustersDiff_HostHealth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClustersDiff_HostHealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClustersDiff_HostHealthValidationError{}
+
+// Validate checks the field values on ClustersDiff_Cluster with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClustersDiff_Cluster) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClustersDiff_Cluster with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClustersDiff_ClusterMultiError, or nil if none found.
+func (m *ClustersDiff_Cluster) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClustersDiff_Cluster) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	for idx, item := range m.GetTargetOnlyHosts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClustersDiff_ClusterValidationError{
+						field:  fmt.Sprintf("TargetOnlyHosts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClustersDiff_ClusterValidationError{
+						field:  fmt.Sprintf("TargetOnlyHosts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClustersDiff_ClusterValidationError{
+					field:  fmt.Sprintf("TargetOnlyHosts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetBaselineOnlyHosts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClustersDiff_ClusterValidationError{
+						field:  fmt.Sprintf("BaselineOnlyHosts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClustersDiff_ClusterValidationError{
+						field:  fmt.Sprintf("BaselineOnlyHosts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClustersDiff_ClusterValidationError{
+					field:  fmt.Sprintf("BaselineOnlyHosts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetHealthMismatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClustersDiff_ClusterValidationError{
+						field:  fmt.Sprintf("HealthMismatches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClustersDiff_ClusterValidationError{
+						field:  fmt.Sprintf("HealthMismatches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClustersDiff_ClusterValidationError{
+					field:  fmt.Sprintf("HealthMismatches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ClustersDiff_ClusterMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClustersDiff_ClusterMultiError is an error wrapping multiple validation
+// errors returned by ClustersDiff_Cluster.ValidateAll() if the designated
+// constraints aren't met.
+type ClustersDiff_ClusterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClustersDiff_ClusterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClustersDiff_ClusterMultiError) AllErrors() []error { return m }
+
+// ClustersDiff_ClusterValidationError is the validation error returned by
+// ClustersDiff_Cluster.Validate if the designated constraints aren't met.
+type ClustersDiff_ClusterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClustersDiff_ClusterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClustersDiff_ClusterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClustersDiff_ClusterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClustersDiff_ClusterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClustersDiff_ClusterValidationError) ErrorName() string {
+	return "ClustersDiff_ClusterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClustersDiff_ClusterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClustersDiff_Cluster.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClustersDiff_ClusterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClustersDiff_ClusterValidationError{}
+
+// Validate checks the field values on ListenersDiff_AddressMismatch with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListenersDiff_AddressMismatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListenersDiff_AddressMismatch with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListenersDiff_AddressMismatchMultiError, or nil if none found.
+func (m *ListenersDiff_AddressMismatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListenersDiff_AddressMismatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for TargetLocalAddress
+
+	// no validation rules for BaselineLocalAddress
+
+	if len(errors) > 0 {
+		return ListenersDiff_AddressMismatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListenersDiff_AddressMismatchMultiError is an error wrapping multiple
+// validation errors returned by ListenersDiff_AddressMismatch.ValidateAll()
+// if the designated constraints aren't met.
+type ListenersDiff_AddressMismatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListenersDiff_AddressMismatchMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListenersDiff_AddressMismatchMultiError) AllErrors() []error { return m }
+
+// ListenersDiff_AddressMismatchValidationError is the validation error
+// returned by ListenersDiff_AddressMismatch.Validate if the designated
+// constraints aren't met.
+type ListenersDiff_AddressMismatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListenersDiff_AddressMismatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListenersDiff_AddressMismatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListenersDiff_AddressMismatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListenersDiff_AddressMismatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListenersDiff_AddressMismatchValidationError) ErrorName() string {
+	return "ListenersDiff_AddressMismatchValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListenersDiff_AddressMismatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListenersDiff_AddressMismatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListenersDiff_AddressMismatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListenersDiff_AddressMismatchValidationError{}
+
+// Validate checks the field values on StatsDiff_Entry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StatsDiff_Entry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatsDiff_Entry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StatsDiff_EntryMultiError, or nil if none found.
+func (m *StatsDiff_Entry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatsDiff_Entry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	if all {
+		switch v := interface{}(m.GetTarget()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatsDiff_EntryValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatsDiff_EntryValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTarget()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatsDiff_EntryValidationError{
+				field:  "Target",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBaseline()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatsDiff_EntryValidationError{
+					field:  "Baseline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatsDiff_EntryValidationError{
+					field:  "Baseline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBaseline()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatsDiff_EntryValidationError{
+				field:  "Baseline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Delta
+
+	if len(errors) > 0 {
+		return StatsDiff_EntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatsDiff_EntryMultiError is an error wrapping multiple validation errors
+// returned by StatsDiff_Entry.ValidateAll() if the designated constraints
+// aren't met.
+type StatsDiff_EntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatsDiff_EntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatsDiff_EntryMultiError) AllErrors() []error { return m }
+
+// StatsDiff_EntryValidationError is the validation error returned by
+// StatsDiff_Entry.Validate if the designated constraints aren't met.
+type StatsDiff_EntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatsDiff_EntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatsDiff_EntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatsDiff_EntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatsDiff_EntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatsDiff_EntryValidationError) ErrorName() string { return "StatsDiff_EntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatsDiff_EntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatsDiff_Entry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatsDiff_EntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatsDiff_EntryValidationError{}
+
+// Validate checks the field values on ConfigDumpDiff_Field with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfigDumpDiff_Field) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfigDumpDiff_Field with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfigDumpDiff_FieldMultiError, or nil if none found.
+func (m *ConfigDumpDiff_Field) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfigDumpDiff_Field) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	if all {
+		switch v := interface{}(m.GetTarget()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigDumpDiff_FieldValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigDumpDiff_FieldValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTarget()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigDumpDiff_FieldValidationError{
+				field:  "Target",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBaseline()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigDumpDiff_FieldValidationError{
+					field:  "Baseline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigDumpDiff_FieldValidationError{
+					field:  "Baseline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBaseline()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigDumpDiff_FieldValidationError{
+				field:  "Baseline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ConfigDumpDiff_FieldMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfigDumpDiff_FieldMultiError is an error wrapping multiple validation
+// errors returned by ConfigDumpDiff_Field.ValidateAll() if the designated
+// constraints aren't met.
+type ConfigDumpDiff_FieldMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfigDumpDiff_FieldMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfigDumpDiff_FieldMultiError) AllErrors() []error { return m }
+
+// ConfigDumpDiff_FieldValidationError is the validation error returned by
+// ConfigDumpDiff_Field.Validate if the designated constraints aren't met.
+type ConfigDumpDiff_FieldValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigDumpDiff_FieldValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigDumpDiff_FieldValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigDumpDiff_FieldValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigDumpDiff_FieldValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigDumpDiff_FieldValidationError) ErrorName() string {
+	return "ConfigDumpDiff_FieldValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfigDumpDiff_FieldValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfigDumpDiff_Field.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigDumpDiff_FieldValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigDumpDiff_FieldValidationError{}
@@ -12,6 +12,8 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -23,6 +25,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type StatsOptions_Format int32
+
+const (
+	// Plain text, or JSON if histograms are requested.
+	StatsOptions_UNSPECIFIED StatsOptions_Format = 0
+	StatsOptions_TEXT        StatsOptions_Format = 1
+	StatsOptions_JSON        StatsOptions_Format = 2
+	StatsOptions_PROMETHEUS  StatsOptions_Format = 3
+)
+
+// Enum value maps for StatsOptions_Format.
+var (
+	StatsOptions_Format_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "TEXT",
+		2: "JSON",
+		3: "PROMETHEUS",
+	}
+	StatsOptions_Format_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"TEXT":        1,
+		"JSON":        2,
+		"PROMETHEUS":  3,
+	}
+)
+
+func (x StatsOptions_Format) Enum() *StatsOptions_Format {
+	p := new(StatsOptions_Format)
+	*p = x
+	return p
+}
+
+func (x StatsOptions_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsOptions_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_envoytriage_v1_envoytriage_api_proto_enumTypes[0].Descriptor()
+}
+
+func (StatsOptions_Format) Type() protoreflect.EnumType {
+	return &file_envoytriage_v1_envoytriage_api_proto_enumTypes[0]
+}
+
+func (x StatsOptions_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsOptions_Format.Descriptor instead.
+func (StatsOptions_Format) EnumDescriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{2, 0}
+}
+
 type ReadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -125,16 +180,24 @@ func (x *ReadOperation) GetInclude() *ReadOperation_Include {
 	return nil
 }
 
-type ReadResponse struct {
+type StatsOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Results []*Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// An RE2 regular expression applied by Envoy to stat names, e.g. "^cluster\.foo\.upstream_rq". Filtering
+	// happens on the Envoy host, which avoids transferring every stat from large hosts.
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Only return stats that Envoy has written to.
+	UsedOnly bool `protobuf:"varint,2,opt,name=used_only,json=usedOnly,proto3" json:"used_only,omitempty"`
+	// Include histograms. Histograms have computed quantiles when using the JSON format and cumulative buckets when
+	// using the Prometheus format.
+	Histograms bool                `protobuf:"varint,3,opt,name=histograms,proto3" json:"histograms,omitempty"`
+	Format     StatsOptions_Format `protobuf:"varint,4,opt,name=format,proto3,enum=clutch.envoytriage.v1.StatsOptions_Format" json:"format,omitempty"`
 }
 
-func (x *ReadResponse) Reset() {
-	*x = ReadResponse{}
+func (x *StatsOptions) Reset() {
+	*x = StatsOptions{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -142,13 +205,13 @@ func (x *ReadResponse) Reset() {
 	}
 }
 
-func (x *ReadResponse) String() string {
+func (x *StatsOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadResponse) ProtoMessage() {}
+func (*StatsOptions) ProtoMessage() {}
 
-func (x *ReadResponse) ProtoReflect() protoreflect.Message {
+func (x *StatsOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -160,29 +223,49 @@ func (x *ReadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadResponse.ProtoReflect.Descriptor instead.
-func (*ReadResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StatsOptions.ProtoReflect.Descriptor instead.
+func (*StatsOptions) Descriptor() ([]byte, []int) {
 	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ReadResponse) GetResults() []*Result {
+func (x *StatsOptions) GetFilter() string {
 	if x != nil {
-		return x.Results
+		return x.Filter
 	}
-	return nil
+	return ""
 }
 
-type Address struct {
+func (x *StatsOptions) GetUsedOnly() bool {
+	if x != nil {
+		return x.UsedOnly
+	}
+	return false
+}
+
+func (x *StatsOptions) GetHistograms() bool {
+	if x != nil {
+		return x.Histograms
+	}
+	return false
+}
+
+func (x *StatsOptions) GetFormat() StatsOptions_Format {
+	if x != nil {
+		return x.Format
+	}
+	return StatsOptions_UNSPECIFIED
+}
+
+type ReadResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
-	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Results []*Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *Address) Reset() {
-	*x = Address{}
+func (x *ReadResponse) Reset() {
+	*x = ReadResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -190,13 +273,13 @@ func (x *Address) Reset() {
 	}
 }
 
-func (x *Address) String() string {
+func (x *ReadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Address) ProtoMessage() {}
+func (*ReadResponse) ProtoMessage() {}
 
-func (x *Address) ProtoReflect() protoreflect.Message {
+func (x *ReadResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -208,37 +291,36 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Address.ProtoReflect.Descriptor instead.
-func (*Address) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadResponse.ProtoReflect.Descriptor instead.
+func (*ReadResponse) Descriptor() ([]byte, []int) {
 	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Address) GetHost() string {
-	if x != nil {
-		return x.Host
-	}
-	return ""
-}
-
-func (x *Address) GetPort() uint32 {
+func (x *ReadResponse) GetResults() []*Result {
 	if x != nil {
-		return x.Port
+		return x.Results
 	}
-	return 0
+	return nil
 }
 
-type Result struct {
+type CompareRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address      *Address       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	NodeMetadata *NodeMetadata  `protobuf:"bytes,2,opt,name=node_metadata,json=nodeMetadata,proto3" json:"node_metadata,omitempty"`
-	Output       *Result_Output `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	// The host under investigation.
+	Target *Address `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// Peers that the target is compared against, e.g. known healthy hosts in the same cluster.
+	Baselines []*Address `protobuf:"bytes,2,rep,name=baselines,proto3" json:"baselines,omitempty"`
+	// The outputs to compare. Server info is always fetched to populate node metadata and is not diffed.
+	Include *ReadOperation_Include `protobuf:"bytes,3,opt,name=include,proto3" json:"include,omitempty"`
+	// Stat name prefixes to compare when stats are included, e.g. "cluster.foo.upstream_rq". If empty, every stat
+	// present on either host is compared.
+	StatPrefixes []string `protobuf:"bytes,4,rep,name=stat_prefixes,json=statPrefixes,proto3" json:"stat_prefixes,omitempty"`
 }
 
-func (x *Result) Reset() {
-	*x = Result{}
+func (x *CompareRequest) Reset() {
+	*x = CompareRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -246,13 +328,13 @@ func (x *Result) Reset() {
 	}
 }
 
-func (x *Result) String() string {
+func (x *CompareRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Result) ProtoMessage() {}
+func (*CompareRequest) ProtoMessage() {}
 
-func (x *Result) ProtoReflect() protoreflect.Message {
+func (x *CompareRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -264,45 +346,52 @@ func (x *Result) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Result.ProtoReflect.Descriptor instead.
-func (*Result) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompareRequest.ProtoReflect.Descriptor instead.
+func (*CompareRequest) Descriptor() ([]byte, []int) {
 	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Result) GetAddress() *Address {
+func (x *CompareRequest) GetTarget() *Address {
 	if x != nil {
-		return x.Address
+		return x.Target
 	}
 	return nil
 }
 
-func (x *Result) GetNodeMetadata() *NodeMetadata {
+func (x *CompareRequest) GetBaselines() []*Address {
 	if x != nil {
-		return x.NodeMetadata
+		return x.Baselines
 	}
 	return nil
 }
 
-func (x *Result) GetOutput() *Result_Output {
+func (x *CompareRequest) GetInclude() *ReadOperation_Include {
 	if x != nil {
-		return x.Output
+		return x.Include
 	}
 	return nil
 }
 
-type NodeMetadata struct {
+func (x *CompareRequest) GetStatPrefixes() []string {
+	if x != nil {
+		return x.StatPrefixes
+	}
+	return nil
+}
+
+type CompareResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServiceNode    string `protobuf:"bytes,1,opt,name=service_node,json=serviceNode,proto3" json:"service_node,omitempty"`
-	ServiceCluster string `protobuf:"bytes,2,opt,name=service_cluster,json=serviceCluster,proto3" json:"service_cluster,omitempty"`
-	ServiceZone    string `protobuf:"bytes,3,opt,name=service_zone,json=serviceZone,proto3" json:"service_zone,omitempty"`
-	Version        string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Target             *Address      `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	TargetNodeMetadata *NodeMetadata `protobuf:"bytes,2,opt,name=target_node_metadata,json=targetNodeMetadata,proto3" json:"target_node_metadata,omitempty"`
+	// One comparison per baseline, in the order they were requested.
+	Comparisons []*Comparison `protobuf:"bytes,3,rep,name=comparisons,proto3" json:"comparisons,omitempty"`
 }
 
-func (x *NodeMetadata) Reset() {
-	*x = NodeMetadata{}
+func (x *CompareResponse) Reset() {
+	*x = CompareResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -310,13 +399,13 @@ func (x *NodeMetadata) Reset() {
 	}
 }
 
-func (x *NodeMetadata) String() string {
+func (x *CompareResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NodeMetadata) ProtoMessage() {}
+func (*CompareResponse) ProtoMessage() {}
 
-func (x *NodeMetadata) ProtoReflect() protoreflect.Message {
+func (x *CompareResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -328,54 +417,50 @@ func (x *NodeMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NodeMetadata.ProtoReflect.Descriptor instead.
-func (*NodeMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompareResponse.ProtoReflect.Descriptor instead.
+func (*CompareResponse) Descriptor() ([]byte, []int) {
 	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *NodeMetadata) GetServiceNode() string {
-	if x != nil {
-		return x.ServiceNode
-	}
-	return ""
-}
-
-func (x *NodeMetadata) GetServiceCluster() string {
+func (x *CompareResponse) GetTarget() *Address {
 	if x != nil {
-		return x.ServiceCluster
+		return x.Target
 	}
-	return ""
+	return nil
 }
 
-func (x *NodeMetadata) GetServiceZone() string {
+func (x *CompareResponse) GetTargetNodeMetadata() *NodeMetadata {
 	if x != nil {
-		return x.ServiceZone
+		return x.TargetNodeMetadata
 	}
-	return ""
+	return nil
 }
 
-func (x *NodeMetadata) GetVersion() string {
+func (x *CompareResponse) GetComparisons() []*Comparison {
 	if x != nil {
-		return x.Version
+		return x.Comparisons
 	}
-	return ""
+	return nil
 }
 
-type ReadOperation_Include struct {
+type Comparison struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Clusters   bool `protobuf:"varint,1,opt,name=clusters,proto3" json:"clusters,omitempty"`
-	ConfigDump bool `protobuf:"varint,2,opt,name=config_dump,json=configDump,proto3" json:"config_dump,omitempty"`
-	Listeners  bool `protobuf:"varint,3,opt,name=listeners,proto3" json:"listeners,omitempty"`
-	Runtime    bool `protobuf:"varint,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	Stats      bool `protobuf:"varint,5,opt,name=stats,proto3" json:"stats,omitempty"`
-	ServerInfo bool `protobuf:"varint,6,opt,name=server_info,json=serverInfo,proto3" json:"server_info,omitempty"`
+	Baseline             *Address       `protobuf:"bytes,1,opt,name=baseline,proto3" json:"baseline,omitempty"`
+	BaselineNodeMetadata *NodeMetadata  `protobuf:"bytes,2,opt,name=baseline_node_metadata,json=baselineNodeMetadata,proto3" json:"baseline_node_metadata,omitempty"`
+	Runtime              *RuntimeDiff   `protobuf:"bytes,3,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	Clusters             *ClustersDiff  `protobuf:"bytes,4,opt,name=clusters,proto3" json:"clusters,omitempty"`
+	Listeners            *ListenersDiff `protobuf:"bytes,5,opt,name=listeners,proto3" json:"listeners,omitempty"`
+	Stats                *StatsDiff     `protobuf:"bytes,6,opt,name=stats,proto3" json:"stats,omitempty"`
+	// Config dump differences grouped by the type URL of each config dump section, e.g.
+	// "type.googleapis.com/envoy.admin.v3.ClustersConfigDump".
+	ConfigDump []*ConfigDumpDiff `protobuf:"bytes,7,rep,name=config_dump,json=configDump,proto3" json:"config_dump,omitempty"`
 }
 
-func (x *ReadOperation_Include) Reset() {
-	*x = ReadOperation_Include{}
+func (x *Comparison) Reset() {
+	*x = Comparison{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -383,13 +468,13 @@ func (x *ReadOperation_Include) Reset() {
 	}
 }
 
-func (x *ReadOperation_Include) String() string {
+func (x *Comparison) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadOperation_Include) ProtoMessage() {}
+func (*Comparison) ProtoMessage() {}
 
-func (x *ReadOperation_Include) ProtoReflect() protoreflect.Message {
+func (x *Comparison) ProtoReflect() protoreflect.Message {
 	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -401,68 +486,70 @@ func (x *ReadOperation_Include) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadOperation_Include.ProtoReflect.Descriptor instead.
-func (*ReadOperation_Include) Descriptor() ([]byte, []int) {
-	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use Comparison.ProtoReflect.Descriptor instead.
+func (*Comparison) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ReadOperation_Include) GetClusters() bool {
+func (x *Comparison) GetBaseline() *Address {
 	if x != nil {
-		return x.Clusters
+		return x.Baseline
 	}
-	return false
+	return nil
 }
 
-func (x *ReadOperation_Include) GetConfigDump() bool {
+func (x *Comparison) GetBaselineNodeMetadata() *NodeMetadata {
 	if x != nil {
-		return x.ConfigDump
+		return x.BaselineNodeMetadata
 	}
-	return false
+	return nil
 }
 
-func (x *ReadOperation_Include) GetListeners() bool {
+func (x *Comparison) GetRuntime() *RuntimeDiff {
 	if x != nil {
-		return x.Listeners
+		return x.Runtime
 	}
-	return false
+	return nil
 }
 
-func (x *ReadOperation_Include) GetRuntime() bool {
+func (x *Comparison) GetClusters() *ClustersDiff {
 	if x != nil {
-		return x.Runtime
+		return x.Clusters
 	}
-	return false
+	return nil
 }
 
-func (x *ReadOperation_Include) GetStats() bool {
+func (x *Comparison) GetListeners() *ListenersDiff {
+	if x != nil {
+		return x.Listeners
+	}
+	return nil
+}
+
+func (x *Comparison) GetStats() *StatsDiff {
 	if x != nil {
 		return x.Stats
 	}
-	return false
+	return nil
 }
 
-func (x *ReadOperation_Include) GetServerInfo() bool {
+func (x *Comparison) GetConfigDump() []*ConfigDumpDiff {
 	if x != nil {
-		return x.ServerInfo
+		return x.ConfigDump
 	}
-	return false
+	return nil
 }
 
-type Result_Output struct {
+type UpdateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Clusters   *Clusters   `protobuf:"bytes,1,opt,name=clusters,proto3" json:"clusters,omitempty"`
-	ConfigDump *ConfigDump `protobuf:"bytes,2,opt,name=config_dump,json=configDump,proto3" json:"config_dump,omitempty"`
-	Listeners  *Listeners  `protobuf:"bytes,3,opt,name=listeners,proto3" json:"listeners,omitempty"`
-	Runtime    *Runtime    `protobuf:"bytes,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	Stats      *Stats      `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
-	ServerInfo *ServerInfo `protobuf:"bytes,6,opt,name=server_info,json=serverInfo,proto3" json:"server_info,omitempty"`
+	Operations []*UpdateOperation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations,omitempty"`
 }
 
-func (x *Result_Output) Reset() {
-	*x = Result_Output{}
+func (x *UpdateRequest) Reset() {
+	*x = UpdateRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -470,13 +557,13 @@ func (x *Result_Output) Reset() {
 	}
 }
 
-func (x *Result_Output) String() string {
+func (x *UpdateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Result_Output) ProtoMessage() {}
+func (*UpdateRequest) ProtoMessage() {}
 
-func (x *Result_Output) ProtoReflect() protoreflect.Message {
+func (x *UpdateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -488,166 +575,1167 @@ func (x *Result_Output) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Result_Output.ProtoReflect.Descriptor instead.
-func (*Result_Output) Descriptor() ([]byte, []int) {
-	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use UpdateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Result_Output) GetClusters() *Clusters {
+func (x *UpdateRequest) GetOperations() []*UpdateOperation {
 	if x != nil {
-		return x.Clusters
+		return x.Operations
 	}
 	return nil
 }
 
-func (x *Result_Output) GetConfigDump() *ConfigDump {
-	if x != nil {
-		return x.ConfigDump
+type UpdateOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Types that are assignable to Action:
+	//	*UpdateOperation_RuntimeModify_
+	//	*UpdateOperation_HealthcheckFail_
+	//	*UpdateOperation_HealthcheckOk_
+	//	*UpdateOperation_DrainListeners_
+	//	*UpdateOperation_ResetCounters_
+	Action isUpdateOperation_Action `protobuf_oneof:"action"`
+}
+
+func (x *UpdateOperation) Reset() {
+	*x = UpdateOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Result_Output) GetListeners() *Listeners {
-	if x != nil {
-		return x.Listeners
+func (x *UpdateOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOperation) ProtoMessage() {}
+
+func (x *UpdateOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Result_Output) GetRuntime() *Runtime {
+// Deprecated: Use UpdateOperation.ProtoReflect.Descriptor instead.
+func (*UpdateOperation) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateOperation) GetAddress() *Address {
 	if x != nil {
-		return x.Runtime
+		return x.Address
 	}
 	return nil
 }
 
-func (x *Result_Output) GetStats() *Stats {
-	if x != nil {
-		return x.Stats
+func (m *UpdateOperation) GetAction() isUpdateOperation_Action {
+	if m != nil {
+		return m.Action
 	}
 	return nil
 }
 
-func (x *Result_Output) GetServerInfo() *ServerInfo {
-	if x != nil {
-		return x.ServerInfo
+func (x *UpdateOperation) GetRuntimeModify() *UpdateOperation_RuntimeModify {
+	if x, ok := x.GetAction().(*UpdateOperation_RuntimeModify_); ok {
+		return x.RuntimeModify
 	}
 	return nil
 }
 
-var File_envoytriage_v1_envoytriage_api_proto protoreflect.FileDescriptor
+func (x *UpdateOperation) GetHealthcheckFail() *UpdateOperation_HealthcheckFail {
+	if x, ok := x.GetAction().(*UpdateOperation_HealthcheckFail_); ok {
+		return x.HealthcheckFail
+	}
+	return nil
+}
 
-var file_envoytriage_v1_envoytriage_api_proto_rawDesc = []byte{
-	0x0a, 0x24, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31,
-	0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65,
-	0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x18, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
-	0x69, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x53, 0x0a, 0x0b, 0x52,
-	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0a, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0xd3, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76,
-	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
-	0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x1a, 0xb5,
-	0x01, 0x0a, 0x07, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x5f, 0x64, 0x75, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x47, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
-	0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22,
-	0x46, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x68, 0x6f,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xa8,
-	0x01, 0x01, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0xff, 0xff,
-	0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xc8, 0x04, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76,
-	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x48, 0x0a, 0x0d,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76,
-	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
-	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x06, 0x6f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x1a, 0xfb, 0x02, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
-	0x3b, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79,
-	0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x0b,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x64, 0x75, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79,
-	0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x44, 0x75, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x75, 0x6d, 0x70,
-	0x12, 0x3e, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76,
-	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73,
-	0x12, 0x38, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79,
-	0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
-	0x65, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6c, 0x75, 0x74,
-	0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x42,
-	0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76,
-	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x22, 0x97, 0x01, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
-	0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5a, 0x6f,
-	0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x88, 0x01, 0x0a,
-	0x0e, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x54, 0x72, 0x69, 0x61, 0x67, 0x65, 0x41, 0x50, 0x49, 0x12,
-	0x76, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x22, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
-	0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6c,
-	0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e,
-	0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x3a, 0x01,
-	0x2a, 0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x02, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63,
-	0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e,
-	0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x76,
-	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+func (x *UpdateOperation) GetHealthcheckOk() *UpdateOperation_HealthcheckOk {
+	if x, ok := x.GetAction().(*UpdateOperation_HealthcheckOk_); ok {
+		return x.HealthcheckOk
+	}
+	return nil
 }
 
-var (
+func (x *UpdateOperation) GetDrainListeners() *UpdateOperation_DrainListeners {
+	if x, ok := x.GetAction().(*UpdateOperation_DrainListeners_); ok {
+		return x.DrainListeners
+	}
+	return nil
+}
+
+func (x *UpdateOperation) GetResetCounters() *UpdateOperation_ResetCounters {
+	if x, ok := x.GetAction().(*UpdateOperation_ResetCounters_); ok {
+		return x.ResetCounters
+	}
+	return nil
+}
+
+type isUpdateOperation_Action interface {
+	isUpdateOperation_Action()
+}
+
+type UpdateOperation_RuntimeModify_ struct {
+	RuntimeModify *UpdateOperation_RuntimeModify `protobuf:"bytes,2,opt,name=runtime_modify,json=runtimeModify,proto3,oneof"`
+}
+
+type UpdateOperation_HealthcheckFail_ struct {
+	HealthcheckFail *UpdateOperation_HealthcheckFail `protobuf:"bytes,3,opt,name=healthcheck_fail,json=healthcheckFail,proto3,oneof"`
+}
+
+type UpdateOperation_HealthcheckOk_ struct {
+	HealthcheckOk *UpdateOperation_HealthcheckOk `protobuf:"bytes,4,opt,name=healthcheck_ok,json=healthcheckOk,proto3,oneof"`
+}
+
+type UpdateOperation_DrainListeners_ struct {
+	DrainListeners *UpdateOperation_DrainListeners `protobuf:"bytes,5,opt,name=drain_listeners,json=drainListeners,proto3,oneof"`
+}
+
+type UpdateOperation_ResetCounters_ struct {
+	ResetCounters *UpdateOperation_ResetCounters `protobuf:"bytes,6,opt,name=reset_counters,json=resetCounters,proto3,oneof"`
+}
+
+func (*UpdateOperation_RuntimeModify_) isUpdateOperation_Action() {}
+
+func (*UpdateOperation_HealthcheckFail_) isUpdateOperation_Action() {}
+
+func (*UpdateOperation_HealthcheckOk_) isUpdateOperation_Action() {}
+
+func (*UpdateOperation_DrainListeners_) isUpdateOperation_Action() {}
+
+func (*UpdateOperation_ResetCounters_) isUpdateOperation_Action() {}
+
+type UpdateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*UpdateResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *UpdateResponse) Reset() {
+	*x = UpdateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResponse) ProtoMessage() {}
+
+func (x *UpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResponse.ProtoReflect.Descriptor instead.
+func (*UpdateResponse) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateResponse) GetResults() []*UpdateResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type UpdateResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The time at which the change will be reverted. Unset if the operation is not reverted.
+	RevertTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=revert_time,json=revertTime,proto3" json:"revert_time,omitempty"`
+}
+
+func (x *UpdateResult) Reset() {
+	*x = UpdateResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateResult) ProtoMessage() {}
+
+func (x *UpdateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateResult.ProtoReflect.Descriptor instead.
+func (*UpdateResult) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateResult) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *UpdateResult) GetRevertTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevertTime
+	}
+	return nil
+}
+
+type Address struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Address) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Address) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address      *Address       `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	NodeMetadata *NodeMetadata  `protobuf:"bytes,2,opt,name=node_metadata,json=nodeMetadata,proto3" json:"node_metadata,omitempty"`
+	Output       *Result_Output `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+}
+
+func (x *Result) Reset() {
+	*x = Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Result) ProtoMessage() {}
+
+func (x *Result) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Result.ProtoReflect.Descriptor instead.
+func (*Result) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Result) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Result) GetNodeMetadata() *NodeMetadata {
+	if x != nil {
+		return x.NodeMetadata
+	}
+	return nil
+}
+
+func (x *Result) GetOutput() *Result_Output {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+type NodeMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceNode    string `protobuf:"bytes,1,opt,name=service_node,json=serviceNode,proto3" json:"service_node,omitempty"`
+	ServiceCluster string `protobuf:"bytes,2,opt,name=service_cluster,json=serviceCluster,proto3" json:"service_cluster,omitempty"`
+	ServiceZone    string `protobuf:"bytes,3,opt,name=service_zone,json=serviceZone,proto3" json:"service_zone,omitempty"`
+	Version        string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *NodeMetadata) Reset() {
+	*x = NodeMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeMetadata) ProtoMessage() {}
+
+func (x *NodeMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeMetadata.ProtoReflect.Descriptor instead.
+func (*NodeMetadata) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NodeMetadata) GetServiceNode() string {
+	if x != nil {
+		return x.ServiceNode
+	}
+	return ""
+}
+
+func (x *NodeMetadata) GetServiceCluster() string {
+	if x != nil {
+		return x.ServiceCluster
+	}
+	return ""
+}
+
+func (x *NodeMetadata) GetServiceZone() string {
+	if x != nil {
+		return x.ServiceZone
+	}
+	return ""
+}
+
+func (x *NodeMetadata) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type ReadOperation_Include struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clusters   bool `protobuf:"varint,1,opt,name=clusters,proto3" json:"clusters,omitempty"`
+	ConfigDump bool `protobuf:"varint,2,opt,name=config_dump,json=configDump,proto3" json:"config_dump,omitempty"`
+	Listeners  bool `protobuf:"varint,3,opt,name=listeners,proto3" json:"listeners,omitempty"`
+	Runtime    bool `protobuf:"varint,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	Stats      bool `protobuf:"varint,5,opt,name=stats,proto3" json:"stats,omitempty"`
+	ServerInfo bool `protobuf:"varint,6,opt,name=server_info,json=serverInfo,proto3" json:"server_info,omitempty"`
+	// Options for fetching stats, only used if stats are included.
+	StatsOptions *StatsOptions `protobuf:"bytes,7,opt,name=stats_options,json=statsOptions,proto3" json:"stats_options,omitempty"`
+}
+
+func (x *ReadOperation_Include) Reset() {
+	*x = ReadOperation_Include{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadOperation_Include) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadOperation_Include) ProtoMessage() {}
+
+func (x *ReadOperation_Include) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadOperation_Include.ProtoReflect.Descriptor instead.
+func (*ReadOperation_Include) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ReadOperation_Include) GetClusters() bool {
+	if x != nil {
+		return x.Clusters
+	}
+	return false
+}
+
+func (x *ReadOperation_Include) GetConfigDump() bool {
+	if x != nil {
+		return x.ConfigDump
+	}
+	return false
+}
+
+func (x *ReadOperation_Include) GetListeners() bool {
+	if x != nil {
+		return x.Listeners
+	}
+	return false
+}
+
+func (x *ReadOperation_Include) GetRuntime() bool {
+	if x != nil {
+		return x.Runtime
+	}
+	return false
+}
+
+func (x *ReadOperation_Include) GetStats() bool {
+	if x != nil {
+		return x.Stats
+	}
+	return false
+}
+
+func (x *ReadOperation_Include) GetServerInfo() bool {
+	if x != nil {
+		return x.ServerInfo
+	}
+	return false
+}
+
+func (x *ReadOperation_Include) GetStatsOptions() *StatsOptions {
+	if x != nil {
+		return x.StatsOptions
+	}
+	return nil
+}
+
+// Sets runtime values in the admin layer. The previous admin layer values are restored when the TTL expires.
+type UpdateOperation_RuntimeModify struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values map[string]string `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// If unset, the default TTL from the service config is used. The TTL is capped at the configured maximum.
+	Ttl *durationpb.Duration `protobuf:"bytes,2,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *UpdateOperation_RuntimeModify) Reset() {
+	*x = UpdateOperation_RuntimeModify{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateOperation_RuntimeModify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOperation_RuntimeModify) ProtoMessage() {}
+
+func (x *UpdateOperation_RuntimeModify) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOperation_RuntimeModify.ProtoReflect.Descriptor instead.
+func (*UpdateOperation_RuntimeModify) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *UpdateOperation_RuntimeModify) GetValues() map[string]string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *UpdateOperation_RuntimeModify) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// Fails the host's health check. The host is marked healthy again when the TTL expires.
+type UpdateOperation_HealthcheckFail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If unset, the default TTL from the service config is used. The TTL is capped at the configured maximum.
+	Ttl *durationpb.Duration `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+}
+
+func (x *UpdateOperation_HealthcheckFail) Reset() {
+	*x = UpdateOperation_HealthcheckFail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateOperation_HealthcheckFail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOperation_HealthcheckFail) ProtoMessage() {}
+
+func (x *UpdateOperation_HealthcheckFail) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOperation_HealthcheckFail.ProtoReflect.Descriptor instead.
+func (*UpdateOperation_HealthcheckFail) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *UpdateOperation_HealthcheckFail) GetTtl() *durationpb.Duration {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+// Marks the host healthy again, cancelling any pending reversion of a previous failure.
+type UpdateOperation_HealthcheckOk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateOperation_HealthcheckOk) Reset() {
+	*x = UpdateOperation_HealthcheckOk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateOperation_HealthcheckOk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOperation_HealthcheckOk) ProtoMessage() {}
+
+func (x *UpdateOperation_HealthcheckOk) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOperation_HealthcheckOk.ProtoReflect.Descriptor instead.
+func (*UpdateOperation_HealthcheckOk) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{8, 2}
+}
+
+// Drains listeners. Envoy cannot undo a drain, so this operation is not reverted.
+type UpdateOperation_DrainListeners struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InboundOnly bool `protobuf:"varint,1,opt,name=inbound_only,json=inboundOnly,proto3" json:"inbound_only,omitempty"`
+	Graceful    bool `protobuf:"varint,2,opt,name=graceful,proto3" json:"graceful,omitempty"`
+}
+
+func (x *UpdateOperation_DrainListeners) Reset() {
+	*x = UpdateOperation_DrainListeners{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateOperation_DrainListeners) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOperation_DrainListeners) ProtoMessage() {}
+
+func (x *UpdateOperation_DrainListeners) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOperation_DrainListeners.ProtoReflect.Descriptor instead.
+func (*UpdateOperation_DrainListeners) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{8, 3}
+}
+
+func (x *UpdateOperation_DrainListeners) GetInboundOnly() bool {
+	if x != nil {
+		return x.InboundOnly
+	}
+	return false
+}
+
+func (x *UpdateOperation_DrainListeners) GetGraceful() bool {
+	if x != nil {
+		return x.Graceful
+	}
+	return false
+}
+
+type UpdateOperation_ResetCounters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateOperation_ResetCounters) Reset() {
+	*x = UpdateOperation_ResetCounters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateOperation_ResetCounters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOperation_ResetCounters) ProtoMessage() {}
+
+func (x *UpdateOperation_ResetCounters) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOperation_ResetCounters.ProtoReflect.Descriptor instead.
+func (*UpdateOperation_ResetCounters) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{8, 4}
+}
+
+type Result_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clusters   *Clusters   `protobuf:"bytes,1,opt,name=clusters,proto3" json:"clusters,omitempty"`
+	ConfigDump *ConfigDump `protobuf:"bytes,2,opt,name=config_dump,json=configDump,proto3" json:"config_dump,omitempty"`
+	Listeners  *Listeners  `protobuf:"bytes,3,opt,name=listeners,proto3" json:"listeners,omitempty"`
+	Runtime    *Runtime    `protobuf:"bytes,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	Stats      *Stats      `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
+	ServerInfo *ServerInfo `protobuf:"bytes,6,opt,name=server_info,json=serverInfo,proto3" json:"server_info,omitempty"`
+}
+
+func (x *Result_Output) Reset() {
+	*x = Result_Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Result_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Result_Output) ProtoMessage() {}
+
+func (x *Result_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Result_Output.ProtoReflect.Descriptor instead.
+func (*Result_Output) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *Result_Output) GetClusters() *Clusters {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *Result_Output) GetConfigDump() *ConfigDump {
+	if x != nil {
+		return x.ConfigDump
+	}
+	return nil
+}
+
+func (x *Result_Output) GetListeners() *Listeners {
+	if x != nil {
+		return x.Listeners
+	}
+	return nil
+}
+
+func (x *Result_Output) GetRuntime() *Runtime {
+	if x != nil {
+		return x.Runtime
+	}
+	return nil
+}
+
+func (x *Result_Output) GetStats() *Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *Result_Output) GetServerInfo() *ServerInfo {
+	if x != nil {
+		return x.ServerInfo
+	}
+	return nil
+}
+
+var File_envoytriage_v1_envoytriage_api_proto protoreflect.FileDescriptor
+
+var file_envoytriage_v1_envoytriage_api_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31,
+	0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65,
+	0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x18, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x66, 0x66, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1b, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x53, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x75,
+	0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9d, 0x03, 0x0a,
+	0x0d, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76,
+	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x1a, 0xff, 0x01, 0x0a, 0x07, 0x49,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x64, 0x75, 0x6d,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44,
+	0x75, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x48, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0c,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf0, 0x01, 0x0a,
+	0x0c, 0x53, 0x74, 0x61, 0x74, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x6f, 0x6e,
+	0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x73, 0x65, 0x64, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x4c, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x22, 0x3d, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x54,
+	0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12,
+	0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x4f, 0x4d, 0x45, 0x54, 0x48, 0x45, 0x55, 0x53, 0x10, 0x03, 0x22,
+	0x47, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x37, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74,
+	0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x93, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x48, 0x0a,
+	0x09, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74,
+	0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x42, 0x0a, 0xfa, 0x42, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x10, 0x52, 0x09, 0x62, 0x61,
+	0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x61,
+	0x74, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0xe5,
+	0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x55, 0x0a, 0x14, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x12, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x43, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x69, 0x73, 0x6f, 0x6e, 0x73, 0x22, 0xe6, 0x03, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
+	0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x59, 0x0a, 0x16, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79,
+	0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x14, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x0a, 0x07,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x08, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12,
+	0x36, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x44, 0x69, 0x66, 0x66,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x5f, 0x64, 0x75, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x75, 0x6d, 0x70, 0x44,
+	0x69, 0x66, 0x66, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x75, 0x6d, 0x70, 0x22,
+	0x73, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x50, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e,
+	0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x3a, 0x10, 0xaa, 0xe1, 0x1c, 0x0c, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xfc, 0x07, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x5d, 0x0a, 0x0e,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e,
+	0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x12, 0x63, 0x0a, 0x10, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65,
+	0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52,
+	0x0f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c,
+	0x12, 0x5d, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f,
+	0x6f, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x6b, 0x48, 0x00,
+	0x52, 0x0d, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x4f, 0x6b, 0x12,
+	0x60, 0x0a, 0x0f, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x48,
+	0x00, 0x52, 0x0e, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x73, 0x12, 0x5d, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x48,
+	0x00, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73,
+	0x1a, 0xe5, 0x01, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x79, 0x12, 0x62, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x40, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x9a, 0x01, 0x02, 0x08, 0x01, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x1a, 0x39, 0x0a,
+	0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x48, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x12, 0x35, 0x0a, 0x03, 0x74,
+	0x74, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x03, 0x74,
+	0x74, 0x6c, 0x1a, 0x0f, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x4f, 0x6b, 0x1a, 0x4f, 0x0a, 0x0e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x63,
+	0x65, 0x66, 0x75, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x67, 0x72, 0x61, 0x63,
+	0x65, 0x66, 0x75, 0x6c, 0x1a, 0x0f, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x3a, 0x0d, 0xaa, 0xe1, 0x1c, 0x09, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x42, 0x0d, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x03,
+	0xf8, 0x42, 0x01, 0x22, 0x4f, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x7c, 0x0a, 0x07,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xa8, 0x01, 0x01, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0xff, 0xff, 0x03, 0x52, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x3a, 0x34, 0xb2, 0xe1, 0x1c, 0x30, 0x0a, 0x2e, 0x0a, 0x1d, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0d, 0x7b, 0x68, 0x6f,
+	0x73, 0x74, 0x7d, 0x3a, 0x7b, 0x70, 0x6f, 0x72, 0x74, 0x7d, 0x22, 0xc8, 0x04, 0x0a, 0x06, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x48, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x6e, 0x6f, 0x64,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x0a, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x1a, 0xfb, 0x02, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e,
+	0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x42, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x64, 0x75, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e,
+	0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44,
+	0x75, 0x6d, 0x70, 0x12, 0x3e, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e,
+	0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x42, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x97, 0x01, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x7a, 0x6f,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32,
+	0x8d, 0x03, 0x0a, 0x0e, 0x45, 0x6e, 0x76, 0x6f, 0x79, 0x54, 0x72, 0x69, 0x61, 0x67, 0x65, 0x41,
+	0x50, 0x49, 0x12, 0x76, 0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x22, 0x2e, 0x63, 0x6c, 0x75,
+	0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x25, 0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x82, 0x01, 0x0a, 0x07, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x12, 0x25, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x02, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x3a, 0x01, 0x2a, 0x12,
+	0x7e, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x03, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74,
+	0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x42,
+	0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79,
+	0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67,
+	0x65, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
 	file_envoytriage_v1_envoytriage_api_proto_rawDescOnce sync.Once
 	file_envoytriage_v1_envoytriage_api_proto_rawDescData = file_envoytriage_v1_envoytriage_api_proto_rawDesc
 )
@@ -659,44 +1747,99 @@ func file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP() []byte {
 	return file_envoytriage_v1_envoytriage_api_proto_rawDescData
 }
 
-var file_envoytriage_v1_envoytriage_api_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_envoytriage_v1_envoytriage_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_envoytriage_v1_envoytriage_api_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_envoytriage_v1_envoytriage_api_proto_goTypes = []interface{}{
-	(*ReadRequest)(nil),           // 0: clutch.envoytriage.v1.ReadRequest
-	(*ReadOperation)(nil),         // 1: clutch.envoytriage.v1.ReadOperation
-	(*ReadResponse)(nil),          // 2: clutch.envoytriage.v1.ReadResponse
-	(*Address)(nil),               // 3: clutch.envoytriage.v1.Address
-	(*Result)(nil),                // 4: clutch.envoytriage.v1.Result
-	(*NodeMetadata)(nil),          // 5: clutch.envoytriage.v1.NodeMetadata
-	(*ReadOperation_Include)(nil), // 6: clutch.envoytriage.v1.ReadOperation.Include
-	(*Result_Output)(nil),         // 7: clutch.envoytriage.v1.Result.Output
-	(*Clusters)(nil),              // 8: clutch.envoytriage.v1.Clusters
-	(*ConfigDump)(nil),            // 9: clutch.envoytriage.v1.ConfigDump
-	(*Listeners)(nil),             // 10: clutch.envoytriage.v1.Listeners
-	(*Runtime)(nil),               // 11: clutch.envoytriage.v1.Runtime
-	(*Stats)(nil),                 // 12: clutch.envoytriage.v1.Stats
-	(*ServerInfo)(nil),            // 13: clutch.envoytriage.v1.ServerInfo
+	(StatsOptions_Format)(0),                // 0: clutch.envoytriage.v1.StatsOptions.Format
+	(*ReadRequest)(nil),                     // 1: clutch.envoytriage.v1.ReadRequest
+	(*ReadOperation)(nil),                   // 2: clutch.envoytriage.v1.ReadOperation
+	(*StatsOptions)(nil),                    // 3: clutch.envoytriage.v1.StatsOptions
+	(*ReadResponse)(nil),                    // 4: clutch.envoytriage.v1.ReadResponse
+	(*CompareRequest)(nil),                  // 5: clutch.envoytriage.v1.CompareRequest
+	(*CompareResponse)(nil),                 // 6: clutch.envoytriage.v1.CompareResponse
+	(*Comparison)(nil),                      // 7: clutch.envoytriage.v1.Comparison
+	(*UpdateRequest)(nil),                   // 8: clutch.envoytriage.v1.UpdateRequest
+	(*UpdateOperation)(nil),                 // 9: clutch.envoytriage.v1.UpdateOperation
+	(*UpdateResponse)(nil),                  // 10: clutch.envoytriage.v1.UpdateResponse
+	(*UpdateResult)(nil),                    // 11: clutch.envoytriage.v1.UpdateResult
+	(*Address)(nil),                         // 12: clutch.envoytriage.v1.Address
+	(*Result)(nil),                          // 13: clutch.envoytriage.v1.Result
+	(*NodeMetadata)(nil),                    // 14: clutch.envoytriage.v1.NodeMetadata
+	(*ReadOperation_Include)(nil),           // 15: clutch.envoytriage.v1.ReadOperation.Include
+	(*UpdateOperation_RuntimeModify)(nil),   // 16: clutch.envoytriage.v1.UpdateOperation.RuntimeModify
+	(*UpdateOperation_HealthcheckFail)(nil), // 17: clutch.envoytriage.v1.UpdateOperation.HealthcheckFail
+	(*UpdateOperation_HealthcheckOk)(nil),   // 18: clutch.envoytriage.v1.UpdateOperation.HealthcheckOk
+	(*UpdateOperation_DrainListeners)(nil),  // 19: clutch.envoytriage.v1.UpdateOperation.DrainListeners
+	(*UpdateOperation_ResetCounters)(nil),   // 20: clutch.envoytriage.v1.UpdateOperation.ResetCounters
+	nil,                                     // 21: clutch.envoytriage.v1.UpdateOperation.RuntimeModify.ValuesEntry
+	(*Result_Output)(nil),                   // 22: clutch.envoytriage.v1.Result.Output
+	(*RuntimeDiff)(nil),                     // 23: clutch.envoytriage.v1.RuntimeDiff
+	(*ClustersDiff)(nil),                    // 24: clutch.envoytriage.v1.ClustersDiff
+	(*ListenersDiff)(nil),                   // 25: clutch.envoytriage.v1.ListenersDiff
+	(*StatsDiff)(nil),                       // 26: clutch.envoytriage.v1.StatsDiff
+	(*ConfigDumpDiff)(nil),                  // 27: clutch.envoytriage.v1.ConfigDumpDiff
+	(*timestamppb.Timestamp)(nil),           // 28: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),             // 29: google.protobuf.Duration
+	(*Clusters)(nil),                        // 30: clutch.envoytriage.v1.Clusters
+	(*ConfigDump)(nil),                      // 31: clutch.envoytriage.v1.ConfigDump
+	(*Listeners)(nil),                       // 32: clutch.envoytriage.v1.Listeners
+	(*Runtime)(nil),                         // 33: clutch.envoytriage.v1.Runtime
+	(*Stats)(nil),                           // 34: clutch.envoytriage.v1.Stats
+	(*ServerInfo)(nil),                      // 35: clutch.envoytriage.v1.ServerInfo
 }
 var file_envoytriage_v1_envoytriage_api_proto_depIdxs = []int32{
-	1,  // 0: clutch.envoytriage.v1.ReadRequest.operations:type_name -> clutch.envoytriage.v1.ReadOperation
-	3,  // 1: clutch.envoytriage.v1.ReadOperation.address:type_name -> clutch.envoytriage.v1.Address
-	6,  // 2: clutch.envoytriage.v1.ReadOperation.include:type_name -> clutch.envoytriage.v1.ReadOperation.Include
-	4,  // 3: clutch.envoytriage.v1.ReadResponse.results:type_name -> clutch.envoytriage.v1.Result
-	3,  // 4: clutch.envoytriage.v1.Result.address:type_name -> clutch.envoytriage.v1.Address
-	5,  // 5: clutch.envoytriage.v1.Result.node_metadata:type_name -> clutch.envoytriage.v1.NodeMetadata
-	7,  // 6: clutch.envoytriage.v1.Result.output:type_name -> clutch.envoytriage.v1.Result.Output
-	8,  // 7: clutch.envoytriage.v1.Result.Output.clusters:type_name -> clutch.envoytriage.v1.Clusters
-	9,  // 8: clutch.envoytriage.v1.Result.Output.config_dump:type_name -> clutch.envoytriage.v1.ConfigDump
-	10, // 9: clutch.envoytriage.v1.Result.Output.listeners:type_name -> clutch.envoytriage.v1.Listeners
-	11, // 10: clutch.envoytriage.v1.Result.Output.runtime:type_name -> clutch.envoytriage.v1.Runtime
-	12, // 11: clutch.envoytriage.v1.Result.Output.stats:type_name -> clutch.envoytriage.v1.Stats
-	13, // 12: clutch.envoytriage.v1.Result.Output.server_info:type_name -> clutch.envoytriage.v1.ServerInfo
-	0,  // 13: clutch.envoytriage.v1.EnvoyTriageAPI.Read:input_type -> clutch.envoytriage.v1.ReadRequest
-	2,  // 14: clutch.envoytriage.v1.EnvoyTriageAPI.Read:output_type -> clutch.envoytriage.v1.ReadResponse
-	14, // [14:15] is the sub-list for method output_type
-	13, // [13:14] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	2,  // 0: clutch.envoytriage.v1.ReadRequest.operations:type_name -> clutch.envoytriage.v1.ReadOperation
+	12, // 1: clutch.envoytriage.v1.ReadOperation.address:type_name -> clutch.envoytriage.v1.Address
+	15, // 2: clutch.envoytriage.v1.ReadOperation.include:type_name -> clutch.envoytriage.v1.ReadOperation.Include
+	0,  // 3: clutch.envoytriage.v1.StatsOptions.format:type_name -> clutch.envoytriage.v1.StatsOptions.Format
+	13, // 4: clutch.envoytriage.v1.ReadResponse.results:type_name -> clutch.envoytriage.v1.Result
+	12, // 5: clutch.envoytriage.v1.CompareRequest.target:type_name -> clutch.envoytriage.v1.Address
+	12, // 6: clutch.envoytriage.v1.CompareRequest.baselines:type_name -> clutch.envoytriage.v1.Address
+	15, // 7: clutch.envoytriage.v1.CompareRequest.include:type_name -> clutch.envoytriage.v1.ReadOperation.Include
+	12, // 8: clutch.envoytriage.v1.CompareResponse.target:type_name -> clutch.envoytriage.v1.Address
+	14, // 9: clutch.envoytriage.v1.CompareResponse.target_node_metadata:type_name -> clutch.envoytriage.v1.NodeMetadata
+	7,  // 10: clutch.envoytriage.v1.CompareResponse.comparisons:type_name -> clutch.envoytriage.v1.Comparison
+	12, // 11: clutch.envoytriage.v1.Comparison.baseline:type_name -> clutch.envoytriage.v1.Address
+	14, // 12: clutch.envoytriage.v1.Comparison.baseline_node_metadata:type_name -> clutch.envoytriage.v1.NodeMetadata
+	23, // 13: clutch.envoytriage.v1.Comparison.runtime:type_name -> clutch.envoytriage.v1.RuntimeDiff
+	24, // 14: clutch.envoytriage.v1.Comparison.clusters:type_name -> clutch.envoytriage.v1.ClustersDiff
+	25, // 15: clutch.envoytriage.v1.Comparison.listeners:type_name -> clutch.envoytriage.v1.ListenersDiff
+	26, // 16: clutch.envoytriage.v1.Comparison.stats:type_name -> clutch.envoytriage.v1.StatsDiff
+	27, // 17: clutch.envoytriage.v1.Comparison.config_dump:type_name -> clutch.envoytriage.v1.ConfigDumpDiff
+	9,  // 18: clutch.envoytriage.v1.UpdateRequest.operations:type_name -> clutch.envoytriage.v1.UpdateOperation
+	12, // 19: clutch.envoytriage.v1.UpdateOperation.address:type_name -> clutch.envoytriage.v1.Address
+	16, // 20: clutch.envoytriage.v1.UpdateOperation.runtime_modify:type_name -> clutch.envoytriage.v1.UpdateOperation.RuntimeModify
+	17, // 21: clutch.envoytriage.v1.UpdateOperation.healthcheck_fail:type_name -> clutch.envoytriage.v1.UpdateOperation.HealthcheckFail
+	18, // 22: clutch.envoytriage.v1.UpdateOperation.healthcheck_ok:type_name -> clutch.envoytriage.v1.UpdateOperation.HealthcheckOk
+	19, // 23: clutch.envoytriage.v1.UpdateOperation.drain_listeners:type_name -> clutch.envoytriage.v1.UpdateOperation.DrainListeners
+	20, // 24: clutch.envoytriage.v1.UpdateOperation.reset_counters:type_name -> clutch.envoytriage.v1.UpdateOperation.ResetCounters
+	11, // 25: clutch.envoytriage.v1.UpdateResponse.results:type_name -> clutch.envoytriage.v1.UpdateResult
+	12, // 26: clutch.envoytriage.v1.UpdateResult.address:type_name -> clutch.envoytriage.v1.Address
+	28, // 27: clutch.envoytriage.v1.UpdateResult.revert_time:type_name -> google.protobuf.Timestamp
+	12, // 28: clutch.envoytriage.v1.Result.address:type_name -> clutch.envoytriage.v1.Address
+	14, // 29: clutch.envoytriage.v1.Result.node_metadata:type_name -> clutch.envoytriage.v1.NodeMetadata
+	22, // 30: clutch.envoytriage.v1.Result.output:type_name -> clutch.envoytriage.v1.Result.Output
+	3,  // 31: clutch.envoytriage.v1.ReadOperation.Include.stats_options:type_name -> clutch.envoytriage.v1.StatsOptions
+	21, // 32: clutch.envoytriage.v1.UpdateOperation.RuntimeModify.values:type_name -> clutch.envoytriage.v1.UpdateOperation.RuntimeModify.ValuesEntry
+	29, // 33: clutch.envoytriage.v1.UpdateOperation.RuntimeModify.ttl:type_name -> google.protobuf.Duration
+	29, // 34: clutch.envoytriage.v1.UpdateOperation.HealthcheckFail.ttl:type_name -> google.protobuf.Duration
+	30, // 35: clutch.envoytriage.v1.Result.Output.clusters:type_name -> clutch.envoytriage.v1.Clusters
+	31, // 36: clutch.envoytriage.v1.Result.Output.config_dump:type_name -> clutch.envoytriage.v1.ConfigDump
+	32, // 37: clutch.envoytriage.v1.Result.Output.listeners:type_name -> clutch.envoytriage.v1.Listeners
+	33, // 38: clutch.envoytriage.v1.Result.Output.runtime:type_name -> clutch.envoytriage.v1.Runtime
+	34, // 39: clutch.envoytriage.v1.Result.Output.stats:type_name -> clutch.envoytriage.v1.Stats
+	35, // 40: clutch.envoytriage.v1.Result.Output.server_info:type_name -> clutch.envoytriage.v1.ServerInfo
+	1,  // 41: clutch.envoytriage.v1.EnvoyTriageAPI.Read:input_type -> clutch.envoytriage.v1.ReadRequest
+	5,  // 42: clutch.envoytriage.v1.EnvoyTriageAPI.Compare:input_type -> clutch.envoytriage.v1.CompareRequest
+	8,  // 43: clutch.envoytriage.v1.EnvoyTriageAPI.Update:input_type -> clutch.envoytriage.v1.UpdateRequest
+	4,  // 44: clutch.envoytriage.v1.EnvoyTriageAPI.Read:output_type -> clutch.envoytriage.v1.ReadResponse
+	6,  // 45: clutch.envoytriage.v1.EnvoyTriageAPI.Compare:output_type -> clutch.envoytriage.v1.CompareResponse
+	10, // 46: clutch.envoytriage.v1.EnvoyTriageAPI.Update:output_type -> clutch.envoytriage.v1.UpdateResponse
+	44, // [44:47] is the sub-list for method output_type
+	41, // [41:44] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_envoytriage_v1_envoytriage_api_proto_init() }
@@ -704,6 +1847,7 @@ func file_envoytriage_v1_envoytriage_api_proto_init() {
 	if File_envoytriage_v1_envoytriage_api_proto != nil {
 		return
 	}
+	file_envoytriage_v1_diff_proto_init()
 	file_envoytriage_v1_output_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_envoytriage_v1_envoytriage_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
@@ -731,7 +1875,7 @@ func file_envoytriage_v1_envoytriage_api_proto_init() {
 			}
 		}
 		file_envoytriage_v1_envoytriage_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadResponse); i {
+			switch v := v.(*StatsOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -743,7 +1887,7 @@ func file_envoytriage_v1_envoytriage_api_proto_init() {
 			}
 		}
 		file_envoytriage_v1_envoytriage_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Address); i {
+			switch v := v.(*ReadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -755,7 +1899,7 @@ func file_envoytriage_v1_envoytriage_api_proto_init() {
 			}
 		}
 		file_envoytriage_v1_envoytriage_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Result); i {
+			switch v := v.(*CompareRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -767,7 +1911,7 @@ func file_envoytriage_v1_envoytriage_api_proto_init() {
 			}
 		}
 		file_envoytriage_v1_envoytriage_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeMetadata); i {
+			switch v := v.(*CompareResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -779,7 +1923,7 @@ func file_envoytriage_v1_envoytriage_api_proto_init() {
 			}
 		}
 		file_envoytriage_v1_envoytriage_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadOperation_Include); i {
+			switch v := v.(*Comparison); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -791,6 +1935,162 @@ func file_envoytriage_v1_envoytriage_api_proto_init() {
 			}
 		}
 		file_envoytriage_v1_envoytriage_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Address); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadOperation_Include); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOperation_RuntimeModify); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOperation_HealthcheckFail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOperation_HealthcheckOk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOperation_DrainListeners); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateOperation_ResetCounters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Result_Output); i {
 			case 0:
 				return &v.state
@@ -803,18 +2103,26 @@ func file_envoytriage_v1_envoytriage_api_proto_init() {
 			}
 		}
 	}
+	file_envoytriage_v1_envoytriage_api_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*UpdateOperation_RuntimeModify_)(nil),
+		(*UpdateOperation_HealthcheckFail_)(nil),
+		(*UpdateOperation_HealthcheckOk_)(nil),
+		(*UpdateOperation_DrainListeners_)(nil),
+		(*UpdateOperation_ResetCounters_)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_envoytriage_v1_envoytriage_api_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      1,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_envoytriage_v1_envoytriage_api_proto_goTypes,
 		DependencyIndexes: file_envoytriage_v1_envoytriage_api_proto_depIdxs,
+		EnumInfos:         file_envoytriage_v1_envoytriage_api_proto_enumTypes,
 		MessageInfos:      file_envoytriage_v1_envoytriage_api_proto_msgTypes,
 	}.Build()
 	File_envoytriage_v1_envoytriage_api_proto = out.File
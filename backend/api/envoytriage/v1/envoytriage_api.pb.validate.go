@@ -337,6 +337,122 @@ var _ interface {
 	ErrorName() string
 } = ReadOperationValidationError{}
 
+// Validate checks the field values on StatsOptions with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatsOptions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatsOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatsOptionsMultiError, or
+// nil if none found.
+func (m *StatsOptions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatsOptions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Filter
+
+	// no validation rules for UsedOnly
+
+	// no validation rules for Histograms
+
+	if _, ok := StatsOptions_Format_name[int32(m.GetFormat())]; !ok {
+		err := StatsOptionsValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StatsOptionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatsOptionsMultiError is an error wrapping multiple validation errors
+// returned by StatsOptions.ValidateAll() if the designated constraints aren't met.
+type StatsOptionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatsOptionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatsOptionsMultiError) AllErrors() []error { return m }
+
+// StatsOptionsValidationError is the validation error returned by
+// StatsOptions.Validate if the designated constraints aren't met.
+type StatsOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatsOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatsOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatsOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatsOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatsOptionsValidationError) ErrorName() string { return "StatsOptionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatsOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatsOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatsOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatsOptionsValidationError{}
+
 // Validate checks the field values on ReadResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -470,44 +586,117 @@ var _ interface {
 	ErrorName() string
 } = ReadResponseValidationError{}
 
-// Validate checks the field values on Address with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on CompareRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Address) Validate() error {
+func (m *CompareRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Address with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in AddressMultiError, or nil if none found.
-func (m *Address) ValidateAll() error {
+// ValidateAll checks the field values on CompareRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CompareRequestMultiError,
+// or nil if none found.
+func (m *CompareRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Address) validate(all bool) error {
+func (m *CompareRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if err := m._validateHostname(m.GetHost()); err != nil {
-		if ip := net.ParseIP(m.GetHost()); ip == nil {
-			err := AddressValidationError{
-				field:  "Host",
-				reason: "value must be a valid hostname, or ip address",
+	if m.GetTarget() == nil {
+		err := CompareRequestValidationError{
+			field:  "Target",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTarget()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompareRequestValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompareRequestValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTarget()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompareRequestValidationError{
+				field:  "Target",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
 	}
 
-	if m.GetPort() > 65535 {
-		err := AddressValidationError{
-			field:  "Port",
-			reason: "value must be less than or equal to 65535",
+	if l := len(m.GetBaselines()); l < 1 || l > 16 {
+		err := CompareRequestValidationError{
+			field:  "Baselines",
+			reason: "value must contain between 1 and 16 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetBaselines() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CompareRequestValidationError{
+						field:  fmt.Sprintf("Baselines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CompareRequestValidationError{
+						field:  fmt.Sprintf("Baselines[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CompareRequestValidationError{
+					field:  fmt.Sprintf("Baselines[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetInclude() == nil {
+		err := CompareRequestValidationError{
+			field:  "Include",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -515,49 +704,2211 @@ func (m *Address) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetInclude()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompareRequestValidationError{
+					field:  "Include",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompareRequestValidationError{
+					field:  "Include",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInclude()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompareRequestValidationError{
+				field:  "Include",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return AddressMultiError(errors)
+		return CompareRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompareRequestMultiError is an error wrapping multiple validation errors
+// returned by CompareRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CompareRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompareRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompareRequestMultiError) AllErrors() []error { return m }
+
+// CompareRequestValidationError is the validation error returned by
+// CompareRequest.Validate if the designated constraints aren't met.
+type CompareRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompareRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompareRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompareRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompareRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompareRequestValidationError) ErrorName() string { return "CompareRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CompareRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
 	}
 
-	return nil
+	return fmt.Sprintf(
+		"invalid %sCompareRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompareRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompareRequestValidationError{}
+
+// Validate checks the field values on CompareResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CompareResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompareResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompareResponseMultiError, or nil if none found.
+func (m *CompareResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompareResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTarget()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompareResponseValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompareResponseValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTarget()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompareResponseValidationError{
+				field:  "Target",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTargetNodeMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompareResponseValidationError{
+					field:  "TargetNodeMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompareResponseValidationError{
+					field:  "TargetNodeMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTargetNodeMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompareResponseValidationError{
+				field:  "TargetNodeMetadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetComparisons() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CompareResponseValidationError{
+						field:  fmt.Sprintf("Comparisons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CompareResponseValidationError{
+						field:  fmt.Sprintf("Comparisons[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CompareResponseValidationError{
+					field:  fmt.Sprintf("Comparisons[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CompareResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompareResponseMultiError is an error wrapping multiple validation errors
+// returned by CompareResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CompareResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompareResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompareResponseMultiError) AllErrors() []error { return m }
+
+// CompareResponseValidationError is the validation error returned by
+// CompareResponse.Validate if the designated constraints aren't met.
+type CompareResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompareResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompareResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompareResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompareResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompareResponseValidationError) ErrorName() string { return "CompareResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CompareResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompareResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompareResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompareResponseValidationError{}
+
+// Validate checks the field values on Comparison with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Comparison) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Comparison with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ComparisonMultiError, or
+// nil if none found.
+func (m *Comparison) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Comparison) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBaseline()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ComparisonValidationError{
+					field:  "Baseline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ComparisonValidationError{
+					field:  "Baseline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBaseline()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ComparisonValidationError{
+				field:  "Baseline",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBaselineNodeMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ComparisonValidationError{
+					field:  "BaselineNodeMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ComparisonValidationError{
+					field:  "BaselineNodeMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBaselineNodeMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ComparisonValidationError{
+				field:  "BaselineNodeMetadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRuntime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ComparisonValidationError{
+					field:  "Runtime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ComparisonValidationError{
+					field:  "Runtime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRuntime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ComparisonValidationError{
+				field:  "Runtime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetClusters()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ComparisonValidationError{
+					field:  "Clusters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ComparisonValidationError{
+					field:  "Clusters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClusters()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ComparisonValidationError{
+				field:  "Clusters",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetListeners()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ComparisonValidationError{
+					field:  "Listeners",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ComparisonValidationError{
+					field:  "Listeners",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetListeners()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ComparisonValidationError{
+				field:  "Listeners",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ComparisonValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ComparisonValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ComparisonValidationError{
+				field:  "Stats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetConfigDump() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ComparisonValidationError{
+						field:  fmt.Sprintf("ConfigDump[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ComparisonValidationError{
+						field:  fmt.Sprintf("ConfigDump[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ComparisonValidationError{
+					field:  fmt.Sprintf("ConfigDump[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ComparisonMultiError(errors)
+	}
+
+	return nil
+}
+
+// ComparisonMultiError is an error wrapping multiple validation errors
+// returned by Comparison.ValidateAll() if the designated constraints aren't met.
+type ComparisonMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ComparisonMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ComparisonMultiError) AllErrors() []error { return m }
+
+// ComparisonValidationError is the validation error returned by
+// Comparison.Validate if the designated constraints aren't met.
+type ComparisonValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComparisonValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComparisonValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComparisonValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComparisonValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComparisonValidationError) ErrorName() string { return "ComparisonValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ComparisonValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComparison.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComparisonValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComparisonValidationError{}
+
+// Validate checks the field values on UpdateRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UpdateRequestMultiError, or
+// nil if none found.
+func (m *UpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetOperations()) < 1 {
+		err := UpdateRequestValidationError{
+			field:  "Operations",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOperations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateRequestValidationError{
+						field:  fmt.Sprintf("Operations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateRequestValidationError{
+						field:  fmt.Sprintf("Operations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateRequestValidationError{
+					field:  fmt.Sprintf("Operations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRequestValidationError is the validation error returned by
+// UpdateRequest.Validate if the designated constraints aren't met.
+type UpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRequestValidationError) ErrorName() string { return "UpdateRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRequestValidationError{}
+
+// Validate checks the field values on UpdateOperation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateOperation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateOperation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateOperationMultiError, or nil if none found.
+func (m *UpdateOperation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateOperation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAddress() == nil {
+		err := UpdateOperationValidationError{
+			field:  "Address",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateOperationValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateOperationValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateOperationValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	oneofActionPresent := false
+	switch v := m.Action.(type) {
+	case *UpdateOperation_RuntimeModify_:
+		if v == nil {
+			err := UpdateOperationValidationError{
+				field:  "Action",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofActionPresent = true
+
+		if all {
+			switch v := interface{}(m.GetRuntimeModify()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateOperationValidationError{
+						field:  "RuntimeModify",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateOperationValidationError{
+						field:  "RuntimeModify",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRuntimeModify()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateOperationValidationError{
+					field:  "RuntimeModify",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UpdateOperation_HealthcheckFail_:
+		if v == nil {
+			err := UpdateOperationValidationError{
+				field:  "Action",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofActionPresent = true
+
+		if all {
+			switch v := interface{}(m.GetHealthcheckFail()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateOperationValidationError{
+						field:  "HealthcheckFail",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateOperationValidationError{
+						field:  "HealthcheckFail",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHealthcheckFail()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateOperationValidationError{
+					field:  "HealthcheckFail",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UpdateOperation_HealthcheckOk_:
+		if v == nil {
+			err := UpdateOperationValidationError{
+				field:  "Action",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofActionPresent = true
+
+		if all {
+			switch v := interface{}(m.GetHealthcheckOk()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateOperationValidationError{
+						field:  "HealthcheckOk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateOperationValidationError{
+						field:  "HealthcheckOk",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHealthcheckOk()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateOperationValidationError{
+					field:  "HealthcheckOk",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UpdateOperation_DrainListeners_:
+		if v == nil {
+			err := UpdateOperationValidationError{
+				field:  "Action",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofActionPresent = true
+
+		if all {
+			switch v := interface{}(m.GetDrainListeners()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateOperationValidationError{
+						field:  "DrainListeners",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateOperationValidationError{
+						field:  "DrainListeners",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDrainListeners()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateOperationValidationError{
+					field:  "DrainListeners",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UpdateOperation_ResetCounters_:
+		if v == nil {
+			err := UpdateOperationValidationError{
+				field:  "Action",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofActionPresent = true
+
+		if all {
+			switch v := interface{}(m.GetResetCounters()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateOperationValidationError{
+						field:  "ResetCounters",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateOperationValidationError{
+						field:  "ResetCounters",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetResetCounters()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateOperationValidationError{
+					field:  "ResetCounters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofActionPresent {
+		err := UpdateOperationValidationError{
+			field:  "Action",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateOperationMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateOperationMultiError is an error wrapping multiple validation errors
+// returned by UpdateOperation.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateOperationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateOperationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateOperationMultiError) AllErrors() []error { return m }
+
+// UpdateOperationValidationError is the validation error returned by
+// UpdateOperation.Validate if the designated constraints aren't met.
+type UpdateOperationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOperationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOperationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOperationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOperationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOperationValidationError) ErrorName() string { return "UpdateOperationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateOperationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOperation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOperationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOperationValidationError{}
+
+// Validate checks the field values on UpdateResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UpdateResponseMultiError,
+// or nil if none found.
+func (m *UpdateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateResponseMultiError) AllErrors() []error { return m }
+
+// UpdateResponseValidationError is the validation error returned by
+// UpdateResponse.Validate if the designated constraints aren't met.
+type UpdateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateResponseValidationError) ErrorName() string { return "UpdateResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateResponseValidationError{}
+
+// Validate checks the field values on UpdateResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UpdateResultMultiError, or
+// nil if none found.
+func (m *UpdateResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateResultValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateResultValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateResultValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRevertTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateResultValidationError{
+					field:  "RevertTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateResultValidationError{
+					field:  "RevertTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevertTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateResultValidationError{
+				field:  "RevertTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateResultMultiError is an error wrapping multiple validation errors
+// returned by UpdateResult.ValidateAll() if the designated constraints aren't met.
+type UpdateResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateResultMultiError) AllErrors() []error { return m }
+
+// UpdateResultValidationError is the validation error returned by
+// UpdateResult.Validate if the designated constraints aren't met.
+type UpdateResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateResultValidationError) ErrorName() string { return "UpdateResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateResultValidationError{}
+
+// Validate checks the field values on Address with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Address) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Address with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AddressMultiError, or nil if none found.
+func (m *Address) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Address) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateHostname(m.GetHost()); err != nil {
+		if ip := net.ParseIP(m.GetHost()); ip == nil {
+			err := AddressValidationError{
+				field:  "Host",
+				reason: "value must be a valid hostname, or ip address",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+	}
+
+	if m.GetPort() > 65535 {
+		err := AddressValidationError{
+			field:  "Port",
+			reason: "value must be less than or equal to 65535",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddressMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *Address) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddressMultiError is an error wrapping multiple validation errors returned
+// by Address.ValidateAll() if the designated constraints aren't met.
+type AddressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddressMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddressMultiError) AllErrors() []error { return m }
+
+// AddressValidationError is the validation error returned by Address.Validate
+// if the designated constraints aren't met.
+type AddressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddressValidationError) ErrorName() string { return "AddressValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddressValidationError{}
+
+// Validate checks the field values on Result with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Result) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Result with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ResultMultiError, or nil if none found.
+func (m *Result) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Result) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResultValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResultValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResultValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNodeMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResultValidationError{
+					field:  "NodeMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResultValidationError{
+					field:  "NodeMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNodeMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResultValidationError{
+				field:  "NodeMetadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOutput()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResultValidationError{
+					field:  "Output",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResultValidationError{
+					field:  "Output",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOutput()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResultValidationError{
+				field:  "Output",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResultMultiError is an error wrapping multiple validation errors returned by
+// Result.ValidateAll() if the designated constraints aren't met.
+type ResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResultMultiError) AllErrors() []error { return m }
+
+// ResultValidationError is the validation error returned by Result.Validate if
+// the designated constraints aren't met.
+type ResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResultValidationError) ErrorName() string { return "ResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResultValidationError{}
+
+// Validate checks the field values on NodeMetadata with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NodeMetadata) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NodeMetadata with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NodeMetadataMultiError, or
+// nil if none found.
+func (m *NodeMetadata) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NodeMetadata) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceNode
+
+	// no validation rules for ServiceCluster
+
+	// no validation rules for ServiceZone
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return NodeMetadataMultiError(errors)
+	}
+
+	return nil
+}
+
+// NodeMetadataMultiError is an error wrapping multiple validation errors
+// returned by NodeMetadata.ValidateAll() if the designated constraints aren't met.
+type NodeMetadataMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NodeMetadataMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NodeMetadataMultiError) AllErrors() []error { return m }
+
+// NodeMetadataValidationError is the validation error returned by
+// NodeMetadata.Validate if the designated constraints aren't met.
+type NodeMetadataValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NodeMetadataValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NodeMetadataValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NodeMetadataValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NodeMetadataValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NodeMetadataValidationError) ErrorName() string { return "NodeMetadataValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NodeMetadataValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNodeMetadata.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NodeMetadataValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NodeMetadataValidationError{}
+
+// Validate checks the field values on ReadOperation_Include with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadOperation_Include) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadOperation_Include with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadOperation_IncludeMultiError, or nil if none found.
+func (m *ReadOperation_Include) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadOperation_Include) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Clusters
+
+	// no validation rules for ConfigDump
+
+	// no validation rules for Listeners
+
+	// no validation rules for Runtime
+
+	// no validation rules for Stats
+
+	// no validation rules for ServerInfo
+
+	if all {
+		switch v := interface{}(m.GetStatsOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadOperation_IncludeValidationError{
+					field:  "StatsOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadOperation_IncludeValidationError{
+					field:  "StatsOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatsOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadOperation_IncludeValidationError{
+				field:  "StatsOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReadOperation_IncludeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadOperation_IncludeMultiError is an error wrapping multiple validation
+// errors returned by ReadOperation_Include.ValidateAll() if the designated
+// constraints aren't met.
+type ReadOperation_IncludeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadOperation_IncludeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadOperation_IncludeMultiError) AllErrors() []error { return m }
+
+// ReadOperation_IncludeValidationError is the validation error returned by
+// ReadOperation_Include.Validate if the designated constraints aren't met.
+type ReadOperation_IncludeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadOperation_IncludeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadOperation_IncludeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadOperation_IncludeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadOperation_IncludeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadOperation_IncludeValidationError) ErrorName() string {
+	return "ReadOperation_IncludeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadOperation_IncludeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadOperation_Include.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadOperation_IncludeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadOperation_IncludeValidationError{}
+
+// Validate checks the field values on UpdateOperation_RuntimeModify with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateOperation_RuntimeModify) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateOperation_RuntimeModify with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateOperation_RuntimeModifyMultiError, or nil if none found.
+func (m *UpdateOperation_RuntimeModify) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateOperation_RuntimeModify) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetValues()) < 1 {
+		err := UpdateOperation_RuntimeModifyValidationError{
+			field:  "Values",
+			reason: "value must contain at least 1 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = UpdateOperation_RuntimeModifyValidationError{
+				field:  "Ttl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := UpdateOperation_RuntimeModifyValidationError{
+					field:  "Ttl",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateOperation_RuntimeModifyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateOperation_RuntimeModifyMultiError is an error wrapping multiple
+// validation errors returned by UpdateOperation_RuntimeModify.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateOperation_RuntimeModifyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateOperation_RuntimeModifyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateOperation_RuntimeModifyMultiError) AllErrors() []error { return m }
+
+// UpdateOperation_RuntimeModifyValidationError is the validation error
+// returned by UpdateOperation_RuntimeModify.Validate if the designated
+// constraints aren't met.
+type UpdateOperation_RuntimeModifyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateOperation_RuntimeModifyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateOperation_RuntimeModifyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateOperation_RuntimeModifyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateOperation_RuntimeModifyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateOperation_RuntimeModifyValidationError) ErrorName() string {
+	return "UpdateOperation_RuntimeModifyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateOperation_RuntimeModifyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateOperation_RuntimeModify.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateOperation_RuntimeModifyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateOperation_RuntimeModifyValidationError{}
+
+// Validate checks the field values on UpdateOperation_HealthcheckFail with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateOperation_HealthcheckFail) Validate() error {
+	return m.validate(false)
 }
 
-func (m *Address) _validateHostname(host string) error {
-	s := strings.ToLower(strings.TrimSuffix(host, "."))
+// ValidateAll checks the field values on UpdateOperation_HealthcheckFail with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateOperation_HealthcheckFailMultiError, or nil if none found.
+func (m *UpdateOperation_HealthcheckFail) ValidateAll() error {
+	return m.validate(true)
+}
 
-	if len(host) > 253 {
-		return errors.New("hostname cannot exceed 253 characters")
+func (m *UpdateOperation_HealthcheckFail) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	for _, part := range strings.Split(s, ".") {
-		if l := len(part); l == 0 || l > 63 {
-			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
-		}
+	var errors []error
 
-		if part[0] == '-' {
-			return errors.New("hostname parts cannot begin with hyphens")
-		}
+	if d := m.GetTtl(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = UpdateOperation_HealthcheckFailValidationError{
+				field:  "Ttl",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
 
-		if part[len(part)-1] == '-' {
-			return errors.New("hostname parts cannot end with hyphens")
-		}
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
 
-		for _, r := range part {
-			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
-				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			if dur < gte {
+				err := UpdateOperation_HealthcheckFailValidationError{
+					field:  "Ttl",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
 			}
+
 		}
 	}
 
+	if len(errors) > 0 {
+		return UpdateOperation_HealthcheckFailMultiError(errors)
+	}
+
 	return nil
 }
 
-// AddressMultiError is an error wrapping multiple validation errors returned
-// by Address.ValidateAll() if the designated constraints aren't met.
-type AddressMultiError []error
+// UpdateOperation_HealthcheckFailMultiError is an error wrapping multiple
+// validation errors returned by UpdateOperation_HealthcheckFail.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateOperation_HealthcheckFailMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddressMultiError) Error() string {
+func (m UpdateOperation_HealthcheckFailMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -566,11 +2917,12 @@ func (m AddressMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddressMultiError) AllErrors() []error { return m }
+func (m UpdateOperation_HealthcheckFailMultiError) AllErrors() []error { return m }
 
-// AddressValidationError is the validation error returned by Address.Validate
-// if the designated constraints aren't met.
-type AddressValidationError struct {
+// UpdateOperation_HealthcheckFailValidationError is the validation error
+// returned by UpdateOperation_HealthcheckFail.Validate if the designated
+// constraints aren't met.
+type UpdateOperation_HealthcheckFailValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -578,22 +2930,24 @@ type AddressValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddressValidationError) Field() string { return e.field }
+func (e UpdateOperation_HealthcheckFailValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddressValidationError) Reason() string { return e.reason }
+func (e UpdateOperation_HealthcheckFailValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddressValidationError) Cause() error { return e.cause }
+func (e UpdateOperation_HealthcheckFailValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddressValidationError) Key() bool { return e.key }
+func (e UpdateOperation_HealthcheckFailValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddressValidationError) ErrorName() string { return "AddressValidationError" }
+func (e UpdateOperation_HealthcheckFailValidationError) ErrorName() string {
+	return "UpdateOperation_HealthcheckFailValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AddressValidationError) Error() string {
+func (e UpdateOperation_HealthcheckFailValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -605,14 +2959,14 @@ func (e AddressValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddress.%s: %s%s",
+		"invalid %sUpdateOperation_HealthcheckFail.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AddressValidationError{}
+var _ error = UpdateOperation_HealthcheckFailValidationError{}
 
 var _ interface {
 	Field() string
@@ -620,129 +2974,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddressValidationError{}
+} = UpdateOperation_HealthcheckFailValidationError{}
 
-// Validate checks the field values on Result with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Result) Validate() error {
+// Validate checks the field values on UpdateOperation_HealthcheckOk with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateOperation_HealthcheckOk) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Result with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ResultMultiError, or nil if none found.
-func (m *Result) ValidateAll() error {
+// ValidateAll checks the field values on UpdateOperation_HealthcheckOk with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateOperation_HealthcheckOkMultiError, or nil if none found.
+func (m *UpdateOperation_HealthcheckOk) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Result) validate(all bool) error {
+func (m *UpdateOperation_HealthcheckOk) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetAddress()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResultValidationError{
-					field:  "Address",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ResultValidationError{
-					field:  "Address",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ResultValidationError{
-				field:  "Address",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetNodeMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResultValidationError{
-					field:  "NodeMetadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ResultValidationError{
-					field:  "NodeMetadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetNodeMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ResultValidationError{
-				field:  "NodeMetadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetOutput()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResultValidationError{
-					field:  "Output",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ResultValidationError{
-					field:  "Output",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOutput()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ResultValidationError{
-				field:  "Output",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return ResultMultiError(errors)
+		return UpdateOperation_HealthcheckOkMultiError(errors)
 	}
 
 	return nil
 }
 
-// ResultMultiError is an error wrapping multiple validation errors returned by
-// Result.ValidateAll() if the designated constraints aren't met.
-type ResultMultiError []error
+// UpdateOperation_HealthcheckOkMultiError is an error wrapping multiple
+// validation errors returned by UpdateOperation_HealthcheckOk.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateOperation_HealthcheckOkMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResultMultiError) Error() string {
+func (m UpdateOperation_HealthcheckOkMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -751,11 +3020,12 @@ func (m ResultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResultMultiError) AllErrors() []error { return m }
+func (m UpdateOperation_HealthcheckOkMultiError) AllErrors() []error { return m }
 
-// ResultValidationError is the validation error returned by Result.Validate if
-// the designated constraints aren't met.
-type ResultValidationError struct {
+// UpdateOperation_HealthcheckOkValidationError is the validation error
+// returned by UpdateOperation_HealthcheckOk.Validate if the designated
+// constraints aren't met.
+type UpdateOperation_HealthcheckOkValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -763,22 +3033,24 @@ type ResultValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResultValidationError) Field() string { return e.field }
+func (e UpdateOperation_HealthcheckOkValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResultValidationError) Reason() string { return e.reason }
+func (e UpdateOperation_HealthcheckOkValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResultValidationError) Cause() error { return e.cause }
+func (e UpdateOperation_HealthcheckOkValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResultValidationError) Key() bool { return e.key }
+func (e UpdateOperation_HealthcheckOkValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResultValidationError) ErrorName() string { return "ResultValidationError" }
+func (e UpdateOperation_HealthcheckOkValidationError) ErrorName() string {
+	return "UpdateOperation_HealthcheckOkValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ResultValidationError) Error() string {
+func (e UpdateOperation_HealthcheckOkValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -790,14 +3062,14 @@ func (e ResultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResult.%s: %s%s",
+		"invalid %sUpdateOperation_HealthcheckOk.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResultValidationError{}
+var _ error = UpdateOperation_HealthcheckOkValidationError{}
 
 var _ interface {
 	Field() string
@@ -805,51 +3077,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResultValidationError{}
+} = UpdateOperation_HealthcheckOkValidationError{}
 
-// Validate checks the field values on NodeMetadata with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *NodeMetadata) Validate() error {
+// Validate checks the field values on UpdateOperation_DrainListeners with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateOperation_DrainListeners) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on NodeMetadata with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in NodeMetadataMultiError, or
-// nil if none found.
-func (m *NodeMetadata) ValidateAll() error {
+// ValidateAll checks the field values on UpdateOperation_DrainListeners with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateOperation_DrainListenersMultiError, or nil if none found.
+func (m *UpdateOperation_DrainListeners) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *NodeMetadata) validate(all bool) error {
+func (m *UpdateOperation_DrainListeners) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ServiceNode
-
-	// no validation rules for ServiceCluster
-
-	// no validation rules for ServiceZone
+	// no validation rules for InboundOnly
 
-	// no validation rules for Version
+	// no validation rules for Graceful
 
 	if len(errors) > 0 {
-		return NodeMetadataMultiError(errors)
+		return UpdateOperation_DrainListenersMultiError(errors)
 	}
 
 	return nil
 }
 
-// NodeMetadataMultiError is an error wrapping multiple validation errors
-// returned by NodeMetadata.ValidateAll() if the designated constraints aren't met.
-type NodeMetadataMultiError []error
+// UpdateOperation_DrainListenersMultiError is an error wrapping multiple
+// validation errors returned by UpdateOperation_DrainListeners.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateOperation_DrainListenersMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NodeMetadataMultiError) Error() string {
+func (m UpdateOperation_DrainListenersMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -858,11 +3127,12 @@ func (m NodeMetadataMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NodeMetadataMultiError) AllErrors() []error { return m }
+func (m UpdateOperation_DrainListenersMultiError) AllErrors() []error { return m }
 
-// NodeMetadataValidationError is the validation error returned by
-// NodeMetadata.Validate if the designated constraints aren't met.
-type NodeMetadataValidationError struct {
+// UpdateOperation_DrainListenersValidationError is the validation error
+// returned by UpdateOperation_DrainListeners.Validate if the designated
+// constraints aren't met.
+type UpdateOperation_DrainListenersValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -870,22 +3140,24 @@ type NodeMetadataValidationError struct {
 }
 
 // Field function returns field value.
-func (e NodeMetadataValidationError) Field() string { return e.field }
+func (e UpdateOperation_DrainListenersValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NodeMetadataValidationError) Reason() string { return e.reason }
+func (e UpdateOperation_DrainListenersValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NodeMetadataValidationError) Cause() error { return e.cause }
+func (e UpdateOperation_DrainListenersValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NodeMetadataValidationError) Key() bool { return e.key }
+func (e UpdateOperation_DrainListenersValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NodeMetadataValidationError) ErrorName() string { return "NodeMetadataValidationError" }
+func (e UpdateOperation_DrainListenersValidationError) ErrorName() string {
+	return "UpdateOperation_DrainListenersValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e NodeMetadataValidationError) Error() string {
+func (e UpdateOperation_DrainListenersValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -897,14 +3169,14 @@ func (e NodeMetadataValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNodeMetadata.%s: %s%s",
+		"invalid %sUpdateOperation_DrainListeners.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NodeMetadataValidationError{}
+var _ error = UpdateOperation_DrainListenersValidationError{}
 
 var _ interface {
 	Field() string
@@ -912,56 +3184,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NodeMetadataValidationError{}
+} = UpdateOperation_DrainListenersValidationError{}
 
-// Validate checks the field values on ReadOperation_Include with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateOperation_ResetCounters with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReadOperation_Include) Validate() error {
+func (m *UpdateOperation_ResetCounters) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReadOperation_Include with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ReadOperation_IncludeMultiError, or nil if none found.
-func (m *ReadOperation_Include) ValidateAll() error {
+// ValidateAll checks the field values on UpdateOperation_ResetCounters with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// UpdateOperation_ResetCountersMultiError, or nil if none found.
+func (m *UpdateOperation_ResetCounters) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReadOperation_Include) validate(all bool) error {
+func (m *UpdateOperation_ResetCounters) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Clusters
-
-	// no validation rules for ConfigDump
-
-	// no validation rules for Listeners
-
-	// no validation rules for Runtime
-
-	// no validation rules for Stats
-
-	// no validation rules for ServerInfo
-
 	if len(errors) > 0 {
-		return ReadOperation_IncludeMultiError(errors)
+		return UpdateOperation_ResetCountersMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReadOperation_IncludeMultiError is an error wrapping multiple validation
-// errors returned by ReadOperation_Include.ValidateAll() if the designated
-// constraints aren't met.
-type ReadOperation_IncludeMultiError []error
+// UpdateOperation_ResetCountersMultiError is an error wrapping multiple
+// validation errors returned by UpdateOperation_ResetCounters.ValidateAll()
+// if the designated constraints aren't met.
+type UpdateOperation_ResetCountersMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReadOperation_IncludeMultiError) Error() string {
+func (m UpdateOperation_ResetCountersMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -970,11 +3230,12 @@ func (m ReadOperation_IncludeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReadOperation_IncludeMultiError) AllErrors() []error { return m }
+func (m UpdateOperation_ResetCountersMultiError) AllErrors() []error { return m }
 
-// ReadOperation_IncludeValidationError is the validation error returned by
-// ReadOperation_Include.Validate if the designated constraints aren't met.
-type ReadOperation_IncludeValidationError struct {
+// UpdateOperation_ResetCountersValidationError is the validation error
+// returned by UpdateOperation_ResetCounters.Validate if the designated
+// constraints aren't met.
+type UpdateOperation_ResetCountersValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -982,24 +3243,24 @@ type ReadOperation_IncludeValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReadOperation_IncludeValidationError) Field() string { return e.field }
+func (e UpdateOperation_ResetCountersValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReadOperation_IncludeValidationError) Reason() string { return e.reason }
+func (e UpdateOperation_ResetCountersValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReadOperation_IncludeValidationError) Cause() error { return e.cause }
+func (e UpdateOperation_ResetCountersValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReadOperation_IncludeValidationError) Key() bool { return e.key }
+func (e UpdateOperation_ResetCountersValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReadOperation_IncludeValidationError) ErrorName() string {
-	return "ReadOperation_IncludeValidationError"
+func (e UpdateOperation_ResetCountersValidationError) ErrorName() string {
+	return "UpdateOperation_ResetCountersValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReadOperation_IncludeValidationError) Error() string {
+func (e UpdateOperation_ResetCountersValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1011,14 +3272,14 @@ func (e ReadOperation_IncludeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReadOperation_Include.%s: %s%s",
+		"invalid %sUpdateOperation_ResetCounters.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReadOperation_IncludeValidationError{}
+var _ error = UpdateOperation_ResetCountersValidationError{}
 
 var _ interface {
 	Field() string
@@ -1026,7 +3287,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReadOperation_IncludeValidationError{}
+} = UpdateOperation_ResetCountersValidationError{}
 
 // Validate checks the field values on Result_Output with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
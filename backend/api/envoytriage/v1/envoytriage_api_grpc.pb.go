@@ -23,6 +23,8 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type EnvoyTriageAPIClient interface {
 	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error)
+	Compare(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*CompareResponse, error)
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 }
 
 type envoyTriageAPIClient struct {
@@ -42,11 +44,31 @@ func (c *envoyTriageAPIClient) Read(ctx context.Context, in *ReadRequest, opts .
 	return out, nil
 }
 
+func (c *envoyTriageAPIClient) Compare(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*CompareResponse, error) {
+	out := new(CompareResponse)
+	err := c.cc.Invoke(ctx, "/clutch.envoytriage.v1.EnvoyTriageAPI/Compare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *envoyTriageAPIClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error) {
+	out := new(UpdateResponse)
+	err := c.cc.Invoke(ctx, "/clutch.envoytriage.v1.EnvoyTriageAPI/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnvoyTriageAPIServer is the server API for EnvoyTriageAPI service.
 // All implementations should embed UnimplementedEnvoyTriageAPIServer
 // for forward compatibility
 type EnvoyTriageAPIServer interface {
 	Read(context.Context, *ReadRequest) (*ReadResponse, error)
+	Compare(context.Context, *CompareRequest) (*CompareResponse, error)
+	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
 }
 
 // UnimplementedEnvoyTriageAPIServer should be embedded to have forward compatible implementations.
@@ -56,6 +78,12 @@ type UnimplementedEnvoyTriageAPIServer struct {
 func (UnimplementedEnvoyTriageAPIServer) Read(context.Context, *ReadRequest) (*ReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
 }
+func (UnimplementedEnvoyTriageAPIServer) Compare(context.Context, *CompareRequest) (*CompareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Compare not implemented")
+}
+func (UnimplementedEnvoyTriageAPIServer) Update(context.Context, *UpdateRequest) (*UpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
 
 // UnsafeEnvoyTriageAPIServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EnvoyTriageAPIServer will
@@ -86,6 +114,42 @@ func _EnvoyTriageAPI_Read_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnvoyTriageAPI_Compare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyTriageAPIServer).Compare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.envoytriage.v1.EnvoyTriageAPI/Compare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyTriageAPIServer).Compare(ctx, req.(*CompareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnvoyTriageAPI_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyTriageAPIServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.envoytriage.v1.EnvoyTriageAPI/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyTriageAPIServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EnvoyTriageAPI_ServiceDesc is the grpc.ServiceDesc for EnvoyTriageAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -97,6 +161,14 @@ var EnvoyTriageAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Read",
 			Handler:    _EnvoyTriageAPI_Read_Handler,
 		},
+		{
+			MethodName: "Compare",
+			Handler:    _EnvoyTriageAPI_Compare_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _EnvoyTriageAPI_Update_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "envoytriage/v1/envoytriage_api.proto",
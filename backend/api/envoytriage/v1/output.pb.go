@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	structpb "google.golang.org/protobuf/types/known/structpb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -426,8 +427,10 @@ type Stats struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Counters and gauges are returned here.
-	Stats []*Stats_Stat `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	// Counters and gauges are returned here. Stats ingested from the Prometheus format are keyed by metric name and
+	// labels, e.g. envoy_cluster_upstream_rq{envoy_cluster_name="foo",envoy_response_code="200"}.
+	Stats      []*Stats_Stat `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	Histograms []*Histogram  `protobuf:"bytes,2,rep,name=histograms,proto3" json:"histograms,omitempty"`
 }
 
 func (x *Stats) Reset() {
@@ -469,6 +472,94 @@ func (x *Stats) GetStats() []*Stats_Stat {
 	return nil
 }
 
+func (x *Stats) GetHistograms() []*Histogram {
+	if x != nil {
+		return x.Histograms
+	}
+	return nil
+}
+
+type Histogram struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Quantiles computed by Envoy, populated from the JSON format.
+	Quantiles []*Histogram_Quantile `protobuf:"bytes,2,rep,name=quantiles,proto3" json:"quantiles,omitempty"`
+	// Cumulative buckets, populated from the Prometheus format.
+	Buckets     []*Histogram_Bucket `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	SampleSum   float64             `protobuf:"fixed64,4,opt,name=sample_sum,json=sampleSum,proto3" json:"sample_sum,omitempty"`
+	SampleCount uint64              `protobuf:"varint,5,opt,name=sample_count,json=sampleCount,proto3" json:"sample_count,omitempty"`
+}
+
+func (x *Histogram) Reset() {
+	*x = Histogram{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_output_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Histogram) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Histogram) ProtoMessage() {}
+
+func (x *Histogram) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_output_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Histogram.ProtoReflect.Descriptor instead.
+func (*Histogram) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_output_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Histogram) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Histogram) GetQuantiles() []*Histogram_Quantile {
+	if x != nil {
+		return x.Quantiles
+	}
+	return nil
+}
+
+func (x *Histogram) GetBuckets() []*Histogram_Bucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *Histogram) GetSampleSum() float64 {
+	if x != nil {
+		return x.SampleSum
+	}
+	return 0
+}
+
+func (x *Histogram) GetSampleCount() uint64 {
+	if x != nil {
+		return x.SampleCount
+	}
+	return 0
+}
+
 type Runtime_Entry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -476,7 +567,6 @@ type Runtime_Entry struct {
 
 	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	// Types that are assignable to Type:
-	//
 	//	*Runtime_Entry_Value
 	Type isRuntime_Entry_Type `protobuf_oneof:"type"`
 }
@@ -484,7 +574,7 @@ type Runtime_Entry struct {
 func (x *Runtime_Entry) Reset() {
 	*x = Runtime_Entry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_envoytriage_v1_output_proto_msgTypes[9]
+		mi := &file_envoytriage_v1_output_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -497,7 +587,7 @@ func (x *Runtime_Entry) String() string {
 func (*Runtime_Entry) ProtoMessage() {}
 
 func (x *Runtime_Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_envoytriage_v1_output_proto_msgTypes[9]
+	mi := &file_envoytriage_v1_output_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -556,7 +646,7 @@ type Stats_Stat struct {
 func (x *Stats_Stat) Reset() {
 	*x = Stats_Stat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_envoytriage_v1_output_proto_msgTypes[10]
+		mi := &file_envoytriage_v1_output_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -569,7 +659,7 @@ func (x *Stats_Stat) String() string {
 func (*Stats_Stat) ProtoMessage() {}
 
 func (x *Stats_Stat) ProtoReflect() protoreflect.Message {
-	mi := &file_envoytriage_v1_output_proto_msgTypes[10]
+	mi := &file_envoytriage_v1_output_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,6 +689,127 @@ func (x *Stats_Stat) GetValue() uint64 {
 	return 0
 }
 
+type Histogram_Quantile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The quantile as a percentage, e.g. 99.9.
+	Quantile float64 `protobuf:"fixed64,1,opt,name=quantile,proto3" json:"quantile,omitempty"`
+	// Unset if the histogram has not recorded any values in the interval or since startup respectively.
+	Interval   *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	Cumulative *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=cumulative,proto3" json:"cumulative,omitempty"`
+}
+
+func (x *Histogram_Quantile) Reset() {
+	*x = Histogram_Quantile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_output_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Histogram_Quantile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Histogram_Quantile) ProtoMessage() {}
+
+func (x *Histogram_Quantile) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_output_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Histogram_Quantile.ProtoReflect.Descriptor instead.
+func (*Histogram_Quantile) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_output_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *Histogram_Quantile) GetQuantile() float64 {
+	if x != nil {
+		return x.Quantile
+	}
+	return 0
+}
+
+func (x *Histogram_Quantile) GetInterval() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *Histogram_Quantile) GetCumulative() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Cumulative
+	}
+	return nil
+}
+
+type Histogram_Bucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The inclusive upper bound of the bucket.
+	UpperBound      float64 `protobuf:"fixed64,1,opt,name=upper_bound,json=upperBound,proto3" json:"upper_bound,omitempty"`
+	CumulativeCount uint64  `protobuf:"varint,2,opt,name=cumulative_count,json=cumulativeCount,proto3" json:"cumulative_count,omitempty"`
+}
+
+func (x *Histogram_Bucket) Reset() {
+	*x = Histogram_Bucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_output_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Histogram_Bucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Histogram_Bucket) ProtoMessage() {}
+
+func (x *Histogram_Bucket) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_output_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Histogram_Bucket.ProtoReflect.Descriptor instead.
+func (*Histogram_Bucket) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_output_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *Histogram_Bucket) GetUpperBound() float64 {
+	if x != nil {
+		return x.UpperBound
+	}
+	return 0
+}
+
+func (x *Histogram_Bucket) GetCumulativeCount() uint64 {
+	if x != nil {
+		return x.CumulativeCount
+	}
+	return 0
+}
+
 var File_envoytriage_v1_output_proto protoreflect.FileDescriptor
 
 var file_envoytriage_v1_output_proto_rawDesc = []byte{
@@ -607,6 +818,8 @@ var file_envoytriage_v1_output_proto_rawDesc = []byte{
 	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67,
 	0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x22, 0x40, 0x0a, 0x0a, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
 	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65,
@@ -650,19 +863,53 @@ var file_envoytriage_v1_output_proto_rawDesc = []byte{
 	0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2c, 0x0a, 0x05, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x70, 0x0a, 0x05, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79,
-	0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x1a, 0x2e, 0x0a, 0x04, 0x53,
-	0x74, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x41, 0x5a, 0x3f, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66, 0x74, 0x2f, 0x63,
-	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31,
-	0x3b, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x0a,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74,
+	0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x52, 0x0a, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x2e,
+	0x0a, 0x04, 0x53, 0x74, 0x61, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xe4,
+	0x03, 0x0a, 0x09, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x47, 0x0a, 0x09, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76,
+	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x2e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x52, 0x09,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6c, 0x75,
+	0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x2e, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x73, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x75, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x9e,
+	0x01, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x3c, 0x0a, 0x0a, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0a, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x1a,
+	0x54, 0x0a, 0x06, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x70,
+	0x65, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x75, 0x70, 0x70, 0x65, 0x72, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x75,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x76, 0x6f, 0x79,
+	0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -677,34 +924,43 @@ func file_envoytriage_v1_output_proto_rawDescGZIP() []byte {
 	return file_envoytriage_v1_output_proto_rawDescData
 }
 
-var file_envoytriage_v1_output_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_envoytriage_v1_output_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_envoytriage_v1_output_proto_goTypes = []interface{}{
-	(*HostStatus)(nil),     // 0: clutch.envoytriage.v1.HostStatus
-	(*ClusterStatus)(nil),  // 1: clutch.envoytriage.v1.ClusterStatus
-	(*Clusters)(nil),       // 2: clutch.envoytriage.v1.Clusters
-	(*ConfigDump)(nil),     // 3: clutch.envoytriage.v1.ConfigDump
-	(*ListenerStatus)(nil), // 4: clutch.envoytriage.v1.ListenerStatus
-	(*Listeners)(nil),      // 5: clutch.envoytriage.v1.Listeners
-	(*Runtime)(nil),        // 6: clutch.envoytriage.v1.Runtime
-	(*ServerInfo)(nil),     // 7: clutch.envoytriage.v1.ServerInfo
-	(*Stats)(nil),          // 8: clutch.envoytriage.v1.Stats
-	(*Runtime_Entry)(nil),  // 9: clutch.envoytriage.v1.Runtime.Entry
-	(*Stats_Stat)(nil),     // 10: clutch.envoytriage.v1.Stats.Stat
-	(*structpb.Value)(nil), // 11: google.protobuf.Value
+	(*HostStatus)(nil),             // 0: clutch.envoytriage.v1.HostStatus
+	(*ClusterStatus)(nil),          // 1: clutch.envoytriage.v1.ClusterStatus
+	(*Clusters)(nil),               // 2: clutch.envoytriage.v1.Clusters
+	(*ConfigDump)(nil),             // 3: clutch.envoytriage.v1.ConfigDump
+	(*ListenerStatus)(nil),         // 4: clutch.envoytriage.v1.ListenerStatus
+	(*Listeners)(nil),              // 5: clutch.envoytriage.v1.Listeners
+	(*Runtime)(nil),                // 6: clutch.envoytriage.v1.Runtime
+	(*ServerInfo)(nil),             // 7: clutch.envoytriage.v1.ServerInfo
+	(*Stats)(nil),                  // 8: clutch.envoytriage.v1.Stats
+	(*Histogram)(nil),              // 9: clutch.envoytriage.v1.Histogram
+	(*Runtime_Entry)(nil),          // 10: clutch.envoytriage.v1.Runtime.Entry
+	(*Stats_Stat)(nil),             // 11: clutch.envoytriage.v1.Stats.Stat
+	(*Histogram_Quantile)(nil),     // 12: clutch.envoytriage.v1.Histogram.Quantile
+	(*Histogram_Bucket)(nil),       // 13: clutch.envoytriage.v1.Histogram.Bucket
+	(*structpb.Value)(nil),         // 14: google.protobuf.Value
+	(*wrapperspb.DoubleValue)(nil), // 15: google.protobuf.DoubleValue
 }
 var file_envoytriage_v1_output_proto_depIdxs = []int32{
 	0,  // 0: clutch.envoytriage.v1.ClusterStatus.host_statuses:type_name -> clutch.envoytriage.v1.HostStatus
 	1,  // 1: clutch.envoytriage.v1.Clusters.cluster_statuses:type_name -> clutch.envoytriage.v1.ClusterStatus
-	11, // 2: clutch.envoytriage.v1.ConfigDump.value:type_name -> google.protobuf.Value
+	14, // 2: clutch.envoytriage.v1.ConfigDump.value:type_name -> google.protobuf.Value
 	4,  // 3: clutch.envoytriage.v1.Listeners.listener_statuses:type_name -> clutch.envoytriage.v1.ListenerStatus
-	9,  // 4: clutch.envoytriage.v1.Runtime.entries:type_name -> clutch.envoytriage.v1.Runtime.Entry
-	11, // 5: clutch.envoytriage.v1.ServerInfo.value:type_name -> google.protobuf.Value
-	10, // 6: clutch.envoytriage.v1.Stats.stats:type_name -> clutch.envoytriage.v1.Stats.Stat
-	7,  // [7:7] is the sub-list for method output_type
-	7,  // [7:7] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	10, // 4: clutch.envoytriage.v1.Runtime.entries:type_name -> clutch.envoytriage.v1.Runtime.Entry
+	14, // 5: clutch.envoytriage.v1.ServerInfo.value:type_name -> google.protobuf.Value
+	11, // 6: clutch.envoytriage.v1.Stats.stats:type_name -> clutch.envoytriage.v1.Stats.Stat
+	9,  // 7: clutch.envoytriage.v1.Stats.histograms:type_name -> clutch.envoytriage.v1.Histogram
+	12, // 8: clutch.envoytriage.v1.Histogram.quantiles:type_name -> clutch.envoytriage.v1.Histogram.Quantile
+	13, // 9: clutch.envoytriage.v1.Histogram.buckets:type_name -> clutch.envoytriage.v1.Histogram.Bucket
+	15, // 10: clutch.envoytriage.v1.Histogram.Quantile.interval:type_name -> google.protobuf.DoubleValue
+	15, // 11: clutch.envoytriage.v1.Histogram.Quantile.cumulative:type_name -> google.protobuf.DoubleValue
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_envoytriage_v1_output_proto_init() }
@@ -822,7 +1078,7 @@ func file_envoytriage_v1_output_proto_init() {
 			}
 		}
 		file_envoytriage_v1_output_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Runtime_Entry); i {
+			switch v := v.(*Histogram); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -834,6 +1090,18 @@ func file_envoytriage_v1_output_proto_init() {
 			}
 		}
 		file_envoytriage_v1_output_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Runtime_Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_output_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Stats_Stat); i {
 			case 0:
 				return &v.state
@@ -845,8 +1113,32 @@ func file_envoytriage_v1_output_proto_init() {
 				return nil
 			}
 		}
+		file_envoytriage_v1_output_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Histogram_Quantile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_output_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Histogram_Bucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_envoytriage_v1_output_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_envoytriage_v1_output_proto_msgTypes[10].OneofWrappers = []interface{}{
 		(*Runtime_Entry_Value)(nil),
 	}
 	type x struct{}
@@ -855,7 +1147,7 @@ func file_envoytriage_v1_output_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_envoytriage_v1_output_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1087,6 +1087,40 @@ func (m *Stats) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetHistograms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StatsValidationError{
+						field:  fmt.Sprintf("Histograms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StatsValidationError{
+						field:  fmt.Sprintf("Histograms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StatsValidationError{
+					field:  fmt.Sprintf("Histograms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return StatsMultiError(errors)
 	}
@@ -1164,6 +1198,179 @@ var _ interface {
 	ErrorName() string
 } = StatsValidationError{}
 
+// Validate checks the field values on Histogram with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Histogram) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Histogram with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HistogramMultiError, or nil
+// if none found.
+func (m *Histogram) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Histogram) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	for idx, item := range m.GetQuantiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HistogramValidationError{
+						field:  fmt.Sprintf("Quantiles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HistogramValidationError{
+						field:  fmt.Sprintf("Quantiles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HistogramValidationError{
+					field:  fmt.Sprintf("Quantiles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetBuckets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HistogramValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HistogramValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HistogramValidationError{
+					field:  fmt.Sprintf("Buckets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SampleSum
+
+	// no validation rules for SampleCount
+
+	if len(errors) > 0 {
+		return HistogramMultiError(errors)
+	}
+
+	return nil
+}
+
+// HistogramMultiError is an error wrapping multiple validation errors returned
+// by Histogram.ValidateAll() if the designated constraints aren't met.
+type HistogramMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HistogramMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HistogramMultiError) AllErrors() []error { return m }
+
+// HistogramValidationError is the validation error returned by
+// Histogram.Validate if the designated constraints aren't met.
+type HistogramValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HistogramValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HistogramValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HistogramValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HistogramValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HistogramValidationError) ErrorName() string { return "HistogramValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HistogramValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHistogram.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HistogramValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HistogramValidationError{}
+
 // Validate checks the field values on Runtime_Entry with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1385,3 +1592,269 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = Stats_StatValidationError{}
+
+// Validate checks the field values on Histogram_Quantile with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Histogram_Quantile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Histogram_Quantile with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Histogram_QuantileMultiError, or nil if none found.
+func (m *Histogram_Quantile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Histogram_Quantile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Quantile
+
+	if all {
+		switch v := interface{}(m.GetInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Histogram_QuantileValidationError{
+					field:  "Interval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Histogram_QuantileValidationError{
+					field:  "Interval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Histogram_QuantileValidationError{
+				field:  "Interval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCumulative()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Histogram_QuantileValidationError{
+					field:  "Cumulative",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Histogram_QuantileValidationError{
+					field:  "Cumulative",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCumulative()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Histogram_QuantileValidationError{
+				field:  "Cumulative",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Histogram_QuantileMultiError(errors)
+	}
+
+	return nil
+}
+
+// Histogram_QuantileMultiError is an error wrapping multiple validation errors
+// returned by Histogram_Quantile.ValidateAll() if the designated constraints
+// aren't met.
+type Histogram_QuantileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Histogram_QuantileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Histogram_QuantileMultiError) AllErrors() []error { return m }
+
+// Histogram_QuantileValidationError is the validation error returned by
+// Histogram_Quantile.Validate if the designated constraints aren't met.
+type Histogram_QuantileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Histogram_QuantileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Histogram_QuantileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Histogram_QuantileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Histogram_QuantileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Histogram_QuantileValidationError) ErrorName() string {
+	return "Histogram_QuantileValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Histogram_QuantileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHistogram_Quantile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Histogram_QuantileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Histogram_QuantileValidationError{}
+
+// Validate checks the field values on Histogram_Bucket with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Histogram_Bucket) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Histogram_Bucket with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Histogram_BucketMultiError, or nil if none found.
+func (m *Histogram_Bucket) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Histogram_Bucket) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UpperBound
+
+	// no validation rules for CumulativeCount
+
+	if len(errors) > 0 {
+		return Histogram_BucketMultiError(errors)
+	}
+
+	return nil
+}
+
+// Histogram_BucketMultiError is an error wrapping multiple validation errors
+// returned by Histogram_Bucket.ValidateAll() if the designated constraints
+// aren't met.
+type Histogram_BucketMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Histogram_BucketMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Histogram_BucketMultiError) AllErrors() []error { return m }
+
+// Histogram_BucketValidationError is the validation error returned by
+// Histogram_Bucket.Validate if the designated constraints aren't met.
+type Histogram_BucketValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Histogram_BucketValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Histogram_BucketValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Histogram_BucketValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Histogram_BucketValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Histogram_BucketValidationError) ErrorName() string { return "Histogram_BucketValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Histogram_BucketValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHistogram_Bucket.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Histogram_BucketValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Histogram_BucketValidationError{}
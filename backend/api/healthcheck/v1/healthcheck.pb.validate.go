@@ -238,3 +238,815 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = HealthcheckResponseValidationError{}
+
+// Validate checks the field values on LivenessRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LivenessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LivenessRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LivenessRequestMultiError, or nil if none found.
+func (m *LivenessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LivenessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return LivenessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// LivenessRequestMultiError is an error wrapping multiple validation errors
+// returned by LivenessRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LivenessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LivenessRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LivenessRequestMultiError) AllErrors() []error { return m }
+
+// LivenessRequestValidationError is the validation error returned by
+// LivenessRequest.Validate if the designated constraints aren't met.
+type LivenessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LivenessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LivenessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LivenessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LivenessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LivenessRequestValidationError) ErrorName() string { return "LivenessRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LivenessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLivenessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LivenessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LivenessRequestValidationError{}
+
+// Validate checks the field values on LivenessResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LivenessResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LivenessResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LivenessResponseMultiError, or nil if none found.
+func (m *LivenessResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LivenessResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return LivenessResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// LivenessResponseMultiError is an error wrapping multiple validation errors
+// returned by LivenessResponse.ValidateAll() if the designated constraints
+// aren't met.
+type LivenessResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LivenessResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LivenessResponseMultiError) AllErrors() []error { return m }
+
+// LivenessResponseValidationError is the validation error returned by
+// LivenessResponse.Validate if the designated constraints aren't met.
+type LivenessResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LivenessResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LivenessResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LivenessResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LivenessResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LivenessResponseValidationError) ErrorName() string { return "LivenessResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LivenessResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLivenessResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LivenessResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LivenessResponseValidationError{}
+
+// Validate checks the field values on ReadinessRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReadinessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadinessRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadinessRequestMultiError, or nil if none found.
+func (m *ReadinessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadinessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReadinessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadinessRequestMultiError is an error wrapping multiple validation errors
+// returned by ReadinessRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReadinessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadinessRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadinessRequestMultiError) AllErrors() []error { return m }
+
+// ReadinessRequestValidationError is the validation error returned by
+// ReadinessRequest.Validate if the designated constraints aren't met.
+type ReadinessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadinessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadinessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadinessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadinessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadinessRequestValidationError) ErrorName() string { return "ReadinessRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReadinessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadinessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadinessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadinessRequestValidationError{}
+
+// Validate checks the field values on ReadinessResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReadinessResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadinessResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadinessResponseMultiError, or nil if none found.
+func (m *ReadinessResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadinessResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadinessResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadinessResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadinessResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReadinessResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadinessResponseMultiError is an error wrapping multiple validation errors
+// returned by ReadinessResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ReadinessResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadinessResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadinessResponseMultiError) AllErrors() []error { return m }
+
+// ReadinessResponseValidationError is the validation error returned by
+// ReadinessResponse.Validate if the designated constraints aren't met.
+type ReadinessResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadinessResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadinessResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadinessResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadinessResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadinessResponseValidationError) ErrorName() string {
+	return "ReadinessResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadinessResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadinessResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadinessResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadinessResponseValidationError{}
+
+// Validate checks the field values on DeepHealthcheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeepHealthcheckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeepHealthcheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeepHealthcheckRequestMultiError, or nil if none found.
+func (m *DeepHealthcheckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeepHealthcheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeepHealthcheckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeepHealthcheckRequestMultiError is an error wrapping multiple validation
+// errors returned by DeepHealthcheckRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeepHealthcheckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeepHealthcheckRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeepHealthcheckRequestMultiError) AllErrors() []error { return m }
+
+// DeepHealthcheckRequestValidationError is the validation error returned by
+// DeepHealthcheckRequest.Validate if the designated constraints aren't met.
+type DeepHealthcheckRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeepHealthcheckRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeepHealthcheckRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeepHealthcheckRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeepHealthcheckRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeepHealthcheckRequestValidationError) ErrorName() string {
+	return "DeepHealthcheckRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeepHealthcheckRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeepHealthcheckRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeepHealthcheckRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeepHealthcheckRequestValidationError{}
+
+// Validate checks the field values on DeepHealthcheckResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeepHealthcheckResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeepHealthcheckResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeepHealthcheckResponseMultiError, or nil if none found.
+func (m *DeepHealthcheckResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeepHealthcheckResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Healthy
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeepHealthcheckResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeepHealthcheckResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeepHealthcheckResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DeepHealthcheckResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeepHealthcheckResponseMultiError is an error wrapping multiple validation
+// errors returned by DeepHealthcheckResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeepHealthcheckResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeepHealthcheckResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeepHealthcheckResponseMultiError) AllErrors() []error { return m }
+
+// DeepHealthcheckResponseValidationError is the validation error returned by
+// DeepHealthcheckResponse.Validate if the designated constraints aren't met.
+type DeepHealthcheckResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeepHealthcheckResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeepHealthcheckResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeepHealthcheckResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeepHealthcheckResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeepHealthcheckResponseValidationError) ErrorName() string {
+	return "DeepHealthcheckResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeepHealthcheckResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeepHealthcheckResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeepHealthcheckResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeepHealthcheckResponseValidationError{}
+
+// Validate checks the field values on CheckResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CheckResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CheckResultMultiError, or
+// nil if none found.
+func (m *CheckResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetLatency()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckResultValidationError{
+					field:  "Latency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckResultValidationError{
+					field:  "Latency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLatency()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckResultValidationError{
+				field:  "Latency",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	// no validation rules for Readiness
+
+	if len(errors) > 0 {
+		return CheckResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckResultMultiError is an error wrapping multiple validation errors
+// returned by CheckResult.ValidateAll() if the designated constraints aren't met.
+type CheckResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckResultMultiError) AllErrors() []error { return m }
+
+// CheckResultValidationError is the validation error returned by
+// CheckResult.Validate if the designated constraints aren't met.
+type CheckResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckResultValidationError) ErrorName() string { return "CheckResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckResultValidationError{}
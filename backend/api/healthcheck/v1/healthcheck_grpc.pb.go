@@ -23,6 +23,16 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type HealthcheckAPIClient interface {
 	Healthcheck(ctx context.Context, in *HealthcheckRequest, opts ...grpc.CallOption) (*HealthcheckResponse, error)
+	// Liveness succeeds as long as the gateway is serving requests and does not check dependencies. It is intended for
+	// Kubernetes liveness probes, where restarting the gateway would not fix an unreachable dependency.
+	Liveness(ctx context.Context, in *LivenessRequest, opts ...grpc.CallOption) (*LivenessResponse, error)
+	// Readiness fails with UNAVAILABLE (HTTP 503) if any of the dependency checks configured for readiness fails. The
+	// results are attached to the error as details. It is intended for Kubernetes readiness probes, so it is served
+	// without authentication and only reports whether each check passed.
+	Readiness(ctx context.Context, in *ReadinessRequest, opts ...grpc.CallOption) (*ReadinessResponse, error)
+	// DeepHealthcheck reports the status of every dependency check without failing the request. Unlike the other
+	// healthchecks it requires authentication, since the results include the errors returned by the checks.
+	DeepHealthcheck(ctx context.Context, in *DeepHealthcheckRequest, opts ...grpc.CallOption) (*DeepHealthcheckResponse, error)
 }
 
 type healthcheckAPIClient struct {
@@ -42,11 +52,48 @@ func (c *healthcheckAPIClient) Healthcheck(ctx context.Context, in *HealthcheckR
 	return out, nil
 }
 
+func (c *healthcheckAPIClient) Liveness(ctx context.Context, in *LivenessRequest, opts ...grpc.CallOption) (*LivenessResponse, error) {
+	out := new(LivenessResponse)
+	err := c.cc.Invoke(ctx, "/clutch.healthcheck.v1.HealthcheckAPI/Liveness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthcheckAPIClient) Readiness(ctx context.Context, in *ReadinessRequest, opts ...grpc.CallOption) (*ReadinessResponse, error) {
+	out := new(ReadinessResponse)
+	err := c.cc.Invoke(ctx, "/clutch.healthcheck.v1.HealthcheckAPI/Readiness", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *healthcheckAPIClient) DeepHealthcheck(ctx context.Context, in *DeepHealthcheckRequest, opts ...grpc.CallOption) (*DeepHealthcheckResponse, error) {
+	out := new(DeepHealthcheckResponse)
+	err := c.cc.Invoke(ctx, "/clutch.healthcheck.v1.HealthcheckAPI/DeepHealthcheck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HealthcheckAPIServer is the server API for HealthcheckAPI service.
 // All implementations should embed UnimplementedHealthcheckAPIServer
 // for forward compatibility
 type HealthcheckAPIServer interface {
 	Healthcheck(context.Context, *HealthcheckRequest) (*HealthcheckResponse, error)
+	// Liveness succeeds as long as the gateway is serving requests and does not check dependencies. It is intended for
+	// Kubernetes liveness probes, where restarting the gateway would not fix an unreachable dependency.
+	Liveness(context.Context, *LivenessRequest) (*LivenessResponse, error)
+	// Readiness fails with UNAVAILABLE (HTTP 503) if any of the dependency checks configured for readiness fails. The
+	// results are attached to the error as details. It is intended for Kubernetes readiness probes, so it is served
+	// without authentication and only reports whether each check passed.
+	Readiness(context.Context, *ReadinessRequest) (*ReadinessResponse, error)
+	// DeepHealthcheck reports the status of every dependency check without failing the request. Unlike the other
+	// healthchecks it requires authentication, since the results include the errors returned by the checks.
+	DeepHealthcheck(context.Context, *DeepHealthcheckRequest) (*DeepHealthcheckResponse, error)
 }
 
 // UnimplementedHealthcheckAPIServer should be embedded to have forward compatible implementations.
@@ -56,6 +103,15 @@ type UnimplementedHealthcheckAPIServer struct {
 func (UnimplementedHealthcheckAPIServer) Healthcheck(context.Context, *HealthcheckRequest) (*HealthcheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Healthcheck not implemented")
 }
+func (UnimplementedHealthcheckAPIServer) Liveness(context.Context, *LivenessRequest) (*LivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Liveness not implemented")
+}
+func (UnimplementedHealthcheckAPIServer) Readiness(context.Context, *ReadinessRequest) (*ReadinessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Readiness not implemented")
+}
+func (UnimplementedHealthcheckAPIServer) DeepHealthcheck(context.Context, *DeepHealthcheckRequest) (*DeepHealthcheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeepHealthcheck not implemented")
+}
 
 // UnsafeHealthcheckAPIServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to HealthcheckAPIServer will
@@ -86,6 +142,60 @@ func _HealthcheckAPI_Healthcheck_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HealthcheckAPI_Liveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthcheckAPIServer).Liveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.healthcheck.v1.HealthcheckAPI/Liveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthcheckAPIServer).Liveness(ctx, req.(*LivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthcheckAPI_Readiness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadinessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthcheckAPIServer).Readiness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.healthcheck.v1.HealthcheckAPI/Readiness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthcheckAPIServer).Readiness(ctx, req.(*ReadinessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HealthcheckAPI_DeepHealthcheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeepHealthcheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HealthcheckAPIServer).DeepHealthcheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.healthcheck.v1.HealthcheckAPI/DeepHealthcheck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HealthcheckAPIServer).DeepHealthcheck(ctx, req.(*DeepHealthcheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HealthcheckAPI_ServiceDesc is the grpc.ServiceDesc for HealthcheckAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -97,6 +207,18 @@ var HealthcheckAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Healthcheck",
 			Handler:    _HealthcheckAPI_Healthcheck_Handler,
 		},
+		{
+			MethodName: "Liveness",
+			Handler:    _HealthcheckAPI_Liveness_Handler,
+		},
+		{
+			MethodName: "Readiness",
+			Handler:    _HealthcheckAPI_Readiness_Handler,
+		},
+		{
+			MethodName: "DeepHealthcheck",
+			Handler:    _HealthcheckAPI_DeepHealthcheck_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "healthcheck/v1/healthcheck.proto",
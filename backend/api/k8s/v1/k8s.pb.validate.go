@@ -2793,79 +2793,99 @@ var _ interface {
 	ErrorName() string
 } = PodLogLineValidationError{}
 
-// Validate checks the field values on HPA with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *HPA) Validate() error {
+// Validate checks the field values on ExecPodRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ExecPodRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on HPA with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in HPAMultiError, or nil if none found.
-func (m *HPA) ValidateAll() error {
+// ValidateAll checks the field values on ExecPodRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ExecPodRequestMultiError,
+// or nil if none found.
+func (m *ExecPodRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *HPA) validate(all bool) error {
+func (m *ExecPodRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Cluster
+	if len(m.GetClientset()) < 1 {
+		err := ExecPodRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Namespace
+	if len(m.GetCluster()) < 1 {
+		err := ExecPodRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Name
+	if len(m.GetNamespace()) < 1 {
+		err := ExecPodRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetSizing()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, HPAValidationError{
-					field:  "Sizing",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, HPAValidationError{
-					field:  "Sizing",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if len(m.GetName()) < 1 {
+		err := ExecPodRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
 		}
-	} else if v, ok := interface{}(m.GetSizing()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return HPAValidationError{
-				field:  "Sizing",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for Labels
+	// no validation rules for Container
 
-	// no validation rules for Annotations
+	if len(m.GetCommand()) < 1 {
+		err := ExecPodRequestValidationError{
+			field:  "Command",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return HPAMultiError(errors)
+		return ExecPodRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// HPAMultiError is an error wrapping multiple validation errors returned by
-// HPA.ValidateAll() if the designated constraints aren't met.
-type HPAMultiError []error
+// ExecPodRequestMultiError is an error wrapping multiple validation errors
+// returned by ExecPodRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExecPodRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m HPAMultiError) Error() string {
+func (m ExecPodRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2874,11 +2894,11 @@ func (m HPAMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m HPAMultiError) AllErrors() []error { return m }
+func (m ExecPodRequestMultiError) AllErrors() []error { return m }
 
-// HPAValidationError is the validation error returned by HPA.Validate if the
-// designated constraints aren't met.
-type HPAValidationError struct {
+// ExecPodRequestValidationError is the validation error returned by
+// ExecPodRequest.Validate if the designated constraints aren't met.
+type ExecPodRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2886,22 +2906,22 @@ type HPAValidationError struct {
 }
 
 // Field function returns field value.
-func (e HPAValidationError) Field() string { return e.field }
+func (e ExecPodRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e HPAValidationError) Reason() string { return e.reason }
+func (e ExecPodRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e HPAValidationError) Cause() error { return e.cause }
+func (e ExecPodRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e HPAValidationError) Key() bool { return e.key }
+func (e ExecPodRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e HPAValidationError) ErrorName() string { return "HPAValidationError" }
+func (e ExecPodRequestValidationError) ErrorName() string { return "ExecPodRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e HPAValidationError) Error() string {
+func (e ExecPodRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2913,14 +2933,14 @@ func (e HPAValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sHPA.%s: %s%s",
+		"invalid %sExecPodRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = HPAValidationError{}
+var _ error = ExecPodRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2928,157 +2948,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = HPAValidationError{}
+} = ExecPodRequestValidationError{}
 
-// Validate checks the field values on ResizeHPARequest with the rules defined
+// Validate checks the field values on ExecPodResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *ResizeHPARequest) Validate() error {
+func (m *ExecPodResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ResizeHPARequest with the rules
+// ValidateAll checks the field values on ExecPodResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ResizeHPARequestMultiError, or nil if none found.
-func (m *ResizeHPARequest) ValidateAll() error {
+// ExecPodResponseMultiError, or nil if none found.
+func (m *ExecPodResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ResizeHPARequest) validate(all bool) error {
+func (m *ExecPodResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetClientset()) < 1 {
-		err := ResizeHPARequestValidationError{
-			field:  "Clientset",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetCluster()) < 1 {
-		err := ResizeHPARequestValidationError{
-			field:  "Cluster",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetNamespace()) < 1 {
-		err := ResizeHPARequestValidationError{
-			field:  "Namespace",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetName()) < 1 {
-		err := ResizeHPARequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Stdout
 
-	if m.GetSizing() == nil {
-		err := ResizeHPARequestValidationError{
-			field:  "Sizing",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Stderr
 
-	if all {
-		switch v := interface{}(m.GetSizing()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResizeHPARequestValidationError{
-					field:  "Sizing",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ResizeHPARequestValidationError{
-					field:  "Sizing",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSizing()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ResizeHPARequestValidationError{
-				field:  "Sizing",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Truncated
 
-	if all {
-		switch v := interface{}(m.GetCurrentSizing()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ResizeHPARequestValidationError{
-					field:  "CurrentSizing",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ResizeHPARequestValidationError{
-					field:  "CurrentSizing",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCurrentSizing()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ResizeHPARequestValidationError{
-				field:  "CurrentSizing",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ExitCode
 
 	if len(errors) > 0 {
-		return ResizeHPARequestMultiError(errors)
+		return ExecPodResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ResizeHPARequestMultiError is an error wrapping multiple validation errors
-// returned by ResizeHPARequest.ValidateAll() if the designated constraints
+// ExecPodResponseMultiError is an error wrapping multiple validation errors
+// returned by ExecPodResponse.ValidateAll() if the designated constraints
 // aren't met.
-type ResizeHPARequestMultiError []error
+type ExecPodResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ResizeHPARequestMultiError) Error() string {
+func (m ExecPodResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3087,11 +3002,11 @@ func (m ResizeHPARequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ResizeHPARequestMultiError) AllErrors() []error { return m }
+func (m ExecPodResponseMultiError) AllErrors() []error { return m }
 
-// ResizeHPARequestValidationError is the validation error returned by
-// ResizeHPARequest.Validate if the designated constraints aren't met.
-type ResizeHPARequestValidationError struct {
+// ExecPodResponseValidationError is the validation error returned by
+// ExecPodResponse.Validate if the designated constraints aren't met.
+type ExecPodResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3099,22 +3014,22 @@ type ResizeHPARequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ResizeHPARequestValidationError) Field() string { return e.field }
+func (e ExecPodResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ResizeHPARequestValidationError) Reason() string { return e.reason }
+func (e ExecPodResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ResizeHPARequestValidationError) Cause() error { return e.cause }
+func (e ExecPodResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ResizeHPARequestValidationError) Key() bool { return e.key }
+func (e ExecPodResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ResizeHPARequestValidationError) ErrorName() string { return "ResizeHPARequestValidationError" }
+func (e ExecPodResponseValidationError) ErrorName() string { return "ExecPodResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ResizeHPARequestValidationError) Error() string {
+func (e ExecPodResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3126,14 +3041,14 @@ func (e ResizeHPARequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResizeHPARequest.%s: %s%s",
+		"invalid %sExecPodResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ResizeHPARequestValidationError{}
+var _ error = ExecPodResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3141,126 +3056,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ResizeHPARequestValidationError{}
+} = ExecPodResponseValidationError{}
 
-// Validate checks the field values on ResizeHPAResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ResizeHPAResponse) Validate() error {
+// Validate checks the field values on CreateDebugContainerRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateDebugContainerRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ResizeHPAResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateDebugContainerRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ResizeHPAResponseMultiError, or nil if none found.
-func (m *ResizeHPAResponse) ValidateAll() error {
+// CreateDebugContainerRequestMultiError, or nil if none found.
+func (m *CreateDebugContainerRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ResizeHPAResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if len(errors) > 0 {
-		return ResizeHPAResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// ResizeHPAResponseMultiError is an error wrapping multiple validation errors
-// returned by ResizeHPAResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ResizeHPAResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m ResizeHPAResponseMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m ResizeHPAResponseMultiError) AllErrors() []error { return m }
-
-// ResizeHPAResponseValidationError is the validation error returned by
-// ResizeHPAResponse.Validate if the designated constraints aren't met.
-type ResizeHPAResponseValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e ResizeHPAResponseValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e ResizeHPAResponseValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e ResizeHPAResponseValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e ResizeHPAResponseValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e ResizeHPAResponseValidationError) ErrorName() string {
-	return "ResizeHPAResponseValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e ResizeHPAResponseValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sResizeHPAResponse.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause)
-}
-
-var _ error = ResizeHPAResponseValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = ResizeHPAResponseValidationError{}
-
-// Validate checks the field values on DeleteHPARequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteHPARequest) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on DeleteHPARequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteHPARequestMultiError, or nil if none found.
-func (m *DeleteHPARequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *DeleteHPARequest) validate(all bool) error {
+func (m *CreateDebugContainerRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3268,7 +3081,7 @@ func (m *DeleteHPARequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetClientset()) < 1 {
-		err := DeleteHPARequestValidationError{
+		err := CreateDebugContainerRequestValidationError{
 			field:  "Clientset",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -3279,7 +3092,7 @@ func (m *DeleteHPARequest) validate(all bool) error {
 	}
 
 	if len(m.GetCluster()) < 1 {
-		err := DeleteHPARequestValidationError{
+		err := CreateDebugContainerRequestValidationError{
 			field:  "Cluster",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -3290,7 +3103,7 @@ func (m *DeleteHPARequest) validate(all bool) error {
 	}
 
 	if len(m.GetNamespace()) < 1 {
-		err := DeleteHPARequestValidationError{
+		err := CreateDebugContainerRequestValidationError{
 			field:  "Namespace",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -3301,7 +3114,7 @@ func (m *DeleteHPARequest) validate(all bool) error {
 	}
 
 	if len(m.GetName()) < 1 {
-		err := DeleteHPARequestValidationError{
+		err := CreateDebugContainerRequestValidationError{
 			field:  "Name",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -3311,20 +3124,33 @@ func (m *DeleteHPARequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if len(m.GetImage()) < 1 {
+		err := CreateDebugContainerRequestValidationError{
+			field:  "Image",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TargetContainer
+
 	if len(errors) > 0 {
-		return DeleteHPARequestMultiError(errors)
+		return CreateDebugContainerRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteHPARequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteHPARequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteHPARequestMultiError []error
+// CreateDebugContainerRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateDebugContainerRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateDebugContainerRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteHPARequestMultiError) Error() string {
+func (m CreateDebugContainerRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3333,11 +3159,12 @@ func (m DeleteHPARequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteHPARequestMultiError) AllErrors() []error { return m }
+func (m CreateDebugContainerRequestMultiError) AllErrors() []error { return m }
 
-// DeleteHPARequestValidationError is the validation error returned by
-// DeleteHPARequest.Validate if the designated constraints aren't met.
-type DeleteHPARequestValidationError struct {
+// CreateDebugContainerRequestValidationError is the validation error returned
+// by CreateDebugContainerRequest.Validate if the designated constraints
+// aren't met.
+type CreateDebugContainerRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3345,22 +3172,24 @@ type DeleteHPARequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteHPARequestValidationError) Field() string { return e.field }
+func (e CreateDebugContainerRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteHPARequestValidationError) Reason() string { return e.reason }
+func (e CreateDebugContainerRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteHPARequestValidationError) Cause() error { return e.cause }
+func (e CreateDebugContainerRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteHPARequestValidationError) Key() bool { return e.key }
+func (e CreateDebugContainerRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteHPARequestValidationError) ErrorName() string { return "DeleteHPARequestValidationError" }
+func (e CreateDebugContainerRequestValidationError) ErrorName() string {
+	return "CreateDebugContainerRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DeleteHPARequestValidationError) Error() string {
+func (e CreateDebugContainerRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3372,14 +3201,14 @@ func (e DeleteHPARequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteHPARequest.%s: %s%s",
+		"invalid %sCreateDebugContainerRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteHPARequestValidationError{}
+var _ error = CreateDebugContainerRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3387,44 +3216,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteHPARequestValidationError{}
+} = CreateDebugContainerRequestValidationError{}
 
-// Validate checks the field values on DeleteHPAResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteHPAResponse) Validate() error {
+// Validate checks the field values on CreateDebugContainerResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateDebugContainerResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteHPAResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CreateDebugContainerResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteHPAResponseMultiError, or nil if none found.
-func (m *DeleteHPAResponse) ValidateAll() error {
+// CreateDebugContainerResponseMultiError, or nil if none found.
+func (m *CreateDebugContainerResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteHPAResponse) validate(all bool) error {
+func (m *CreateDebugContainerResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for ContainerName
+
 	if len(errors) > 0 {
-		return DeleteHPAResponseMultiError(errors)
+		return CreateDebugContainerResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteHPAResponseMultiError is an error wrapping multiple validation errors
-// returned by DeleteHPAResponse.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteHPAResponseMultiError []error
+// CreateDebugContainerResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateDebugContainerResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateDebugContainerResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteHPAResponseMultiError) Error() string {
+func (m CreateDebugContainerResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3433,11 +3264,12 @@ func (m DeleteHPAResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteHPAResponseMultiError) AllErrors() []error { return m }
+func (m CreateDebugContainerResponseMultiError) AllErrors() []error { return m }
 
-// DeleteHPAResponseValidationError is the validation error returned by
-// DeleteHPAResponse.Validate if the designated constraints aren't met.
-type DeleteHPAResponseValidationError struct {
+// CreateDebugContainerResponseValidationError is the validation error returned
+// by CreateDebugContainerResponse.Validate if the designated constraints
+// aren't met.
+type CreateDebugContainerResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3445,24 +3277,24 @@ type DeleteHPAResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteHPAResponseValidationError) Field() string { return e.field }
+func (e CreateDebugContainerResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteHPAResponseValidationError) Reason() string { return e.reason }
+func (e CreateDebugContainerResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteHPAResponseValidationError) Cause() error { return e.cause }
+func (e CreateDebugContainerResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteHPAResponseValidationError) Key() bool { return e.key }
+func (e CreateDebugContainerResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteHPAResponseValidationError) ErrorName() string {
-	return "DeleteHPAResponseValidationError"
+func (e CreateDebugContainerResponseValidationError) ErrorName() string {
+	return "CreateDebugContainerResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteHPAResponseValidationError) Error() string {
+func (e CreateDebugContainerResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3474,14 +3306,14 @@ func (e DeleteHPAResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteHPAResponse.%s: %s%s",
+		"invalid %sCreateDebugContainerResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteHPAResponseValidationError{}
+var _ error = CreateDebugContainerResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3489,24 +3321,23 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteHPAResponseValidationError{}
+} = CreateDebugContainerResponseValidationError{}
 
-// Validate checks the field values on Deployment with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Deployment) Validate() error {
+// Validate checks the field values on HPA with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *HPA) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Deployment with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in DeploymentMultiError, or
-// nil if none found.
-func (m *Deployment) ValidateAll() error {
+// ValidateAll checks the field values on HPA with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in HPAMultiError, or nil if none found.
+func (m *HPA) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Deployment) validate(all bool) error {
+func (m *HPA) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3519,54 +3350,52 @@ func (m *Deployment) validate(all bool) error {
 
 	// no validation rules for Name
 
-	// no validation rules for Labels
-
-	// no validation rules for Annotations
-
 	if all {
-		switch v := interface{}(m.GetDeploymentStatus()).(type) {
+		switch v := interface{}(m.GetSizing()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeploymentValidationError{
-					field:  "DeploymentStatus",
+				errors = append(errors, HPAValidationError{
+					field:  "Sizing",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DeploymentValidationError{
-					field:  "DeploymentStatus",
+				errors = append(errors, HPAValidationError{
+					field:  "Sizing",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDeploymentStatus()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSizing()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DeploymentValidationError{
-				field:  "DeploymentStatus",
+			return HPAValidationError{
+				field:  "Sizing",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for CreationTimeMillis
+	// no validation rules for Labels
+
+	// no validation rules for Annotations
 
 	if len(errors) > 0 {
-		return DeploymentMultiError(errors)
+		return HPAMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeploymentMultiError is an error wrapping multiple validation errors
-// returned by Deployment.ValidateAll() if the designated constraints aren't met.
-type DeploymentMultiError []error
+// HPAMultiError is an error wrapping multiple validation errors returned by
+// HPA.ValidateAll() if the designated constraints aren't met.
+type HPAMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeploymentMultiError) Error() string {
+func (m HPAMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3575,11 +3404,11 @@ func (m DeploymentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeploymentMultiError) AllErrors() []error { return m }
+func (m HPAMultiError) AllErrors() []error { return m }
 
-// DeploymentValidationError is the validation error returned by
-// Deployment.Validate if the designated constraints aren't met.
-type DeploymentValidationError struct {
+// HPAValidationError is the validation error returned by HPA.Validate if the
+// designated constraints aren't met.
+type HPAValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3587,22 +3416,22 @@ type DeploymentValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeploymentValidationError) Field() string { return e.field }
+func (e HPAValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeploymentValidationError) Reason() string { return e.reason }
+func (e HPAValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeploymentValidationError) Cause() error { return e.cause }
+func (e HPAValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeploymentValidationError) Key() bool { return e.key }
+func (e HPAValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeploymentValidationError) ErrorName() string { return "DeploymentValidationError" }
+func (e HPAValidationError) ErrorName() string { return "HPAValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeploymentValidationError) Error() string {
+func (e HPAValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3614,14 +3443,14 @@ func (e DeploymentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeployment.%s: %s%s",
+		"invalid %sHPA.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeploymentValidationError{}
+var _ error = HPAValidationError{}
 
 var _ interface {
 	Field() string
@@ -3629,24 +3458,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeploymentValidationError{}
+} = HPAValidationError{}
 
-// Validate checks the field values on DescribeDeploymentRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DescribeDeploymentRequest) Validate() error {
+// Validate checks the field values on ResizeHPARequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResizeHPARequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DescribeDeploymentRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ResizeHPARequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DescribeDeploymentRequestMultiError, or nil if none found.
-func (m *DescribeDeploymentRequest) ValidateAll() error {
+// ResizeHPARequestMultiError, or nil if none found.
+func (m *ResizeHPARequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DescribeDeploymentRequest) validate(all bool) error {
+func (m *ResizeHPARequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3654,7 +3483,7 @@ func (m *DescribeDeploymentRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetClientset()) < 1 {
-		err := DescribeDeploymentRequestValidationError{
+		err := ResizeHPARequestValidationError{
 			field:  "Clientset",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -3665,7 +3494,7 @@ func (m *DescribeDeploymentRequest) validate(all bool) error {
 	}
 
 	if len(m.GetCluster()) < 1 {
-		err := DescribeDeploymentRequestValidationError{
+		err := ResizeHPARequestValidationError{
 			field:  "Cluster",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -3676,7 +3505,7 @@ func (m *DescribeDeploymentRequest) validate(all bool) error {
 	}
 
 	if len(m.GetNamespace()) < 1 {
-		err := DescribeDeploymentRequestValidationError{
+		err := ResizeHPARequestValidationError{
 			field:  "Namespace",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -3687,7 +3516,7 @@ func (m *DescribeDeploymentRequest) validate(all bool) error {
 	}
 
 	if len(m.GetName()) < 1 {
-		err := DescribeDeploymentRequestValidationError{
+		err := ResizeHPARequestValidationError{
 			field:  "Name",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -3697,20 +3526,89 @@ func (m *DescribeDeploymentRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetSizing() == nil {
+		err := ResizeHPARequestValidationError{
+			field:  "Sizing",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSizing()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResizeHPARequestValidationError{
+					field:  "Sizing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResizeHPARequestValidationError{
+					field:  "Sizing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSizing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResizeHPARequestValidationError{
+				field:  "Sizing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCurrentSizing()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResizeHPARequestValidationError{
+					field:  "CurrentSizing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResizeHPARequestValidationError{
+					field:  "CurrentSizing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCurrentSizing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResizeHPARequestValidationError{
+				field:  "CurrentSizing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DescribeDeploymentRequestMultiError(errors)
+		return ResizeHPARequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DescribeDeploymentRequestMultiError is an error wrapping multiple validation
-// errors returned by DescribeDeploymentRequest.ValidateAll() if the
-// designated constraints aren't met.
-type DescribeDeploymentRequestMultiError []error
+// ResizeHPARequestMultiError is an error wrapping multiple validation errors
+// returned by ResizeHPARequest.ValidateAll() if the designated constraints
+// aren't met.
+type ResizeHPARequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DescribeDeploymentRequestMultiError) Error() string {
+func (m ResizeHPARequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3719,11 +3617,11 @@ func (m DescribeDeploymentRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DescribeDeploymentRequestMultiError) AllErrors() []error { return m }
+func (m ResizeHPARequestMultiError) AllErrors() []error { return m }
 
-// DescribeDeploymentRequestValidationError is the validation error returned by
-// DescribeDeploymentRequest.Validate if the designated constraints aren't met.
-type DescribeDeploymentRequestValidationError struct {
+// ResizeHPARequestValidationError is the validation error returned by
+// ResizeHPARequest.Validate if the designated constraints aren't met.
+type ResizeHPARequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3731,24 +3629,22 @@ type DescribeDeploymentRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeDeploymentRequestValidationError) Field() string { return e.field }
+func (e ResizeHPARequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeDeploymentRequestValidationError) Reason() string { return e.reason }
+func (e ResizeHPARequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeDeploymentRequestValidationError) Cause() error { return e.cause }
+func (e ResizeHPARequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeDeploymentRequestValidationError) Key() bool { return e.key }
+func (e ResizeHPARequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeDeploymentRequestValidationError) ErrorName() string {
-	return "DescribeDeploymentRequestValidationError"
-}
+func (e ResizeHPARequestValidationError) ErrorName() string { return "ResizeHPARequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DescribeDeploymentRequestValidationError) Error() string {
+func (e ResizeHPARequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3760,14 +3656,14 @@ func (e DescribeDeploymentRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeDeploymentRequest.%s: %s%s",
+		"invalid %sResizeHPARequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeDeploymentRequestValidationError{}
+var _ error = ResizeHPARequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3775,73 +3671,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeDeploymentRequestValidationError{}
+} = ResizeHPARequestValidationError{}
 
-// Validate checks the field values on DescribeDeploymentResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DescribeDeploymentResponse) Validate() error {
+// Validate checks the field values on ResizeHPAResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResizeHPAResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DescribeDeploymentResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ResizeHPAResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DescribeDeploymentResponseMultiError, or nil if none found.
-func (m *DescribeDeploymentResponse) ValidateAll() error {
+// ResizeHPAResponseMultiError, or nil if none found.
+func (m *ResizeHPAResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DescribeDeploymentResponse) validate(all bool) error {
+func (m *ResizeHPAResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetDeployment()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DescribeDeploymentResponseValidationError{
-					field:  "Deployment",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DescribeDeploymentResponseValidationError{
-					field:  "Deployment",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DescribeDeploymentResponseValidationError{
-				field:  "Deployment",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return DescribeDeploymentResponseMultiError(errors)
+		return ResizeHPAResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DescribeDeploymentResponseMultiError is an error wrapping multiple
-// validation errors returned by DescribeDeploymentResponse.ValidateAll() if
-// the designated constraints aren't met.
-type DescribeDeploymentResponseMultiError []error
+// ResizeHPAResponseMultiError is an error wrapping multiple validation errors
+// returned by ResizeHPAResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ResizeHPAResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DescribeDeploymentResponseMultiError) Error() string {
+func (m ResizeHPAResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3850,11 +3717,11 @@ func (m DescribeDeploymentResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DescribeDeploymentResponseMultiError) AllErrors() []error { return m }
+func (m ResizeHPAResponseMultiError) AllErrors() []error { return m }
 
-// DescribeDeploymentResponseValidationError is the validation error returned
-// by DescribeDeploymentResponse.Validate if the designated constraints aren't met.
-type DescribeDeploymentResponseValidationError struct {
+// ResizeHPAResponseValidationError is the validation error returned by
+// ResizeHPAResponse.Validate if the designated constraints aren't met.
+type ResizeHPAResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3862,24 +3729,24 @@ type DescribeDeploymentResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeDeploymentResponseValidationError) Field() string { return e.field }
+func (e ResizeHPAResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeDeploymentResponseValidationError) Reason() string { return e.reason }
+func (e ResizeHPAResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeDeploymentResponseValidationError) Cause() error { return e.cause }
+func (e ResizeHPAResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeDeploymentResponseValidationError) Key() bool { return e.key }
+func (e ResizeHPAResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeDeploymentResponseValidationError) ErrorName() string {
-	return "DescribeDeploymentResponseValidationError"
+func (e ResizeHPAResponseValidationError) ErrorName() string {
+	return "ResizeHPAResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeDeploymentResponseValidationError) Error() string {
+func (e ResizeHPAResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3891,14 +3758,14 @@ func (e DescribeDeploymentResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeDeploymentResponse.%s: %s%s",
+		"invalid %sResizeHPAResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeDeploymentResponseValidationError{}
+var _ error = ResizeHPAResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3906,24 +3773,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeDeploymentResponseValidationError{}
+} = ResizeHPAResponseValidationError{}
 
-// Validate checks the field values on ListDeploymentsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListDeploymentsRequest) Validate() error {
+// Validate checks the field values on DeleteHPARequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteHPARequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListDeploymentsRequest with the rules
+// ValidateAll checks the field values on DeleteHPARequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListDeploymentsRequestMultiError, or nil if none found.
-func (m *ListDeploymentsRequest) ValidateAll() error {
+// DeleteHPARequestMultiError, or nil if none found.
+func (m *DeleteHPARequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListDeploymentsRequest) validate(all bool) error {
+func (m *DeleteHPARequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3931,7 +3798,7 @@ func (m *ListDeploymentsRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetClientset()) < 1 {
-		err := ListDeploymentsRequestValidationError{
+		err := DeleteHPARequestValidationError{
 			field:  "Clientset",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -3942,7 +3809,7 @@ func (m *ListDeploymentsRequest) validate(all bool) error {
 	}
 
 	if len(m.GetCluster()) < 1 {
-		err := ListDeploymentsRequestValidationError{
+		err := DeleteHPARequestValidationError{
 			field:  "Cluster",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -3953,7 +3820,7 @@ func (m *ListDeploymentsRequest) validate(all bool) error {
 	}
 
 	if len(m.GetNamespace()) < 1 {
-		err := ListDeploymentsRequestValidationError{
+		err := DeleteHPARequestValidationError{
 			field:  "Namespace",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -3963,10 +3830,10 @@ func (m *ListDeploymentsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetOptions() == nil {
-		err := ListDeploymentsRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
+	if len(m.GetName()) < 1 {
+		err := DeleteHPARequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
 		}
 		if !all {
 			return err
@@ -3974,49 +3841,20 @@ func (m *ListDeploymentsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListDeploymentsRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListDeploymentsRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListDeploymentsRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return ListDeploymentsRequestMultiError(errors)
+		return DeleteHPARequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListDeploymentsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListDeploymentsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type ListDeploymentsRequestMultiError []error
+// DeleteHPARequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteHPARequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteHPARequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListDeploymentsRequestMultiError) Error() string {
+func (m DeleteHPARequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4025,11 +3863,11 @@ func (m ListDeploymentsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListDeploymentsRequestMultiError) AllErrors() []error { return m }
+func (m DeleteHPARequestMultiError) AllErrors() []error { return m }
 
-// ListDeploymentsRequestValidationError is the validation error returned by
-// ListDeploymentsRequest.Validate if the designated constraints aren't met.
-type ListDeploymentsRequestValidationError struct {
+// DeleteHPARequestValidationError is the validation error returned by
+// DeleteHPARequest.Validate if the designated constraints aren't met.
+type DeleteHPARequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4037,24 +3875,22 @@ type ListDeploymentsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListDeploymentsRequestValidationError) Field() string { return e.field }
+func (e DeleteHPARequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListDeploymentsRequestValidationError) Reason() string { return e.reason }
+func (e DeleteHPARequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListDeploymentsRequestValidationError) Cause() error { return e.cause }
+func (e DeleteHPARequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListDeploymentsRequestValidationError) Key() bool { return e.key }
+func (e DeleteHPARequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListDeploymentsRequestValidationError) ErrorName() string {
-	return "ListDeploymentsRequestValidationError"
-}
+func (e DeleteHPARequestValidationError) ErrorName() string { return "DeleteHPARequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListDeploymentsRequestValidationError) Error() string {
+func (e DeleteHPARequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4066,14 +3902,14 @@ func (e ListDeploymentsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListDeploymentsRequest.%s: %s%s",
+		"invalid %sDeleteHPARequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListDeploymentsRequestValidationError{}
+var _ error = DeleteHPARequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4081,78 +3917,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListDeploymentsRequestValidationError{}
+} = DeleteHPARequestValidationError{}
 
-// Validate checks the field values on ListDeploymentsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListDeploymentsResponse) Validate() error {
+// Validate checks the field values on DeleteHPAResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteHPAResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListDeploymentsResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteHPAResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListDeploymentsResponseMultiError, or nil if none found.
-func (m *ListDeploymentsResponse) ValidateAll() error {
+// DeleteHPAResponseMultiError, or nil if none found.
+func (m *DeleteHPAResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListDeploymentsResponse) validate(all bool) error {
+func (m *DeleteHPAResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetDeployments() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListDeploymentsResponseValidationError{
-						field:  fmt.Sprintf("Deployments[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListDeploymentsResponseValidationError{
-						field:  fmt.Sprintf("Deployments[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListDeploymentsResponseValidationError{
-					field:  fmt.Sprintf("Deployments[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ListDeploymentsResponseMultiError(errors)
+		return DeleteHPAResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListDeploymentsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListDeploymentsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListDeploymentsResponseMultiError []error
+// DeleteHPAResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteHPAResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteHPAResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListDeploymentsResponseMultiError) Error() string {
+func (m DeleteHPAResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4161,11 +3963,11 @@ func (m ListDeploymentsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListDeploymentsResponseMultiError) AllErrors() []error { return m }
+func (m DeleteHPAResponseMultiError) AllErrors() []error { return m }
 
-// ListDeploymentsResponseValidationError is the validation error returned by
-// ListDeploymentsResponse.Validate if the designated constraints aren't met.
-type ListDeploymentsResponseValidationError struct {
+// DeleteHPAResponseValidationError is the validation error returned by
+// DeleteHPAResponse.Validate if the designated constraints aren't met.
+type DeleteHPAResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4173,24 +3975,24 @@ type ListDeploymentsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListDeploymentsResponseValidationError) Field() string { return e.field }
+func (e DeleteHPAResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListDeploymentsResponseValidationError) Reason() string { return e.reason }
+func (e DeleteHPAResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListDeploymentsResponseValidationError) Cause() error { return e.cause }
+func (e DeleteHPAResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListDeploymentsResponseValidationError) Key() bool { return e.key }
+func (e DeleteHPAResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListDeploymentsResponseValidationError) ErrorName() string {
-	return "ListDeploymentsResponseValidationError"
+func (e DeleteHPAResponseValidationError) ErrorName() string {
+	return "DeleteHPAResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListDeploymentsResponseValidationError) Error() string {
+func (e DeleteHPAResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4202,14 +4004,14 @@ func (e ListDeploymentsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListDeploymentsResponse.%s: %s%s",
+		"invalid %sDeleteHPAResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListDeploymentsResponseValidationError{}
+var _ error = DeleteHPAResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4217,128 +4019,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListDeploymentsResponseValidationError{}
+} = DeleteHPAResponseValidationError{}
 
-// Validate checks the field values on UpdateDeploymentRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateDeploymentRequest) Validate() error {
+// Validate checks the field values on Deployment with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Deployment) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateDeploymentRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// UpdateDeploymentRequestMultiError, or nil if none found.
-func (m *UpdateDeploymentRequest) ValidateAll() error {
+// ValidateAll checks the field values on Deployment with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeploymentMultiError, or
+// nil if none found.
+func (m *Deployment) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateDeploymentRequest) validate(all bool) error {
+func (m *Deployment) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetClientset()) < 1 {
-		err := UpdateDeploymentRequestValidationError{
-			field:  "Clientset",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Cluster
 
-	if len(m.GetCluster()) < 1 {
-		err := UpdateDeploymentRequestValidationError{
-			field:  "Cluster",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Namespace
 
-	if len(m.GetNamespace()) < 1 {
-		err := UpdateDeploymentRequestValidationError{
-			field:  "Namespace",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Name
 
-	if len(m.GetName()) < 1 {
-		err := UpdateDeploymentRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Labels
 
-	if m.GetFields() == nil {
-		err := UpdateDeploymentRequestValidationError{
-			field:  "Fields",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Annotations
 
 	if all {
-		switch v := interface{}(m.GetFields()).(type) {
+		switch v := interface{}(m.GetDeploymentStatus()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateDeploymentRequestValidationError{
-					field:  "Fields",
+				errors = append(errors, DeploymentValidationError{
+					field:  "DeploymentStatus",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateDeploymentRequestValidationError{
-					field:  "Fields",
+				errors = append(errors, DeploymentValidationError{
+					field:  "DeploymentStatus",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFields()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDeploymentStatus()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateDeploymentRequestValidationError{
-				field:  "Fields",
+			return DeploymentValidationError{
+				field:  "DeploymentStatus",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for CreationTimeMillis
+
 	if len(errors) > 0 {
-		return UpdateDeploymentRequestMultiError(errors)
+		return DeploymentMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateDeploymentRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateDeploymentRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateDeploymentRequestMultiError []error
+// DeploymentMultiError is an error wrapping multiple validation errors
+// returned by Deployment.ValidateAll() if the designated constraints aren't met.
+type DeploymentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateDeploymentRequestMultiError) Error() string {
+func (m DeploymentMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4347,11 +4105,11 @@ func (m UpdateDeploymentRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateDeploymentRequestMultiError) AllErrors() []error { return m }
+func (m DeploymentMultiError) AllErrors() []error { return m }
 
-// UpdateDeploymentRequestValidationError is the validation error returned by
-// UpdateDeploymentRequest.Validate if the designated constraints aren't met.
-type UpdateDeploymentRequestValidationError struct {
+// DeploymentValidationError is the validation error returned by
+// Deployment.Validate if the designated constraints aren't met.
+type DeploymentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4359,24 +4117,22 @@ type UpdateDeploymentRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateDeploymentRequestValidationError) Field() string { return e.field }
+func (e DeploymentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateDeploymentRequestValidationError) Reason() string { return e.reason }
+func (e DeploymentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateDeploymentRequestValidationError) Cause() error { return e.cause }
+func (e DeploymentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateDeploymentRequestValidationError) Key() bool { return e.key }
+func (e DeploymentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateDeploymentRequestValidationError) ErrorName() string {
-	return "UpdateDeploymentRequestValidationError"
-}
+func (e DeploymentValidationError) ErrorName() string { return "DeploymentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e UpdateDeploymentRequestValidationError) Error() string {
+func (e DeploymentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4388,14 +4144,14 @@ func (e UpdateDeploymentRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateDeploymentRequest.%s: %s%s",
+		"invalid %sDeployment.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateDeploymentRequestValidationError{}
+var _ error = DeploymentValidationError{}
 
 var _ interface {
 	Field() string
@@ -4403,44 +4159,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateDeploymentRequestValidationError{}
+} = DeploymentValidationError{}
 
-// Validate checks the field values on UpdateDeploymentResponse with the rules
+// Validate checks the field values on DescribeDeploymentRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateDeploymentResponse) Validate() error {
+func (m *DescribeDeploymentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateDeploymentResponse with the
+// ValidateAll checks the field values on DescribeDeploymentRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateDeploymentResponseMultiError, or nil if none found.
-func (m *UpdateDeploymentResponse) ValidateAll() error {
+// DescribeDeploymentRequestMultiError, or nil if none found.
+func (m *DescribeDeploymentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateDeploymentResponse) validate(all bool) error {
+func (m *DescribeDeploymentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if len(m.GetClientset()) < 1 {
+		err := DescribeDeploymentRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := DescribeDeploymentRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := DescribeDeploymentRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := DescribeDeploymentRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return UpdateDeploymentResponseMultiError(errors)
+		return DescribeDeploymentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateDeploymentResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateDeploymentResponse.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateDeploymentResponseMultiError []error
+// DescribeDeploymentRequestMultiError is an error wrapping multiple validation
+// errors returned by DescribeDeploymentRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DescribeDeploymentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateDeploymentResponseMultiError) Error() string {
+func (m DescribeDeploymentRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4449,11 +4249,11 @@ func (m UpdateDeploymentResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateDeploymentResponseMultiError) AllErrors() []error { return m }
+func (m DescribeDeploymentRequestMultiError) AllErrors() []error { return m }
 
-// UpdateDeploymentResponseValidationError is the validation error returned by
-// UpdateDeploymentResponse.Validate if the designated constraints aren't met.
-type UpdateDeploymentResponseValidationError struct {
+// DescribeDeploymentRequestValidationError is the validation error returned by
+// DescribeDeploymentRequest.Validate if the designated constraints aren't met.
+type DescribeDeploymentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4461,24 +4261,24 @@ type UpdateDeploymentResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateDeploymentResponseValidationError) Field() string { return e.field }
+func (e DescribeDeploymentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateDeploymentResponseValidationError) Reason() string { return e.reason }
+func (e DescribeDeploymentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateDeploymentResponseValidationError) Cause() error { return e.cause }
+func (e DescribeDeploymentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateDeploymentResponseValidationError) Key() bool { return e.key }
+func (e DescribeDeploymentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateDeploymentResponseValidationError) ErrorName() string {
-	return "UpdateDeploymentResponseValidationError"
+func (e DescribeDeploymentRequestValidationError) ErrorName() string {
+	return "DescribeDeploymentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateDeploymentResponseValidationError) Error() string {
+func (e DescribeDeploymentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4490,14 +4290,14 @@ func (e UpdateDeploymentResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateDeploymentResponse.%s: %s%s",
+		"invalid %sDescribeDeploymentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateDeploymentResponseValidationError{}
+var _ error = DescribeDeploymentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4505,88 +4305,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateDeploymentResponseValidationError{}
+} = DescribeDeploymentRequestValidationError{}
 
-// Validate checks the field values on DeleteDeploymentRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DescribeDeploymentResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteDeploymentRequest) Validate() error {
+func (m *DescribeDeploymentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteDeploymentRequest with the
+// ValidateAll checks the field values on DescribeDeploymentResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteDeploymentRequestMultiError, or nil if none found.
-func (m *DeleteDeploymentRequest) ValidateAll() error {
+// DescribeDeploymentResponseMultiError, or nil if none found.
+func (m *DescribeDeploymentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteDeploymentRequest) validate(all bool) error {
+func (m *DescribeDeploymentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetClientset()) < 1 {
-		err := DeleteDeploymentRequestValidationError{
-			field:  "Clientset",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetCluster()) < 1 {
-		err := DeleteDeploymentRequestValidationError{
-			field:  "Cluster",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetNamespace()) < 1 {
-		err := DeleteDeploymentRequestValidationError{
-			field:  "Namespace",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetName()) < 1 {
-		err := DeleteDeploymentRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 bytes",
+	if all {
+		switch v := interface{}(m.GetDeployment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DescribeDeploymentResponseValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DescribeDeploymentResponseValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeDeploymentResponseValidationError{
+				field:  "Deployment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return DeleteDeploymentRequestMultiError(errors)
+		return DescribeDeploymentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteDeploymentRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteDeploymentRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteDeploymentRequestMultiError []error
+// DescribeDeploymentResponseMultiError is an error wrapping multiple
+// validation errors returned by DescribeDeploymentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DescribeDeploymentResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteDeploymentRequestMultiError) Error() string {
+func (m DescribeDeploymentResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4595,11 +4380,11 @@ func (m DeleteDeploymentRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteDeploymentRequestMultiError) AllErrors() []error { return m }
+func (m DescribeDeploymentResponseMultiError) AllErrors() []error { return m }
 
-// DeleteDeploymentRequestValidationError is the validation error returned by
-// DeleteDeploymentRequest.Validate if the designated constraints aren't met.
-type DeleteDeploymentRequestValidationError struct {
+// DescribeDeploymentResponseValidationError is the validation error returned
+// by DescribeDeploymentResponse.Validate if the designated constraints aren't met.
+type DescribeDeploymentResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4607,24 +4392,24 @@ type DeleteDeploymentRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteDeploymentRequestValidationError) Field() string { return e.field }
+func (e DescribeDeploymentResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteDeploymentRequestValidationError) Reason() string { return e.reason }
+func (e DescribeDeploymentResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteDeploymentRequestValidationError) Cause() error { return e.cause }
+func (e DescribeDeploymentResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteDeploymentRequestValidationError) Key() bool { return e.key }
+func (e DescribeDeploymentResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteDeploymentRequestValidationError) ErrorName() string {
-	return "DeleteDeploymentRequestValidationError"
+func (e DescribeDeploymentResponseValidationError) ErrorName() string {
+	return "DescribeDeploymentResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteDeploymentRequestValidationError) Error() string {
+func (e DescribeDeploymentResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4636,14 +4421,14 @@ func (e DeleteDeploymentRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteDeploymentRequest.%s: %s%s",
+		"invalid %sDescribeDeploymentResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteDeploymentRequestValidationError{}
+var _ error = DescribeDeploymentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4651,44 +4436,117 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteDeploymentRequestValidationError{}
+} = DescribeDeploymentResponseValidationError{}
 
-// Validate checks the field values on DeleteDeploymentResponse with the rules
+// Validate checks the field values on ListDeploymentsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteDeploymentResponse) Validate() error {
+func (m *ListDeploymentsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteDeploymentResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListDeploymentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteDeploymentResponseMultiError, or nil if none found.
-func (m *DeleteDeploymentResponse) ValidateAll() error {
+// ListDeploymentsRequestMultiError, or nil if none found.
+func (m *ListDeploymentsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteDeploymentResponse) validate(all bool) error {
+func (m *ListDeploymentsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return DeleteDeploymentResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// DeleteDeploymentResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteDeploymentResponse.ValidateAll() if the designated
+	if len(m.GetClientset()) < 1 {
+		err := ListDeploymentsRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := ListDeploymentsRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := ListDeploymentsRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOptions() == nil {
+		err := ListDeploymentsRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListDeploymentsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListDeploymentsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListDeploymentsRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListDeploymentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeploymentsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeploymentsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteDeploymentResponseMultiError []error
+type ListDeploymentsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteDeploymentResponseMultiError) Error() string {
+func (m ListDeploymentsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4697,11 +4555,11 @@ func (m DeleteDeploymentResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteDeploymentResponseMultiError) AllErrors() []error { return m }
+func (m ListDeploymentsRequestMultiError) AllErrors() []error { return m }
 
-// DeleteDeploymentResponseValidationError is the validation error returned by
-// DeleteDeploymentResponse.Validate if the designated constraints aren't met.
-type DeleteDeploymentResponseValidationError struct {
+// ListDeploymentsRequestValidationError is the validation error returned by
+// ListDeploymentsRequest.Validate if the designated constraints aren't met.
+type ListDeploymentsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4709,24 +4567,24 @@ type DeleteDeploymentResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteDeploymentResponseValidationError) Field() string { return e.field }
+func (e ListDeploymentsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteDeploymentResponseValidationError) Reason() string { return e.reason }
+func (e ListDeploymentsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteDeploymentResponseValidationError) Cause() error { return e.cause }
+func (e ListDeploymentsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteDeploymentResponseValidationError) Key() bool { return e.key }
+func (e ListDeploymentsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteDeploymentResponseValidationError) ErrorName() string {
-	return "DeleteDeploymentResponseValidationError"
+func (e ListDeploymentsRequestValidationError) ErrorName() string {
+	return "ListDeploymentsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteDeploymentResponseValidationError) Error() string {
+func (e ListDeploymentsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4738,14 +4596,14 @@ func (e DeleteDeploymentResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteDeploymentResponse.%s: %s%s",
+		"invalid %sListDeploymentsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteDeploymentResponseValidationError{}
+var _ error = ListDeploymentsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4753,84 +4611,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteDeploymentResponseValidationError{}
+} = ListDeploymentsRequestValidationError{}
 
-// Validate checks the field values on StatefulSet with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *StatefulSet) Validate() error {
+// Validate checks the field values on ListDeploymentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeploymentsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StatefulSet with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in StatefulSetMultiError, or
-// nil if none found.
-func (m *StatefulSet) ValidateAll() error {
+// ValidateAll checks the field values on ListDeploymentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeploymentsResponseMultiError, or nil if none found.
+func (m *ListDeploymentsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StatefulSet) validate(all bool) error {
+func (m *ListDeploymentsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Cluster
-
-	// no validation rules for Namespace
-
-	// no validation rules for Name
-
-	// no validation rules for Labels
-
-	// no validation rules for Annotations
+	for idx, item := range m.GetDeployments() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetStatus()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StatefulSetValidationError{
-					field:  "Status",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeploymentsResponseValidationError{
+						field:  fmt.Sprintf("Deployments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeploymentsResponseValidationError{
+						field:  fmt.Sprintf("Deployments[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, StatefulSetValidationError{
-					field:  "Status",
+				return ListDeploymentsResponseValidationError{
+					field:  fmt.Sprintf("Deployments[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StatefulSetValidationError{
-				field:  "Status",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	// no validation rules for CreationTimeMillis
+	}
 
 	if len(errors) > 0 {
-		return StatefulSetMultiError(errors)
+		return ListDeploymentsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// StatefulSetMultiError is an error wrapping multiple validation errors
-// returned by StatefulSet.ValidateAll() if the designated constraints aren't met.
-type StatefulSetMultiError []error
+// ListDeploymentsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListDeploymentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeploymentsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StatefulSetMultiError) Error() string {
+func (m ListDeploymentsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4839,11 +4691,11 @@ func (m StatefulSetMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StatefulSetMultiError) AllErrors() []error { return m }
+func (m ListDeploymentsResponseMultiError) AllErrors() []error { return m }
 
-// StatefulSetValidationError is the validation error returned by
-// StatefulSet.Validate if the designated constraints aren't met.
-type StatefulSetValidationError struct {
+// ListDeploymentsResponseValidationError is the validation error returned by
+// ListDeploymentsResponse.Validate if the designated constraints aren't met.
+type ListDeploymentsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4851,22 +4703,24 @@ type StatefulSetValidationError struct {
 }
 
 // Field function returns field value.
-func (e StatefulSetValidationError) Field() string { return e.field }
+func (e ListDeploymentsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StatefulSetValidationError) Reason() string { return e.reason }
+func (e ListDeploymentsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StatefulSetValidationError) Cause() error { return e.cause }
+func (e ListDeploymentsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StatefulSetValidationError) Key() bool { return e.key }
+func (e ListDeploymentsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StatefulSetValidationError) ErrorName() string { return "StatefulSetValidationError" }
+func (e ListDeploymentsResponseValidationError) ErrorName() string {
+	return "ListDeploymentsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e StatefulSetValidationError) Error() string {
+func (e ListDeploymentsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4878,14 +4732,14 @@ func (e StatefulSetValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStatefulSet.%s: %s%s",
+		"invalid %sListDeploymentsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StatefulSetValidationError{}
+var _ error = ListDeploymentsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4893,24 +4747,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StatefulSetValidationError{}
+} = ListDeploymentsResponseValidationError{}
 
-// Validate checks the field values on DescribeStatefulSetRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateDeploymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DescribeStatefulSetRequest) Validate() error {
+func (m *UpdateDeploymentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DescribeStatefulSetRequest with the
+// ValidateAll checks the field values on UpdateDeploymentRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DescribeStatefulSetRequestMultiError, or nil if none found.
-func (m *DescribeStatefulSetRequest) ValidateAll() error {
+// UpdateDeploymentRequestMultiError, or nil if none found.
+func (m *UpdateDeploymentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DescribeStatefulSetRequest) validate(all bool) error {
+func (m *UpdateDeploymentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -4918,7 +4772,7 @@ func (m *DescribeStatefulSetRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetClientset()) < 1 {
-		err := DescribeStatefulSetRequestValidationError{
+		err := UpdateDeploymentRequestValidationError{
 			field:  "Clientset",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -4929,7 +4783,7 @@ func (m *DescribeStatefulSetRequest) validate(all bool) error {
 	}
 
 	if len(m.GetCluster()) < 1 {
-		err := DescribeStatefulSetRequestValidationError{
+		err := UpdateDeploymentRequestValidationError{
 			field:  "Cluster",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -4940,7 +4794,7 @@ func (m *DescribeStatefulSetRequest) validate(all bool) error {
 	}
 
 	if len(m.GetNamespace()) < 1 {
-		err := DescribeStatefulSetRequestValidationError{
+		err := UpdateDeploymentRequestValidationError{
 			field:  "Namespace",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -4951,7 +4805,7 @@ func (m *DescribeStatefulSetRequest) validate(all bool) error {
 	}
 
 	if len(m.GetName()) < 1 {
-		err := DescribeStatefulSetRequestValidationError{
+		err := UpdateDeploymentRequestValidationError{
 			field:  "Name",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -4961,20 +4815,78 @@ func (m *DescribeStatefulSetRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(errors) > 0 {
-		return DescribeStatefulSetRequestMultiError(errors)
-	}
-
+	if all {
+		switch v := interface{}(m.GetFields()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateDeploymentRequestValidationError{
+					field:  "Fields",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateDeploymentRequestValidationError{
+					field:  "Fields",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFields()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateDeploymentRequestValidationError{
+				field:  "Fields",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPatch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateDeploymentRequestValidationError{
+					field:  "Patch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateDeploymentRequestValidationError{
+					field:  "Patch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPatch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateDeploymentRequestValidationError{
+				field:  "Patch",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateDeploymentRequestMultiError(errors)
+	}
+
 	return nil
 }
 
-// DescribeStatefulSetRequestMultiError is an error wrapping multiple
-// validation errors returned by DescribeStatefulSetRequest.ValidateAll() if
-// the designated constraints aren't met.
-type DescribeStatefulSetRequestMultiError []error
+// UpdateDeploymentRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateDeploymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateDeploymentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DescribeStatefulSetRequestMultiError) Error() string {
+func (m UpdateDeploymentRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4983,11 +4895,11 @@ func (m DescribeStatefulSetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DescribeStatefulSetRequestMultiError) AllErrors() []error { return m }
+func (m UpdateDeploymentRequestMultiError) AllErrors() []error { return m }
 
-// DescribeStatefulSetRequestValidationError is the validation error returned
-// by DescribeStatefulSetRequest.Validate if the designated constraints aren't met.
-type DescribeStatefulSetRequestValidationError struct {
+// UpdateDeploymentRequestValidationError is the validation error returned by
+// UpdateDeploymentRequest.Validate if the designated constraints aren't met.
+type UpdateDeploymentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4995,24 +4907,24 @@ type DescribeStatefulSetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeStatefulSetRequestValidationError) Field() string { return e.field }
+func (e UpdateDeploymentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeStatefulSetRequestValidationError) Reason() string { return e.reason }
+func (e UpdateDeploymentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeStatefulSetRequestValidationError) Cause() error { return e.cause }
+func (e UpdateDeploymentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeStatefulSetRequestValidationError) Key() bool { return e.key }
+func (e UpdateDeploymentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeStatefulSetRequestValidationError) ErrorName() string {
-	return "DescribeStatefulSetRequestValidationError"
+func (e UpdateDeploymentRequestValidationError) ErrorName() string {
+	return "UpdateDeploymentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeStatefulSetRequestValidationError) Error() string {
+func (e UpdateDeploymentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5024,14 +4936,14 @@ func (e DescribeStatefulSetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeStatefulSetRequest.%s: %s%s",
+		"invalid %sUpdateDeploymentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeStatefulSetRequestValidationError{}
+var _ error = UpdateDeploymentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5039,73 +4951,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeStatefulSetRequestValidationError{}
+} = UpdateDeploymentRequestValidationError{}
 
-// Validate checks the field values on DescribeStatefulSetResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on UpdateDeploymentResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DescribeStatefulSetResponse) Validate() error {
+func (m *UpdateDeploymentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DescribeStatefulSetResponse with the
+// ValidateAll checks the field values on UpdateDeploymentResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DescribeStatefulSetResponseMultiError, or nil if none found.
-func (m *DescribeStatefulSetResponse) ValidateAll() error {
+// UpdateDeploymentResponseMultiError, or nil if none found.
+func (m *UpdateDeploymentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DescribeStatefulSetResponse) validate(all bool) error {
+func (m *UpdateDeploymentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetStatefulSet()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DescribeStatefulSetResponseValidationError{
-					field:  "StatefulSet",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DescribeStatefulSetResponseValidationError{
-					field:  "StatefulSet",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStatefulSet()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DescribeStatefulSetResponseValidationError{
-				field:  "StatefulSet",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Diff
 
 	if len(errors) > 0 {
-		return DescribeStatefulSetResponseMultiError(errors)
+		return UpdateDeploymentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DescribeStatefulSetResponseMultiError is an error wrapping multiple
-// validation errors returned by DescribeStatefulSetResponse.ValidateAll() if
-// the designated constraints aren't met.
-type DescribeStatefulSetResponseMultiError []error
+// UpdateDeploymentResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateDeploymentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateDeploymentResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DescribeStatefulSetResponseMultiError) Error() string {
+func (m UpdateDeploymentResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5114,12 +4999,11 @@ func (m DescribeStatefulSetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DescribeStatefulSetResponseMultiError) AllErrors() []error { return m }
+func (m UpdateDeploymentResponseMultiError) AllErrors() []error { return m }
 
-// DescribeStatefulSetResponseValidationError is the validation error returned
-// by DescribeStatefulSetResponse.Validate if the designated constraints
-// aren't met.
-type DescribeStatefulSetResponseValidationError struct {
+// UpdateDeploymentResponseValidationError is the validation error returned by
+// UpdateDeploymentResponse.Validate if the designated constraints aren't met.
+type UpdateDeploymentResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5127,24 +5011,24 @@ type DescribeStatefulSetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeStatefulSetResponseValidationError) Field() string { return e.field }
+func (e UpdateDeploymentResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeStatefulSetResponseValidationError) Reason() string { return e.reason }
+func (e UpdateDeploymentResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeStatefulSetResponseValidationError) Cause() error { return e.cause }
+func (e UpdateDeploymentResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeStatefulSetResponseValidationError) Key() bool { return e.key }
+func (e UpdateDeploymentResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeStatefulSetResponseValidationError) ErrorName() string {
-	return "DescribeStatefulSetResponseValidationError"
+func (e UpdateDeploymentResponseValidationError) ErrorName() string {
+	return "UpdateDeploymentResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeStatefulSetResponseValidationError) Error() string {
+func (e UpdateDeploymentResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5156,14 +5040,14 @@ func (e DescribeStatefulSetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeStatefulSetResponse.%s: %s%s",
+		"invalid %sUpdateDeploymentResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeStatefulSetResponseValidationError{}
+var _ error = UpdateDeploymentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5171,24 +5055,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeStatefulSetResponseValidationError{}
+} = UpdateDeploymentResponseValidationError{}
 
-// Validate checks the field values on ListStatefulSetsRequest with the rules
+// Validate checks the field values on DeleteDeploymentRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListStatefulSetsRequest) Validate() error {
+func (m *DeleteDeploymentRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListStatefulSetsRequest with the
+// ValidateAll checks the field values on DeleteDeploymentRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListStatefulSetsRequestMultiError, or nil if none found.
-func (m *ListStatefulSetsRequest) ValidateAll() error {
+// DeleteDeploymentRequestMultiError, or nil if none found.
+func (m *DeleteDeploymentRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListStatefulSetsRequest) validate(all bool) error {
+func (m *DeleteDeploymentRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5196,7 +5080,7 @@ func (m *ListStatefulSetsRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetClientset()) < 1 {
-		err := ListStatefulSetsRequestValidationError{
+		err := DeleteDeploymentRequestValidationError{
 			field:  "Clientset",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -5207,7 +5091,7 @@ func (m *ListStatefulSetsRequest) validate(all bool) error {
 	}
 
 	if len(m.GetCluster()) < 1 {
-		err := ListStatefulSetsRequestValidationError{
+		err := DeleteDeploymentRequestValidationError{
 			field:  "Cluster",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -5218,7 +5102,7 @@ func (m *ListStatefulSetsRequest) validate(all bool) error {
 	}
 
 	if len(m.GetNamespace()) < 1 {
-		err := ListStatefulSetsRequestValidationError{
+		err := DeleteDeploymentRequestValidationError{
 			field:  "Namespace",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -5228,10 +5112,10 @@ func (m *ListStatefulSetsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetOptions() == nil {
-		err := ListStatefulSetsRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
+	if len(m.GetName()) < 1 {
+		err := DeleteDeploymentRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
 		}
 		if !all {
 			return err
@@ -5239,49 +5123,20 @@ func (m *ListStatefulSetsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListStatefulSetsRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListStatefulSetsRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListStatefulSetsRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return ListStatefulSetsRequestMultiError(errors)
+		return DeleteDeploymentRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListStatefulSetsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListStatefulSetsRequest.ValidateAll() if the designated
+// DeleteDeploymentRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteDeploymentRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListStatefulSetsRequestMultiError []error
+type DeleteDeploymentRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListStatefulSetsRequestMultiError) Error() string {
+func (m DeleteDeploymentRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5290,11 +5145,11 @@ func (m ListStatefulSetsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListStatefulSetsRequestMultiError) AllErrors() []error { return m }
+func (m DeleteDeploymentRequestMultiError) AllErrors() []error { return m }
 
-// ListStatefulSetsRequestValidationError is the validation error returned by
-// ListStatefulSetsRequest.Validate if the designated constraints aren't met.
-type ListStatefulSetsRequestValidationError struct {
+// DeleteDeploymentRequestValidationError is the validation error returned by
+// DeleteDeploymentRequest.Validate if the designated constraints aren't met.
+type DeleteDeploymentRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5302,24 +5157,24 @@ type ListStatefulSetsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListStatefulSetsRequestValidationError) Field() string { return e.field }
+func (e DeleteDeploymentRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListStatefulSetsRequestValidationError) Reason() string { return e.reason }
+func (e DeleteDeploymentRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListStatefulSetsRequestValidationError) Cause() error { return e.cause }
+func (e DeleteDeploymentRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListStatefulSetsRequestValidationError) Key() bool { return e.key }
+func (e DeleteDeploymentRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListStatefulSetsRequestValidationError) ErrorName() string {
-	return "ListStatefulSetsRequestValidationError"
+func (e DeleteDeploymentRequestValidationError) ErrorName() string {
+	return "DeleteDeploymentRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListStatefulSetsRequestValidationError) Error() string {
+func (e DeleteDeploymentRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5331,14 +5186,14 @@ func (e ListStatefulSetsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListStatefulSetsRequest.%s: %s%s",
+		"invalid %sDeleteDeploymentRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListStatefulSetsRequestValidationError{}
+var _ error = DeleteDeploymentRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5346,78 +5201,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListStatefulSetsRequestValidationError{}
+} = DeleteDeploymentRequestValidationError{}
 
-// Validate checks the field values on ListStatefulSetsResponse with the rules
+// Validate checks the field values on DeleteDeploymentResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListStatefulSetsResponse) Validate() error {
+func (m *DeleteDeploymentResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListStatefulSetsResponse with the
+// ValidateAll checks the field values on DeleteDeploymentResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListStatefulSetsResponseMultiError, or nil if none found.
-func (m *ListStatefulSetsResponse) ValidateAll() error {
+// DeleteDeploymentResponseMultiError, or nil if none found.
+func (m *DeleteDeploymentResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListStatefulSetsResponse) validate(all bool) error {
+func (m *DeleteDeploymentResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetStatefulSets() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListStatefulSetsResponseValidationError{
-						field:  fmt.Sprintf("StatefulSets[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListStatefulSetsResponseValidationError{
-						field:  fmt.Sprintf("StatefulSets[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListStatefulSetsResponseValidationError{
-					field:  fmt.Sprintf("StatefulSets[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ListStatefulSetsResponseMultiError(errors)
+		return DeleteDeploymentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListStatefulSetsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListStatefulSetsResponse.ValidateAll() if the designated
+// DeleteDeploymentResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteDeploymentResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListStatefulSetsResponseMultiError []error
+type DeleteDeploymentResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListStatefulSetsResponseMultiError) Error() string {
+func (m DeleteDeploymentResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5426,11 +5247,11 @@ func (m ListStatefulSetsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListStatefulSetsResponseMultiError) AllErrors() []error { return m }
+func (m DeleteDeploymentResponseMultiError) AllErrors() []error { return m }
 
-// ListStatefulSetsResponseValidationError is the validation error returned by
-// ListStatefulSetsResponse.Validate if the designated constraints aren't met.
-type ListStatefulSetsResponseValidationError struct {
+// DeleteDeploymentResponseValidationError is the validation error returned by
+// DeleteDeploymentResponse.Validate if the designated constraints aren't met.
+type DeleteDeploymentResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5438,24 +5259,24 @@ type ListStatefulSetsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListStatefulSetsResponseValidationError) Field() string { return e.field }
+func (e DeleteDeploymentResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListStatefulSetsResponseValidationError) Reason() string { return e.reason }
+func (e DeleteDeploymentResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListStatefulSetsResponseValidationError) Cause() error { return e.cause }
+func (e DeleteDeploymentResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListStatefulSetsResponseValidationError) Key() bool { return e.key }
+func (e DeleteDeploymentResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListStatefulSetsResponseValidationError) ErrorName() string {
-	return "ListStatefulSetsResponseValidationError"
+func (e DeleteDeploymentResponseValidationError) ErrorName() string {
+	return "DeleteDeploymentResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListStatefulSetsResponseValidationError) Error() string {
+func (e DeleteDeploymentResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5467,14 +5288,14 @@ func (e ListStatefulSetsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListStatefulSetsResponse.%s: %s%s",
+		"invalid %sDeleteDeploymentResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListStatefulSetsResponseValidationError{}
+var _ error = DeleteDeploymentResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5482,88 +5303,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListStatefulSetsResponseValidationError{}
+} = DeleteDeploymentResponseValidationError{}
 
-// Validate checks the field values on DeleteStatefulSetRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteStatefulSetRequest) Validate() error {
+// Validate checks the field values on StatefulSet with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatefulSet) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteStatefulSetRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeleteStatefulSetRequestMultiError, or nil if none found.
-func (m *DeleteStatefulSetRequest) ValidateAll() error {
+// ValidateAll checks the field values on StatefulSet with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatefulSetMultiError, or
+// nil if none found.
+func (m *StatefulSet) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteStatefulSetRequest) validate(all bool) error {
+func (m *StatefulSet) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetClientset()) < 1 {
-		err := DeleteStatefulSetRequestValidationError{
-			field:  "Clientset",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Cluster
 
-	if len(m.GetCluster()) < 1 {
-		err := DeleteStatefulSetRequestValidationError{
-			field:  "Cluster",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Namespace
 
-	if len(m.GetNamespace()) < 1 {
-		err := DeleteStatefulSetRequestValidationError{
-			field:  "Namespace",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Name
 
-	if len(m.GetName()) < 1 {
-		err := DeleteStatefulSetRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 bytes",
+	// no validation rules for Labels
+
+	// no validation rules for Annotations
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatefulSetValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatefulSetValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatefulSetValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
+	// no validation rules for CreationTimeMillis
+
 	if len(errors) > 0 {
-		return DeleteStatefulSetRequestMultiError(errors)
+		return StatefulSetMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteStatefulSetRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteStatefulSetRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DeleteStatefulSetRequestMultiError []error
+// StatefulSetMultiError is an error wrapping multiple validation errors
+// returned by StatefulSet.ValidateAll() if the designated constraints aren't met.
+type StatefulSetMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteStatefulSetRequestMultiError) Error() string {
+func (m StatefulSetMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5572,11 +5389,11 @@ func (m DeleteStatefulSetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteStatefulSetRequestMultiError) AllErrors() []error { return m }
+func (m StatefulSetMultiError) AllErrors() []error { return m }
 
-// DeleteStatefulSetRequestValidationError is the validation error returned by
-// DeleteStatefulSetRequest.Validate if the designated constraints aren't met.
-type DeleteStatefulSetRequestValidationError struct {
+// StatefulSetValidationError is the validation error returned by
+// StatefulSet.Validate if the designated constraints aren't met.
+type StatefulSetValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5584,24 +5401,22 @@ type DeleteStatefulSetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteStatefulSetRequestValidationError) Field() string { return e.field }
+func (e StatefulSetValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteStatefulSetRequestValidationError) Reason() string { return e.reason }
+func (e StatefulSetValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteStatefulSetRequestValidationError) Cause() error { return e.cause }
+func (e StatefulSetValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteStatefulSetRequestValidationError) Key() bool { return e.key }
+func (e StatefulSetValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteStatefulSetRequestValidationError) ErrorName() string {
-	return "DeleteStatefulSetRequestValidationError"
-}
+func (e StatefulSetValidationError) ErrorName() string { return "StatefulSetValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteStatefulSetRequestValidationError) Error() string {
+func (e StatefulSetValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5613,14 +5428,14 @@ func (e DeleteStatefulSetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteStatefulSetRequest.%s: %s%s",
+		"invalid %sStatefulSet.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteStatefulSetRequestValidationError{}
+var _ error = StatefulSetValidationError{}
 
 var _ interface {
 	Field() string
@@ -5628,44 +5443,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteStatefulSetRequestValidationError{}
+} = StatefulSetValidationError{}
 
-// Validate checks the field values on DeleteStatefulSetResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DescribeStatefulSetRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteStatefulSetResponse) Validate() error {
+func (m *DescribeStatefulSetRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteStatefulSetResponse with the
+// ValidateAll checks the field values on DescribeStatefulSetRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteStatefulSetResponseMultiError, or nil if none found.
-func (m *DeleteStatefulSetResponse) ValidateAll() error {
+// DescribeStatefulSetRequestMultiError, or nil if none found.
+func (m *DescribeStatefulSetRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteStatefulSetResponse) validate(all bool) error {
+func (m *DescribeStatefulSetRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return DeleteStatefulSetResponseMultiError(errors)
+	if len(m.GetClientset()) < 1 {
+		err := DescribeStatefulSetRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
+	if len(m.GetCluster()) < 1 {
+		err := DescribeStatefulSetRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-// DeleteStatefulSetResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteStatefulSetResponse.ValidateAll() if the
-// designated constraints aren't met.
-type DeleteStatefulSetResponseMultiError []error
+	if len(m.GetNamespace()) < 1 {
+		err := DescribeStatefulSetRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := DescribeStatefulSetRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DescribeStatefulSetRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeStatefulSetRequestMultiError is an error wrapping multiple
+// validation errors returned by DescribeStatefulSetRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DescribeStatefulSetRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteStatefulSetResponseMultiError) Error() string {
+func (m DescribeStatefulSetRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5674,11 +5533,11 @@ func (m DeleteStatefulSetResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteStatefulSetResponseMultiError) AllErrors() []error { return m }
+func (m DescribeStatefulSetRequestMultiError) AllErrors() []error { return m }
 
-// DeleteStatefulSetResponseValidationError is the validation error returned by
-// DeleteStatefulSetResponse.Validate if the designated constraints aren't met.
-type DeleteStatefulSetResponseValidationError struct {
+// DescribeStatefulSetRequestValidationError is the validation error returned
+// by DescribeStatefulSetRequest.Validate if the designated constraints aren't met.
+type DescribeStatefulSetRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5686,24 +5545,24 @@ type DeleteStatefulSetResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteStatefulSetResponseValidationError) Field() string { return e.field }
+func (e DescribeStatefulSetRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteStatefulSetResponseValidationError) Reason() string { return e.reason }
+func (e DescribeStatefulSetRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteStatefulSetResponseValidationError) Cause() error { return e.cause }
+func (e DescribeStatefulSetRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteStatefulSetResponseValidationError) Key() bool { return e.key }
+func (e DescribeStatefulSetRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteStatefulSetResponseValidationError) ErrorName() string {
-	return "DeleteStatefulSetResponseValidationError"
+func (e DescribeStatefulSetRequestValidationError) ErrorName() string {
+	return "DescribeStatefulSetRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteStatefulSetResponseValidationError) Error() string {
+func (e DescribeStatefulSetRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5715,14 +5574,14 @@ func (e DeleteStatefulSetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteStatefulSetResponse.%s: %s%s",
+		"invalid %sDescribeStatefulSetRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteStatefulSetResponseValidationError{}
+var _ error = DescribeStatefulSetRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5730,108 +5589,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteStatefulSetResponseValidationError{}
+} = DescribeStatefulSetRequestValidationError{}
 
-// Validate checks the field values on UpdateStatefulSetRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on DescribeStatefulSetResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateStatefulSetRequest) Validate() error {
+func (m *DescribeStatefulSetResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateStatefulSetRequest with the
+// ValidateAll checks the field values on DescribeStatefulSetResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateStatefulSetRequestMultiError, or nil if none found.
-func (m *UpdateStatefulSetRequest) ValidateAll() error {
+// DescribeStatefulSetResponseMultiError, or nil if none found.
+func (m *DescribeStatefulSetResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateStatefulSetRequest) validate(all bool) error {
+func (m *DescribeStatefulSetResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetClientset()) < 1 {
-		err := UpdateStatefulSetRequestValidationError{
-			field:  "Clientset",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetCluster()) < 1 {
-		err := UpdateStatefulSetRequestValidationError{
-			field:  "Cluster",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetNamespace()) < 1 {
-		err := UpdateStatefulSetRequestValidationError{
-			field:  "Namespace",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetName()) < 1 {
-		err := UpdateStatefulSetRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetFields() == nil {
-		err := UpdateStatefulSetRequestValidationError{
-			field:  "Fields",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
-		switch v := interface{}(m.GetFields()).(type) {
+		switch v := interface{}(m.GetStatefulSet()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, UpdateStatefulSetRequestValidationError{
-					field:  "Fields",
+				errors = append(errors, DescribeStatefulSetResponseValidationError{
+					field:  "StatefulSet",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, UpdateStatefulSetRequestValidationError{
-					field:  "Fields",
+				errors = append(errors, DescribeStatefulSetResponseValidationError{
+					field:  "StatefulSet",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetFields()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetStatefulSet()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateStatefulSetRequestValidationError{
-				field:  "Fields",
+			return DescribeStatefulSetResponseValidationError{
+				field:  "StatefulSet",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -5839,19 +5643,19 @@ func (m *UpdateStatefulSetRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return UpdateStatefulSetRequestMultiError(errors)
+		return DescribeStatefulSetResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateStatefulSetRequestMultiError is an error wrapping multiple validation
-// errors returned by UpdateStatefulSetRequest.ValidateAll() if the designated
-// constraints aren't met.
-type UpdateStatefulSetRequestMultiError []error
+// DescribeStatefulSetResponseMultiError is an error wrapping multiple
+// validation errors returned by DescribeStatefulSetResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DescribeStatefulSetResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateStatefulSetRequestMultiError) Error() string {
+func (m DescribeStatefulSetResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5860,11 +5664,12 @@ func (m UpdateStatefulSetRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateStatefulSetRequestMultiError) AllErrors() []error { return m }
+func (m DescribeStatefulSetResponseMultiError) AllErrors() []error { return m }
 
-// UpdateStatefulSetRequestValidationError is the validation error returned by
-// UpdateStatefulSetRequest.Validate if the designated constraints aren't met.
-type UpdateStatefulSetRequestValidationError struct {
+// DescribeStatefulSetResponseValidationError is the validation error returned
+// by DescribeStatefulSetResponse.Validate if the designated constraints
+// aren't met.
+type DescribeStatefulSetResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5872,24 +5677,24 @@ type UpdateStatefulSetRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateStatefulSetRequestValidationError) Field() string { return e.field }
+func (e DescribeStatefulSetResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateStatefulSetRequestValidationError) Reason() string { return e.reason }
+func (e DescribeStatefulSetResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateStatefulSetRequestValidationError) Cause() error { return e.cause }
+func (e DescribeStatefulSetResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateStatefulSetRequestValidationError) Key() bool { return e.key }
+func (e DescribeStatefulSetResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateStatefulSetRequestValidationError) ErrorName() string {
-	return "UpdateStatefulSetRequestValidationError"
+func (e DescribeStatefulSetResponseValidationError) ErrorName() string {
+	return "DescribeStatefulSetResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateStatefulSetRequestValidationError) Error() string {
+func (e DescribeStatefulSetResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5901,14 +5706,14 @@ func (e UpdateStatefulSetRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateStatefulSetRequest.%s: %s%s",
+		"invalid %sDescribeStatefulSetResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateStatefulSetRequestValidationError{}
+var _ error = DescribeStatefulSetResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5916,82 +5721,155 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateStatefulSetRequestValidationError{}
+} = DescribeStatefulSetResponseValidationError{}
 
-// Validate checks the field values on UpdateStatefulSetResponse with the rules
+// Validate checks the field values on ListStatefulSetsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateStatefulSetResponse) Validate() error {
+func (m *ListStatefulSetsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateStatefulSetResponse with the
+// ValidateAll checks the field values on ListStatefulSetsRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateStatefulSetResponseMultiError, or nil if none found.
-func (m *UpdateStatefulSetResponse) ValidateAll() error {
+// ListStatefulSetsRequestMultiError, or nil if none found.
+func (m *ListStatefulSetsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateStatefulSetResponse) validate(all bool) error {
+func (m *ListStatefulSetsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return UpdateStatefulSetResponseMultiError(errors)
+	if len(m.GetClientset()) < 1 {
+		err := ListStatefulSetsRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
-
-// UpdateStatefulSetResponseMultiError is an error wrapping multiple validation
-// errors returned by UpdateStatefulSetResponse.ValidateAll() if the
-// designated constraints aren't met.
-type UpdateStatefulSetResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m UpdateStatefulSetResponseMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if len(m.GetCluster()) < 1 {
+		err := ListStatefulSetsRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
-func (m UpdateStatefulSetResponseMultiError) AllErrors() []error { return m }
-
-// UpdateStatefulSetResponseValidationError is the validation error returned by
-// UpdateStatefulSetResponse.Validate if the designated constraints aren't met.
-type UpdateStatefulSetResponseValidationError struct {
-	field  string
-	reason string
+	if len(m.GetNamespace()) < 1 {
+		err := ListStatefulSetsRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOptions() == nil {
+		err := ListStatefulSetsRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListStatefulSetsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListStatefulSetsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListStatefulSetsRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListStatefulSetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListStatefulSetsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListStatefulSetsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListStatefulSetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListStatefulSetsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListStatefulSetsRequestMultiError) AllErrors() []error { return m }
+
+// ListStatefulSetsRequestValidationError is the validation error returned by
+// ListStatefulSetsRequest.Validate if the designated constraints aren't met.
+type ListStatefulSetsRequestValidationError struct {
+	field  string
+	reason string
 	cause  error
 	key    bool
 }
 
 // Field function returns field value.
-func (e UpdateStatefulSetResponseValidationError) Field() string { return e.field }
+func (e ListStatefulSetsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateStatefulSetResponseValidationError) Reason() string { return e.reason }
+func (e ListStatefulSetsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateStatefulSetResponseValidationError) Cause() error { return e.cause }
+func (e ListStatefulSetsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateStatefulSetResponseValidationError) Key() bool { return e.key }
+func (e ListStatefulSetsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateStatefulSetResponseValidationError) ErrorName() string {
-	return "UpdateStatefulSetResponseValidationError"
+func (e ListStatefulSetsRequestValidationError) ErrorName() string {
+	return "ListStatefulSetsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateStatefulSetResponseValidationError) Error() string {
+func (e ListStatefulSetsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6003,14 +5881,14 @@ func (e UpdateStatefulSetResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateStatefulSetResponse.%s: %s%s",
+		"invalid %sListStatefulSetsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateStatefulSetResponseValidationError{}
+var _ error = ListStatefulSetsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6018,56 +5896,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateStatefulSetResponseValidationError{}
+} = ListStatefulSetsRequestValidationError{}
 
-// Validate checks the field values on Service with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Service) Validate() error {
+// Validate checks the field values on ListStatefulSetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListStatefulSetsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Service with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ServiceMultiError, or nil if none found.
-func (m *Service) ValidateAll() error {
+// ValidateAll checks the field values on ListStatefulSetsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListStatefulSetsResponseMultiError, or nil if none found.
+func (m *ListStatefulSetsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Service) validate(all bool) error {
+func (m *ListStatefulSetsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Cluster
-
-	// no validation rules for Namespace
-
-	// no validation rules for Name
-
-	// no validation rules for Type
-
-	// no validation rules for Labels
+	for idx, item := range m.GetStatefulSets() {
+		_, _ = idx, item
 
-	// no validation rules for Annotations
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListStatefulSetsResponseValidationError{
+						field:  fmt.Sprintf("StatefulSets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListStatefulSetsResponseValidationError{
+						field:  fmt.Sprintf("StatefulSets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListStatefulSetsResponseValidationError{
+					field:  fmt.Sprintf("StatefulSets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Selector
+	}
 
 	if len(errors) > 0 {
-		return ServiceMultiError(errors)
+		return ListStatefulSetsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ServiceMultiError is an error wrapping multiple validation errors returned
-// by Service.ValidateAll() if the designated constraints aren't met.
-type ServiceMultiError []error
+// ListStatefulSetsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListStatefulSetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListStatefulSetsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ServiceMultiError) Error() string {
+func (m ListStatefulSetsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6076,11 +5976,11 @@ func (m ServiceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ServiceMultiError) AllErrors() []error { return m }
+func (m ListStatefulSetsResponseMultiError) AllErrors() []error { return m }
 
-// ServiceValidationError is the validation error returned by Service.Validate
-// if the designated constraints aren't met.
-type ServiceValidationError struct {
+// ListStatefulSetsResponseValidationError is the validation error returned by
+// ListStatefulSetsResponse.Validate if the designated constraints aren't met.
+type ListStatefulSetsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6088,22 +5988,24 @@ type ServiceValidationError struct {
 }
 
 // Field function returns field value.
-func (e ServiceValidationError) Field() string { return e.field }
+func (e ListStatefulSetsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ServiceValidationError) Reason() string { return e.reason }
+func (e ListStatefulSetsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ServiceValidationError) Cause() error { return e.cause }
+func (e ListStatefulSetsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ServiceValidationError) Key() bool { return e.key }
+func (e ListStatefulSetsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ServiceValidationError) ErrorName() string { return "ServiceValidationError" }
+func (e ListStatefulSetsResponseValidationError) ErrorName() string {
+	return "ListStatefulSetsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ServiceValidationError) Error() string {
+func (e ListStatefulSetsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6115,14 +6017,14 @@ func (e ServiceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sService.%s: %s%s",
+		"invalid %sListStatefulSetsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ServiceValidationError{}
+var _ error = ListStatefulSetsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6130,24 +6032,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ServiceValidationError{}
+} = ListStatefulSetsResponseValidationError{}
 
-// Validate checks the field values on DescribeServiceRequest with the rules
+// Validate checks the field values on DeleteStatefulSetRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DescribeServiceRequest) Validate() error {
+func (m *DeleteStatefulSetRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DescribeServiceRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DeleteStatefulSetRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DescribeServiceRequestMultiError, or nil if none found.
-func (m *DescribeServiceRequest) ValidateAll() error {
+// DeleteStatefulSetRequestMultiError, or nil if none found.
+func (m *DeleteStatefulSetRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DescribeServiceRequest) validate(all bool) error {
+func (m *DeleteStatefulSetRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6155,7 +6057,7 @@ func (m *DescribeServiceRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetClientset()) < 1 {
-		err := DescribeServiceRequestValidationError{
+		err := DeleteStatefulSetRequestValidationError{
 			field:  "Clientset",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -6166,7 +6068,7 @@ func (m *DescribeServiceRequest) validate(all bool) error {
 	}
 
 	if len(m.GetCluster()) < 1 {
-		err := DescribeServiceRequestValidationError{
+		err := DeleteStatefulSetRequestValidationError{
 			field:  "Cluster",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -6177,7 +6079,7 @@ func (m *DescribeServiceRequest) validate(all bool) error {
 	}
 
 	if len(m.GetNamespace()) < 1 {
-		err := DescribeServiceRequestValidationError{
+		err := DeleteStatefulSetRequestValidationError{
 			field:  "Namespace",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -6188,7 +6090,7 @@ func (m *DescribeServiceRequest) validate(all bool) error {
 	}
 
 	if len(m.GetName()) < 1 {
-		err := DescribeServiceRequestValidationError{
+		err := DeleteStatefulSetRequestValidationError{
 			field:  "Name",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -6199,19 +6101,19 @@ func (m *DescribeServiceRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DescribeServiceRequestMultiError(errors)
+		return DeleteStatefulSetRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DescribeServiceRequestMultiError is an error wrapping multiple validation
-// errors returned by DescribeServiceRequest.ValidateAll() if the designated
+// DeleteStatefulSetRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteStatefulSetRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DescribeServiceRequestMultiError []error
+type DeleteStatefulSetRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DescribeServiceRequestMultiError) Error() string {
+func (m DeleteStatefulSetRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6220,11 +6122,11 @@ func (m DescribeServiceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DescribeServiceRequestMultiError) AllErrors() []error { return m }
+func (m DeleteStatefulSetRequestMultiError) AllErrors() []error { return m }
 
-// DescribeServiceRequestValidationError is the validation error returned by
-// DescribeServiceRequest.Validate if the designated constraints aren't met.
-type DescribeServiceRequestValidationError struct {
+// DeleteStatefulSetRequestValidationError is the validation error returned by
+// DeleteStatefulSetRequest.Validate if the designated constraints aren't met.
+type DeleteStatefulSetRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6232,24 +6134,24 @@ type DescribeServiceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeServiceRequestValidationError) Field() string { return e.field }
+func (e DeleteStatefulSetRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeServiceRequestValidationError) Reason() string { return e.reason }
+func (e DeleteStatefulSetRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeServiceRequestValidationError) Cause() error { return e.cause }
+func (e DeleteStatefulSetRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeServiceRequestValidationError) Key() bool { return e.key }
+func (e DeleteStatefulSetRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeServiceRequestValidationError) ErrorName() string {
-	return "DescribeServiceRequestValidationError"
+func (e DeleteStatefulSetRequestValidationError) ErrorName() string {
+	return "DeleteStatefulSetRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeServiceRequestValidationError) Error() string {
+func (e DeleteStatefulSetRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6261,14 +6163,14 @@ func (e DescribeServiceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeServiceRequest.%s: %s%s",
+		"invalid %sDeleteStatefulSetRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeServiceRequestValidationError{}
+var _ error = DeleteStatefulSetRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6276,73 +6178,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeServiceRequestValidationError{}
+} = DeleteStatefulSetRequestValidationError{}
 
-// Validate checks the field values on DescribeServiceResponse with the rules
+// Validate checks the field values on DeleteStatefulSetResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DescribeServiceResponse) Validate() error {
+func (m *DeleteStatefulSetResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DescribeServiceResponse with the
+// ValidateAll checks the field values on DeleteStatefulSetResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DescribeServiceResponseMultiError, or nil if none found.
-func (m *DescribeServiceResponse) ValidateAll() error {
+// DeleteStatefulSetResponseMultiError, or nil if none found.
+func (m *DeleteStatefulSetResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DescribeServiceResponse) validate(all bool) error {
+func (m *DeleteStatefulSetResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetService()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DescribeServiceResponseValidationError{
-					field:  "Service",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DescribeServiceResponseValidationError{
-					field:  "Service",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetService()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DescribeServiceResponseValidationError{
-				field:  "Service",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return DescribeServiceResponseMultiError(errors)
+		return DeleteStatefulSetResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DescribeServiceResponseMultiError is an error wrapping multiple validation
-// errors returned by DescribeServiceResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DescribeServiceResponseMultiError []error
+// DeleteStatefulSetResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteStatefulSetResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteStatefulSetResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DescribeServiceResponseMultiError) Error() string {
+func (m DeleteStatefulSetResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6351,11 +6224,11 @@ func (m DescribeServiceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DescribeServiceResponseMultiError) AllErrors() []error { return m }
+func (m DeleteStatefulSetResponseMultiError) AllErrors() []error { return m }
 
-// DescribeServiceResponseValidationError is the validation error returned by
-// DescribeServiceResponse.Validate if the designated constraints aren't met.
-type DescribeServiceResponseValidationError struct {
+// DeleteStatefulSetResponseValidationError is the validation error returned by
+// DeleteStatefulSetResponse.Validate if the designated constraints aren't met.
+type DeleteStatefulSetResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6363,24 +6236,24 @@ type DescribeServiceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeServiceResponseValidationError) Field() string { return e.field }
+func (e DeleteStatefulSetResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeServiceResponseValidationError) Reason() string { return e.reason }
+func (e DeleteStatefulSetResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeServiceResponseValidationError) Cause() error { return e.cause }
+func (e DeleteStatefulSetResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeServiceResponseValidationError) Key() bool { return e.key }
+func (e DeleteStatefulSetResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeServiceResponseValidationError) ErrorName() string {
-	return "DescribeServiceResponseValidationError"
+func (e DeleteStatefulSetResponseValidationError) ErrorName() string {
+	return "DeleteStatefulSetResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeServiceResponseValidationError) Error() string {
+func (e DeleteStatefulSetResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6392,14 +6265,14 @@ func (e DescribeServiceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeServiceResponse.%s: %s%s",
+		"invalid %sDeleteStatefulSetResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeServiceResponseValidationError{}
+var _ error = DeleteStatefulSetResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6407,24 +6280,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeServiceResponseValidationError{}
+} = DeleteStatefulSetResponseValidationError{}
 
-// Validate checks the field values on ListServicesRequest with the rules
+// Validate checks the field values on UpdateStatefulSetRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListServicesRequest) Validate() error {
+func (m *UpdateStatefulSetRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListServicesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateStatefulSetRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListServicesRequestMultiError, or nil if none found.
-func (m *ListServicesRequest) ValidateAll() error {
+// UpdateStatefulSetRequestMultiError, or nil if none found.
+func (m *UpdateStatefulSetRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListServicesRequest) validate(all bool) error {
+func (m *UpdateStatefulSetRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6432,7 +6305,7 @@ func (m *ListServicesRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetClientset()) < 1 {
-		err := ListServicesRequestValidationError{
+		err := UpdateStatefulSetRequestValidationError{
 			field:  "Clientset",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -6443,7 +6316,7 @@ func (m *ListServicesRequest) validate(all bool) error {
 	}
 
 	if len(m.GetCluster()) < 1 {
-		err := ListServicesRequestValidationError{
+		err := UpdateStatefulSetRequestValidationError{
 			field:  "Cluster",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -6454,7 +6327,7 @@ func (m *ListServicesRequest) validate(all bool) error {
 	}
 
 	if len(m.GetNamespace()) < 1 {
-		err := ListServicesRequestValidationError{
+		err := UpdateStatefulSetRequestValidationError{
 			field:  "Namespace",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -6464,10 +6337,10 @@ func (m *ListServicesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetOptions() == nil {
-		err := ListServicesRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
+	if len(m.GetName()) < 1 {
+		err := UpdateStatefulSetRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
 		}
 		if !all {
 			return err
@@ -6476,28 +6349,57 @@ func (m *ListServicesRequest) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
+		switch v := interface{}(m.GetFields()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListServicesRequestValidationError{
-					field:  "Options",
+				errors = append(errors, UpdateStatefulSetRequestValidationError{
+					field:  "Fields",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListServicesRequestValidationError{
-					field:  "Options",
+				errors = append(errors, UpdateStatefulSetRequestValidationError{
+					field:  "Fields",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetFields()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListServicesRequestValidationError{
-				field:  "Options",
+			return UpdateStatefulSetRequestValidationError{
+				field:  "Fields",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPatch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateStatefulSetRequestValidationError{
+					field:  "Patch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateStatefulSetRequestValidationError{
+					field:  "Patch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPatch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateStatefulSetRequestValidationError{
+				field:  "Patch",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -6505,19 +6407,19 @@ func (m *ListServicesRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListServicesRequestMultiError(errors)
+		return UpdateStatefulSetRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListServicesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListServicesRequest.ValidateAll() if the designated
+// UpdateStatefulSetRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateStatefulSetRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListServicesRequestMultiError []error
+type UpdateStatefulSetRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListServicesRequestMultiError) Error() string {
+func (m UpdateStatefulSetRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6526,11 +6428,11 @@ func (m ListServicesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListServicesRequestMultiError) AllErrors() []error { return m }
+func (m UpdateStatefulSetRequestMultiError) AllErrors() []error { return m }
 
-// ListServicesRequestValidationError is the validation error returned by
-// ListServicesRequest.Validate if the designated constraints aren't met.
-type ListServicesRequestValidationError struct {
+// UpdateStatefulSetRequestValidationError is the validation error returned by
+// UpdateStatefulSetRequest.Validate if the designated constraints aren't met.
+type UpdateStatefulSetRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6538,24 +6440,24 @@ type ListServicesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListServicesRequestValidationError) Field() string { return e.field }
+func (e UpdateStatefulSetRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListServicesRequestValidationError) Reason() string { return e.reason }
+func (e UpdateStatefulSetRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListServicesRequestValidationError) Cause() error { return e.cause }
+func (e UpdateStatefulSetRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListServicesRequestValidationError) Key() bool { return e.key }
+func (e UpdateStatefulSetRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListServicesRequestValidationError) ErrorName() string {
-	return "ListServicesRequestValidationError"
+func (e UpdateStatefulSetRequestValidationError) ErrorName() string {
+	return "UpdateStatefulSetRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListServicesRequestValidationError) Error() string {
+func (e UpdateStatefulSetRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6567,14 +6469,14 @@ func (e ListServicesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListServicesRequest.%s: %s%s",
+		"invalid %sUpdateStatefulSetRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListServicesRequestValidationError{}
+var _ error = UpdateStatefulSetRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6582,78 +6484,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListServicesRequestValidationError{}
+} = UpdateStatefulSetRequestValidationError{}
 
-// Validate checks the field values on ListServicesResponse with the rules
+// Validate checks the field values on UpdateStatefulSetResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListServicesResponse) Validate() error {
+func (m *UpdateStatefulSetResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListServicesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on UpdateStatefulSetResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListServicesResponseMultiError, or nil if none found.
-func (m *ListServicesResponse) ValidateAll() error {
+// UpdateStatefulSetResponseMultiError, or nil if none found.
+func (m *UpdateStatefulSetResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListServicesResponse) validate(all bool) error {
+func (m *UpdateStatefulSetResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetServices() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListServicesResponseValidationError{
-						field:  fmt.Sprintf("Services[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListServicesResponseValidationError{
-						field:  fmt.Sprintf("Services[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListServicesResponseValidationError{
-					field:  fmt.Sprintf("Services[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Diff
 
 	if len(errors) > 0 {
-		return ListServicesResponseMultiError(errors)
+		return UpdateStatefulSetResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListServicesResponseMultiError is an error wrapping multiple validation
-// errors returned by ListServicesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListServicesResponseMultiError []error
+// UpdateStatefulSetResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateStatefulSetResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateStatefulSetResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListServicesResponseMultiError) Error() string {
+func (m UpdateStatefulSetResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6662,36 +6532,36 @@ func (m ListServicesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListServicesResponseMultiError) AllErrors() []error { return m }
+func (m UpdateStatefulSetResponseMultiError) AllErrors() []error { return m }
 
-// ListServicesResponseValidationError is the validation error returned by
-// ListServicesResponse.Validate if the designated constraints aren't met.
-type ListServicesResponseValidationError struct {
-	field  string
+// UpdateStatefulSetResponseValidationError is the validation error returned by
+// UpdateStatefulSetResponse.Validate if the designated constraints aren't met.
+type UpdateStatefulSetResponseValidationError struct {
+	field  string
 	reason string
 	cause  error
 	key    bool
 }
 
 // Field function returns field value.
-func (e ListServicesResponseValidationError) Field() string { return e.field }
+func (e UpdateStatefulSetResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListServicesResponseValidationError) Reason() string { return e.reason }
+func (e UpdateStatefulSetResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListServicesResponseValidationError) Cause() error { return e.cause }
+func (e UpdateStatefulSetResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListServicesResponseValidationError) Key() bool { return e.key }
+func (e UpdateStatefulSetResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListServicesResponseValidationError) ErrorName() string {
-	return "ListServicesResponseValidationError"
+func (e UpdateStatefulSetResponseValidationError) ErrorName() string {
+	return "UpdateStatefulSetResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListServicesResponseValidationError) Error() string {
+func (e UpdateStatefulSetResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6703,14 +6573,14 @@ func (e ListServicesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListServicesResponse.%s: %s%s",
+		"invalid %sUpdateStatefulSetResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListServicesResponseValidationError{}
+var _ error = UpdateStatefulSetResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6718,24 +6588,170 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListServicesResponseValidationError{}
+} = UpdateStatefulSetResponseValidationError{}
 
-// Validate checks the field values on DeleteServiceRequest with the rules
+// Validate checks the field values on Workload with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Workload) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Workload with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WorkloadMultiError, or nil
+// if none found.
+func (m *Workload) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Workload) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kind
+
+	// no validation rules for Cluster
+
+	// no validation rules for Namespace
+
+	// no validation rules for Name
+
+	// no validation rules for Replicas
+
+	// no validation rules for UpdatedReplicas
+
+	// no validation rules for ReadyReplicas
+
+	// no validation rules for AvailableReplicas
+
+	// no validation rules for RolloutComplete
+
+	if all {
+		switch v := interface{}(m.GetDeploymentStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkloadValidationError{
+					field:  "DeploymentStatus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkloadValidationError{
+					field:  "DeploymentStatus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeploymentStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkloadValidationError{
+				field:  "DeploymentStatus",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WorkloadMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkloadMultiError is an error wrapping multiple validation errors returned
+// by Workload.ValidateAll() if the designated constraints aren't met.
+type WorkloadMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkloadMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkloadMultiError) AllErrors() []error { return m }
+
+// WorkloadValidationError is the validation error returned by
+// Workload.Validate if the designated constraints aren't met.
+type WorkloadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkloadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkloadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkloadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkloadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkloadValidationError) ErrorName() string { return "WorkloadValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WorkloadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkload.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkloadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkloadValidationError{}
+
+// Validate checks the field values on ScaleWorkloadRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteServiceRequest) Validate() error {
+func (m *ScaleWorkloadRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteServiceRequest with the rules
+// ValidateAll checks the field values on ScaleWorkloadRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteServiceRequestMultiError, or nil if none found.
-func (m *DeleteServiceRequest) ValidateAll() error {
+// ScaleWorkloadRequestMultiError, or nil if none found.
+func (m *ScaleWorkloadRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteServiceRequest) validate(all bool) error {
+func (m *ScaleWorkloadRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -6743,7 +6759,7 @@ func (m *DeleteServiceRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetClientset()) < 1 {
-		err := DeleteServiceRequestValidationError{
+		err := ScaleWorkloadRequestValidationError{
 			field:  "Clientset",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -6754,7 +6770,7 @@ func (m *DeleteServiceRequest) validate(all bool) error {
 	}
 
 	if len(m.GetCluster()) < 1 {
-		err := DeleteServiceRequestValidationError{
+		err := ScaleWorkloadRequestValidationError{
 			field:  "Cluster",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -6765,7 +6781,7 @@ func (m *DeleteServiceRequest) validate(all bool) error {
 	}
 
 	if len(m.GetNamespace()) < 1 {
-		err := DeleteServiceRequestValidationError{
+		err := ScaleWorkloadRequestValidationError{
 			field:  "Namespace",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -6776,7 +6792,7 @@ func (m *DeleteServiceRequest) validate(all bool) error {
 	}
 
 	if len(m.GetName()) < 1 {
-		err := DeleteServiceRequestValidationError{
+		err := ScaleWorkloadRequestValidationError{
 			field:  "Name",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -6786,20 +6802,76 @@ func (m *DeleteServiceRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := _ScaleWorkloadRequest_Kind_NotInLookup[m.GetKind()]; ok {
+		err := ScaleWorkloadRequestValidationError{
+			field:  "Kind",
+			reason: "value must not be in list [0]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := WorkloadKind_name[int32(m.GetKind())]; !ok {
+		err := ScaleWorkloadRequestValidationError{
+			field:  "Kind",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Replicas
+
+	// no validation rules for WaitForRollout
+
+	if d := m.GetRolloutTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ScaleWorkloadRequestValidationError{
+				field:  "RolloutTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := ScaleWorkloadRequestValidationError{
+					field:  "RolloutTimeout",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteServiceRequestMultiError(errors)
+		return ScaleWorkloadRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteServiceRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteServiceRequest.ValidateAll() if the designated
+// ScaleWorkloadRequestMultiError is an error wrapping multiple validation
+// errors returned by ScaleWorkloadRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteServiceRequestMultiError []error
+type ScaleWorkloadRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteServiceRequestMultiError) Error() string {
+func (m ScaleWorkloadRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6808,11 +6880,11 @@ func (m DeleteServiceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteServiceRequestMultiError) AllErrors() []error { return m }
+func (m ScaleWorkloadRequestMultiError) AllErrors() []error { return m }
 
-// DeleteServiceRequestValidationError is the validation error returned by
-// DeleteServiceRequest.Validate if the designated constraints aren't met.
-type DeleteServiceRequestValidationError struct {
+// ScaleWorkloadRequestValidationError is the validation error returned by
+// ScaleWorkloadRequest.Validate if the designated constraints aren't met.
+type ScaleWorkloadRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6820,24 +6892,24 @@ type DeleteServiceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteServiceRequestValidationError) Field() string { return e.field }
+func (e ScaleWorkloadRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteServiceRequestValidationError) Reason() string { return e.reason }
+func (e ScaleWorkloadRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteServiceRequestValidationError) Cause() error { return e.cause }
+func (e ScaleWorkloadRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteServiceRequestValidationError) Key() bool { return e.key }
+func (e ScaleWorkloadRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteServiceRequestValidationError) ErrorName() string {
-	return "DeleteServiceRequestValidationError"
+func (e ScaleWorkloadRequestValidationError) ErrorName() string {
+	return "ScaleWorkloadRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteServiceRequestValidationError) Error() string {
+func (e ScaleWorkloadRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6849,14 +6921,14 @@ func (e DeleteServiceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteServiceRequest.%s: %s%s",
+		"invalid %sScaleWorkloadRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteServiceRequestValidationError{}
+var _ error = ScaleWorkloadRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6864,44 +6936,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteServiceRequestValidationError{}
+} = ScaleWorkloadRequestValidationError{}
 
-// Validate checks the field values on DeleteServiceResponse with the rules
+var _ScaleWorkloadRequest_Kind_NotInLookup = map[WorkloadKind]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ScaleWorkloadResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteServiceResponse) Validate() error {
+func (m *ScaleWorkloadResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteServiceResponse with the rules
+// ValidateAll checks the field values on ScaleWorkloadResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteServiceResponseMultiError, or nil if none found.
-func (m *DeleteServiceResponse) ValidateAll() error {
+// ScaleWorkloadResponseMultiError, or nil if none found.
+func (m *ScaleWorkloadResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteServiceResponse) validate(all bool) error {
+func (m *ScaleWorkloadResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetWorkload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScaleWorkloadResponseValidationError{
+					field:  "Workload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScaleWorkloadResponseValidationError{
+					field:  "Workload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScaleWorkloadResponseValidationError{
+				field:  "Workload",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteServiceResponseMultiError(errors)
+		return ScaleWorkloadResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteServiceResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteServiceResponse.ValidateAll() if the designated
+// ScaleWorkloadResponseMultiError is an error wrapping multiple validation
+// errors returned by ScaleWorkloadResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteServiceResponseMultiError []error
+type ScaleWorkloadResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteServiceResponseMultiError) Error() string {
+func (m ScaleWorkloadResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6910,11 +7015,11 @@ func (m DeleteServiceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteServiceResponseMultiError) AllErrors() []error { return m }
+func (m ScaleWorkloadResponseMultiError) AllErrors() []error { return m }
 
-// DeleteServiceResponseValidationError is the validation error returned by
-// DeleteServiceResponse.Validate if the designated constraints aren't met.
-type DeleteServiceResponseValidationError struct {
+// ScaleWorkloadResponseValidationError is the validation error returned by
+// ScaleWorkloadResponse.Validate if the designated constraints aren't met.
+type ScaleWorkloadResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6922,24 +7027,24 @@ type DeleteServiceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteServiceResponseValidationError) Field() string { return e.field }
+func (e ScaleWorkloadResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteServiceResponseValidationError) Reason() string { return e.reason }
+func (e ScaleWorkloadResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteServiceResponseValidationError) Cause() error { return e.cause }
+func (e ScaleWorkloadResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteServiceResponseValidationError) Key() bool { return e.key }
+func (e ScaleWorkloadResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteServiceResponseValidationError) ErrorName() string {
-	return "DeleteServiceResponseValidationError"
+func (e ScaleWorkloadResponseValidationError) ErrorName() string {
+	return "ScaleWorkloadResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteServiceResponseValidationError) Error() string {
+func (e ScaleWorkloadResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6951,14 +7056,14 @@ func (e DeleteServiceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteServiceResponse.%s: %s%s",
+		"invalid %sScaleWorkloadResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteServiceResponseValidationError{}
+var _ error = ScaleWorkloadResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6966,89 +7071,164 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteServiceResponseValidationError{}
+} = ScaleWorkloadResponseValidationError{}
 
-// Validate checks the field values on CronJob with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CronJob) Validate() error {
+// Validate checks the field values on SetContainerImageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetContainerImageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CronJob with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in CronJobMultiError, or nil if none found.
-func (m *CronJob) ValidateAll() error {
+// ValidateAll checks the field values on SetContainerImageRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetContainerImageRequestMultiError, or nil if none found.
+func (m *SetContainerImageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CronJob) validate(all bool) error {
+func (m *SetContainerImageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Cluster
+	if len(m.GetClientset()) < 1 {
+		err := SetContainerImageRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Namespace
+	if len(m.GetCluster()) < 1 {
+		err := SetContainerImageRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Name
+	if len(m.GetNamespace()) < 1 {
+		err := SetContainerImageRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Schedule
+	if len(m.GetName()) < 1 {
+		err := SetContainerImageRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Labels
+	if _, ok := _SetContainerImageRequest_Kind_NotInLookup[m.GetKind()]; ok {
+		err := SetContainerImageRequestValidationError{
+			field:  "Kind",
+			reason: "value must not be in list [0]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Annotations
+	if _, ok := WorkloadKind_name[int32(m.GetKind())]; !ok {
+		err := SetContainerImageRequestValidationError{
+			field:  "Kind",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Suspend
+	if len(m.GetContainerName()) < 1 {
+		err := SetContainerImageRequestValidationError{
+			field:  "ContainerName",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for NumActiveJobs
+	if len(m.GetImage()) < 1 {
+		err := SetContainerImageRequestValidationError{
+			field:  "Image",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for ConcurrencyPolicy
+	// no validation rules for WaitForRollout
 
-	if all {
-		switch v := interface{}(m.GetStartingDeadlineSeconds()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CronJobValidationError{
-					field:  "StartingDeadlineSeconds",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if d := m.GetRolloutTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = SetContainerImageRequestValidationError{
+				field:  "RolloutTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
 			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CronJobValidationError{
-					field:  "StartingDeadlineSeconds",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+			if !all {
+				return err
 			}
-		}
-	} else if v, ok := interface{}(m.GetStartingDeadlineSeconds()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CronJobValidationError{
-				field:  "StartingDeadlineSeconds",
-				reason: "embedded message failed validation",
-				cause:  err,
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := SetContainerImageRequestValidationError{
+					field:  "RolloutTimeout",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
 			}
+
 		}
 	}
 
 	if len(errors) > 0 {
-		return CronJobMultiError(errors)
+		return SetContainerImageRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CronJobMultiError is an error wrapping multiple validation errors returned
-// by CronJob.ValidateAll() if the designated constraints aren't met.
-type CronJobMultiError []error
+// SetContainerImageRequestMultiError is an error wrapping multiple validation
+// errors returned by SetContainerImageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetContainerImageRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CronJobMultiError) Error() string {
+func (m SetContainerImageRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7057,11 +7237,11 @@ func (m CronJobMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CronJobMultiError) AllErrors() []error { return m }
+func (m SetContainerImageRequestMultiError) AllErrors() []error { return m }
 
-// CronJobValidationError is the validation error returned by CronJob.Validate
-// if the designated constraints aren't met.
-type CronJobValidationError struct {
+// SetContainerImageRequestValidationError is the validation error returned by
+// SetContainerImageRequest.Validate if the designated constraints aren't met.
+type SetContainerImageRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7069,22 +7249,24 @@ type CronJobValidationError struct {
 }
 
 // Field function returns field value.
-func (e CronJobValidationError) Field() string { return e.field }
+func (e SetContainerImageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CronJobValidationError) Reason() string { return e.reason }
+func (e SetContainerImageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CronJobValidationError) Cause() error { return e.cause }
+func (e SetContainerImageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CronJobValidationError) Key() bool { return e.key }
+func (e SetContainerImageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CronJobValidationError) ErrorName() string { return "CronJobValidationError" }
+func (e SetContainerImageRequestValidationError) ErrorName() string {
+	return "SetContainerImageRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CronJobValidationError) Error() string {
+func (e SetContainerImageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7096,14 +7278,14 @@ func (e CronJobValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCronJob.%s: %s%s",
+		"invalid %sSetContainerImageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CronJobValidationError{}
+var _ error = SetContainerImageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7111,88 +7293,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CronJobValidationError{}
+} = SetContainerImageRequestValidationError{}
 
-// Validate checks the field values on DescribeCronJobRequest with the rules
+var _SetContainerImageRequest_Kind_NotInLookup = map[WorkloadKind]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on SetContainerImageResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DescribeCronJobRequest) Validate() error {
+func (m *SetContainerImageResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DescribeCronJobRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetContainerImageResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DescribeCronJobRequestMultiError, or nil if none found.
-func (m *DescribeCronJobRequest) ValidateAll() error {
+// SetContainerImageResponseMultiError, or nil if none found.
+func (m *SetContainerImageResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DescribeCronJobRequest) validate(all bool) error {
+func (m *SetContainerImageResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetClientset()) < 1 {
-		err := DescribeCronJobRequestValidationError{
-			field:  "Clientset",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetCluster()) < 1 {
-		err := DescribeCronJobRequestValidationError{
-			field:  "Cluster",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetNamespace()) < 1 {
-		err := DescribeCronJobRequestValidationError{
-			field:  "Namespace",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetName()) < 1 {
-		err := DescribeCronJobRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 bytes",
+	if all {
+		switch v := interface{}(m.GetWorkload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetContainerImageResponseValidationError{
+					field:  "Workload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetContainerImageResponseValidationError{
+					field:  "Workload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetWorkload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetContainerImageResponseValidationError{
+				field:  "Workload",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return DescribeCronJobRequestMultiError(errors)
+		return SetContainerImageResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DescribeCronJobRequestMultiError is an error wrapping multiple validation
-// errors returned by DescribeCronJobRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DescribeCronJobRequestMultiError []error
+// SetContainerImageResponseMultiError is an error wrapping multiple validation
+// errors returned by SetContainerImageResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SetContainerImageResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DescribeCronJobRequestMultiError) Error() string {
+func (m SetContainerImageResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7201,11 +7372,11 @@ func (m DescribeCronJobRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DescribeCronJobRequestMultiError) AllErrors() []error { return m }
+func (m SetContainerImageResponseMultiError) AllErrors() []error { return m }
 
-// DescribeCronJobRequestValidationError is the validation error returned by
-// DescribeCronJobRequest.Validate if the designated constraints aren't met.
-type DescribeCronJobRequestValidationError struct {
+// SetContainerImageResponseValidationError is the validation error returned by
+// SetContainerImageResponse.Validate if the designated constraints aren't met.
+type SetContainerImageResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7213,24 +7384,24 @@ type DescribeCronJobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeCronJobRequestValidationError) Field() string { return e.field }
+func (e SetContainerImageResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeCronJobRequestValidationError) Reason() string { return e.reason }
+func (e SetContainerImageResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeCronJobRequestValidationError) Cause() error { return e.cause }
+func (e SetContainerImageResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeCronJobRequestValidationError) Key() bool { return e.key }
+func (e SetContainerImageResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeCronJobRequestValidationError) ErrorName() string {
-	return "DescribeCronJobRequestValidationError"
+func (e SetContainerImageResponseValidationError) ErrorName() string {
+	return "SetContainerImageResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeCronJobRequestValidationError) Error() string {
+func (e SetContainerImageResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7242,14 +7413,14 @@ func (e DescribeCronJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeCronJobRequest.%s: %s%s",
+		"invalid %sSetContainerImageResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeCronJobRequestValidationError{}
+var _ error = SetContainerImageResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7257,73 +7428,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeCronJobRequestValidationError{}
+} = SetContainerImageResponseValidationError{}
 
-// Validate checks the field values on DescribeCronJobResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DescribeCronJobResponse) Validate() error {
+// Validate checks the field values on Service with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Service) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DescribeCronJobResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DescribeCronJobResponseMultiError, or nil if none found.
-func (m *DescribeCronJobResponse) ValidateAll() error {
+// ValidateAll checks the field values on Service with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ServiceMultiError, or nil if none found.
+func (m *Service) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DescribeCronJobResponse) validate(all bool) error {
+func (m *Service) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCronjob()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DescribeCronJobResponseValidationError{
-					field:  "Cronjob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DescribeCronJobResponseValidationError{
-					field:  "Cronjob",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCronjob()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DescribeCronJobResponseValidationError{
-				field:  "Cronjob",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Cluster
+
+	// no validation rules for Namespace
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	// no validation rules for Labels
+
+	// no validation rules for Annotations
+
+	// no validation rules for Selector
 
 	if len(errors) > 0 {
-		return DescribeCronJobResponseMultiError(errors)
+		return ServiceMultiError(errors)
 	}
 
 	return nil
 }
 
-// DescribeCronJobResponseMultiError is an error wrapping multiple validation
-// errors returned by DescribeCronJobResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DescribeCronJobResponseMultiError []error
+// ServiceMultiError is an error wrapping multiple validation errors returned
+// by Service.ValidateAll() if the designated constraints aren't met.
+type ServiceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DescribeCronJobResponseMultiError) Error() string {
+func (m ServiceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7332,11 +7486,11 @@ func (m DescribeCronJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DescribeCronJobResponseMultiError) AllErrors() []error { return m }
+func (m ServiceMultiError) AllErrors() []error { return m }
 
-// DescribeCronJobResponseValidationError is the validation error returned by
-// DescribeCronJobResponse.Validate if the designated constraints aren't met.
-type DescribeCronJobResponseValidationError struct {
+// ServiceValidationError is the validation error returned by Service.Validate
+// if the designated constraints aren't met.
+type ServiceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7344,24 +7498,22 @@ type DescribeCronJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeCronJobResponseValidationError) Field() string { return e.field }
+func (e ServiceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeCronJobResponseValidationError) Reason() string { return e.reason }
+func (e ServiceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeCronJobResponseValidationError) Cause() error { return e.cause }
+func (e ServiceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeCronJobResponseValidationError) Key() bool { return e.key }
+func (e ServiceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeCronJobResponseValidationError) ErrorName() string {
-	return "DescribeCronJobResponseValidationError"
-}
+func (e ServiceValidationError) ErrorName() string { return "ServiceValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DescribeCronJobResponseValidationError) Error() string {
+func (e ServiceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7373,14 +7525,14 @@ func (e DescribeCronJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeCronJobResponse.%s: %s%s",
+		"invalid %sService.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeCronJobResponseValidationError{}
+var _ error = ServiceValidationError{}
 
 var _ interface {
 	Field() string
@@ -7388,24 +7540,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeCronJobResponseValidationError{}
+} = ServiceValidationError{}
 
-// Validate checks the field values on ListCronJobsRequest with the rules
+// Validate checks the field values on DescribeServiceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListCronJobsRequest) Validate() error {
+func (m *DescribeServiceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCronJobsRequest with the rules
+// ValidateAll checks the field values on DescribeServiceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCronJobsRequestMultiError, or nil if none found.
-func (m *ListCronJobsRequest) ValidateAll() error {
+// DescribeServiceRequestMultiError, or nil if none found.
+func (m *DescribeServiceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCronJobsRequest) validate(all bool) error {
+func (m *DescribeServiceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7413,7 +7565,7 @@ func (m *ListCronJobsRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetClientset()) < 1 {
-		err := ListCronJobsRequestValidationError{
+		err := DescribeServiceRequestValidationError{
 			field:  "Clientset",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -7424,7 +7576,7 @@ func (m *ListCronJobsRequest) validate(all bool) error {
 	}
 
 	if len(m.GetCluster()) < 1 {
-		err := ListCronJobsRequestValidationError{
+		err := DescribeServiceRequestValidationError{
 			field:  "Cluster",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -7435,7 +7587,7 @@ func (m *ListCronJobsRequest) validate(all bool) error {
 	}
 
 	if len(m.GetNamespace()) < 1 {
-		err := ListCronJobsRequestValidationError{
+		err := DescribeServiceRequestValidationError{
 			field:  "Namespace",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -7445,10 +7597,10 @@ func (m *ListCronJobsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetOptions() == nil {
-		err := ListCronJobsRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
+	if len(m.GetName()) < 1 {
+		err := DescribeServiceRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
 		}
 		if !all {
 			return err
@@ -7456,49 +7608,20 @@ func (m *ListCronJobsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListCronJobsRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListCronJobsRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListCronJobsRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return ListCronJobsRequestMultiError(errors)
+		return DescribeServiceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCronJobsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListCronJobsRequest.ValidateAll() if the designated
+// DescribeServiceRequestMultiError is an error wrapping multiple validation
+// errors returned by DescribeServiceRequest.ValidateAll() if the designated
 // constraints aren't met.
-type ListCronJobsRequestMultiError []error
+type DescribeServiceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCronJobsRequestMultiError) Error() string {
+func (m DescribeServiceRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7507,11 +7630,11 @@ func (m ListCronJobsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCronJobsRequestMultiError) AllErrors() []error { return m }
+func (m DescribeServiceRequestMultiError) AllErrors() []error { return m }
 
-// ListCronJobsRequestValidationError is the validation error returned by
-// ListCronJobsRequest.Validate if the designated constraints aren't met.
-type ListCronJobsRequestValidationError struct {
+// DescribeServiceRequestValidationError is the validation error returned by
+// DescribeServiceRequest.Validate if the designated constraints aren't met.
+type DescribeServiceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7519,24 +7642,24 @@ type ListCronJobsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCronJobsRequestValidationError) Field() string { return e.field }
+func (e DescribeServiceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCronJobsRequestValidationError) Reason() string { return e.reason }
+func (e DescribeServiceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCronJobsRequestValidationError) Cause() error { return e.cause }
+func (e DescribeServiceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCronJobsRequestValidationError) Key() bool { return e.key }
+func (e DescribeServiceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCronJobsRequestValidationError) ErrorName() string {
-	return "ListCronJobsRequestValidationError"
+func (e DescribeServiceRequestValidationError) ErrorName() string {
+	return "DescribeServiceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListCronJobsRequestValidationError) Error() string {
+func (e DescribeServiceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7548,14 +7671,14 @@ func (e ListCronJobsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCronJobsRequest.%s: %s%s",
+		"invalid %sDescribeServiceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCronJobsRequestValidationError{}
+var _ error = DescribeServiceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7563,78 +7686,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCronJobsRequestValidationError{}
+} = DescribeServiceRequestValidationError{}
 
-// Validate checks the field values on ListCronJobsResponse with the rules
+// Validate checks the field values on DescribeServiceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListCronJobsResponse) Validate() error {
+func (m *DescribeServiceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCronJobsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DescribeServiceResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCronJobsResponseMultiError, or nil if none found.
-func (m *ListCronJobsResponse) ValidateAll() error {
+// DescribeServiceResponseMultiError, or nil if none found.
+func (m *DescribeServiceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCronJobsResponse) validate(all bool) error {
+func (m *DescribeServiceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetCronJobs() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListCronJobsResponseValidationError{
-						field:  fmt.Sprintf("CronJobs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListCronJobsResponseValidationError{
-						field:  fmt.Sprintf("CronJobs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetService()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DescribeServiceResponseValidationError{
+					field:  "Service",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListCronJobsResponseValidationError{
-					field:  fmt.Sprintf("CronJobs[%v]", idx),
+				errors = append(errors, DescribeServiceResponseValidationError{
+					field:  "Service",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetService()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeServiceResponseValidationError{
+				field:  "Service",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return ListCronJobsResponseMultiError(errors)
+		return DescribeServiceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCronJobsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListCronJobsResponse.ValidateAll() if the designated
+// DescribeServiceResponseMultiError is an error wrapping multiple validation
+// errors returned by DescribeServiceResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListCronJobsResponseMultiError []error
+type DescribeServiceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCronJobsResponseMultiError) Error() string {
+func (m DescribeServiceResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7643,11 +7761,11 @@ func (m ListCronJobsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCronJobsResponseMultiError) AllErrors() []error { return m }
+func (m DescribeServiceResponseMultiError) AllErrors() []error { return m }
 
-// ListCronJobsResponseValidationError is the validation error returned by
-// ListCronJobsResponse.Validate if the designated constraints aren't met.
-type ListCronJobsResponseValidationError struct {
+// DescribeServiceResponseValidationError is the validation error returned by
+// DescribeServiceResponse.Validate if the designated constraints aren't met.
+type DescribeServiceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7655,24 +7773,24 @@ type ListCronJobsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCronJobsResponseValidationError) Field() string { return e.field }
+func (e DescribeServiceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCronJobsResponseValidationError) Reason() string { return e.reason }
+func (e DescribeServiceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCronJobsResponseValidationError) Cause() error { return e.cause }
+func (e DescribeServiceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCronJobsResponseValidationError) Key() bool { return e.key }
+func (e DescribeServiceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCronJobsResponseValidationError) ErrorName() string {
-	return "ListCronJobsResponseValidationError"
+func (e DescribeServiceResponseValidationError) ErrorName() string {
+	return "DescribeServiceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListCronJobsResponseValidationError) Error() string {
+func (e DescribeServiceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7684,14 +7802,14 @@ func (e ListCronJobsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCronJobsResponse.%s: %s%s",
+		"invalid %sDescribeServiceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCronJobsResponseValidationError{}
+var _ error = DescribeServiceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7699,24 +7817,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCronJobsResponseValidationError{}
+} = DescribeServiceResponseValidationError{}
 
-// Validate checks the field values on DeleteCronJobRequest with the rules
+// Validate checks the field values on ListServicesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteCronJobRequest) Validate() error {
+func (m *ListServicesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteCronJobRequest with the rules
+// ValidateAll checks the field values on ListServicesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteCronJobRequestMultiError, or nil if none found.
-func (m *DeleteCronJobRequest) ValidateAll() error {
+// ListServicesRequestMultiError, or nil if none found.
+func (m *ListServicesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteCronJobRequest) validate(all bool) error {
+func (m *ListServicesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -7724,7 +7842,7 @@ func (m *DeleteCronJobRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetClientset()) < 1 {
-		err := DeleteCronJobRequestValidationError{
+		err := ListServicesRequestValidationError{
 			field:  "Clientset",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -7735,7 +7853,7 @@ func (m *DeleteCronJobRequest) validate(all bool) error {
 	}
 
 	if len(m.GetCluster()) < 1 {
-		err := DeleteCronJobRequestValidationError{
+		err := ListServicesRequestValidationError{
 			field:  "Cluster",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -7746,7 +7864,7 @@ func (m *DeleteCronJobRequest) validate(all bool) error {
 	}
 
 	if len(m.GetNamespace()) < 1 {
-		err := DeleteCronJobRequestValidationError{
+		err := ListServicesRequestValidationError{
 			field:  "Namespace",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -7756,10 +7874,10 @@ func (m *DeleteCronJobRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetName()) < 1 {
-		err := DeleteCronJobRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 bytes",
+	if m.GetOptions() == nil {
+		err := ListServicesRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -7767,20 +7885,49 @@ func (m *DeleteCronJobRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListServicesRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListServicesRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListServicesRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteCronJobRequestMultiError(errors)
+		return ListServicesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteCronJobRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteCronJobRequest.ValidateAll() if the designated
+// ListServicesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListServicesRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteCronJobRequestMultiError []error
+type ListServicesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteCronJobRequestMultiError) Error() string {
+func (m ListServicesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7789,11 +7936,11 @@ func (m DeleteCronJobRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteCronJobRequestMultiError) AllErrors() []error { return m }
+func (m ListServicesRequestMultiError) AllErrors() []error { return m }
 
-// DeleteCronJobRequestValidationError is the validation error returned by
-// DeleteCronJobRequest.Validate if the designated constraints aren't met.
-type DeleteCronJobRequestValidationError struct {
+// ListServicesRequestValidationError is the validation error returned by
+// ListServicesRequest.Validate if the designated constraints aren't met.
+type ListServicesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7801,24 +7948,24 @@ type DeleteCronJobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteCronJobRequestValidationError) Field() string { return e.field }
+func (e ListServicesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteCronJobRequestValidationError) Reason() string { return e.reason }
+func (e ListServicesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteCronJobRequestValidationError) Cause() error { return e.cause }
+func (e ListServicesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteCronJobRequestValidationError) Key() bool { return e.key }
+func (e ListServicesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteCronJobRequestValidationError) ErrorName() string {
-	return "DeleteCronJobRequestValidationError"
+func (e ListServicesRequestValidationError) ErrorName() string {
+	return "ListServicesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteCronJobRequestValidationError) Error() string {
+func (e ListServicesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7830,14 +7977,14 @@ func (e DeleteCronJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteCronJobRequest.%s: %s%s",
+		"invalid %sListServicesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteCronJobRequestValidationError{}
+var _ error = ListServicesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -7845,44 +7992,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteCronJobRequestValidationError{}
+} = ListServicesRequestValidationError{}
 
-// Validate checks the field values on DeleteCronJobResponse with the rules
+// Validate checks the field values on ListServicesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteCronJobResponse) Validate() error {
+func (m *ListServicesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteCronJobResponse with the rules
+// ValidateAll checks the field values on ListServicesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteCronJobResponseMultiError, or nil if none found.
-func (m *DeleteCronJobResponse) ValidateAll() error {
+// ListServicesResponseMultiError, or nil if none found.
+func (m *ListServicesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteCronJobResponse) validate(all bool) error {
+func (m *ListServicesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetServices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListServicesResponseValidationError{
+						field:  fmt.Sprintf("Services[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListServicesResponseValidationError{
+						field:  fmt.Sprintf("Services[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListServicesResponseValidationError{
+					field:  fmt.Sprintf("Services[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return DeleteCronJobResponseMultiError(errors)
+		return ListServicesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteCronJobResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteCronJobResponse.ValidateAll() if the designated
+// ListServicesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListServicesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteCronJobResponseMultiError []error
+type ListServicesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteCronJobResponseMultiError) Error() string {
+func (m ListServicesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7891,11 +8072,11 @@ func (m DeleteCronJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteCronJobResponseMultiError) AllErrors() []error { return m }
+func (m ListServicesResponseMultiError) AllErrors() []error { return m }
 
-// DeleteCronJobResponseValidationError is the validation error returned by
-// DeleteCronJobResponse.Validate if the designated constraints aren't met.
-type DeleteCronJobResponseValidationError struct {
+// ListServicesResponseValidationError is the validation error returned by
+// ListServicesResponse.Validate if the designated constraints aren't met.
+type ListServicesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7903,24 +8084,24 @@ type DeleteCronJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteCronJobResponseValidationError) Field() string { return e.field }
+func (e ListServicesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteCronJobResponseValidationError) Reason() string { return e.reason }
+func (e ListServicesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteCronJobResponseValidationError) Cause() error { return e.cause }
+func (e ListServicesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteCronJobResponseValidationError) Key() bool { return e.key }
+func (e ListServicesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteCronJobResponseValidationError) ErrorName() string {
-	return "DeleteCronJobResponseValidationError"
+func (e ListServicesResponseValidationError) ErrorName() string {
+	return "ListServicesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteCronJobResponseValidationError) Error() string {
+func (e ListServicesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7932,14 +8113,14 @@ func (e DeleteCronJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteCronJobResponse.%s: %s%s",
+		"invalid %sListServicesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteCronJobResponseValidationError{}
+var _ error = ListServicesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -7947,57 +8128,88 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteCronJobResponseValidationError{}
+} = ListServicesResponseValidationError{}
 
-// Validate checks the field values on ConfigMap with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ConfigMap) Validate() error {
+// Validate checks the field values on DeleteServiceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteServiceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ConfigMap with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ConfigMapMultiError, or nil
-// if none found.
-func (m *ConfigMap) ValidateAll() error {
+// ValidateAll checks the field values on DeleteServiceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteServiceRequestMultiError, or nil if none found.
+func (m *DeleteServiceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ConfigMap) validate(all bool) error {
+func (m *DeleteServiceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Cluster
-
-	// no validation rules for Namespace
-
-	// no validation rules for Name
-
-	// no validation rules for Annotations
-
-	// no validation rules for Labels
-
-	// no validation rules for Data
-
-	// no validation rules for BinaryData
+	if len(m.GetClientset()) < 1 {
+		err := DeleteServiceRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if len(errors) > 0 {
-		return ConfigMapMultiError(errors)
+	if len(m.GetCluster()) < 1 {
+		err := DeleteServiceRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
+	if len(m.GetNamespace()) < 1 {
+		err := DeleteServiceRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-// ConfigMapMultiError is an error wrapping multiple validation errors returned
-// by ConfigMap.ValidateAll() if the designated constraints aren't met.
-type ConfigMapMultiError []error
+	if len(m.GetName()) < 1 {
+		err := DeleteServiceRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteServiceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteServiceRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteServiceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteServiceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ConfigMapMultiError) Error() string {
+func (m DeleteServiceRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8006,11 +8218,11 @@ func (m ConfigMapMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ConfigMapMultiError) AllErrors() []error { return m }
+func (m DeleteServiceRequestMultiError) AllErrors() []error { return m }
 
-// ConfigMapValidationError is the validation error returned by
-// ConfigMap.Validate if the designated constraints aren't met.
-type ConfigMapValidationError struct {
+// DeleteServiceRequestValidationError is the validation error returned by
+// DeleteServiceRequest.Validate if the designated constraints aren't met.
+type DeleteServiceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8018,22 +8230,24 @@ type ConfigMapValidationError struct {
 }
 
 // Field function returns field value.
-func (e ConfigMapValidationError) Field() string { return e.field }
+func (e DeleteServiceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ConfigMapValidationError) Reason() string { return e.reason }
+func (e DeleteServiceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ConfigMapValidationError) Cause() error { return e.cause }
+func (e DeleteServiceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ConfigMapValidationError) Key() bool { return e.key }
+func (e DeleteServiceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ConfigMapValidationError) ErrorName() string { return "ConfigMapValidationError" }
+func (e DeleteServiceRequestValidationError) ErrorName() string {
+	return "DeleteServiceRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ConfigMapValidationError) Error() string {
+func (e DeleteServiceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8045,14 +8259,14 @@ func (e ConfigMapValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sConfigMap.%s: %s%s",
+		"invalid %sDeleteServiceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ConfigMapValidationError{}
+var _ error = DeleteServiceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8060,117 +8274,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ConfigMapValidationError{}
+} = DeleteServiceRequestValidationError{}
 
-// Validate checks the field values on ListConfigMapsRequest with the rules
+// Validate checks the field values on DeleteServiceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListConfigMapsRequest) Validate() error {
+func (m *DeleteServiceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListConfigMapsRequest with the rules
+// ValidateAll checks the field values on DeleteServiceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListConfigMapsRequestMultiError, or nil if none found.
-func (m *ListConfigMapsRequest) ValidateAll() error {
+// DeleteServiceResponseMultiError, or nil if none found.
+func (m *DeleteServiceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListConfigMapsRequest) validate(all bool) error {
+func (m *DeleteServiceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetClientset()) < 1 {
-		err := ListConfigMapsRequestValidationError{
-			field:  "Clientset",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetCluster()) < 1 {
-		err := ListConfigMapsRequestValidationError{
-			field:  "Cluster",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetNamespace()) < 1 {
-		err := ListConfigMapsRequestValidationError{
-			field:  "Namespace",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetOptions() == nil {
-		err := ListConfigMapsRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if all {
-		switch v := interface{}(m.GetOptions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListConfigMapsRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ListConfigMapsRequestValidationError{
-					field:  "Options",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListConfigMapsRequestValidationError{
-				field:  "Options",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return ListConfigMapsRequestMultiError(errors)
+		return DeleteServiceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListConfigMapsRequestMultiError is an error wrapping multiple validation
-// errors returned by ListConfigMapsRequest.ValidateAll() if the designated
+// DeleteServiceResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteServiceResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ListConfigMapsRequestMultiError []error
+type DeleteServiceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListConfigMapsRequestMultiError) Error() string {
+func (m DeleteServiceResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8179,11 +8320,11 @@ func (m ListConfigMapsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListConfigMapsRequestMultiError) AllErrors() []error { return m }
+func (m DeleteServiceResponseMultiError) AllErrors() []error { return m }
 
-// ListConfigMapsRequestValidationError is the validation error returned by
-// ListConfigMapsRequest.Validate if the designated constraints aren't met.
-type ListConfigMapsRequestValidationError struct {
+// DeleteServiceResponseValidationError is the validation error returned by
+// DeleteServiceResponse.Validate if the designated constraints aren't met.
+type DeleteServiceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8191,24 +8332,24 @@ type ListConfigMapsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListConfigMapsRequestValidationError) Field() string { return e.field }
+func (e DeleteServiceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListConfigMapsRequestValidationError) Reason() string { return e.reason }
+func (e DeleteServiceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListConfigMapsRequestValidationError) Cause() error { return e.cause }
+func (e DeleteServiceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListConfigMapsRequestValidationError) Key() bool { return e.key }
+func (e DeleteServiceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListConfigMapsRequestValidationError) ErrorName() string {
-	return "ListConfigMapsRequestValidationError"
+func (e DeleteServiceResponseValidationError) ErrorName() string {
+	return "DeleteServiceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListConfigMapsRequestValidationError) Error() string {
+func (e DeleteServiceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8220,14 +8361,14 @@ func (e ListConfigMapsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListConfigMapsRequest.%s: %s%s",
+		"invalid %sDeleteServiceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListConfigMapsRequestValidationError{}
+var _ error = DeleteServiceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8235,78 +8376,7075 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListConfigMapsRequestValidationError{}
+} = DeleteServiceResponseValidationError{}
 
-// Validate checks the field values on ListConfigMapsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListConfigMapsResponse) Validate() error {
+// Validate checks the field values on CronJob with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CronJob) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListConfigMapsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListConfigMapsResponseMultiError, or nil if none found.
-func (m *ListConfigMapsResponse) ValidateAll() error {
+// ValidateAll checks the field values on CronJob with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CronJobMultiError, or nil if none found.
+func (m *CronJob) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListConfigMapsResponse) validate(all bool) error {
+func (m *CronJob) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetConfigMaps() {
-		_, _ = idx, item
+	// no validation rules for Cluster
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListConfigMapsResponseValidationError{
-						field:  fmt.Sprintf("ConfigMaps[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListConfigMapsResponseValidationError{
-						field:  fmt.Sprintf("ConfigMaps[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
+	// no validation rules for Namespace
+
+	// no validation rules for Name
+
+	// no validation rules for Schedule
+
+	// no validation rules for Labels
+
+	// no validation rules for Annotations
+
+	// no validation rules for Suspend
+
+	// no validation rules for NumActiveJobs
+
+	// no validation rules for ConcurrencyPolicy
+
+	if all {
+		switch v := interface{}(m.GetStartingDeadlineSeconds()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CronJobValidationError{
+					field:  "StartingDeadlineSeconds",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CronJobValidationError{
+					field:  "StartingDeadlineSeconds",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartingDeadlineSeconds()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CronJobValidationError{
+				field:  "StartingDeadlineSeconds",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CronJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// CronJobMultiError is an error wrapping multiple validation errors returned
+// by CronJob.ValidateAll() if the designated constraints aren't met.
+type CronJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CronJobMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CronJobMultiError) AllErrors() []error { return m }
+
+// CronJobValidationError is the validation error returned by CronJob.Validate
+// if the designated constraints aren't met.
+type CronJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CronJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CronJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CronJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CronJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CronJobValidationError) ErrorName() string { return "CronJobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CronJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCronJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CronJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CronJobValidationError{}
+
+// Validate checks the field values on DescribeCronJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeCronJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeCronJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeCronJobRequestMultiError, or nil if none found.
+func (m *DescribeCronJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeCronJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := DescribeCronJobRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := DescribeCronJobRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := DescribeCronJobRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := DescribeCronJobRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DescribeCronJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeCronJobRequestMultiError is an error wrapping multiple validation
+// errors returned by DescribeCronJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeCronJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeCronJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeCronJobRequestMultiError) AllErrors() []error { return m }
+
+// DescribeCronJobRequestValidationError is the validation error returned by
+// DescribeCronJobRequest.Validate if the designated constraints aren't met.
+type DescribeCronJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeCronJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeCronJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeCronJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeCronJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeCronJobRequestValidationError) ErrorName() string {
+	return "DescribeCronJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeCronJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeCronJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeCronJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeCronJobRequestValidationError{}
+
+// Validate checks the field values on DescribeCronJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeCronJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeCronJobResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeCronJobResponseMultiError, or nil if none found.
+func (m *DescribeCronJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeCronJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCronjob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DescribeCronJobResponseValidationError{
+					field:  "Cronjob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DescribeCronJobResponseValidationError{
+					field:  "Cronjob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCronjob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeCronJobResponseValidationError{
+				field:  "Cronjob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DescribeCronJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeCronJobResponseMultiError is an error wrapping multiple validation
+// errors returned by DescribeCronJobResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeCronJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeCronJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeCronJobResponseMultiError) AllErrors() []error { return m }
+
+// DescribeCronJobResponseValidationError is the validation error returned by
+// DescribeCronJobResponse.Validate if the designated constraints aren't met.
+type DescribeCronJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeCronJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeCronJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeCronJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeCronJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeCronJobResponseValidationError) ErrorName() string {
+	return "DescribeCronJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeCronJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeCronJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeCronJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeCronJobResponseValidationError{}
+
+// Validate checks the field values on ListCronJobsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCronJobsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCronJobsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCronJobsRequestMultiError, or nil if none found.
+func (m *ListCronJobsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCronJobsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := ListCronJobsRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := ListCronJobsRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := ListCronJobsRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOptions() == nil {
+		err := ListCronJobsRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListCronJobsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListCronJobsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListCronJobsRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListCronJobsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCronJobsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCronJobsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListCronJobsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCronJobsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCronJobsRequestMultiError) AllErrors() []error { return m }
+
+// ListCronJobsRequestValidationError is the validation error returned by
+// ListCronJobsRequest.Validate if the designated constraints aren't met.
+type ListCronJobsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCronJobsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCronJobsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCronJobsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCronJobsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCronJobsRequestValidationError) ErrorName() string {
+	return "ListCronJobsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCronJobsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCronJobsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCronJobsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCronJobsRequestValidationError{}
+
+// Validate checks the field values on ListCronJobsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCronJobsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCronJobsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCronJobsResponseMultiError, or nil if none found.
+func (m *ListCronJobsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCronJobsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCronJobs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCronJobsResponseValidationError{
+						field:  fmt.Sprintf("CronJobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCronJobsResponseValidationError{
+						field:  fmt.Sprintf("CronJobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCronJobsResponseValidationError{
+					field:  fmt.Sprintf("CronJobs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCronJobsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCronJobsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListCronJobsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListCronJobsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCronJobsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCronJobsResponseMultiError) AllErrors() []error { return m }
+
+// ListCronJobsResponseValidationError is the validation error returned by
+// ListCronJobsResponse.Validate if the designated constraints aren't met.
+type ListCronJobsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCronJobsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCronJobsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCronJobsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCronJobsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCronJobsResponseValidationError) ErrorName() string {
+	return "ListCronJobsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCronJobsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCronJobsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCronJobsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCronJobsResponseValidationError{}
+
+// Validate checks the field values on DeleteCronJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCronJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCronJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCronJobRequestMultiError, or nil if none found.
+func (m *DeleteCronJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCronJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := DeleteCronJobRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := DeleteCronJobRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := DeleteCronJobRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := DeleteCronJobRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteCronJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCronJobRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteCronJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCronJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCronJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCronJobRequestMultiError) AllErrors() []error { return m }
+
+// DeleteCronJobRequestValidationError is the validation error returned by
+// DeleteCronJobRequest.Validate if the designated constraints aren't met.
+type DeleteCronJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCronJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCronJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCronJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCronJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCronJobRequestValidationError) ErrorName() string {
+	return "DeleteCronJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCronJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCronJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCronJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCronJobRequestValidationError{}
+
+// Validate checks the field values on DeleteCronJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteCronJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteCronJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteCronJobResponseMultiError, or nil if none found.
+func (m *DeleteCronJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteCronJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteCronJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteCronJobResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteCronJobResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteCronJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteCronJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteCronJobResponseMultiError) AllErrors() []error { return m }
+
+// DeleteCronJobResponseValidationError is the validation error returned by
+// DeleteCronJobResponse.Validate if the designated constraints aren't met.
+type DeleteCronJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteCronJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteCronJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteCronJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteCronJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteCronJobResponseValidationError) ErrorName() string {
+	return "DeleteCronJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteCronJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteCronJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteCronJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteCronJobResponseValidationError{}
+
+// Validate checks the field values on SuspendCronJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuspendCronJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuspendCronJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuspendCronJobRequestMultiError, or nil if none found.
+func (m *SuspendCronJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuspendCronJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := SuspendCronJobRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := SuspendCronJobRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := SuspendCronJobRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := SuspendCronJobRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SuspendCronJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuspendCronJobRequestMultiError is an error wrapping multiple validation
+// errors returned by SuspendCronJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SuspendCronJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuspendCronJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuspendCronJobRequestMultiError) AllErrors() []error { return m }
+
+// SuspendCronJobRequestValidationError is the validation error returned by
+// SuspendCronJobRequest.Validate if the designated constraints aren't met.
+type SuspendCronJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuspendCronJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuspendCronJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuspendCronJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuspendCronJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuspendCronJobRequestValidationError) ErrorName() string {
+	return "SuspendCronJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuspendCronJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuspendCronJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuspendCronJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuspendCronJobRequestValidationError{}
+
+// Validate checks the field values on SuspendCronJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SuspendCronJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SuspendCronJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SuspendCronJobResponseMultiError, or nil if none found.
+func (m *SuspendCronJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SuspendCronJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCronJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SuspendCronJobResponseValidationError{
+					field:  "CronJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SuspendCronJobResponseValidationError{
+					field:  "CronJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCronJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SuspendCronJobResponseValidationError{
+				field:  "CronJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SuspendCronJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SuspendCronJobResponseMultiError is an error wrapping multiple validation
+// errors returned by SuspendCronJobResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SuspendCronJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SuspendCronJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SuspendCronJobResponseMultiError) AllErrors() []error { return m }
+
+// SuspendCronJobResponseValidationError is the validation error returned by
+// SuspendCronJobResponse.Validate if the designated constraints aren't met.
+type SuspendCronJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SuspendCronJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SuspendCronJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SuspendCronJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SuspendCronJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SuspendCronJobResponseValidationError) ErrorName() string {
+	return "SuspendCronJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SuspendCronJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSuspendCronJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SuspendCronJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SuspendCronJobResponseValidationError{}
+
+// Validate checks the field values on ResumeCronJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeCronJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeCronJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeCronJobRequestMultiError, or nil if none found.
+func (m *ResumeCronJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeCronJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := ResumeCronJobRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := ResumeCronJobRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := ResumeCronJobRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := ResumeCronJobRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResumeCronJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeCronJobRequestMultiError is an error wrapping multiple validation
+// errors returned by ResumeCronJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeCronJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeCronJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeCronJobRequestMultiError) AllErrors() []error { return m }
+
+// ResumeCronJobRequestValidationError is the validation error returned by
+// ResumeCronJobRequest.Validate if the designated constraints aren't met.
+type ResumeCronJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeCronJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeCronJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeCronJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeCronJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeCronJobRequestValidationError) ErrorName() string {
+	return "ResumeCronJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeCronJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeCronJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeCronJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeCronJobRequestValidationError{}
+
+// Validate checks the field values on ResumeCronJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeCronJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeCronJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeCronJobResponseMultiError, or nil if none found.
+func (m *ResumeCronJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeCronJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCronJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResumeCronJobResponseValidationError{
+					field:  "CronJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResumeCronJobResponseValidationError{
+					field:  "CronJob",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCronJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResumeCronJobResponseValidationError{
+				field:  "CronJob",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ResumeCronJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeCronJobResponseMultiError is an error wrapping multiple validation
+// errors returned by ResumeCronJobResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeCronJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeCronJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeCronJobResponseMultiError) AllErrors() []error { return m }
+
+// ResumeCronJobResponseValidationError is the validation error returned by
+// ResumeCronJobResponse.Validate if the designated constraints aren't met.
+type ResumeCronJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeCronJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeCronJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeCronJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeCronJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeCronJobResponseValidationError) ErrorName() string {
+	return "ResumeCronJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeCronJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeCronJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeCronJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeCronJobResponseValidationError{}
+
+// Validate checks the field values on TriggerCronJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerCronJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerCronJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerCronJobRequestMultiError, or nil if none found.
+func (m *TriggerCronJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerCronJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := TriggerCronJobRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := TriggerCronJobRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := TriggerCronJobRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := TriggerCronJobRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TriggerCronJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerCronJobRequestMultiError is an error wrapping multiple validation
+// errors returned by TriggerCronJobRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TriggerCronJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerCronJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerCronJobRequestMultiError) AllErrors() []error { return m }
+
+// TriggerCronJobRequestValidationError is the validation error returned by
+// TriggerCronJobRequest.Validate if the designated constraints aren't met.
+type TriggerCronJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerCronJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerCronJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerCronJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerCronJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerCronJobRequestValidationError) ErrorName() string {
+	return "TriggerCronJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerCronJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerCronJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerCronJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerCronJobRequestValidationError{}
+
+// Validate checks the field values on TriggerCronJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TriggerCronJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TriggerCronJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TriggerCronJobResponseMultiError, or nil if none found.
+func (m *TriggerCronJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TriggerCronJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TriggerCronJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TriggerCronJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TriggerCronJobResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TriggerCronJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// TriggerCronJobResponseMultiError is an error wrapping multiple validation
+// errors returned by TriggerCronJobResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TriggerCronJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TriggerCronJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TriggerCronJobResponseMultiError) AllErrors() []error { return m }
+
+// TriggerCronJobResponseValidationError is the validation error returned by
+// TriggerCronJobResponse.Validate if the designated constraints aren't met.
+type TriggerCronJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TriggerCronJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TriggerCronJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TriggerCronJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TriggerCronJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TriggerCronJobResponseValidationError) ErrorName() string {
+	return "TriggerCronJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TriggerCronJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTriggerCronJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TriggerCronJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TriggerCronJobResponseValidationError{}
+
+// Validate checks the field values on ConfigMap with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConfigMap) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfigMap with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConfigMapMultiError, or nil
+// if none found.
+func (m *ConfigMap) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfigMap) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cluster
+
+	// no validation rules for Namespace
+
+	// no validation rules for Name
+
+	// no validation rules for Annotations
+
+	// no validation rules for Labels
+
+	// no validation rules for Data
+
+	// no validation rules for BinaryData
+
+	if len(errors) > 0 {
+		return ConfigMapMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfigMapMultiError is an error wrapping multiple validation errors returned
+// by ConfigMap.ValidateAll() if the designated constraints aren't met.
+type ConfigMapMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfigMapMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfigMapMultiError) AllErrors() []error { return m }
+
+// ConfigMapValidationError is the validation error returned by
+// ConfigMap.Validate if the designated constraints aren't met.
+type ConfigMapValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigMapValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigMapValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigMapValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigMapValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigMapValidationError) ErrorName() string { return "ConfigMapValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfigMapValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfigMap.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigMapValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigMapValidationError{}
+
+// Validate checks the field values on ListConfigMapsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConfigMapsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConfigMapsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConfigMapsRequestMultiError, or nil if none found.
+func (m *ListConfigMapsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConfigMapsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := ListConfigMapsRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := ListConfigMapsRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := ListConfigMapsRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOptions() == nil {
+		err := ListConfigMapsRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListConfigMapsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListConfigMapsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListConfigMapsRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListConfigMapsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConfigMapsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListConfigMapsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListConfigMapsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConfigMapsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConfigMapsRequestMultiError) AllErrors() []error { return m }
+
+// ListConfigMapsRequestValidationError is the validation error returned by
+// ListConfigMapsRequest.Validate if the designated constraints aren't met.
+type ListConfigMapsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConfigMapsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConfigMapsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConfigMapsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConfigMapsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConfigMapsRequestValidationError) ErrorName() string {
+	return "ListConfigMapsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConfigMapsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConfigMapsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConfigMapsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConfigMapsRequestValidationError{}
+
+// Validate checks the field values on ListConfigMapsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConfigMapsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConfigMapsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConfigMapsResponseMultiError, or nil if none found.
+func (m *ListConfigMapsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConfigMapsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetConfigMaps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListConfigMapsResponseValidationError{
+						field:  fmt.Sprintf("ConfigMaps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListConfigMapsResponseValidationError{
+						field:  fmt.Sprintf("ConfigMaps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListConfigMapsResponseValidationError{
+					field:  fmt.Sprintf("ConfigMaps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListConfigMapsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConfigMapsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListConfigMapsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListConfigMapsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConfigMapsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConfigMapsResponseMultiError) AllErrors() []error { return m }
+
+// ListConfigMapsResponseValidationError is the validation error returned by
+// ListConfigMapsResponse.Validate if the designated constraints aren't met.
+type ListConfigMapsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConfigMapsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConfigMapsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConfigMapsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConfigMapsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConfigMapsResponseValidationError) ErrorName() string {
+	return "ListConfigMapsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConfigMapsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConfigMapsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConfigMapsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConfigMapsResponseValidationError{}
+
+// Validate checks the field values on DescribeConfigMapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeConfigMapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeConfigMapRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeConfigMapRequestMultiError, or nil if none found.
+func (m *DescribeConfigMapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeConfigMapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := DescribeConfigMapRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := DescribeConfigMapRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := DescribeConfigMapRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := DescribeConfigMapRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DescribeConfigMapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeConfigMapRequestMultiError is an error wrapping multiple validation
+// errors returned by DescribeConfigMapRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeConfigMapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeConfigMapRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeConfigMapRequestMultiError) AllErrors() []error { return m }
+
+// DescribeConfigMapRequestValidationError is the validation error returned by
+// DescribeConfigMapRequest.Validate if the designated constraints aren't met.
+type DescribeConfigMapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeConfigMapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeConfigMapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeConfigMapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeConfigMapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeConfigMapRequestValidationError) ErrorName() string {
+	return "DescribeConfigMapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeConfigMapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeConfigMapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeConfigMapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeConfigMapRequestValidationError{}
+
+// Validate checks the field values on DescribeConfigMapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeConfigMapResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeConfigMapResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeConfigMapResponseMultiError, or nil if none found.
+func (m *DescribeConfigMapResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeConfigMapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConfigMap()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DescribeConfigMapResponseValidationError{
+					field:  "ConfigMap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DescribeConfigMapResponseValidationError{
+					field:  "ConfigMap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfigMap()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeConfigMapResponseValidationError{
+				field:  "ConfigMap",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DescribeConfigMapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeConfigMapResponseMultiError is an error wrapping multiple validation
+// errors returned by DescribeConfigMapResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DescribeConfigMapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeConfigMapResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeConfigMapResponseMultiError) AllErrors() []error { return m }
+
+// DescribeConfigMapResponseValidationError is the validation error returned by
+// DescribeConfigMapResponse.Validate if the designated constraints aren't met.
+type DescribeConfigMapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeConfigMapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeConfigMapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeConfigMapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeConfigMapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeConfigMapResponseValidationError) ErrorName() string {
+	return "DescribeConfigMapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeConfigMapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeConfigMapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeConfigMapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeConfigMapResponseValidationError{}
+
+// Validate checks the field values on DeleteConfigMapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteConfigMapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteConfigMapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteConfigMapRequestMultiError, or nil if none found.
+func (m *DeleteConfigMapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteConfigMapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := DeleteConfigMapRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := DeleteConfigMapRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := DeleteConfigMapRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := DeleteConfigMapRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteConfigMapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteConfigMapRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteConfigMapRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteConfigMapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteConfigMapRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteConfigMapRequestMultiError) AllErrors() []error { return m }
+
+// DeleteConfigMapRequestValidationError is the validation error returned by
+// DeleteConfigMapRequest.Validate if the designated constraints aren't met.
+type DeleteConfigMapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteConfigMapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteConfigMapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteConfigMapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteConfigMapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteConfigMapRequestValidationError) ErrorName() string {
+	return "DeleteConfigMapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteConfigMapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteConfigMapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteConfigMapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteConfigMapRequestValidationError{}
+
+// Validate checks the field values on DeleteConfigMapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteConfigMapResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteConfigMapResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteConfigMapResponseMultiError, or nil if none found.
+func (m *DeleteConfigMapResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteConfigMapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteConfigMapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteConfigMapResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteConfigMapResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteConfigMapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteConfigMapResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteConfigMapResponseMultiError) AllErrors() []error { return m }
+
+// DeleteConfigMapResponseValidationError is the validation error returned by
+// DeleteConfigMapResponse.Validate if the designated constraints aren't met.
+type DeleteConfigMapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteConfigMapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteConfigMapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteConfigMapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteConfigMapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteConfigMapResponseValidationError) ErrorName() string {
+	return "DeleteConfigMapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteConfigMapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteConfigMapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteConfigMapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteConfigMapResponseValidationError{}
+
+// Validate checks the field values on CreateConfigMapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateConfigMapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateConfigMapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateConfigMapRequestMultiError, or nil if none found.
+func (m *CreateConfigMapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateConfigMapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := CreateConfigMapRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := CreateConfigMapRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := CreateConfigMapRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := CreateConfigMapRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if len(key) < 1 {
+				err := CreateConfigMapRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at least 1 bytes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Labels[key]
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAnnotations()))
+		i := 0
+		for key := range m.GetAnnotations() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAnnotations()[key]
+			_ = val
+
+			if len(key) < 1 {
+				err := CreateConfigMapRequestValidationError{
+					field:  fmt.Sprintf("Annotations[%v]", key),
+					reason: "value length must be at least 1 bytes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Annotations[key]
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetData()))
+		i := 0
+		for key := range m.GetData() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetData()[key]
+			_ = val
+
+			if len(key) < 1 {
+				err := CreateConfigMapRequestValidationError{
+					field:  fmt.Sprintf("Data[%v]", key),
+					reason: "value length must be at least 1 bytes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Data[key]
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateConfigMapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateConfigMapRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateConfigMapRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateConfigMapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateConfigMapRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateConfigMapRequestMultiError) AllErrors() []error { return m }
+
+// CreateConfigMapRequestValidationError is the validation error returned by
+// CreateConfigMapRequest.Validate if the designated constraints aren't met.
+type CreateConfigMapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateConfigMapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateConfigMapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateConfigMapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateConfigMapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateConfigMapRequestValidationError) ErrorName() string {
+	return "CreateConfigMapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateConfigMapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateConfigMapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateConfigMapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateConfigMapRequestValidationError{}
+
+// Validate checks the field values on CreateConfigMapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateConfigMapResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateConfigMapResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateConfigMapResponseMultiError, or nil if none found.
+func (m *CreateConfigMapResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateConfigMapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConfigMap()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigMapResponseValidationError{
+					field:  "ConfigMap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigMapResponseValidationError{
+					field:  "ConfigMap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfigMap()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigMapResponseValidationError{
+				field:  "ConfigMap",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateConfigMapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateConfigMapResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateConfigMapResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateConfigMapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateConfigMapResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateConfigMapResponseMultiError) AllErrors() []error { return m }
+
+// CreateConfigMapResponseValidationError is the validation error returned by
+// CreateConfigMapResponse.Validate if the designated constraints aren't met.
+type CreateConfigMapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateConfigMapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateConfigMapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateConfigMapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateConfigMapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateConfigMapResponseValidationError) ErrorName() string {
+	return "CreateConfigMapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateConfigMapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateConfigMapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateConfigMapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateConfigMapResponseValidationError{}
+
+// Validate checks the field values on UpdateConfigMapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateConfigMapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateConfigMapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateConfigMapRequestMultiError, or nil if none found.
+func (m *UpdateConfigMapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateConfigMapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := UpdateConfigMapRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := UpdateConfigMapRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := UpdateConfigMapRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := UpdateConfigMapRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetData()))
+		i := 0
+		for key := range m.GetData() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetData()[key]
+			_ = val
+
+			if len(key) < 1 {
+				err := UpdateConfigMapRequestValidationError{
+					field:  fmt.Sprintf("Data[%v]", key),
+					reason: "value length must be at least 1 bytes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Data[key]
+		}
+	}
+
+	for idx, item := range m.GetRemoveKeys() {
+		_, _ = idx, item
+
+		if len(item) < 1 {
+			err := UpdateConfigMapRequestValidationError{
+				field:  fmt.Sprintf("RemoveKeys[%v]", idx),
+				reason: "value length must be at least 1 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for RestartDependentDeployments
+
+	if len(errors) > 0 {
+		return UpdateConfigMapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateConfigMapRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateConfigMapRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateConfigMapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateConfigMapRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateConfigMapRequestMultiError) AllErrors() []error { return m }
+
+// UpdateConfigMapRequestValidationError is the validation error returned by
+// UpdateConfigMapRequest.Validate if the designated constraints aren't met.
+type UpdateConfigMapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateConfigMapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateConfigMapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateConfigMapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateConfigMapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateConfigMapRequestValidationError) ErrorName() string {
+	return "UpdateConfigMapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateConfigMapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateConfigMapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateConfigMapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateConfigMapRequestValidationError{}
+
+// Validate checks the field values on UpdateConfigMapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateConfigMapResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateConfigMapResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateConfigMapResponseMultiError, or nil if none found.
+func (m *UpdateConfigMapResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateConfigMapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetConfigMap()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateConfigMapResponseValidationError{
+					field:  "ConfigMap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateConfigMapResponseValidationError{
+					field:  "ConfigMap",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfigMap()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateConfigMapResponseValidationError{
+				field:  "ConfigMap",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateConfigMapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateConfigMapResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateConfigMapResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateConfigMapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateConfigMapResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateConfigMapResponseMultiError) AllErrors() []error { return m }
+
+// UpdateConfigMapResponseValidationError is the validation error returned by
+// UpdateConfigMapResponse.Validate if the designated constraints aren't met.
+type UpdateConfigMapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateConfigMapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateConfigMapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateConfigMapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateConfigMapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateConfigMapResponseValidationError) ErrorName() string {
+	return "UpdateConfigMapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateConfigMapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateConfigMapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateConfigMapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateConfigMapResponseValidationError{}
+
+// Validate checks the field values on Secret with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Secret) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Secret with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SecretMultiError, or nil if none found.
+func (m *Secret) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Secret) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cluster
+
+	// no validation rules for Namespace
+
+	// no validation rules for Name
+
+	// no validation rules for Annotations
+
+	// no validation rules for Labels
+
+	// no validation rules for Type
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecretValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecretValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecretValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SecretMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecretMultiError is an error wrapping multiple validation errors returned by
+// Secret.ValidateAll() if the designated constraints aren't met.
+type SecretMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecretMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecretMultiError) AllErrors() []error { return m }
+
+// SecretValidationError is the validation error returned by Secret.Validate if
+// the designated constraints aren't met.
+type SecretValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecretValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecretValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecretValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecretValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecretValidationError) ErrorName() string { return "SecretValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecretValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecret.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretValidationError{}
+
+// Validate checks the field values on ListSecretsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSecretsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSecretsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSecretsRequestMultiError, or nil if none found.
+func (m *ListSecretsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSecretsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := ListSecretsRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := ListSecretsRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := ListSecretsRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOptions() == nil {
+		err := ListSecretsRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListSecretsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListSecretsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListSecretsRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListSecretsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSecretsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListSecretsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListSecretsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSecretsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSecretsRequestMultiError) AllErrors() []error { return m }
+
+// ListSecretsRequestValidationError is the validation error returned by
+// ListSecretsRequest.Validate if the designated constraints aren't met.
+type ListSecretsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSecretsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSecretsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSecretsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSecretsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSecretsRequestValidationError) ErrorName() string {
+	return "ListSecretsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSecretsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSecretsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSecretsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSecretsRequestValidationError{}
+
+// Validate checks the field values on ListSecretsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSecretsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSecretsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSecretsResponseMultiError, or nil if none found.
+func (m *ListSecretsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSecretsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSecrets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSecretsResponseValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSecretsResponseValidationError{
+						field:  fmt.Sprintf("Secrets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSecretsResponseValidationError{
+					field:  fmt.Sprintf("Secrets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSecretsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSecretsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSecretsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListSecretsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSecretsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSecretsResponseMultiError) AllErrors() []error { return m }
+
+// ListSecretsResponseValidationError is the validation error returned by
+// ListSecretsResponse.Validate if the designated constraints aren't met.
+type ListSecretsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSecretsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSecretsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSecretsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSecretsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSecretsResponseValidationError) ErrorName() string {
+	return "ListSecretsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSecretsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSecretsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSecretsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSecretsResponseValidationError{}
+
+// Validate checks the field values on DescribeSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeSecretRequestMultiError, or nil if none found.
+func (m *DescribeSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := DescribeSecretRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := DescribeSecretRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := DescribeSecretRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := DescribeSecretRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DescribeSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeSecretRequestMultiError is an error wrapping multiple validation
+// errors returned by DescribeSecretRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeSecretRequestMultiError) AllErrors() []error { return m }
+
+// DescribeSecretRequestValidationError is the validation error returned by
+// DescribeSecretRequest.Validate if the designated constraints aren't met.
+type DescribeSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeSecretRequestValidationError) ErrorName() string {
+	return "DescribeSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeSecretRequestValidationError{}
+
+// Validate checks the field values on DescribeSecretResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeSecretResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeSecretResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeSecretResponseMultiError, or nil if none found.
+func (m *DescribeSecretResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeSecretResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DescribeSecretResponseValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DescribeSecretResponseValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeSecretResponseValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DescribeSecretResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeSecretResponseMultiError is an error wrapping multiple validation
+// errors returned by DescribeSecretResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeSecretResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeSecretResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeSecretResponseMultiError) AllErrors() []error { return m }
+
+// DescribeSecretResponseValidationError is the validation error returned by
+// DescribeSecretResponse.Validate if the designated constraints aren't met.
+type DescribeSecretResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeSecretResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeSecretResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeSecretResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeSecretResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeSecretResponseValidationError) ErrorName() string {
+	return "DescribeSecretResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeSecretResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeSecretResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeSecretResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeSecretResponseValidationError{}
+
+// Validate checks the field values on UpdateSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSecretRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSecretRequestMultiError, or nil if none found.
+func (m *UpdateSecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := UpdateSecretRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := UpdateSecretRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := UpdateSecretRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := UpdateSecretRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetData()))
+		i := 0
+		for key := range m.GetData() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetData()[key]
+			_ = val
+
+			if len(key) < 1 {
+				err := UpdateSecretRequestValidationError{
+					field:  fmt.Sprintf("Data[%v]", key),
+					reason: "value length must be at least 1 bytes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Data[key]
+		}
+	}
+
+	for idx, item := range m.GetRemoveKeys() {
+		_, _ = idx, item
+
+		if len(item) < 1 {
+			err := UpdateSecretRequestValidationError{
+				field:  fmt.Sprintf("RemoveKeys[%v]", idx),
+				reason: "value length must be at least 1 bytes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for RestartDependentDeployments
+
+	if len(errors) > 0 {
+		return UpdateSecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSecretRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateSecretRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSecretRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSecretRequestMultiError) AllErrors() []error { return m }
+
+// UpdateSecretRequestValidationError is the validation error returned by
+// UpdateSecretRequest.Validate if the designated constraints aren't met.
+type UpdateSecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSecretRequestValidationError) ErrorName() string {
+	return "UpdateSecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSecretRequestValidationError{}
+
+// Validate checks the field values on UpdateSecretResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateSecretResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateSecretResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateSecretResponseMultiError, or nil if none found.
+func (m *UpdateSecretResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateSecretResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSecret()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateSecretResponseValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateSecretResponseValidationError{
+					field:  "Secret",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSecret()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateSecretResponseValidationError{
+				field:  "Secret",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateSecretResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateSecretResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateSecretResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateSecretResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateSecretResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateSecretResponseMultiError) AllErrors() []error { return m }
+
+// UpdateSecretResponseValidationError is the validation error returned by
+// UpdateSecretResponse.Validate if the designated constraints aren't met.
+type UpdateSecretResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateSecretResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateSecretResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateSecretResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateSecretResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateSecretResponseValidationError) ErrorName() string {
+	return "UpdateSecretResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateSecretResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateSecretResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateSecretResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateSecretResponseValidationError{}
+
+// Validate checks the field values on Job with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Job) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Job with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JobMultiError, or nil if none found.
+func (m *Job) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Job) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cluster
+
+	// no validation rules for Namespace
+
+	// no validation rules for Name
+
+	// no validation rules for Annotations
+
+	// no validation rules for Labels
+
+	// no validation rules for CronJobName
+
+	if len(errors) > 0 {
+		return JobMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobMultiError is an error wrapping multiple validation errors returned by
+// Job.ValidateAll() if the designated constraints aren't met.
+type JobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobMultiError) AllErrors() []error { return m }
+
+// JobValidationError is the validation error returned by Job.Validate if the
+// designated constraints aren't met.
+type JobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobValidationError) ErrorName() string { return "JobValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobValidationError{}
+
+// Validate checks the field values on DescribeJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeJobRequestMultiError, or nil if none found.
+func (m *DescribeJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := DescribeJobRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := DescribeJobRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := DescribeJobRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := DescribeJobRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DescribeJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeJobRequestMultiError is an error wrapping multiple validation errors
+// returned by DescribeJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DescribeJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeJobRequestMultiError) AllErrors() []error { return m }
+
+// DescribeJobRequestValidationError is the validation error returned by
+// DescribeJobRequest.Validate if the designated constraints aren't met.
+type DescribeJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeJobRequestValidationError) ErrorName() string {
+	return "DescribeJobRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeJobRequestValidationError{}
+
+// Validate checks the field values on DescribeJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeJobResponseMultiError, or nil if none found.
+func (m *DescribeJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DescribeJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DescribeJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeJobResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DescribeJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeJobResponseMultiError is an error wrapping multiple validation
+// errors returned by DescribeJobResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeJobResponseMultiError) AllErrors() []error { return m }
+
+// DescribeJobResponseValidationError is the validation error returned by
+// DescribeJobResponse.Validate if the designated constraints aren't met.
+type DescribeJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeJobResponseValidationError) ErrorName() string {
+	return "DescribeJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeJobResponseValidationError{}
+
+// Validate checks the field values on ListJobsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListJobsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListJobsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListJobsRequestMultiError, or nil if none found.
+func (m *ListJobsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListJobsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := ListJobsRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := ListJobsRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := ListJobsRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOptions() == nil {
+		err := ListJobsRequestValidationError{
+			field:  "Options",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListJobsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListJobsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListJobsRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListJobsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListJobsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListJobsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListJobsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListJobsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListJobsRequestMultiError) AllErrors() []error { return m }
+
+// ListJobsRequestValidationError is the validation error returned by
+// ListJobsRequest.Validate if the designated constraints aren't met.
+type ListJobsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListJobsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListJobsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListJobsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListJobsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListJobsRequestValidationError) ErrorName() string { return "ListJobsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListJobsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListJobsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListJobsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListJobsRequestValidationError{}
+
+// Validate checks the field values on ListJobsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListJobsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListJobsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListJobsResponseMultiError, or nil if none found.
+func (m *ListJobsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListJobsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetJobs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListJobsResponseValidationError{
+						field:  fmt.Sprintf("Jobs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListJobsResponseValidationError{
+					field:  fmt.Sprintf("Jobs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListJobsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListJobsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListJobsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListJobsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListJobsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListJobsResponseMultiError) AllErrors() []error { return m }
+
+// ListJobsResponseValidationError is the validation error returned by
+// ListJobsResponse.Validate if the designated constraints aren't met.
+type ListJobsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListJobsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListJobsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListJobsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListJobsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListJobsResponseValidationError) ErrorName() string { return "ListJobsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListJobsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListJobsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListJobsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListJobsResponseValidationError{}
+
+// Validate checks the field values on DeleteJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteJobRequestMultiError, or nil if none found.
+func (m *DeleteJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := DeleteJobRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := DeleteJobRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := DeleteJobRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := DeleteJobRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobRequestMultiError) AllErrors() []error { return m }
+
+// DeleteJobRequestValidationError is the validation error returned by
+// DeleteJobRequest.Validate if the designated constraints aren't met.
+type DeleteJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobRequestValidationError) ErrorName() string { return "DeleteJobRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobRequestValidationError{}
+
+// Validate checks the field values on DeleteJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteJobResponseMultiError, or nil if none found.
+func (m *DeleteJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteJobResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteJobResponseMultiError) AllErrors() []error { return m }
+
+// DeleteJobResponseValidationError is the validation error returned by
+// DeleteJobResponse.Validate if the designated constraints aren't met.
+type DeleteJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteJobResponseValidationError) ErrorName() string {
+	return "DeleteJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteJobResponseValidationError{}
+
+// Validate checks the field values on JobConfig with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobConfigMultiError, or nil
+// if none found.
+func (m *JobConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobConfigValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobConfigValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobConfigValidationError{
+				field:  "Value",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return JobConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobConfigMultiError is an error wrapping multiple validation errors returned
+// by JobConfig.ValidateAll() if the designated constraints aren't met.
+type JobConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobConfigMultiError) AllErrors() []error { return m }
+
+// JobConfigValidationError is the validation error returned by
+// JobConfig.Validate if the designated constraints aren't met.
+type JobConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobConfigValidationError) ErrorName() string { return "JobConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobConfigValidationError{}
+
+// Validate checks the field values on CreateJobRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateJobRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobRequestMultiError, or nil if none found.
+func (m *CreateJobRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := CreateJobRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := CreateJobRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := CreateJobRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetJobConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "JobConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobRequestValidationError{
+					field:  "JobConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJobConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobRequestValidationError{
+				field:  "JobConfig",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobRequestMultiError) AllErrors() []error { return m }
+
+// CreateJobRequestValidationError is the validation error returned by
+// CreateJobRequest.Validate if the designated constraints aren't met.
+type CreateJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobRequestValidationError) ErrorName() string { return "CreateJobRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateJobRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobRequestValidationError{}
+
+// Validate checks the field values on CreateJobResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateJobResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateJobResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateJobResponseMultiError, or nil if none found.
+func (m *CreateJobResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateJobResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateJobResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateJobResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateJobResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateJobResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateJobResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateJobResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateJobResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateJobResponseMultiError) AllErrors() []error { return m }
+
+// CreateJobResponseValidationError is the validation error returned by
+// CreateJobResponse.Validate if the designated constraints aren't met.
+type CreateJobResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateJobResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateJobResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateJobResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateJobResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateJobResponseValidationError) ErrorName() string {
+	return "CreateJobResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateJobResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateJobResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateJobResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateJobResponseValidationError{}
+
+// Validate checks the field values on Namespace with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Namespace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Namespace with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NamespaceMultiError, or nil
+// if none found.
+func (m *Namespace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Namespace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cluster
+
+	// no validation rules for Name
+
+	// no validation rules for Annotations
+
+	// no validation rules for Labels
+
+	if len(errors) > 0 {
+		return NamespaceMultiError(errors)
+	}
+
+	return nil
+}
+
+// NamespaceMultiError is an error wrapping multiple validation errors returned
+// by Namespace.ValidateAll() if the designated constraints aren't met.
+type NamespaceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NamespaceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NamespaceMultiError) AllErrors() []error { return m }
+
+// NamespaceValidationError is the validation error returned by
+// Namespace.Validate if the designated constraints aren't met.
+type NamespaceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NamespaceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NamespaceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NamespaceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NamespaceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NamespaceValidationError) ErrorName() string { return "NamespaceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NamespaceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNamespace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NamespaceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NamespaceValidationError{}
+
+// Validate checks the field values on DescribeNamespaceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeNamespaceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeNamespaceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeNamespaceRequestMultiError, or nil if none found.
+func (m *DescribeNamespaceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeNamespaceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := DescribeNamespaceRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := DescribeNamespaceRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := DescribeNamespaceRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DescribeNamespaceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeNamespaceRequestMultiError is an error wrapping multiple validation
+// errors returned by DescribeNamespaceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeNamespaceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeNamespaceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeNamespaceRequestMultiError) AllErrors() []error { return m }
+
+// DescribeNamespaceRequestValidationError is the validation error returned by
+// DescribeNamespaceRequest.Validate if the designated constraints aren't met.
+type DescribeNamespaceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeNamespaceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeNamespaceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeNamespaceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeNamespaceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeNamespaceRequestValidationError) ErrorName() string {
+	return "DescribeNamespaceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeNamespaceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeNamespaceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeNamespaceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeNamespaceRequestValidationError{}
+
+// Validate checks the field values on DescribeNamespaceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeNamespaceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeNamespaceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeNamespaceResponseMultiError, or nil if none found.
+func (m *DescribeNamespaceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeNamespaceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetNamespace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DescribeNamespaceResponseValidationError{
+					field:  "Namespace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DescribeNamespaceResponseValidationError{
+					field:  "Namespace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNamespace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeNamespaceResponseValidationError{
+				field:  "Namespace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DescribeNamespaceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeNamespaceResponseMultiError is an error wrapping multiple validation
+// errors returned by DescribeNamespaceResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DescribeNamespaceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeNamespaceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeNamespaceResponseMultiError) AllErrors() []error { return m }
+
+// DescribeNamespaceResponseValidationError is the validation error returned by
+// DescribeNamespaceResponse.Validate if the designated constraints aren't met.
+type DescribeNamespaceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeNamespaceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeNamespaceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeNamespaceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeNamespaceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeNamespaceResponseValidationError) ErrorName() string {
+	return "DescribeNamespaceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeNamespaceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeNamespaceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeNamespaceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeNamespaceResponseValidationError{}
+
+// Validate checks the field values on Event with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Event) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Event with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in EventMultiError, or nil if none found.
+func (m *Event) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Event) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Reason
+
+	// no validation rules for Description
+
+	// no validation rules for Cluster
+
+	// no validation rules for Namespace
+
+	// no validation rules for InvolvedObjectName
+
+	// no validation rules for Kind
+
+	// no validation rules for CreationTimeMillis
+
+	if len(errors) > 0 {
+		return EventMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventMultiError is an error wrapping multiple validation errors returned by
+// Event.ValidateAll() if the designated constraints aren't met.
+type EventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventMultiError) AllErrors() []error { return m }
+
+// EventValidationError is the validation error returned by Event.Validate if
+// the designated constraints aren't met.
+type EventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventValidationError) ErrorName() string { return "EventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventValidationError{}
+
+// Validate checks the field values on ListEventsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEventsRequestMultiError, or nil if none found.
+func (m *ListEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := ListEventsRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := ListEventsRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := ListEventsRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetObjectName()) < 1 {
+		err := ListEventsRequestValidationError{
+			field:  "ObjectName",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ObjectKind_name[int32(m.GetKind())]; !ok {
+		err := ListEventsRequestValidationError{
+			field:  "Kind",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEventsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListEventsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEventsRequestMultiError) AllErrors() []error { return m }
+
+// ListEventsRequestValidationError is the validation error returned by
+// ListEventsRequest.Validate if the designated constraints aren't met.
+type ListEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsRequestValidationError) ErrorName() string {
+	return "ListEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsRequestValidationError{}
+
+// Validate checks the field values on ListEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEventsResponseMultiError, or nil if none found.
+func (m *ListEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEventsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListEventsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEventsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEventsResponseMultiError) AllErrors() []error { return m }
+
+// ListEventsResponseValidationError is the validation error returned by
+// ListEventsResponse.Validate if the designated constraints aren't met.
+type ListEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEventsResponseValidationError) ErrorName() string {
+	return "ListEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEventsResponseValidationError{}
+
+// Validate checks the field values on NullableString with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *NullableString) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NullableString with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in NullableStringMultiError,
+// or nil if none found.
+func (m *NullableString) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NullableString) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Kind.(type) {
+	case *NullableString_Null:
+		if v == nil {
+			err := NullableStringValidationError{
+				field:  "Kind",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Null
+	case *NullableString_Value:
+		if v == nil {
+			err := NullableStringValidationError{
+				field:  "Kind",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Value
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return NullableStringMultiError(errors)
+	}
+
+	return nil
+}
+
+// NullableStringMultiError is an error wrapping multiple validation errors
+// returned by NullableString.ValidateAll() if the designated constraints
+// aren't met.
+type NullableStringMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NullableStringMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NullableStringMultiError) AllErrors() []error { return m }
+
+// NullableStringValidationError is the validation error returned by
+// NullableString.Validate if the designated constraints aren't met.
+type NullableStringValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NullableStringValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NullableStringValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NullableStringValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NullableStringValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NullableStringValidationError) ErrorName() string { return "NullableStringValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NullableStringValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNullableString.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NullableStringValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NullableStringValidationError{}
+
+// Validate checks the field values on ExpectedObjectMetaFields with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExpectedObjectMetaFields) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExpectedObjectMetaFields with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExpectedObjectMetaFieldsMultiError, or nil if none found.
+func (m *ExpectedObjectMetaFields) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExpectedObjectMetaFields) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if len(key) < 1 {
+				err := ExpectedObjectMetaFieldsValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at least 1 bytes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, ExpectedObjectMetaFieldsValidationError{
+							field:  fmt.Sprintf("Labels[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, ExpectedObjectMetaFieldsValidationError{
+							field:  fmt.Sprintf("Labels[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return ExpectedObjectMetaFieldsValidationError{
+						field:  fmt.Sprintf("Labels[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAnnotations()))
+		i := 0
+		for key := range m.GetAnnotations() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAnnotations()[key]
+			_ = val
+
+			if len(key) < 1 {
+				err := ExpectedObjectMetaFieldsValidationError{
+					field:  fmt.Sprintf("Annotations[%v]", key),
+					reason: "value length must be at least 1 bytes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, ExpectedObjectMetaFieldsValidationError{
+							field:  fmt.Sprintf("Annotations[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, ExpectedObjectMetaFieldsValidationError{
+							field:  fmt.Sprintf("Annotations[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return ExpectedObjectMetaFieldsValidationError{
+						field:  fmt.Sprintf("Annotations[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExpectedObjectMetaFieldsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExpectedObjectMetaFieldsMultiError is an error wrapping multiple validation
+// errors returned by ExpectedObjectMetaFields.ValidateAll() if the designated
+// constraints aren't met.
+type ExpectedObjectMetaFieldsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExpectedObjectMetaFieldsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExpectedObjectMetaFieldsMultiError) AllErrors() []error { return m }
+
+// ExpectedObjectMetaFieldsValidationError is the validation error returned by
+// ExpectedObjectMetaFields.Validate if the designated constraints aren't met.
+type ExpectedObjectMetaFieldsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExpectedObjectMetaFieldsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExpectedObjectMetaFieldsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExpectedObjectMetaFieldsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExpectedObjectMetaFieldsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExpectedObjectMetaFieldsValidationError) ErrorName() string {
+	return "ExpectedObjectMetaFieldsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExpectedObjectMetaFieldsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExpectedObjectMetaFields.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExpectedObjectMetaFieldsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExpectedObjectMetaFieldsValidationError{}
+
+// Validate checks the field values on ObjectMetaFields with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ObjectMetaFields) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ObjectMetaFields with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ObjectMetaFieldsMultiError, or nil if none found.
+func (m *ObjectMetaFields) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ObjectMetaFields) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if len(key) < 1 {
+				err := ObjectMetaFieldsValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at least 1 bytes",
 				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListConfigMapsResponseValidationError{
-					field:  fmt.Sprintf("ConfigMaps[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
+				if !all {
+					return err
 				}
+				errors = append(errors, err)
 			}
+
+			// no validation rules for Labels[key]
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetAnnotations()))
+		i := 0
+		for key := range m.GetAnnotations() {
+			sorted_keys[i] = key
+			i++
 		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetAnnotations()[key]
+			_ = val
+
+			if len(key) < 1 {
+				err := ObjectMetaFieldsValidationError{
+					field:  fmt.Sprintf("Annotations[%v]", key),
+					reason: "value length must be at least 1 bytes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
 
+			// no validation rules for Annotations[key]
+		}
 	}
 
 	if len(errors) > 0 {
-		return ListConfigMapsResponseMultiError(errors)
+		return ObjectMetaFieldsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListConfigMapsResponseMultiError is an error wrapping multiple validation
-// errors returned by ListConfigMapsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type ListConfigMapsResponseMultiError []error
+// ObjectMetaFieldsMultiError is an error wrapping multiple validation errors
+// returned by ObjectMetaFields.ValidateAll() if the designated constraints
+// aren't met.
+type ObjectMetaFieldsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListConfigMapsResponseMultiError) Error() string {
+func (m ObjectMetaFieldsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8315,11 +15453,11 @@ func (m ListConfigMapsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListConfigMapsResponseMultiError) AllErrors() []error { return m }
+func (m ObjectMetaFieldsMultiError) AllErrors() []error { return m }
 
-// ListConfigMapsResponseValidationError is the validation error returned by
-// ListConfigMapsResponse.Validate if the designated constraints aren't met.
-type ListConfigMapsResponseValidationError struct {
+// ObjectMetaFieldsValidationError is the validation error returned by
+// ObjectMetaFields.Validate if the designated constraints aren't met.
+type ObjectMetaFieldsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8327,24 +15465,22 @@ type ListConfigMapsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListConfigMapsResponseValidationError) Field() string { return e.field }
+func (e ObjectMetaFieldsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListConfigMapsResponseValidationError) Reason() string { return e.reason }
+func (e ObjectMetaFieldsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListConfigMapsResponseValidationError) Cause() error { return e.cause }
+func (e ObjectMetaFieldsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListConfigMapsResponseValidationError) Key() bool { return e.key }
+func (e ObjectMetaFieldsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListConfigMapsResponseValidationError) ErrorName() string {
-	return "ListConfigMapsResponseValidationError"
-}
+func (e ObjectMetaFieldsValidationError) ErrorName() string { return "ObjectMetaFieldsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListConfigMapsResponseValidationError) Error() string {
+func (e ObjectMetaFieldsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8356,14 +15492,14 @@ func (e ListConfigMapsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListConfigMapsResponse.%s: %s%s",
+		"invalid %sObjectMetaFields.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListConfigMapsResponseValidationError{}
+var _ error = ObjectMetaFieldsValidationError{}
 
 var _ interface {
 	Field() string
@@ -8371,88 +15507,86 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListConfigMapsResponseValidationError{}
+} = ObjectMetaFieldsValidationError{}
 
-// Validate checks the field values on DescribeConfigMapRequest with the rules
+// Validate checks the field values on RemoveObjectMetaFields with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DescribeConfigMapRequest) Validate() error {
+func (m *RemoveObjectMetaFields) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DescribeConfigMapRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on RemoveObjectMetaFields with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DescribeConfigMapRequestMultiError, or nil if none found.
-func (m *DescribeConfigMapRequest) ValidateAll() error {
+// RemoveObjectMetaFieldsMultiError, or nil if none found.
+func (m *RemoveObjectMetaFields) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DescribeConfigMapRequest) validate(all bool) error {
+func (m *RemoveObjectMetaFields) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetClientset()) < 1 {
-		err := DescribeConfigMapRequestValidationError{
-			field:  "Clientset",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	_RemoveObjectMetaFields_Labels_Unique := make(map[string]struct{}, len(m.GetLabels()))
 
-	if len(m.GetCluster()) < 1 {
-		err := DescribeConfigMapRequestValidationError{
-			field:  "Cluster",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetLabels() {
+		_, _ = idx, item
 
-	if len(m.GetNamespace()) < 1 {
-		err := DescribeConfigMapRequestValidationError{
-			field:  "Namespace",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
+		if _, exists := _RemoveObjectMetaFields_Labels_Unique[item]; exists {
+			err := RemoveObjectMetaFieldsValidationError{
+				field:  fmt.Sprintf("Labels[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_RemoveObjectMetaFields_Labels_Unique[item] = struct{}{}
 		}
-		errors = append(errors, err)
+
+		// no validation rules for Labels[idx]
 	}
 
-	if len(m.GetName()) < 1 {
-		err := DescribeConfigMapRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
+	_RemoveObjectMetaFields_Annotations_Unique := make(map[string]struct{}, len(m.GetAnnotations()))
+
+	for idx, item := range m.GetAnnotations() {
+		_, _ = idx, item
+
+		if _, exists := _RemoveObjectMetaFields_Annotations_Unique[item]; exists {
+			err := RemoveObjectMetaFieldsValidationError{
+				field:  fmt.Sprintf("Annotations[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_RemoveObjectMetaFields_Annotations_Unique[item] = struct{}{}
 		}
-		errors = append(errors, err)
+
+		// no validation rules for Annotations[idx]
 	}
 
 	if len(errors) > 0 {
-		return DescribeConfigMapRequestMultiError(errors)
+		return RemoveObjectMetaFieldsMultiError(errors)
 	}
 
 	return nil
 }
 
-// DescribeConfigMapRequestMultiError is an error wrapping multiple validation
-// errors returned by DescribeConfigMapRequest.ValidateAll() if the designated
+// RemoveObjectMetaFieldsMultiError is an error wrapping multiple validation
+// errors returned by RemoveObjectMetaFields.ValidateAll() if the designated
 // constraints aren't met.
-type DescribeConfigMapRequestMultiError []error
+type RemoveObjectMetaFieldsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DescribeConfigMapRequestMultiError) Error() string {
+func (m RemoveObjectMetaFieldsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8461,11 +15595,11 @@ func (m DescribeConfigMapRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DescribeConfigMapRequestMultiError) AllErrors() []error { return m }
+func (m RemoveObjectMetaFieldsMultiError) AllErrors() []error { return m }
 
-// DescribeConfigMapRequestValidationError is the validation error returned by
-// DescribeConfigMapRequest.Validate if the designated constraints aren't met.
-type DescribeConfigMapRequestValidationError struct {
+// RemoveObjectMetaFieldsValidationError is the validation error returned by
+// RemoveObjectMetaFields.Validate if the designated constraints aren't met.
+type RemoveObjectMetaFieldsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8473,24 +15607,24 @@ type DescribeConfigMapRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeConfigMapRequestValidationError) Field() string { return e.field }
+func (e RemoveObjectMetaFieldsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeConfigMapRequestValidationError) Reason() string { return e.reason }
+func (e RemoveObjectMetaFieldsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeConfigMapRequestValidationError) Cause() error { return e.cause }
+func (e RemoveObjectMetaFieldsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeConfigMapRequestValidationError) Key() bool { return e.key }
+func (e RemoveObjectMetaFieldsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeConfigMapRequestValidationError) ErrorName() string {
-	return "DescribeConfigMapRequestValidationError"
+func (e RemoveObjectMetaFieldsValidationError) ErrorName() string {
+	return "RemoveObjectMetaFieldsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeConfigMapRequestValidationError) Error() string {
+func (e RemoveObjectMetaFieldsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8502,14 +15636,14 @@ func (e DescribeConfigMapRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeConfigMapRequest.%s: %s%s",
+		"invalid %sRemoveObjectMetaFields.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeConfigMapRequestValidationError{}
+var _ error = RemoveObjectMetaFieldsValidationError{}
 
 var _ interface {
 	Field() string
@@ -8517,73 +15651,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeConfigMapRequestValidationError{}
+} = RemoveObjectMetaFieldsValidationError{}
 
-// Validate checks the field values on DescribeConfigMapResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DescribeConfigMapResponse) Validate() error {
+// Validate checks the field values on Node with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Node) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DescribeConfigMapResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DescribeConfigMapResponseMultiError, or nil if none found.
-func (m *DescribeConfigMapResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *DescribeConfigMapResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if all {
-		switch v := interface{}(m.GetConfigMap()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DescribeConfigMapResponseValidationError{
-					field:  "ConfigMap",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DescribeConfigMapResponseValidationError{
-					field:  "ConfigMap",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConfigMap()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DescribeConfigMapResponseValidationError{
-				field:  "ConfigMap",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+// ValidateAll checks the field values on Node with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in NodeMultiError, or nil if none found.
+func (m *Node) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Node) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Cluster
+
+	// no validation rules for Unschedulable
+
 	if len(errors) > 0 {
-		return DescribeConfigMapResponseMultiError(errors)
+		return NodeMultiError(errors)
 	}
 
 	return nil
 }
 
-// DescribeConfigMapResponseMultiError is an error wrapping multiple validation
-// errors returned by DescribeConfigMapResponse.ValidateAll() if the
-// designated constraints aren't met.
-type DescribeConfigMapResponseMultiError []error
+// NodeMultiError is an error wrapping multiple validation errors returned by
+// Node.ValidateAll() if the designated constraints aren't met.
+type NodeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DescribeConfigMapResponseMultiError) Error() string {
+func (m NodeMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8592,11 +15701,11 @@ func (m DescribeConfigMapResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DescribeConfigMapResponseMultiError) AllErrors() []error { return m }
+func (m NodeMultiError) AllErrors() []error { return m }
 
-// DescribeConfigMapResponseValidationError is the validation error returned by
-// DescribeConfigMapResponse.Validate if the designated constraints aren't met.
-type DescribeConfigMapResponseValidationError struct {
+// NodeValidationError is the validation error returned by Node.Validate if the
+// designated constraints aren't met.
+type NodeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8604,24 +15713,22 @@ type DescribeConfigMapResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeConfigMapResponseValidationError) Field() string { return e.field }
+func (e NodeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeConfigMapResponseValidationError) Reason() string { return e.reason }
+func (e NodeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeConfigMapResponseValidationError) Cause() error { return e.cause }
+func (e NodeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeConfigMapResponseValidationError) Key() bool { return e.key }
+func (e NodeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeConfigMapResponseValidationError) ErrorName() string {
-	return "DescribeConfigMapResponseValidationError"
-}
+func (e NodeValidationError) ErrorName() string { return "NodeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DescribeConfigMapResponseValidationError) Error() string {
+func (e NodeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8633,14 +15740,14 @@ func (e DescribeConfigMapResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeConfigMapResponse.%s: %s%s",
+		"invalid %sNode.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeConfigMapResponseValidationError{}
+var _ error = NodeValidationError{}
 
 var _ interface {
 	Field() string
@@ -8648,24 +15755,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeConfigMapResponseValidationError{}
+} = NodeValidationError{}
 
-// Validate checks the field values on DeleteConfigMapRequest with the rules
+// Validate checks the field values on DescribeNodeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteConfigMapRequest) Validate() error {
+func (m *DescribeNodeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteConfigMapRequest with the rules
+// ValidateAll checks the field values on DescribeNodeRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteConfigMapRequestMultiError, or nil if none found.
-func (m *DeleteConfigMapRequest) ValidateAll() error {
+// DescribeNodeRequestMultiError, or nil if none found.
+func (m *DescribeNodeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteConfigMapRequest) validate(all bool) error {
+func (m *DescribeNodeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8673,7 +15780,7 @@ func (m *DeleteConfigMapRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetClientset()) < 1 {
-		err := DeleteConfigMapRequestValidationError{
+		err := DescribeNodeRequestValidationError{
 			field:  "Clientset",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -8684,7 +15791,7 @@ func (m *DeleteConfigMapRequest) validate(all bool) error {
 	}
 
 	if len(m.GetCluster()) < 1 {
-		err := DeleteConfigMapRequestValidationError{
+		err := DescribeNodeRequestValidationError{
 			field:  "Cluster",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -8694,19 +15801,8 @@ func (m *DeleteConfigMapRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetNamespace()) < 1 {
-		err := DeleteConfigMapRequestValidationError{
-			field:  "Namespace",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(m.GetName()) < 1 {
-		err := DeleteConfigMapRequestValidationError{
+		err := DescribeNodeRequestValidationError{
 			field:  "Name",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -8717,19 +15813,19 @@ func (m *DeleteConfigMapRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeleteConfigMapRequestMultiError(errors)
+		return DescribeNodeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteConfigMapRequestMultiError is an error wrapping multiple validation
-// errors returned by DeleteConfigMapRequest.ValidateAll() if the designated
+// DescribeNodeRequestMultiError is an error wrapping multiple validation
+// errors returned by DescribeNodeRequest.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteConfigMapRequestMultiError []error
+type DescribeNodeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteConfigMapRequestMultiError) Error() string {
+func (m DescribeNodeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8738,11 +15834,11 @@ func (m DeleteConfigMapRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteConfigMapRequestMultiError) AllErrors() []error { return m }
+func (m DescribeNodeRequestMultiError) AllErrors() []error { return m }
 
-// DeleteConfigMapRequestValidationError is the validation error returned by
-// DeleteConfigMapRequest.Validate if the designated constraints aren't met.
-type DeleteConfigMapRequestValidationError struct {
+// DescribeNodeRequestValidationError is the validation error returned by
+// DescribeNodeRequest.Validate if the designated constraints aren't met.
+type DescribeNodeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8750,24 +15846,24 @@ type DeleteConfigMapRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteConfigMapRequestValidationError) Field() string { return e.field }
+func (e DescribeNodeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteConfigMapRequestValidationError) Reason() string { return e.reason }
+func (e DescribeNodeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteConfigMapRequestValidationError) Cause() error { return e.cause }
+func (e DescribeNodeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteConfigMapRequestValidationError) Key() bool { return e.key }
+func (e DescribeNodeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteConfigMapRequestValidationError) ErrorName() string {
-	return "DeleteConfigMapRequestValidationError"
+func (e DescribeNodeRequestValidationError) ErrorName() string {
+	return "DescribeNodeRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteConfigMapRequestValidationError) Error() string {
+func (e DescribeNodeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8779,14 +15875,14 @@ func (e DeleteConfigMapRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteConfigMapRequest.%s: %s%s",
+		"invalid %sDescribeNodeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteConfigMapRequestValidationError{}
+var _ error = DescribeNodeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -8794,44 +15890,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteConfigMapRequestValidationError{}
+} = DescribeNodeRequestValidationError{}
 
-// Validate checks the field values on DeleteConfigMapResponse with the rules
+// Validate checks the field values on DescribeNodeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteConfigMapResponse) Validate() error {
+func (m *DescribeNodeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteConfigMapResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on DescribeNodeResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteConfigMapResponseMultiError, or nil if none found.
-func (m *DeleteConfigMapResponse) ValidateAll() error {
+// DescribeNodeResponseMultiError, or nil if none found.
+func (m *DescribeNodeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteConfigMapResponse) validate(all bool) error {
+func (m *DescribeNodeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if all {
+		switch v := interface{}(m.GetNode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DescribeNodeResponseValidationError{
+					field:  "Node",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DescribeNodeResponseValidationError{
+					field:  "Node",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeNodeResponseValidationError{
+				field:  "Node",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteConfigMapResponseMultiError(errors)
+		return DescribeNodeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteConfigMapResponseMultiError is an error wrapping multiple validation
-// errors returned by DeleteConfigMapResponse.ValidateAll() if the designated
+// DescribeNodeResponseMultiError is an error wrapping multiple validation
+// errors returned by DescribeNodeResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DeleteConfigMapResponseMultiError []error
+type DescribeNodeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteConfigMapResponseMultiError) Error() string {
+func (m DescribeNodeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8840,11 +15965,11 @@ func (m DeleteConfigMapResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteConfigMapResponseMultiError) AllErrors() []error { return m }
+func (m DescribeNodeResponseMultiError) AllErrors() []error { return m }
 
-// DeleteConfigMapResponseValidationError is the validation error returned by
-// DeleteConfigMapResponse.Validate if the designated constraints aren't met.
-type DeleteConfigMapResponseValidationError struct {
+// DescribeNodeResponseValidationError is the validation error returned by
+// DescribeNodeResponse.Validate if the designated constraints aren't met.
+type DescribeNodeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8852,24 +15977,24 @@ type DeleteConfigMapResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteConfigMapResponseValidationError) Field() string { return e.field }
+func (e DescribeNodeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteConfigMapResponseValidationError) Reason() string { return e.reason }
+func (e DescribeNodeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteConfigMapResponseValidationError) Cause() error { return e.cause }
+func (e DescribeNodeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteConfigMapResponseValidationError) Key() bool { return e.key }
+func (e DescribeNodeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteConfigMapResponseValidationError) ErrorName() string {
-	return "DeleteConfigMapResponseValidationError"
+func (e DescribeNodeResponseValidationError) ErrorName() string {
+	return "DescribeNodeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteConfigMapResponseValidationError) Error() string {
+func (e DescribeNodeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8881,14 +16006,14 @@ func (e DeleteConfigMapResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteConfigMapResponse.%s: %s%s",
+		"invalid %sDescribeNodeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteConfigMapResponseValidationError{}
+var _ error = DescribeNodeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -8896,52 +16021,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteConfigMapResponseValidationError{}
+} = DescribeNodeResponseValidationError{}
 
-// Validate checks the field values on Job with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Job) Validate() error {
+// Validate checks the field values on UpdateNodeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateNodeRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Job with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in JobMultiError, or nil if none found.
-func (m *Job) ValidateAll() error {
+// ValidateAll checks the field values on UpdateNodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateNodeRequestMultiError, or nil if none found.
+func (m *UpdateNodeRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Job) validate(all bool) error {
+func (m *UpdateNodeRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for Cluster
-
-	// no validation rules for Namespace
-
-	// no validation rules for Name
-
-	// no validation rules for Annotations
-
-	// no validation rules for Labels
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := UpdateNodeRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := UpdateNodeRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := UpdateNodeRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Unschedulable
 
 	if len(errors) > 0 {
-		return JobMultiError(errors)
+		return UpdateNodeRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobMultiError is an error wrapping multiple validation errors returned by
-// Job.ValidateAll() if the designated constraints aren't met.
-type JobMultiError []error
+// UpdateNodeRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateNodeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateNodeRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobMultiError) Error() string {
+func (m UpdateNodeRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8950,11 +16102,11 @@ func (m JobMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobMultiError) AllErrors() []error { return m }
+func (m UpdateNodeRequestMultiError) AllErrors() []error { return m }
 
-// JobValidationError is the validation error returned by Job.Validate if the
-// designated constraints aren't met.
-type JobValidationError struct {
+// UpdateNodeRequestValidationError is the validation error returned by
+// UpdateNodeRequest.Validate if the designated constraints aren't met.
+type UpdateNodeRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8962,22 +16114,24 @@ type JobValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobValidationError) Field() string { return e.field }
+func (e UpdateNodeRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobValidationError) Reason() string { return e.reason }
+func (e UpdateNodeRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobValidationError) Cause() error { return e.cause }
+func (e UpdateNodeRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobValidationError) Key() bool { return e.key }
+func (e UpdateNodeRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobValidationError) ErrorName() string { return "JobValidationError" }
+func (e UpdateNodeRequestValidationError) ErrorName() string {
+	return "UpdateNodeRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobValidationError) Error() string {
+func (e UpdateNodeRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8989,14 +16143,14 @@ func (e JobValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJob.%s: %s%s",
+		"invalid %sUpdateNodeRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobValidationError{}
+var _ error = UpdateNodeRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9004,88 +16158,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobValidationError{}
+} = UpdateNodeRequestValidationError{}
 
-// Validate checks the field values on DescribeJobRequest with the rules
+// Validate checks the field values on UpdateNodeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DescribeJobRequest) Validate() error {
+func (m *UpdateNodeResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DescribeJobRequest with the rules
+// ValidateAll checks the field values on UpdateNodeResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DescribeJobRequestMultiError, or nil if none found.
-func (m *DescribeJobRequest) ValidateAll() error {
+// UpdateNodeResponseMultiError, or nil if none found.
+func (m *UpdateNodeResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DescribeJobRequest) validate(all bool) error {
+func (m *UpdateNodeResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetClientset()) < 1 {
-		err := DescribeJobRequestValidationError{
-			field:  "Clientset",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetCluster()) < 1 {
-		err := DescribeJobRequestValidationError{
-			field:  "Cluster",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetNamespace()) < 1 {
-		err := DescribeJobRequestValidationError{
-			field:  "Namespace",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetName()) < 1 {
-		err := DescribeJobRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return DescribeJobRequestMultiError(errors)
+		return UpdateNodeResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DescribeJobRequestMultiError is an error wrapping multiple validation errors
-// returned by DescribeJobRequest.ValidateAll() if the designated constraints
+// UpdateNodeResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateNodeResponse.ValidateAll() if the designated constraints
 // aren't met.
-type DescribeJobRequestMultiError []error
+type UpdateNodeResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DescribeJobRequestMultiError) Error() string {
+func (m UpdateNodeResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9094,11 +16204,11 @@ func (m DescribeJobRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DescribeJobRequestMultiError) AllErrors() []error { return m }
+func (m UpdateNodeResponseMultiError) AllErrors() []error { return m }
 
-// DescribeJobRequestValidationError is the validation error returned by
-// DescribeJobRequest.Validate if the designated constraints aren't met.
-type DescribeJobRequestValidationError struct {
+// UpdateNodeResponseValidationError is the validation error returned by
+// UpdateNodeResponse.Validate if the designated constraints aren't met.
+type UpdateNodeResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9106,24 +16216,24 @@ type DescribeJobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeJobRequestValidationError) Field() string { return e.field }
+func (e UpdateNodeResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeJobRequestValidationError) Reason() string { return e.reason }
+func (e UpdateNodeResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeJobRequestValidationError) Cause() error { return e.cause }
+func (e UpdateNodeResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeJobRequestValidationError) Key() bool { return e.key }
+func (e UpdateNodeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeJobRequestValidationError) ErrorName() string {
-	return "DescribeJobRequestValidationError"
+func (e UpdateNodeResponseValidationError) ErrorName() string {
+	return "UpdateNodeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeJobRequestValidationError) Error() string {
+func (e UpdateNodeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9135,14 +16245,14 @@ func (e DescribeJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeJobRequest.%s: %s%s",
+		"invalid %sUpdateNodeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeJobRequestValidationError{}
+var _ error = UpdateNodeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9150,53 +16260,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeJobRequestValidationError{}
+} = UpdateNodeResponseValidationError{}
 
-// Validate checks the field values on DescribeJobResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DescribeJobResponse) Validate() error {
+// Validate checks the field values on WatchEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WatchEvent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DescribeJobResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DescribeJobResponseMultiError, or nil if none found.
-func (m *DescribeJobResponse) ValidateAll() error {
+// ValidateAll checks the field values on WatchEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WatchEventMultiError, or
+// nil if none found.
+func (m *WatchEvent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DescribeJobResponse) validate(all bool) error {
+func (m *WatchEvent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Type
+
+	// no validation rules for Clientset
+
+	// no validation rules for Cluster
+
+	// no validation rules for ResourceVersion
+
 	if all {
-		switch v := interface{}(m.GetJob()).(type) {
+		switch v := interface{}(m.GetStatus()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DescribeJobResponseValidationError{
-					field:  "Job",
+				errors = append(errors, WatchEventValidationError{
+					field:  "Status",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DescribeJobResponseValidationError{
-					field:  "Job",
+				errors = append(errors, WatchEventValidationError{
+					field:  "Status",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DescribeJobResponseValidationError{
-				field:  "Job",
+			return WatchEventValidationError{
+				field:  "Status",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -9204,19 +16322,18 @@ func (m *DescribeJobResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DescribeJobResponseMultiError(errors)
+		return WatchEventMultiError(errors)
 	}
 
 	return nil
 }
 
-// DescribeJobResponseMultiError is an error wrapping multiple validation
-// errors returned by DescribeJobResponse.ValidateAll() if the designated
-// constraints aren't met.
-type DescribeJobResponseMultiError []error
+// WatchEventMultiError is an error wrapping multiple validation errors
+// returned by WatchEvent.ValidateAll() if the designated constraints aren't met.
+type WatchEventMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DescribeJobResponseMultiError) Error() string {
+func (m WatchEventMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9225,11 +16342,11 @@ func (m DescribeJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DescribeJobResponseMultiError) AllErrors() []error { return m }
+func (m WatchEventMultiError) AllErrors() []error { return m }
 
-// DescribeJobResponseValidationError is the validation error returned by
-// DescribeJobResponse.Validate if the designated constraints aren't met.
-type DescribeJobResponseValidationError struct {
+// WatchEventValidationError is the validation error returned by
+// WatchEvent.Validate if the designated constraints aren't met.
+type WatchEventValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9237,24 +16354,22 @@ type DescribeJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeJobResponseValidationError) Field() string { return e.field }
+func (e WatchEventValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeJobResponseValidationError) Reason() string { return e.reason }
+func (e WatchEventValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeJobResponseValidationError) Cause() error { return e.cause }
+func (e WatchEventValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeJobResponseValidationError) Key() bool { return e.key }
+func (e WatchEventValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeJobResponseValidationError) ErrorName() string {
-	return "DescribeJobResponseValidationError"
-}
+func (e WatchEventValidationError) ErrorName() string { return "WatchEventValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DescribeJobResponseValidationError) Error() string {
+func (e WatchEventValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9266,14 +16381,14 @@ func (e DescribeJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeJobResponse.%s: %s%s",
+		"invalid %sWatchEvent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeJobResponseValidationError{}
+var _ error = WatchEventValidationError{}
 
 var _ interface {
 	Field() string
@@ -9281,79 +16396,41 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeJobResponseValidationError{}
+} = WatchEventValidationError{}
 
-// Validate checks the field values on ListJobsRequest with the rules defined
+// Validate checks the field values on WatchPodsRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *ListJobsRequest) Validate() error {
+func (m *WatchPodsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListJobsRequest with the rules
+// ValidateAll checks the field values on WatchPodsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListJobsRequestMultiError, or nil if none found.
-func (m *ListJobsRequest) ValidateAll() error {
+// WatchPodsRequestMultiError, or nil if none found.
+func (m *WatchPodsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListJobsRequest) validate(all bool) error {
+func (m *WatchPodsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetClientset()) < 1 {
-		err := ListJobsRequestValidationError{
-			field:  "Clientset",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetCluster()) < 1 {
-		err := ListJobsRequestValidationError{
-			field:  "Cluster",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Clientset
 
-	if len(m.GetNamespace()) < 1 {
-		err := ListJobsRequestValidationError{
-			field:  "Namespace",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Cluster
 
-	if m.GetOptions() == nil {
-		err := ListJobsRequestValidationError{
-			field:  "Options",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Namespace
 
 	if all {
 		switch v := interface{}(m.GetOptions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ListJobsRequestValidationError{
+				errors = append(errors, WatchPodsRequestValidationError{
 					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -9361,7 +16438,7 @@ func (m *ListJobsRequest) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ListJobsRequestValidationError{
+				errors = append(errors, WatchPodsRequestValidationError{
 					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -9370,7 +16447,7 @@ func (m *ListJobsRequest) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ListJobsRequestValidationError{
+			return WatchPodsRequestValidationError{
 				field:  "Options",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -9378,20 +16455,22 @@ func (m *ListJobsRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for ResourceVersions
+
 	if len(errors) > 0 {
-		return ListJobsRequestMultiError(errors)
+		return WatchPodsRequestMultiError(errors)
 	}
 
 	return nil
 }
-
-// ListJobsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListJobsRequest.ValidateAll() if the designated constraints
+
+// WatchPodsRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchPodsRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ListJobsRequestMultiError []error
+type WatchPodsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListJobsRequestMultiError) Error() string {
+func (m WatchPodsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9400,11 +16479,11 @@ func (m ListJobsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListJobsRequestMultiError) AllErrors() []error { return m }
+func (m WatchPodsRequestMultiError) AllErrors() []error { return m }
 
-// ListJobsRequestValidationError is the validation error returned by
-// ListJobsRequest.Validate if the designated constraints aren't met.
-type ListJobsRequestValidationError struct {
+// WatchPodsRequestValidationError is the validation error returned by
+// WatchPodsRequest.Validate if the designated constraints aren't met.
+type WatchPodsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9412,22 +16491,22 @@ type ListJobsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListJobsRequestValidationError) Field() string { return e.field }
+func (e WatchPodsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListJobsRequestValidationError) Reason() string { return e.reason }
+func (e WatchPodsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListJobsRequestValidationError) Cause() error { return e.cause }
+func (e WatchPodsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListJobsRequestValidationError) Key() bool { return e.key }
+func (e WatchPodsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListJobsRequestValidationError) ErrorName() string { return "ListJobsRequestValidationError" }
+func (e WatchPodsRequestValidationError) ErrorName() string { return "WatchPodsRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListJobsRequestValidationError) Error() string {
+func (e WatchPodsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9439,14 +16518,14 @@ func (e ListJobsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListJobsRequest.%s: %s%s",
+		"invalid %sWatchPodsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListJobsRequestValidationError{}
+var _ error = WatchPodsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9454,78 +16533,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListJobsRequestValidationError{}
+} = WatchPodsRequestValidationError{}
 
-// Validate checks the field values on ListJobsResponse with the rules defined
+// Validate checks the field values on WatchPodsResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *ListJobsResponse) Validate() error {
+func (m *WatchPodsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListJobsResponse with the rules
+// ValidateAll checks the field values on WatchPodsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListJobsResponseMultiError, or nil if none found.
-func (m *ListJobsResponse) ValidateAll() error {
+// WatchPodsResponseMultiError, or nil if none found.
+func (m *WatchPodsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListJobsResponse) validate(all bool) error {
+func (m *WatchPodsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetJobs() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListJobsResponseValidationError{
-						field:  fmt.Sprintf("Jobs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListJobsResponseValidationError{
-						field:  fmt.Sprintf("Jobs[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchPodsResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListJobsResponseValidationError{
-					field:  fmt.Sprintf("Jobs[%v]", idx),
+				errors = append(errors, WatchPodsResponseValidationError{
+					field:  "Event",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchPodsResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if all {
+		switch v := interface{}(m.GetPod()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchPodsResponseValidationError{
+					field:  "Pod",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchPodsResponseValidationError{
+					field:  "Pod",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPod()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchPodsResponseValidationError{
+				field:  "Pod",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
 
 	if len(errors) > 0 {
-		return ListJobsResponseMultiError(errors)
+		return WatchPodsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListJobsResponseMultiError is an error wrapping multiple validation errors
-// returned by ListJobsResponse.ValidateAll() if the designated constraints
+// WatchPodsResponseMultiError is an error wrapping multiple validation errors
+// returned by WatchPodsResponse.ValidateAll() if the designated constraints
 // aren't met.
-type ListJobsResponseMultiError []error
+type WatchPodsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListJobsResponseMultiError) Error() string {
+func (m WatchPodsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9534,11 +16637,11 @@ func (m ListJobsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListJobsResponseMultiError) AllErrors() []error { return m }
+func (m WatchPodsResponseMultiError) AllErrors() []error { return m }
 
-// ListJobsResponseValidationError is the validation error returned by
-// ListJobsResponse.Validate if the designated constraints aren't met.
-type ListJobsResponseValidationError struct {
+// WatchPodsResponseValidationError is the validation error returned by
+// WatchPodsResponse.Validate if the designated constraints aren't met.
+type WatchPodsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9546,22 +16649,24 @@ type ListJobsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListJobsResponseValidationError) Field() string { return e.field }
+func (e WatchPodsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListJobsResponseValidationError) Reason() string { return e.reason }
+func (e WatchPodsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListJobsResponseValidationError) Cause() error { return e.cause }
+func (e WatchPodsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListJobsResponseValidationError) Key() bool { return e.key }
+func (e WatchPodsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListJobsResponseValidationError) ErrorName() string { return "ListJobsResponseValidationError" }
+func (e WatchPodsResponseValidationError) ErrorName() string {
+	return "WatchPodsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListJobsResponseValidationError) Error() string {
+func (e WatchPodsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9573,14 +16678,14 @@ func (e ListJobsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListJobsResponse.%s: %s%s",
+		"invalid %sWatchPodsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListJobsResponseValidationError{}
+var _ error = WatchPodsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9588,88 +16693,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListJobsResponseValidationError{}
+} = WatchPodsResponseValidationError{}
 
-// Validate checks the field values on DeleteJobRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteJobRequest) Validate() error {
+// Validate checks the field values on WatchDeploymentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchDeploymentsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on WatchDeploymentsRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteJobRequestMultiError, or nil if none found.
-func (m *DeleteJobRequest) ValidateAll() error {
+// WatchDeploymentsRequestMultiError, or nil if none found.
+func (m *WatchDeploymentsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobRequest) validate(all bool) error {
+func (m *WatchDeploymentsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetClientset()) < 1 {
-		err := DeleteJobRequestValidationError{
-			field:  "Clientset",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Clientset
 
-	if len(m.GetCluster()) < 1 {
-		err := DeleteJobRequestValidationError{
-			field:  "Cluster",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Cluster
 
-	if len(m.GetNamespace()) < 1 {
-		err := DeleteJobRequestValidationError{
-			field:  "Namespace",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Namespace
 
-	if len(m.GetName()) < 1 {
-		err := DeleteJobRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 bytes",
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchDeploymentsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchDeploymentsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchDeploymentsRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
+	// no validation rules for ResourceVersions
+
 	if len(errors) > 0 {
-		return DeleteJobRequestMultiError(errors)
+		return WatchDeploymentsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteJobRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteJobRequestMultiError []error
+// WatchDeploymentsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchDeploymentsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchDeploymentsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobRequestMultiError) Error() string {
+func (m WatchDeploymentsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9678,11 +16776,11 @@ func (m DeleteJobRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobRequestMultiError) AllErrors() []error { return m }
+func (m WatchDeploymentsRequestMultiError) AllErrors() []error { return m }
 
-// DeleteJobRequestValidationError is the validation error returned by
-// DeleteJobRequest.Validate if the designated constraints aren't met.
-type DeleteJobRequestValidationError struct {
+// WatchDeploymentsRequestValidationError is the validation error returned by
+// WatchDeploymentsRequest.Validate if the designated constraints aren't met.
+type WatchDeploymentsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9690,22 +16788,24 @@ type DeleteJobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobRequestValidationError) Field() string { return e.field }
+func (e WatchDeploymentsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobRequestValidationError) Reason() string { return e.reason }
+func (e WatchDeploymentsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobRequestValidationError) Cause() error { return e.cause }
+func (e WatchDeploymentsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobRequestValidationError) Key() bool { return e.key }
+func (e WatchDeploymentsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobRequestValidationError) ErrorName() string { return "DeleteJobRequestValidationError" }
+func (e WatchDeploymentsRequestValidationError) ErrorName() string {
+	return "WatchDeploymentsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DeleteJobRequestValidationError) Error() string {
+func (e WatchDeploymentsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9717,14 +16817,14 @@ func (e DeleteJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobRequest.%s: %s%s",
+		"invalid %sWatchDeploymentsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobRequestValidationError{}
+var _ error = WatchDeploymentsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9732,44 +16832,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobRequestValidationError{}
+} = WatchDeploymentsRequestValidationError{}
 
-// Validate checks the field values on DeleteJobResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteJobResponse) Validate() error {
+// Validate checks the field values on WatchDeploymentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchDeploymentsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteJobResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on WatchDeploymentsResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteJobResponseMultiError, or nil if none found.
-func (m *DeleteJobResponse) ValidateAll() error {
+// WatchDeploymentsResponseMultiError, or nil if none found.
+func (m *WatchDeploymentsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteJobResponse) validate(all bool) error {
+func (m *WatchDeploymentsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchDeploymentsResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchDeploymentsResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchDeploymentsResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeployment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchDeploymentsResponseValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchDeploymentsResponseValidationError{
+					field:  "Deployment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeployment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchDeploymentsResponseValidationError{
+				field:  "Deployment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return DeleteJobResponseMultiError(errors)
+		return WatchDeploymentsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteJobResponseMultiError is an error wrapping multiple validation errors
-// returned by DeleteJobResponse.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteJobResponseMultiError []error
+// WatchDeploymentsResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchDeploymentsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchDeploymentsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteJobResponseMultiError) Error() string {
+func (m WatchDeploymentsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9778,11 +16936,11 @@ func (m DeleteJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteJobResponseMultiError) AllErrors() []error { return m }
+func (m WatchDeploymentsResponseMultiError) AllErrors() []error { return m }
 
-// DeleteJobResponseValidationError is the validation error returned by
-// DeleteJobResponse.Validate if the designated constraints aren't met.
-type DeleteJobResponseValidationError struct {
+// WatchDeploymentsResponseValidationError is the validation error returned by
+// WatchDeploymentsResponse.Validate if the designated constraints aren't met.
+type WatchDeploymentsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9790,24 +16948,24 @@ type DeleteJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteJobResponseValidationError) Field() string { return e.field }
+func (e WatchDeploymentsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteJobResponseValidationError) Reason() string { return e.reason }
+func (e WatchDeploymentsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteJobResponseValidationError) Cause() error { return e.cause }
+func (e WatchDeploymentsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteJobResponseValidationError) Key() bool { return e.key }
+func (e WatchDeploymentsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteJobResponseValidationError) ErrorName() string {
-	return "DeleteJobResponseValidationError"
+func (e WatchDeploymentsResponseValidationError) ErrorName() string {
+	return "WatchDeploymentsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteJobResponseValidationError) Error() string {
+func (e WatchDeploymentsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9819,14 +16977,14 @@ func (e DeleteJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteJobResponse.%s: %s%s",
+		"invalid %sWatchDeploymentsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteJobResponseValidationError{}
+var _ error = WatchDeploymentsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -9834,72 +16992,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteJobResponseValidationError{}
+} = WatchDeploymentsResponseValidationError{}
 
-// Validate checks the field values on JobConfig with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *JobConfig) Validate() error {
+// Validate checks the field values on WatchHPAsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WatchHPAsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobConfig with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in JobConfigMultiError, or nil
-// if none found.
-func (m *JobConfig) ValidateAll() error {
+// ValidateAll checks the field values on WatchHPAsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchHPAsRequestMultiError, or nil if none found.
+func (m *WatchHPAsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobConfig) validate(all bool) error {
+func (m *WatchHPAsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Clientset
+
+	// no validation rules for Cluster
+
+	// no validation rules for Namespace
+
 	if all {
-		switch v := interface{}(m.GetValue()).(type) {
+		switch v := interface{}(m.GetOptions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobConfigValidationError{
-					field:  "Value",
+				errors = append(errors, WatchHPAsRequestValidationError{
+					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, JobConfigValidationError{
-					field:  "Value",
+				errors = append(errors, WatchHPAsRequestValidationError{
+					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return JobConfigValidationError{
-				field:  "Value",
+			return WatchHPAsRequestValidationError{
+				field:  "Options",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for ResourceVersions
+
 	if len(errors) > 0 {
-		return JobConfigMultiError(errors)
+		return WatchHPAsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobConfigMultiError is an error wrapping multiple validation errors returned
-// by JobConfig.ValidateAll() if the designated constraints aren't met.
-type JobConfigMultiError []error
+// WatchHPAsRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchHPAsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchHPAsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobConfigMultiError) Error() string {
+func (m WatchHPAsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9908,11 +17075,11 @@ func (m JobConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobConfigMultiError) AllErrors() []error { return m }
+func (m WatchHPAsRequestMultiError) AllErrors() []error { return m }
 
-// JobConfigValidationError is the validation error returned by
-// JobConfig.Validate if the designated constraints aren't met.
-type JobConfigValidationError struct {
+// WatchHPAsRequestValidationError is the validation error returned by
+// WatchHPAsRequest.Validate if the designated constraints aren't met.
+type WatchHPAsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9920,22 +17087,22 @@ type JobConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobConfigValidationError) Field() string { return e.field }
+func (e WatchHPAsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobConfigValidationError) Reason() string { return e.reason }
+func (e WatchHPAsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobConfigValidationError) Cause() error { return e.cause }
+func (e WatchHPAsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobConfigValidationError) Key() bool { return e.key }
+func (e WatchHPAsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobConfigValidationError) ErrorName() string { return "JobConfigValidationError" }
+func (e WatchHPAsRequestValidationError) ErrorName() string { return "WatchHPAsRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e JobConfigValidationError) Error() string {
+func (e WatchHPAsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9947,14 +17114,14 @@ func (e JobConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobConfig.%s: %s%s",
+		"invalid %sWatchHPAsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = JobConfigValidationError{}
+var _ error = WatchHPAsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -9962,86 +17129,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobConfigValidationError{}
+} = WatchHPAsRequestValidationError{}
 
-// Validate checks the field values on CreateJobRequest with the rules defined
+// Validate checks the field values on WatchHPAsResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *CreateJobRequest) Validate() error {
+func (m *WatchHPAsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateJobRequest with the rules
+// ValidateAll checks the field values on WatchHPAsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateJobRequestMultiError, or nil if none found.
-func (m *CreateJobRequest) ValidateAll() error {
+// WatchHPAsResponseMultiError, or nil if none found.
+func (m *WatchHPAsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobRequest) validate(all bool) error {
+func (m *WatchHPAsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetClientset()) < 1 {
-		err := CreateJobRequestValidationError{
-			field:  "Clientset",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetCluster()) < 1 {
-		err := CreateJobRequestValidationError{
-			field:  "Cluster",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetNamespace()) < 1 {
-		err := CreateJobRequestValidationError{
-			field:  "Namespace",
-			reason: "value length must be at least 1 bytes",
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchHPAsResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchHPAsResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchHPAsResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if all {
-		switch v := interface{}(m.GetJobConfig()).(type) {
+		switch v := interface{}(m.GetHpa()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "JobConfig",
+				errors = append(errors, WatchHPAsResponseValidationError{
+					field:  "Hpa",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobRequestValidationError{
-					field:  "JobConfig",
+				errors = append(errors, WatchHPAsResponseValidationError{
+					field:  "Hpa",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetJobConfig()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetHpa()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateJobRequestValidationError{
-				field:  "JobConfig",
+			return WatchHPAsResponseValidationError{
+				field:  "Hpa",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -10049,19 +17212,19 @@ func (m *CreateJobRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CreateJobRequestMultiError(errors)
+		return WatchHPAsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateJobRequest.ValidateAll() if the designated constraints
+// WatchHPAsResponseMultiError is an error wrapping multiple validation errors
+// returned by WatchHPAsResponse.ValidateAll() if the designated constraints
 // aren't met.
-type CreateJobRequestMultiError []error
+type WatchHPAsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobRequestMultiError) Error() string {
+func (m WatchHPAsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10070,11 +17233,11 @@ func (m CreateJobRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobRequestMultiError) AllErrors() []error { return m }
+func (m WatchHPAsResponseMultiError) AllErrors() []error { return m }
 
-// CreateJobRequestValidationError is the validation error returned by
-// CreateJobRequest.Validate if the designated constraints aren't met.
-type CreateJobRequestValidationError struct {
+// WatchHPAsResponseValidationError is the validation error returned by
+// WatchHPAsResponse.Validate if the designated constraints aren't met.
+type WatchHPAsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10082,22 +17245,24 @@ type CreateJobRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobRequestValidationError) Field() string { return e.field }
+func (e WatchHPAsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobRequestValidationError) Reason() string { return e.reason }
+func (e WatchHPAsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobRequestValidationError) Cause() error { return e.cause }
+func (e WatchHPAsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobRequestValidationError) Key() bool { return e.key }
+func (e WatchHPAsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobRequestValidationError) ErrorName() string { return "CreateJobRequestValidationError" }
+func (e WatchHPAsResponseValidationError) ErrorName() string {
+	return "WatchHPAsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CreateJobRequestValidationError) Error() string {
+func (e WatchHPAsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10109,14 +17274,14 @@ func (e CreateJobRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobRequest.%s: %s%s",
+		"invalid %sWatchHPAsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobRequestValidationError{}
+var _ error = WatchHPAsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10124,73 +17289,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobRequestValidationError{}
+} = WatchHPAsResponseValidationError{}
 
-// Validate checks the field values on CreateJobResponse with the rules defined
+// Validate checks the field values on WatchNodesRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *CreateJobResponse) Validate() error {
+func (m *WatchNodesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateJobResponse with the rules
+// ValidateAll checks the field values on WatchNodesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateJobResponseMultiError, or nil if none found.
-func (m *CreateJobResponse) ValidateAll() error {
+// WatchNodesRequestMultiError, or nil if none found.
+func (m *WatchNodesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateJobResponse) validate(all bool) error {
+func (m *WatchNodesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Clientset
+
+	// no validation rules for Cluster
+
 	if all {
-		switch v := interface{}(m.GetJob()).(type) {
+		switch v := interface{}(m.GetOptions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CreateJobResponseValidationError{
-					field:  "Job",
+				errors = append(errors, WatchNodesRequestValidationError{
+					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CreateJobResponseValidationError{
-					field:  "Job",
+				errors = append(errors, WatchNodesRequestValidationError{
+					field:  "Options",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CreateJobResponseValidationError{
-				field:  "Job",
+			return WatchNodesRequestValidationError{
+				field:  "Options",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for ResourceVersions
+
 	if len(errors) > 0 {
-		return CreateJobResponseMultiError(errors)
+		return WatchNodesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateJobResponseMultiError is an error wrapping multiple validation errors
-// returned by CreateJobResponse.ValidateAll() if the designated constraints
+// WatchNodesRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchNodesRequest.ValidateAll() if the designated constraints
 // aren't met.
-type CreateJobResponseMultiError []error
+type WatchNodesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateJobResponseMultiError) Error() string {
+func (m WatchNodesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10199,11 +17370,11 @@ func (m CreateJobResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateJobResponseMultiError) AllErrors() []error { return m }
+func (m WatchNodesRequestMultiError) AllErrors() []error { return m }
 
-// CreateJobResponseValidationError is the validation error returned by
-// CreateJobResponse.Validate if the designated constraints aren't met.
-type CreateJobResponseValidationError struct {
+// WatchNodesRequestValidationError is the validation error returned by
+// WatchNodesRequest.Validate if the designated constraints aren't met.
+type WatchNodesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10211,24 +17382,24 @@ type CreateJobResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateJobResponseValidationError) Field() string { return e.field }
+func (e WatchNodesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateJobResponseValidationError) Reason() string { return e.reason }
+func (e WatchNodesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateJobResponseValidationError) Cause() error { return e.cause }
+func (e WatchNodesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateJobResponseValidationError) Key() bool { return e.key }
+func (e WatchNodesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateJobResponseValidationError) ErrorName() string {
-	return "CreateJobResponseValidationError"
+func (e WatchNodesRequestValidationError) ErrorName() string {
+	return "WatchNodesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateJobResponseValidationError) Error() string {
+func (e WatchNodesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10240,14 +17411,14 @@ func (e CreateJobResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateJobResponse.%s: %s%s",
+		"invalid %sWatchNodesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateJobResponseValidationError{}
+var _ error = WatchNodesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10255,51 +17426,102 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateJobResponseValidationError{}
+} = WatchNodesRequestValidationError{}
 
-// Validate checks the field values on Namespace with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Namespace) Validate() error {
+// Validate checks the field values on WatchNodesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchNodesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Namespace with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in NamespaceMultiError, or nil
-// if none found.
-func (m *Namespace) ValidateAll() error {
+// ValidateAll checks the field values on WatchNodesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchNodesResponseMultiError, or nil if none found.
+func (m *WatchNodesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Namespace) validate(all bool) error {
+func (m *WatchNodesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Cluster
-
-	// no validation rules for Name
-
-	// no validation rules for Annotations
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchNodesResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchNodesResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchNodesResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Labels
+	if all {
+		switch v := interface{}(m.GetNode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchNodesResponseValidationError{
+					field:  "Node",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchNodesResponseValidationError{
+					field:  "Node",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchNodesResponseValidationError{
+				field:  "Node",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return NamespaceMultiError(errors)
+		return WatchNodesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// NamespaceMultiError is an error wrapping multiple validation errors returned
-// by Namespace.ValidateAll() if the designated constraints aren't met.
-type NamespaceMultiError []error
+// WatchNodesResponseMultiError is an error wrapping multiple validation errors
+// returned by WatchNodesResponse.ValidateAll() if the designated constraints
+// aren't met.
+type WatchNodesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NamespaceMultiError) Error() string {
+func (m WatchNodesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10308,11 +17530,11 @@ func (m NamespaceMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NamespaceMultiError) AllErrors() []error { return m }
+func (m WatchNodesResponseMultiError) AllErrors() []error { return m }
 
-// NamespaceValidationError is the validation error returned by
-// Namespace.Validate if the designated constraints aren't met.
-type NamespaceValidationError struct {
+// WatchNodesResponseValidationError is the validation error returned by
+// WatchNodesResponse.Validate if the designated constraints aren't met.
+type WatchNodesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10320,22 +17542,24 @@ type NamespaceValidationError struct {
 }
 
 // Field function returns field value.
-func (e NamespaceValidationError) Field() string { return e.field }
+func (e WatchNodesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NamespaceValidationError) Reason() string { return e.reason }
+func (e WatchNodesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NamespaceValidationError) Cause() error { return e.cause }
+func (e WatchNodesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NamespaceValidationError) Key() bool { return e.key }
+func (e WatchNodesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NamespaceValidationError) ErrorName() string { return "NamespaceValidationError" }
+func (e WatchNodesResponseValidationError) ErrorName() string {
+	return "WatchNodesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e NamespaceValidationError) Error() string {
+func (e WatchNodesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10347,14 +17571,14 @@ func (e NamespaceValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNamespace.%s: %s%s",
+		"invalid %sWatchNodesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NamespaceValidationError{}
+var _ error = WatchNodesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10362,45 +17586,42 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NamespaceValidationError{}
+} = WatchNodesResponseValidationError{}
 
-// Validate checks the field values on DescribeNamespaceRequest with the rules
+// Validate checks the field values on WatchEventsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DescribeNamespaceRequest) Validate() error {
+func (m *WatchEventsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DescribeNamespaceRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on WatchEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DescribeNamespaceRequestMultiError, or nil if none found.
-func (m *DescribeNamespaceRequest) ValidateAll() error {
+// WatchEventsRequestMultiError, or nil if none found.
+func (m *WatchEventsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DescribeNamespaceRequest) validate(all bool) error {
+func (m *WatchEventsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetClientset()) < 1 {
-		err := DescribeNamespaceRequestValidationError{
-			field:  "Clientset",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Clientset
 
-	if len(m.GetCluster()) < 1 {
-		err := DescribeNamespaceRequestValidationError{
-			field:  "Cluster",
-			reason: "value length must be at least 1 bytes",
+	// no validation rules for Cluster
+
+	// no validation rules for Namespace
+
+	// no validation rules for ObjectName
+
+	if _, ok := ObjectKind_name[int32(m.GetKind())]; !ok {
+		err := WatchEventsRequestValidationError{
+			field:  "Kind",
+			reason: "value must be one of the defined enum values",
 		}
 		if !all {
 			return err
@@ -10408,31 +17629,51 @@ func (m *DescribeNamespaceRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetName()) < 1 {
-		err := DescribeNamespaceRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 bytes",
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchEventsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchEventsRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchEventsRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
+	// no validation rules for ResourceVersions
+
 	if len(errors) > 0 {
-		return DescribeNamespaceRequestMultiError(errors)
+		return WatchEventsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DescribeNamespaceRequestMultiError is an error wrapping multiple validation
-// errors returned by DescribeNamespaceRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DescribeNamespaceRequestMultiError []error
+// WatchEventsRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchEventsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchEventsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DescribeNamespaceRequestMultiError) Error() string {
+func (m WatchEventsRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10441,11 +17682,11 @@ func (m DescribeNamespaceRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DescribeNamespaceRequestMultiError) AllErrors() []error { return m }
+func (m WatchEventsRequestMultiError) AllErrors() []error { return m }
 
-// DescribeNamespaceRequestValidationError is the validation error returned by
-// DescribeNamespaceRequest.Validate if the designated constraints aren't met.
-type DescribeNamespaceRequestValidationError struct {
+// WatchEventsRequestValidationError is the validation error returned by
+// WatchEventsRequest.Validate if the designated constraints aren't met.
+type WatchEventsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10453,24 +17694,24 @@ type DescribeNamespaceRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeNamespaceRequestValidationError) Field() string { return e.field }
+func (e WatchEventsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeNamespaceRequestValidationError) Reason() string { return e.reason }
+func (e WatchEventsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeNamespaceRequestValidationError) Cause() error { return e.cause }
+func (e WatchEventsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeNamespaceRequestValidationError) Key() bool { return e.key }
+func (e WatchEventsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeNamespaceRequestValidationError) ErrorName() string {
-	return "DescribeNamespaceRequestValidationError"
+func (e WatchEventsRequestValidationError) ErrorName() string {
+	return "WatchEventsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeNamespaceRequestValidationError) Error() string {
+func (e WatchEventsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10482,14 +17723,14 @@ func (e DescribeNamespaceRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeNamespaceRequest.%s: %s%s",
+		"invalid %sWatchEventsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeNamespaceRequestValidationError{}
+var _ error = WatchEventsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10497,24 +17738,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeNamespaceRequestValidationError{}
+} = WatchEventsRequestValidationError{}
 
-// Validate checks the field values on DescribeNamespaceResponse with the rules
+// Validate checks the field values on WatchEventsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DescribeNamespaceResponse) Validate() error {
+func (m *WatchEventsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DescribeNamespaceResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on WatchEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DescribeNamespaceResponseMultiError, or nil if none found.
-func (m *DescribeNamespaceResponse) ValidateAll() error {
+// WatchEventsResponseMultiError, or nil if none found.
+func (m *WatchEventsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DescribeNamespaceResponse) validate(all bool) error {
+func (m *WatchEventsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -10522,28 +17763,57 @@ func (m *DescribeNamespaceResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetNamespace()).(type) {
+		switch v := interface{}(m.GetEvent()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DescribeNamespaceResponseValidationError{
-					field:  "Namespace",
+				errors = append(errors, WatchEventsResponseValidationError{
+					field:  "Event",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DescribeNamespaceResponseValidationError{
-					field:  "Namespace",
+				errors = append(errors, WatchEventsResponseValidationError{
+					field:  "Event",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNamespace()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DescribeNamespaceResponseValidationError{
-				field:  "Namespace",
+			return WatchEventsResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetK8SEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchEventsResponseValidationError{
+					field:  "K8SEvent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchEventsResponseValidationError{
+					field:  "K8SEvent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetK8SEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchEventsResponseValidationError{
+				field:  "K8SEvent",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -10551,19 +17821,19 @@ func (m *DescribeNamespaceResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DescribeNamespaceResponseMultiError(errors)
+		return WatchEventsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DescribeNamespaceResponseMultiError is an error wrapping multiple validation
-// errors returned by DescribeNamespaceResponse.ValidateAll() if the
-// designated constraints aren't met.
-type DescribeNamespaceResponseMultiError []error
+// WatchEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchEventsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DescribeNamespaceResponseMultiError) Error() string {
+func (m WatchEventsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10572,11 +17842,11 @@ func (m DescribeNamespaceResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DescribeNamespaceResponseMultiError) AllErrors() []error { return m }
+func (m WatchEventsResponseMultiError) AllErrors() []error { return m }
 
-// DescribeNamespaceResponseValidationError is the validation error returned by
-// DescribeNamespaceResponse.Validate if the designated constraints aren't met.
-type DescribeNamespaceResponseValidationError struct {
+// WatchEventsResponseValidationError is the validation error returned by
+// WatchEventsResponse.Validate if the designated constraints aren't met.
+type WatchEventsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10584,24 +17854,24 @@ type DescribeNamespaceResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeNamespaceResponseValidationError) Field() string { return e.field }
+func (e WatchEventsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeNamespaceResponseValidationError) Reason() string { return e.reason }
+func (e WatchEventsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeNamespaceResponseValidationError) Cause() error { return e.cause }
+func (e WatchEventsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeNamespaceResponseValidationError) Key() bool { return e.key }
+func (e WatchEventsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeNamespaceResponseValidationError) ErrorName() string {
-	return "DescribeNamespaceResponseValidationError"
+func (e WatchEventsResponseValidationError) ErrorName() string {
+	return "WatchEventsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeNamespaceResponseValidationError) Error() string {
+func (e WatchEventsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10613,14 +17883,14 @@ func (e DescribeNamespaceResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeNamespaceResponse.%s: %s%s",
+		"invalid %sWatchEventsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeNamespaceResponseValidationError{}
+var _ error = WatchEventsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -10628,58 +17898,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeNamespaceResponseValidationError{}
+} = WatchEventsResponseValidationError{}
 
-// Validate checks the field values on Event with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on APIResource with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Event) Validate() error {
+func (m *APIResource) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Event with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in EventMultiError, or nil if none found.
-func (m *Event) ValidateAll() error {
+// ValidateAll checks the field values on APIResource with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in APIResourceMultiError, or
+// nil if none found.
+func (m *APIResource) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Event) validate(all bool) error {
+func (m *APIResource) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
-	// no validation rules for Reason
-
-	// no validation rules for Description
-
-	// no validation rules for Cluster
-
-	// no validation rules for Namespace
+	// no validation rules for Group
 
-	// no validation rules for InvolvedObjectName
+	// no validation rules for Version
 
 	// no validation rules for Kind
 
-	// no validation rules for CreationTimeMillis
+	// no validation rules for Resource
+
+	// no validation rules for Namespaced
 
 	if len(errors) > 0 {
-		return EventMultiError(errors)
+		return APIResourceMultiError(errors)
 	}
 
 	return nil
 }
 
-// EventMultiError is an error wrapping multiple validation errors returned by
-// Event.ValidateAll() if the designated constraints aren't met.
-type EventMultiError []error
+// APIResourceMultiError is an error wrapping multiple validation errors
+// returned by APIResource.ValidateAll() if the designated constraints aren't met.
+type APIResourceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m EventMultiError) Error() string {
+func (m APIResourceMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10688,11 +17953,11 @@ func (m EventMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m EventMultiError) AllErrors() []error { return m }
+func (m APIResourceMultiError) AllErrors() []error { return m }
 
-// EventValidationError is the validation error returned by Event.Validate if
-// the designated constraints aren't met.
-type EventValidationError struct {
+// APIResourceValidationError is the validation error returned by
+// APIResource.Validate if the designated constraints aren't met.
+type APIResourceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10700,22 +17965,22 @@ type EventValidationError struct {
 }
 
 // Field function returns field value.
-func (e EventValidationError) Field() string { return e.field }
+func (e APIResourceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e EventValidationError) Reason() string { return e.reason }
+func (e APIResourceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e EventValidationError) Cause() error { return e.cause }
+func (e APIResourceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e EventValidationError) Key() bool { return e.key }
+func (e APIResourceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e EventValidationError) ErrorName() string { return "EventValidationError" }
+func (e APIResourceValidationError) ErrorName() string { return "APIResourceValidationError" }
 
 // Error satisfies the builtin error interface
-func (e EventValidationError) Error() string {
+func (e APIResourceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10727,14 +17992,14 @@ func (e EventValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sEvent.%s: %s%s",
+		"invalid %sAPIResource.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = EventValidationError{}
+var _ error = APIResourceValidationError{}
 
 var _ interface {
 	Field() string
@@ -10742,66 +18007,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = EventValidationError{}
+} = APIResourceValidationError{}
 
-// Validate checks the field values on ListEventsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListEventsRequest) Validate() error {
+// Validate checks the field values on ListAPIResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAPIResourcesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListEventsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAPIResourcesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListEventsRequestMultiError, or nil if none found.
-func (m *ListEventsRequest) ValidateAll() error {
+// ListAPIResourcesRequestMultiError, or nil if none found.
+func (m *ListAPIResourcesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListEventsRequest) validate(all bool) error {
+func (m *ListAPIResourcesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	if len(m.GetClientset()) < 1 {
-		err := ListEventsRequestValidationError{
-			field:  "Clientset",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetCluster()) < 1 {
-		err := ListEventsRequestValidationError{
-			field:  "Cluster",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetNamespace()) < 1 {
-		err := ListEventsRequestValidationError{
-			field:  "Namespace",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(m.GetObjectName()) < 1 {
-		err := ListEventsRequestValidationError{
-			field:  "ObjectName",
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := ListAPIResourcesRequestValidationError{
+			field:  "Clientset",
 			reason: "value length must be at least 1 bytes",
 		}
 		if !all {
@@ -10810,10 +18042,10 @@ func (m *ListEventsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := ObjectKind_name[int32(m.GetKind())]; !ok {
-		err := ListEventsRequestValidationError{
-			field:  "Kind",
-			reason: "value must be one of the defined enum values",
+	if len(m.GetCluster()) < 1 {
+		err := ListAPIResourcesRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
 		}
 		if !all {
 			return err
@@ -10822,19 +18054,19 @@ func (m *ListEventsRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListEventsRequestMultiError(errors)
+		return ListAPIResourcesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListEventsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListEventsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListEventsRequestMultiError []error
+// ListAPIResourcesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAPIResourcesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAPIResourcesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListEventsRequestMultiError) Error() string {
+func (m ListAPIResourcesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10843,11 +18075,11 @@ func (m ListEventsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListEventsRequestMultiError) AllErrors() []error { return m }
+func (m ListAPIResourcesRequestMultiError) AllErrors() []error { return m }
 
-// ListEventsRequestValidationError is the validation error returned by
-// ListEventsRequest.Validate if the designated constraints aren't met.
-type ListEventsRequestValidationError struct {
+// ListAPIResourcesRequestValidationError is the validation error returned by
+// ListAPIResourcesRequest.Validate if the designated constraints aren't met.
+type ListAPIResourcesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10855,24 +18087,24 @@ type ListEventsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListEventsRequestValidationError) Field() string { return e.field }
+func (e ListAPIResourcesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListEventsRequestValidationError) Reason() string { return e.reason }
+func (e ListAPIResourcesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListEventsRequestValidationError) Cause() error { return e.cause }
+func (e ListAPIResourcesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListEventsRequestValidationError) Key() bool { return e.key }
+func (e ListAPIResourcesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListEventsRequestValidationError) ErrorName() string {
-	return "ListEventsRequestValidationError"
+func (e ListAPIResourcesRequestValidationError) ErrorName() string {
+	return "ListAPIResourcesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListEventsRequestValidationError) Error() string {
+func (e ListAPIResourcesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10884,14 +18116,14 @@ func (e ListEventsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListEventsRequest.%s: %s%s",
+		"invalid %sListAPIResourcesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListEventsRequestValidationError{}
+var _ error = ListAPIResourcesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -10899,47 +18131,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListEventsRequestValidationError{}
+} = ListAPIResourcesRequestValidationError{}
 
-// Validate checks the field values on ListEventsResponse with the rules
+// Validate checks the field values on ListAPIResourcesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListEventsResponse) Validate() error {
+func (m *ListAPIResourcesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListEventsResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListAPIResourcesResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListEventsResponseMultiError, or nil if none found.
-func (m *ListEventsResponse) ValidateAll() error {
+// ListAPIResourcesResponseMultiError, or nil if none found.
+func (m *ListAPIResourcesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListEventsResponse) validate(all bool) error {
+func (m *ListAPIResourcesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetEvents() {
+	for idx, item := range m.GetResources() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListEventsResponseValidationError{
-						field:  fmt.Sprintf("Events[%v]", idx),
+					errors = append(errors, ListAPIResourcesResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListEventsResponseValidationError{
-						field:  fmt.Sprintf("Events[%v]", idx),
+					errors = append(errors, ListAPIResourcesResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -10947,8 +18179,8 @@ func (m *ListEventsResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListEventsResponseValidationError{
-					field:  fmt.Sprintf("Events[%v]", idx),
+				return ListAPIResourcesResponseValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -10958,19 +18190,19 @@ func (m *ListEventsResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ListEventsResponseMultiError(errors)
+		return ListAPIResourcesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListEventsResponseMultiError is an error wrapping multiple validation errors
-// returned by ListEventsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListEventsResponseMultiError []error
+// ListAPIResourcesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAPIResourcesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAPIResourcesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListEventsResponseMultiError) Error() string {
+func (m ListAPIResourcesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10979,11 +18211,11 @@ func (m ListEventsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListEventsResponseMultiError) AllErrors() []error { return m }
+func (m ListAPIResourcesResponseMultiError) AllErrors() []error { return m }
 
-// ListEventsResponseValidationError is the validation error returned by
-// ListEventsResponse.Validate if the designated constraints aren't met.
-type ListEventsResponseValidationError struct {
+// ListAPIResourcesResponseValidationError is the validation error returned by
+// ListAPIResourcesResponse.Validate if the designated constraints aren't met.
+type ListAPIResourcesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10991,24 +18223,24 @@ type ListEventsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListEventsResponseValidationError) Field() string { return e.field }
+func (e ListAPIResourcesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListEventsResponseValidationError) Reason() string { return e.reason }
+func (e ListAPIResourcesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListEventsResponseValidationError) Cause() error { return e.cause }
+func (e ListAPIResourcesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListEventsResponseValidationError) Key() bool { return e.key }
+func (e ListAPIResourcesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListEventsResponseValidationError) ErrorName() string {
-	return "ListEventsResponseValidationError"
+func (e ListAPIResourcesResponseValidationError) ErrorName() string {
+	return "ListAPIResourcesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListEventsResponseValidationError) Error() string {
+func (e ListAPIResourcesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11020,14 +18252,14 @@ func (e ListEventsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListEventsResponse.%s: %s%s",
+		"invalid %sListAPIResourcesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListEventsResponseValidationError{}
+var _ error = ListAPIResourcesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11035,73 +18267,243 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListEventsResponseValidationError{}
+} = ListAPIResourcesResponseValidationError{}
 
-// Validate checks the field values on NullableString with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on Resource with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *NullableString) Validate() error {
+func (m *Resource) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on NullableString with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in NullableStringMultiError,
-// or nil if none found.
-func (m *NullableString) ValidateAll() error {
+// ValidateAll checks the field values on Resource with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ResourceMultiError, or nil
+// if none found.
+func (m *Resource) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *NullableString) validate(all bool) error {
+func (m *Resource) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Kind.(type) {
-	case *NullableString_Null:
-		if v == nil {
-			err := NullableStringValidationError{
-				field:  "Kind",
-				reason: "oneof value cannot be a typed-nil",
+	// no validation rules for Cluster
+
+	// no validation rules for Namespace
+
+	// no validation rules for Name
+
+	// no validation rules for Group
+
+	// no validation rules for Version
+
+	// no validation rules for Kind
+
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourceValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourceValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			errors = append(errors, err)
 		}
-		// no validation rules for Null
-	case *NullableString_Value:
-		if v == nil {
-			err := NullableStringValidationError{
-				field:  "Kind",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourceValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
-		// no validation rules for Value
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return NullableStringMultiError(errors)
+		return ResourceMultiError(errors)
 	}
 
 	return nil
 }
 
-// NullableStringMultiError is an error wrapping multiple validation errors
-// returned by NullableString.ValidateAll() if the designated constraints
-// aren't met.
-type NullableStringMultiError []error
+// ResourceMultiError is an error wrapping multiple validation errors returned
+// by Resource.ValidateAll() if the designated constraints aren't met.
+type ResourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceMultiError) AllErrors() []error { return m }
+
+// ResourceValidationError is the validation error returned by
+// Resource.Validate if the designated constraints aren't met.
+type ResourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceValidationError) ErrorName() string { return "ResourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceValidationError{}
+
+// Validate checks the field values on DescribeResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeResourceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeResourceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeResourceRequestMultiError, or nil if none found.
+func (m *DescribeResourceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeResourceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetClientset()) < 1 {
+		err := DescribeResourceRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetCluster()) < 1 {
+		err := DescribeResourceRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Namespace
+
+	// no validation rules for Group
+
+	if len(m.GetVersion()) < 1 {
+		err := DescribeResourceRequestValidationError{
+			field:  "Version",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetKind()) < 1 {
+		err := DescribeResourceRequestValidationError{
+			field:  "Kind",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := DescribeResourceRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DescribeResourceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeResourceRequestMultiError is an error wrapping multiple validation
+// errors returned by DescribeResourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeResourceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NullableStringMultiError) Error() string {
+func (m DescribeResourceRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11110,11 +18512,11 @@ func (m NullableStringMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NullableStringMultiError) AllErrors() []error { return m }
+func (m DescribeResourceRequestMultiError) AllErrors() []error { return m }
 
-// NullableStringValidationError is the validation error returned by
-// NullableString.Validate if the designated constraints aren't met.
-type NullableStringValidationError struct {
+// DescribeResourceRequestValidationError is the validation error returned by
+// DescribeResourceRequest.Validate if the designated constraints aren't met.
+type DescribeResourceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11122,22 +18524,24 @@ type NullableStringValidationError struct {
 }
 
 // Field function returns field value.
-func (e NullableStringValidationError) Field() string { return e.field }
+func (e DescribeResourceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NullableStringValidationError) Reason() string { return e.reason }
+func (e DescribeResourceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NullableStringValidationError) Cause() error { return e.cause }
+func (e DescribeResourceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NullableStringValidationError) Key() bool { return e.key }
+func (e DescribeResourceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NullableStringValidationError) ErrorName() string { return "NullableStringValidationError" }
+func (e DescribeResourceRequestValidationError) ErrorName() string {
+	return "DescribeResourceRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e NullableStringValidationError) Error() string {
+func (e DescribeResourceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11149,14 +18553,14 @@ func (e NullableStringValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNullableString.%s: %s%s",
+		"invalid %sDescribeResourceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NullableStringValidationError{}
+var _ error = DescribeResourceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11164,154 +18568,73 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NullableStringValidationError{}
+} = DescribeResourceRequestValidationError{}
 
-// Validate checks the field values on ExpectedObjectMetaFields with the rules
+// Validate checks the field values on DescribeResourceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ExpectedObjectMetaFields) Validate() error {
+func (m *DescribeResourceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExpectedObjectMetaFields with the
+// ValidateAll checks the field values on DescribeResourceResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ExpectedObjectMetaFieldsMultiError, or nil if none found.
-func (m *ExpectedObjectMetaFields) ValidateAll() error {
+// DescribeResourceResponseMultiError, or nil if none found.
+func (m *DescribeResourceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExpectedObjectMetaFields) validate(all bool) error {
+func (m *DescribeResourceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetLabels()))
-		i := 0
-		for key := range m.GetLabels() {
-			sorted_keys[i] = key
-			i++
-		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetLabels()[key]
-			_ = val
-
-			if len(key) < 1 {
-				err := ExpectedObjectMetaFieldsValidationError{
-					field:  fmt.Sprintf("Labels[%v]", key),
-					reason: "value length must be at least 1 bytes",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DescribeResourceResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, ExpectedObjectMetaFieldsValidationError{
-							field:  fmt.Sprintf("Labels[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, ExpectedObjectMetaFieldsValidationError{
-							field:  fmt.Sprintf("Labels[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return ExpectedObjectMetaFieldsValidationError{
-						field:  fmt.Sprintf("Labels[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DescribeResourceResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-
-		}
-	}
-
-	{
-		sorted_keys := make([]string, len(m.GetAnnotations()))
-		i := 0
-		for key := range m.GetAnnotations() {
-			sorted_keys[i] = key
-			i++
 		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetAnnotations()[key]
-			_ = val
-
-			if len(key) < 1 {
-				err := ExpectedObjectMetaFieldsValidationError{
-					field:  fmt.Sprintf("Annotations[%v]", key),
-					reason: "value length must be at least 1 bytes",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
-			if all {
-				switch v := interface{}(val).(type) {
-				case interface{ ValidateAll() error }:
-					if err := v.ValidateAll(); err != nil {
-						errors = append(errors, ExpectedObjectMetaFieldsValidationError{
-							field:  fmt.Sprintf("Annotations[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				case interface{ Validate() error }:
-					if err := v.Validate(); err != nil {
-						errors = append(errors, ExpectedObjectMetaFieldsValidationError{
-							field:  fmt.Sprintf("Annotations[%v]", key),
-							reason: "embedded message failed validation",
-							cause:  err,
-						})
-					}
-				}
-			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
-				if err := v.Validate(); err != nil {
-					return ExpectedObjectMetaFieldsValidationError{
-						field:  fmt.Sprintf("Annotations[%v]", key),
-						reason: "embedded message failed validation",
-						cause:  err,
-					}
-				}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeResourceResponseValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-
 		}
 	}
 
 	if len(errors) > 0 {
-		return ExpectedObjectMetaFieldsMultiError(errors)
+		return DescribeResourceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExpectedObjectMetaFieldsMultiError is an error wrapping multiple validation
-// errors returned by ExpectedObjectMetaFields.ValidateAll() if the designated
+// DescribeResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by DescribeResourceResponse.ValidateAll() if the designated
 // constraints aren't met.
-type ExpectedObjectMetaFieldsMultiError []error
+type DescribeResourceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExpectedObjectMetaFieldsMultiError) Error() string {
+func (m DescribeResourceResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11320,11 +18643,11 @@ func (m ExpectedObjectMetaFieldsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExpectedObjectMetaFieldsMultiError) AllErrors() []error { return m }
+func (m DescribeResourceResponseMultiError) AllErrors() []error { return m }
 
-// ExpectedObjectMetaFieldsValidationError is the validation error returned by
-// ExpectedObjectMetaFields.Validate if the designated constraints aren't met.
-type ExpectedObjectMetaFieldsValidationError struct {
+// DescribeResourceResponseValidationError is the validation error returned by
+// DescribeResourceResponse.Validate if the designated constraints aren't met.
+type DescribeResourceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11332,24 +18655,24 @@ type ExpectedObjectMetaFieldsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExpectedObjectMetaFieldsValidationError) Field() string { return e.field }
+func (e DescribeResourceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExpectedObjectMetaFieldsValidationError) Reason() string { return e.reason }
+func (e DescribeResourceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExpectedObjectMetaFieldsValidationError) Cause() error { return e.cause }
+func (e DescribeResourceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExpectedObjectMetaFieldsValidationError) Key() bool { return e.key }
+func (e DescribeResourceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExpectedObjectMetaFieldsValidationError) ErrorName() string {
-	return "ExpectedObjectMetaFieldsValidationError"
+func (e DescribeResourceResponseValidationError) ErrorName() string {
+	return "DescribeResourceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ExpectedObjectMetaFieldsValidationError) Error() string {
+func (e DescribeResourceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11361,14 +18684,14 @@ func (e ExpectedObjectMetaFieldsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExpectedObjectMetaFields.%s: %s%s",
+		"invalid %sDescribeResourceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ExpectedObjectMetaFieldsValidationError{}
+var _ error = DescribeResourceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11376,98 +18699,121 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExpectedObjectMetaFieldsValidationError{}
+} = DescribeResourceResponseValidationError{}
 
-// Validate checks the field values on ObjectMetaFields with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ObjectMetaFields) Validate() error {
+// Validate checks the field values on ListResourcesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListResourcesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ObjectMetaFields with the rules
+// ValidateAll checks the field values on ListResourcesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ObjectMetaFieldsMultiError, or nil if none found.
-func (m *ObjectMetaFields) ValidateAll() error {
+// ListResourcesRequestMultiError, or nil if none found.
+func (m *ListResourcesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ObjectMetaFields) validate(all bool) error {
+func (m *ListResourcesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	{
-		sorted_keys := make([]string, len(m.GetLabels()))
-		i := 0
-		for key := range m.GetLabels() {
-			sorted_keys[i] = key
-			i++
+	if len(m.GetClientset()) < 1 {
+		err := ListResourcesRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
 		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetLabels()[key]
-			_ = val
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-			if len(key) < 1 {
-				err := ObjectMetaFieldsValidationError{
-					field:  fmt.Sprintf("Labels[%v]", key),
-					reason: "value length must be at least 1 bytes",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
+	if len(m.GetCluster()) < 1 {
+		err := ListResourcesRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-			// no validation rules for Labels[key]
+	// no validation rules for Namespace
+
+	// no validation rules for Group
+
+	if len(m.GetVersion()) < 1 {
+		err := ListResourcesRequestValidationError{
+			field:  "Version",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	{
-		sorted_keys := make([]string, len(m.GetAnnotations()))
-		i := 0
-		for key := range m.GetAnnotations() {
-			sorted_keys[i] = key
-			i++
+	if len(m.GetKind()) < 1 {
+		err := ListResourcesRequestValidationError{
+			field:  "Kind",
+			reason: "value length must be at least 1 bytes",
 		}
-		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
-		for _, key := range sorted_keys {
-			val := m.GetAnnotations()[key]
-			_ = val
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-			if len(key) < 1 {
-				err := ObjectMetaFieldsValidationError{
-					field:  fmt.Sprintf("Annotations[%v]", key),
-					reason: "value length must be at least 1 bytes",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
+	if all {
+		switch v := interface{}(m.GetOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListResourcesRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListResourcesRequestValidationError{
+					field:  "Options",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListResourcesRequestValidationError{
+				field:  "Options",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-
-			// no validation rules for Annotations[key]
 		}
 	}
 
 	if len(errors) > 0 {
-		return ObjectMetaFieldsMultiError(errors)
+		return ListResourcesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ObjectMetaFieldsMultiError is an error wrapping multiple validation errors
-// returned by ObjectMetaFields.ValidateAll() if the designated constraints
-// aren't met.
-type ObjectMetaFieldsMultiError []error
+// ListResourcesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListResourcesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListResourcesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ObjectMetaFieldsMultiError) Error() string {
+func (m ListResourcesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11476,11 +18822,11 @@ func (m ObjectMetaFieldsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ObjectMetaFieldsMultiError) AllErrors() []error { return m }
+func (m ListResourcesRequestMultiError) AllErrors() []error { return m }
 
-// ObjectMetaFieldsValidationError is the validation error returned by
-// ObjectMetaFields.Validate if the designated constraints aren't met.
-type ObjectMetaFieldsValidationError struct {
+// ListResourcesRequestValidationError is the validation error returned by
+// ListResourcesRequest.Validate if the designated constraints aren't met.
+type ListResourcesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11488,22 +18834,24 @@ type ObjectMetaFieldsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ObjectMetaFieldsValidationError) Field() string { return e.field }
+func (e ListResourcesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ObjectMetaFieldsValidationError) Reason() string { return e.reason }
+func (e ListResourcesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ObjectMetaFieldsValidationError) Cause() error { return e.cause }
+func (e ListResourcesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ObjectMetaFieldsValidationError) Key() bool { return e.key }
+func (e ListResourcesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ObjectMetaFieldsValidationError) ErrorName() string { return "ObjectMetaFieldsValidationError" }
+func (e ListResourcesRequestValidationError) ErrorName() string {
+	return "ListResourcesRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ObjectMetaFieldsValidationError) Error() string {
+func (e ListResourcesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11515,14 +18863,14 @@ func (e ObjectMetaFieldsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sObjectMetaFields.%s: %s%s",
+		"invalid %sListResourcesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ObjectMetaFieldsValidationError{}
+var _ error = ListResourcesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11530,86 +18878,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ObjectMetaFieldsValidationError{}
+} = ListResourcesRequestValidationError{}
 
-// Validate checks the field values on RemoveObjectMetaFields with the rules
+// Validate checks the field values on ListResourcesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RemoveObjectMetaFields) Validate() error {
+func (m *ListResourcesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RemoveObjectMetaFields with the rules
+// ValidateAll checks the field values on ListResourcesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RemoveObjectMetaFieldsMultiError, or nil if none found.
-func (m *RemoveObjectMetaFields) ValidateAll() error {
+// ListResourcesResponseMultiError, or nil if none found.
+func (m *ListResourcesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RemoveObjectMetaFields) validate(all bool) error {
+func (m *ListResourcesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	_RemoveObjectMetaFields_Labels_Unique := make(map[string]struct{}, len(m.GetLabels()))
-
-	for idx, item := range m.GetLabels() {
-		_, _ = idx, item
-
-		if _, exists := _RemoveObjectMetaFields_Labels_Unique[item]; exists {
-			err := RemoveObjectMetaFieldsValidationError{
-				field:  fmt.Sprintf("Labels[%v]", idx),
-				reason: "repeated value must contain unique items",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-			_RemoveObjectMetaFields_Labels_Unique[item] = struct{}{}
-		}
-
-		// no validation rules for Labels[idx]
-	}
-
-	_RemoveObjectMetaFields_Annotations_Unique := make(map[string]struct{}, len(m.GetAnnotations()))
-
-	for idx, item := range m.GetAnnotations() {
+	for idx, item := range m.GetResources() {
 		_, _ = idx, item
 
-		if _, exists := _RemoveObjectMetaFields_Annotations_Unique[item]; exists {
-			err := RemoveObjectMetaFieldsValidationError{
-				field:  fmt.Sprintf("Annotations[%v]", idx),
-				reason: "repeated value must contain unique items",
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListResourcesResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListResourcesResponseValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-			if !all {
-				return err
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListResourcesResponseValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
-			errors = append(errors, err)
-		} else {
-			_RemoveObjectMetaFields_Annotations_Unique[item] = struct{}{}
 		}
 
-		// no validation rules for Annotations[idx]
 	}
 
 	if len(errors) > 0 {
-		return RemoveObjectMetaFieldsMultiError(errors)
+		return ListResourcesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RemoveObjectMetaFieldsMultiError is an error wrapping multiple validation
-// errors returned by RemoveObjectMetaFields.ValidateAll() if the designated
+// ListResourcesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListResourcesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type RemoveObjectMetaFieldsMultiError []error
+type ListResourcesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RemoveObjectMetaFieldsMultiError) Error() string {
+func (m ListResourcesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11618,11 +18958,11 @@ func (m RemoveObjectMetaFieldsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RemoveObjectMetaFieldsMultiError) AllErrors() []error { return m }
+func (m ListResourcesResponseMultiError) AllErrors() []error { return m }
 
-// RemoveObjectMetaFieldsValidationError is the validation error returned by
-// RemoveObjectMetaFields.Validate if the designated constraints aren't met.
-type RemoveObjectMetaFieldsValidationError struct {
+// ListResourcesResponseValidationError is the validation error returned by
+// ListResourcesResponse.Validate if the designated constraints aren't met.
+type ListResourcesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11630,24 +18970,24 @@ type RemoveObjectMetaFieldsValidationError struct {
 }
 
 // Field function returns field value.
-func (e RemoveObjectMetaFieldsValidationError) Field() string { return e.field }
+func (e ListResourcesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RemoveObjectMetaFieldsValidationError) Reason() string { return e.reason }
+func (e ListResourcesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RemoveObjectMetaFieldsValidationError) Cause() error { return e.cause }
+func (e ListResourcesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RemoveObjectMetaFieldsValidationError) Key() bool { return e.key }
+func (e ListResourcesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RemoveObjectMetaFieldsValidationError) ErrorName() string {
-	return "RemoveObjectMetaFieldsValidationError"
+func (e ListResourcesResponseValidationError) ErrorName() string {
+	return "ListResourcesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RemoveObjectMetaFieldsValidationError) Error() string {
+func (e ListResourcesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11659,14 +18999,14 @@ func (e RemoveObjectMetaFieldsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRemoveObjectMetaFields.%s: %s%s",
+		"invalid %sListResourcesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RemoveObjectMetaFieldsValidationError{}
+var _ error = ListResourcesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -11674,48 +19014,149 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RemoveObjectMetaFieldsValidationError{}
+} = ListResourcesResponseValidationError{}
 
-// Validate checks the field values on Node with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Node) Validate() error {
+// Validate checks the field values on PatchResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PatchResourceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Node with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in NodeMultiError, or nil if none found.
-func (m *Node) ValidateAll() error {
+// ValidateAll checks the field values on PatchResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PatchResourceRequestMultiError, or nil if none found.
+func (m *PatchResourceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Node) validate(all bool) error {
+func (m *PatchResourceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
+	if len(m.GetClientset()) < 1 {
+		err := PatchResourceRequestValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Cluster
+	if len(m.GetCluster()) < 1 {
+		err := PatchResourceRequestValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Unschedulable
+	// no validation rules for Namespace
+
+	// no validation rules for Group
+
+	if len(m.GetVersion()) < 1 {
+		err := PatchResourceRequestValidationError{
+			field:  "Version",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetKind()) < 1 {
+		err := PatchResourceRequestValidationError{
+			field:  "Kind",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := PatchResourceRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PatchType
+
+	if m.GetPatch() == nil {
+		err := PatchResourceRequestValidationError{
+			field:  "Patch",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPatch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PatchResourceRequestValidationError{
+					field:  "Patch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PatchResourceRequestValidationError{
+					field:  "Patch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPatch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PatchResourceRequestValidationError{
+				field:  "Patch",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DryRun
+
+	// no validation rules for Force
 
 	if len(errors) > 0 {
-		return NodeMultiError(errors)
+		return PatchResourceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// NodeMultiError is an error wrapping multiple validation errors returned by
-// Node.ValidateAll() if the designated constraints aren't met.
-type NodeMultiError []error
+// PatchResourceRequestMultiError is an error wrapping multiple validation
+// errors returned by PatchResourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PatchResourceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m NodeMultiError) Error() string {
+func (m PatchResourceRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11724,11 +19165,11 @@ func (m NodeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m NodeMultiError) AllErrors() []error { return m }
+func (m PatchResourceRequestMultiError) AllErrors() []error { return m }
 
-// NodeValidationError is the validation error returned by Node.Validate if the
-// designated constraints aren't met.
-type NodeValidationError struct {
+// PatchResourceRequestValidationError is the validation error returned by
+// PatchResourceRequest.Validate if the designated constraints aren't met.
+type PatchResourceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11736,22 +19177,24 @@ type NodeValidationError struct {
 }
 
 // Field function returns field value.
-func (e NodeValidationError) Field() string { return e.field }
+func (e PatchResourceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NodeValidationError) Reason() string { return e.reason }
+func (e PatchResourceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NodeValidationError) Cause() error { return e.cause }
+func (e PatchResourceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NodeValidationError) Key() bool { return e.key }
+func (e PatchResourceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NodeValidationError) ErrorName() string { return "NodeValidationError" }
+func (e PatchResourceRequestValidationError) ErrorName() string {
+	return "PatchResourceRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e NodeValidationError) Error() string {
+func (e PatchResourceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11763,14 +19206,14 @@ func (e NodeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNode.%s: %s%s",
+		"invalid %sPatchResourceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NodeValidationError{}
+var _ error = PatchResourceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -11778,45 +19221,36 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NodeValidationError{}
+} = PatchResourceRequestValidationError{}
 
-// Validate checks the field values on DescribeNodeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DescribeNodeRequest) Validate() error {
+// Validate checks the field values on ResourcePatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ResourcePatch) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DescribeNodeRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DescribeNodeRequestMultiError, or nil if none found.
-func (m *DescribeNodeRequest) ValidateAll() error {
+// ValidateAll checks the field values on ResourcePatch with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ResourcePatchMultiError, or
+// nil if none found.
+func (m *ResourcePatch) ValidateAll() error {
 	return m.validate(true)
-}
-
-func (m *DescribeNodeRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if len(m.GetClientset()) < 1 {
-		err := DescribeNodeRequestValidationError{
-			field:  "Clientset",
-			reason: "value length must be at least 1 bytes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+}
+
+func (m *ResourcePatch) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	if len(m.GetCluster()) < 1 {
-		err := DescribeNodeRequestValidationError{
-			field:  "Cluster",
-			reason: "value length must be at least 1 bytes",
+	var errors []error
+
+	// no validation rules for PatchType
+
+	if m.GetPatch() == nil {
+		err := ResourcePatchValidationError{
+			field:  "Patch",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -11824,31 +19258,53 @@ func (m *DescribeNodeRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetName()) < 1 {
-		err := DescribeNodeRequestValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 bytes",
+	if all {
+		switch v := interface{}(m.GetPatch()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ResourcePatchValidationError{
+					field:  "Patch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ResourcePatchValidationError{
+					field:  "Patch",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetPatch()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ResourcePatchValidationError{
+				field:  "Patch",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
+	// no validation rules for DryRun
+
+	// no validation rules for Force
+
 	if len(errors) > 0 {
-		return DescribeNodeRequestMultiError(errors)
+		return ResourcePatchMultiError(errors)
 	}
 
 	return nil
 }
 
-// DescribeNodeRequestMultiError is an error wrapping multiple validation
-// errors returned by DescribeNodeRequest.ValidateAll() if the designated
-// constraints aren't met.
-type DescribeNodeRequestMultiError []error
+// ResourcePatchMultiError is an error wrapping multiple validation errors
+// returned by ResourcePatch.ValidateAll() if the designated constraints
+// aren't met.
+type ResourcePatchMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DescribeNodeRequestMultiError) Error() string {
+func (m ResourcePatchMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11857,11 +19313,11 @@ func (m DescribeNodeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DescribeNodeRequestMultiError) AllErrors() []error { return m }
+func (m ResourcePatchMultiError) AllErrors() []error { return m }
 
-// DescribeNodeRequestValidationError is the validation error returned by
-// DescribeNodeRequest.Validate if the designated constraints aren't met.
-type DescribeNodeRequestValidationError struct {
+// ResourcePatchValidationError is the validation error returned by
+// ResourcePatch.Validate if the designated constraints aren't met.
+type ResourcePatchValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11869,24 +19325,22 @@ type DescribeNodeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeNodeRequestValidationError) Field() string { return e.field }
+func (e ResourcePatchValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeNodeRequestValidationError) Reason() string { return e.reason }
+func (e ResourcePatchValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeNodeRequestValidationError) Cause() error { return e.cause }
+func (e ResourcePatchValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeNodeRequestValidationError) Key() bool { return e.key }
+func (e ResourcePatchValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeNodeRequestValidationError) ErrorName() string {
-	return "DescribeNodeRequestValidationError"
-}
+func (e ResourcePatchValidationError) ErrorName() string { return "ResourcePatchValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DescribeNodeRequestValidationError) Error() string {
+func (e ResourcePatchValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11898,14 +19352,14 @@ func (e DescribeNodeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeNodeRequest.%s: %s%s",
+		"invalid %sResourcePatch.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeNodeRequestValidationError{}
+var _ error = ResourcePatchValidationError{}
 
 var _ interface {
 	Field() string
@@ -11913,24 +19367,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeNodeRequestValidationError{}
+} = ResourcePatchValidationError{}
 
-// Validate checks the field values on DescribeNodeResponse with the rules
+// Validate checks the field values on PatchResourceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DescribeNodeResponse) Validate() error {
+func (m *PatchResourceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DescribeNodeResponse with the rules
+// ValidateAll checks the field values on PatchResourceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DescribeNodeResponseMultiError, or nil if none found.
-func (m *DescribeNodeResponse) ValidateAll() error {
+// PatchResourceResponseMultiError, or nil if none found.
+func (m *PatchResourceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DescribeNodeResponse) validate(all bool) error {
+func (m *PatchResourceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11938,48 +19392,50 @@ func (m *DescribeNodeResponse) validate(all bool) error {
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetNode()).(type) {
+		switch v := interface{}(m.GetResource()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DescribeNodeResponseValidationError{
-					field:  "Node",
+				errors = append(errors, PatchResourceResponseValidationError{
+					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, DescribeNodeResponseValidationError{
-					field:  "Node",
+				errors = append(errors, PatchResourceResponseValidationError{
+					field:  "Resource",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetNode()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return DescribeNodeResponseValidationError{
-				field:  "Node",
+			return PatchResourceResponseValidationError{
+				field:  "Resource",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Diff
+
 	if len(errors) > 0 {
-		return DescribeNodeResponseMultiError(errors)
+		return PatchResourceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DescribeNodeResponseMultiError is an error wrapping multiple validation
-// errors returned by DescribeNodeResponse.ValidateAll() if the designated
+// PatchResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by PatchResourceResponse.ValidateAll() if the designated
 // constraints aren't met.
-type DescribeNodeResponseMultiError []error
+type PatchResourceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DescribeNodeResponseMultiError) Error() string {
+func (m PatchResourceResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11988,11 +19444,11 @@ func (m DescribeNodeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DescribeNodeResponseMultiError) AllErrors() []error { return m }
+func (m PatchResourceResponseMultiError) AllErrors() []error { return m }
 
-// DescribeNodeResponseValidationError is the validation error returned by
-// DescribeNodeResponse.Validate if the designated constraints aren't met.
-type DescribeNodeResponseValidationError struct {
+// PatchResourceResponseValidationError is the validation error returned by
+// PatchResourceResponse.Validate if the designated constraints aren't met.
+type PatchResourceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12000,24 +19456,24 @@ type DescribeNodeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeNodeResponseValidationError) Field() string { return e.field }
+func (e PatchResourceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeNodeResponseValidationError) Reason() string { return e.reason }
+func (e PatchResourceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeNodeResponseValidationError) Cause() error { return e.cause }
+func (e PatchResourceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeNodeResponseValidationError) Key() bool { return e.key }
+func (e PatchResourceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeNodeResponseValidationError) ErrorName() string {
-	return "DescribeNodeResponseValidationError"
+func (e PatchResourceResponseValidationError) ErrorName() string {
+	return "PatchResourceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeNodeResponseValidationError) Error() string {
+func (e PatchResourceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12029,14 +19485,14 @@ func (e DescribeNodeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeNodeResponse.%s: %s%s",
+		"invalid %sPatchResourceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeNodeResponseValidationError{}
+var _ error = PatchResourceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12044,24 +19500,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeNodeResponseValidationError{}
+} = PatchResourceResponseValidationError{}
 
-// Validate checks the field values on UpdateNodeRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *UpdateNodeRequest) Validate() error {
+// Validate checks the field values on DeleteResourceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteResourceRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateNodeRequest with the rules
+// ValidateAll checks the field values on DeleteResourceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateNodeRequestMultiError, or nil if none found.
-func (m *UpdateNodeRequest) ValidateAll() error {
+// DeleteResourceRequestMultiError, or nil if none found.
+func (m *DeleteResourceRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateNodeRequest) validate(all bool) error {
+func (m *DeleteResourceRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12069,7 +19525,7 @@ func (m *UpdateNodeRequest) validate(all bool) error {
 	var errors []error
 
 	if len(m.GetClientset()) < 1 {
-		err := UpdateNodeRequestValidationError{
+		err := DeleteResourceRequestValidationError{
 			field:  "Clientset",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -12080,7 +19536,7 @@ func (m *UpdateNodeRequest) validate(all bool) error {
 	}
 
 	if len(m.GetCluster()) < 1 {
-		err := UpdateNodeRequestValidationError{
+		err := DeleteResourceRequestValidationError{
 			field:  "Cluster",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -12090,8 +19546,34 @@ func (m *UpdateNodeRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Namespace
+
+	// no validation rules for Group
+
+	if len(m.GetVersion()) < 1 {
+		err := DeleteResourceRequestValidationError{
+			field:  "Version",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetKind()) < 1 {
+		err := DeleteResourceRequestValidationError{
+			field:  "Kind",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(m.GetName()) < 1 {
-		err := UpdateNodeRequestValidationError{
+		err := DeleteResourceRequestValidationError{
 			field:  "Name",
 			reason: "value length must be at least 1 bytes",
 		}
@@ -12101,22 +19583,20 @@ func (m *UpdateNodeRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Unschedulable
-
 	if len(errors) > 0 {
-		return UpdateNodeRequestMultiError(errors)
+		return DeleteResourceRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateNodeRequestMultiError is an error wrapping multiple validation errors
-// returned by UpdateNodeRequest.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateNodeRequestMultiError []error
+// DeleteResourceRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteResourceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteResourceRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateNodeRequestMultiError) Error() string {
+func (m DeleteResourceRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12125,11 +19605,11 @@ func (m UpdateNodeRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateNodeRequestMultiError) AllErrors() []error { return m }
+func (m DeleteResourceRequestMultiError) AllErrors() []error { return m }
 
-// UpdateNodeRequestValidationError is the validation error returned by
-// UpdateNodeRequest.Validate if the designated constraints aren't met.
-type UpdateNodeRequestValidationError struct {
+// DeleteResourceRequestValidationError is the validation error returned by
+// DeleteResourceRequest.Validate if the designated constraints aren't met.
+type DeleteResourceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12137,24 +19617,24 @@ type UpdateNodeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateNodeRequestValidationError) Field() string { return e.field }
+func (e DeleteResourceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateNodeRequestValidationError) Reason() string { return e.reason }
+func (e DeleteResourceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateNodeRequestValidationError) Cause() error { return e.cause }
+func (e DeleteResourceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateNodeRequestValidationError) Key() bool { return e.key }
+func (e DeleteResourceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateNodeRequestValidationError) ErrorName() string {
-	return "UpdateNodeRequestValidationError"
+func (e DeleteResourceRequestValidationError) ErrorName() string {
+	return "DeleteResourceRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateNodeRequestValidationError) Error() string {
+func (e DeleteResourceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12166,14 +19646,14 @@ func (e UpdateNodeRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateNodeRequest.%s: %s%s",
+		"invalid %sDeleteResourceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateNodeRequestValidationError{}
+var _ error = DeleteResourceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -12181,24 +19661,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateNodeRequestValidationError{}
+} = DeleteResourceRequestValidationError{}
 
-// Validate checks the field values on UpdateNodeResponse with the rules
+// Validate checks the field values on DeleteResourceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *UpdateNodeResponse) Validate() error {
+func (m *DeleteResourceResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on UpdateNodeResponse with the rules
+// ValidateAll checks the field values on DeleteResourceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// UpdateNodeResponseMultiError, or nil if none found.
-func (m *UpdateNodeResponse) ValidateAll() error {
+// DeleteResourceResponseMultiError, or nil if none found.
+func (m *DeleteResourceResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *UpdateNodeResponse) validate(all bool) error {
+func (m *DeleteResourceResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12206,19 +19686,19 @@ func (m *UpdateNodeResponse) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return UpdateNodeResponseMultiError(errors)
+		return DeleteResourceResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// UpdateNodeResponseMultiError is an error wrapping multiple validation errors
-// returned by UpdateNodeResponse.ValidateAll() if the designated constraints
-// aren't met.
-type UpdateNodeResponseMultiError []error
+// DeleteResourceResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteResourceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteResourceResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m UpdateNodeResponseMultiError) Error() string {
+func (m DeleteResourceResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12227,11 +19707,11 @@ func (m UpdateNodeResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m UpdateNodeResponseMultiError) AllErrors() []error { return m }
+func (m DeleteResourceResponseMultiError) AllErrors() []error { return m }
 
-// UpdateNodeResponseValidationError is the validation error returned by
-// UpdateNodeResponse.Validate if the designated constraints aren't met.
-type UpdateNodeResponseValidationError struct {
+// DeleteResourceResponseValidationError is the validation error returned by
+// DeleteResourceResponse.Validate if the designated constraints aren't met.
+type DeleteResourceResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12239,24 +19719,24 @@ type UpdateNodeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateNodeResponseValidationError) Field() string { return e.field }
+func (e DeleteResourceResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateNodeResponseValidationError) Reason() string { return e.reason }
+func (e DeleteResourceResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateNodeResponseValidationError) Cause() error { return e.cause }
+func (e DeleteResourceResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateNodeResponseValidationError) Key() bool { return e.key }
+func (e DeleteResourceResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateNodeResponseValidationError) ErrorName() string {
-	return "UpdateNodeResponseValidationError"
+func (e DeleteResourceResponseValidationError) ErrorName() string {
+	return "DeleteResourceResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateNodeResponseValidationError) Error() string {
+func (e DeleteResourceResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12268,14 +19748,14 @@ func (e UpdateNodeResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateNodeResponse.%s: %s%s",
+		"invalid %sDeleteResourceResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateNodeResponseValidationError{}
+var _ error = DeleteResourceResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -12283,7 +19763,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateNodeResponseValidationError{}
+} = DeleteResourceResponseValidationError{}
 
 // Validate checks the field values on HPA_Sizing with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -13179,3 +20659,108 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = UpdateStatefulSetRequest_FieldsValidationError{}
+
+// Validate checks the field values on Secret_Key with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Secret_Key) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Secret_Key with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Secret_KeyMultiError, or
+// nil if none found.
+func (m *Secret_Key) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Secret_Key) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for SizeBytes
+
+	// no validation rules for HmacSha256
+
+	if len(errors) > 0 {
+		return Secret_KeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// Secret_KeyMultiError is an error wrapping multiple validation errors
+// returned by Secret_Key.ValidateAll() if the designated constraints aren't met.
+type Secret_KeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Secret_KeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Secret_KeyMultiError) AllErrors() []error { return m }
+
+// Secret_KeyValidationError is the validation error returned by
+// Secret_Key.Validate if the designated constraints aren't met.
+type Secret_KeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Secret_KeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Secret_KeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Secret_KeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Secret_KeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Secret_KeyValidationError) ErrorName() string { return "Secret_KeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Secret_KeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecret_Key.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Secret_KeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Secret_KeyValidationError{}
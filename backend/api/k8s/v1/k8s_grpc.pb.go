@@ -27,6 +27,11 @@ type K8SAPIClient interface {
 	DeletePod(ctx context.Context, in *DeletePodRequest, opts ...grpc.CallOption) (*DeletePodResponse, error)
 	UpdatePod(ctx context.Context, in *UpdatePodRequest, opts ...grpc.CallOption) (*UpdatePodResponse, error)
 	GetPodLogs(ctx context.Context, in *GetPodLogsRequest, opts ...grpc.CallOption) (*GetPodLogsResponse, error)
+	// Runs a non-interactive command in a container of a pod. Only the commands allowed by the configuration can be run.
+	ExecPod(ctx context.Context, in *ExecPodRequest, opts ...grpc.CallOption) (*ExecPodResponse, error)
+	// Adds an ephemeral debug container to a running pod. Only the images and commands allowed by the configuration can
+	// be run.
+	CreateDebugContainer(ctx context.Context, in *CreateDebugContainerRequest, opts ...grpc.CallOption) (*CreateDebugContainerResponse, error)
 	ResizeHPA(ctx context.Context, in *ResizeHPARequest, opts ...grpc.CallOption) (*ResizeHPAResponse, error)
 	DeleteHPA(ctx context.Context, in *DeleteHPARequest, opts ...grpc.CallOption) (*DeleteHPAResponse, error)
 	DescribeDeployment(ctx context.Context, in *DescribeDeploymentRequest, opts ...grpc.CallOption) (*DescribeDeploymentResponse, error)
@@ -40,12 +45,27 @@ type K8SAPIClient interface {
 	ListStatefulSets(ctx context.Context, in *ListStatefulSetsRequest, opts ...grpc.CallOption) (*ListStatefulSetsResponse, error)
 	UpdateStatefulSet(ctx context.Context, in *UpdateStatefulSetRequest, opts ...grpc.CallOption) (*UpdateStatefulSetResponse, error)
 	DeleteStatefulSet(ctx context.Context, in *DeleteStatefulSetRequest, opts ...grpc.CallOption) (*DeleteStatefulSetResponse, error)
+	// Scales a workload through its scale subresource. A single response is sent unless the request waits for the
+	// rollout, in which case a response is sent whenever the progress of the rollout changes. Replica sets managed by a
+	// deployment and workloads targeted by a horizontal pod autoscaler can't be scaled, since their replicas would be
+	// scaled back.
+	ScaleWorkload(ctx context.Context, in *ScaleWorkloadRequest, opts ...grpc.CallOption) (K8SAPI_ScaleWorkloadClient, error)
+	// Sets the image of a container of a workload, streaming the progress of the rollout like ScaleWorkload.
+	SetContainerImage(ctx context.Context, in *SetContainerImageRequest, opts ...grpc.CallOption) (K8SAPI_SetContainerImageClient, error)
 	DescribeCronJob(ctx context.Context, in *DescribeCronJobRequest, opts ...grpc.CallOption) (*DescribeCronJobResponse, error)
 	ListCronJobs(ctx context.Context, in *ListCronJobsRequest, opts ...grpc.CallOption) (*ListCronJobsResponse, error)
 	DeleteCronJob(ctx context.Context, in *DeleteCronJobRequest, opts ...grpc.CallOption) (*DeleteCronJobResponse, error)
+	SuspendCronJob(ctx context.Context, in *SuspendCronJobRequest, opts ...grpc.CallOption) (*SuspendCronJobResponse, error)
+	ResumeCronJob(ctx context.Context, in *ResumeCronJobRequest, opts ...grpc.CallOption) (*ResumeCronJobResponse, error)
+	TriggerCronJob(ctx context.Context, in *TriggerCronJobRequest, opts ...grpc.CallOption) (*TriggerCronJobResponse, error)
 	ListConfigMaps(ctx context.Context, in *ListConfigMapsRequest, opts ...grpc.CallOption) (*ListConfigMapsResponse, error)
 	DescribeConfigMap(ctx context.Context, in *DescribeConfigMapRequest, opts ...grpc.CallOption) (*DescribeConfigMapResponse, error)
 	DeleteConfigMap(ctx context.Context, in *DeleteConfigMapRequest, opts ...grpc.CallOption) (*DeleteConfigMapResponse, error)
+	CreateConfigMap(ctx context.Context, in *CreateConfigMapRequest, opts ...grpc.CallOption) (*CreateConfigMapResponse, error)
+	UpdateConfigMap(ctx context.Context, in *UpdateConfigMapRequest, opts ...grpc.CallOption) (*UpdateConfigMapResponse, error)
+	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
+	DescribeSecret(ctx context.Context, in *DescribeSecretRequest, opts ...grpc.CallOption) (*DescribeSecretResponse, error)
+	UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...grpc.CallOption) (*UpdateSecretResponse, error)
 	DescribeJob(ctx context.Context, in *DescribeJobRequest, opts ...grpc.CallOption) (*DescribeJobResponse, error)
 	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
 	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*DeleteJobResponse, error)
@@ -54,6 +74,16 @@ type K8SAPIClient interface {
 	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
 	DescribeNode(ctx context.Context, in *DescribeNodeRequest, opts ...grpc.CallOption) (*DescribeNodeResponse, error)
 	UpdateNode(ctx context.Context, in *UpdateNodeRequest, opts ...grpc.CallOption) (*UpdateNodeResponse, error)
+	ListAPIResources(ctx context.Context, in *ListAPIResourcesRequest, opts ...grpc.CallOption) (*ListAPIResourcesResponse, error)
+	DescribeResource(ctx context.Context, in *DescribeResourceRequest, opts ...grpc.CallOption) (*DescribeResourceResponse, error)
+	ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error)
+	PatchResource(ctx context.Context, in *PatchResourceRequest, opts ...grpc.CallOption) (*PatchResourceResponse, error)
+	DeleteResource(ctx context.Context, in *DeleteResourceRequest, opts ...grpc.CallOption) (*DeleteResourceResponse, error)
+	WatchPods(ctx context.Context, in *WatchPodsRequest, opts ...grpc.CallOption) (K8SAPI_WatchPodsClient, error)
+	WatchDeployments(ctx context.Context, in *WatchDeploymentsRequest, opts ...grpc.CallOption) (K8SAPI_WatchDeploymentsClient, error)
+	WatchHPAs(ctx context.Context, in *WatchHPAsRequest, opts ...grpc.CallOption) (K8SAPI_WatchHPAsClient, error)
+	WatchNodes(ctx context.Context, in *WatchNodesRequest, opts ...grpc.CallOption) (K8SAPI_WatchNodesClient, error)
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (K8SAPI_WatchEventsClient, error)
 }
 
 type k8SAPIClient struct {
@@ -109,6 +139,24 @@ func (c *k8SAPIClient) GetPodLogs(ctx context.Context, in *GetPodLogsRequest, op
 	return out, nil
 }
 
+func (c *k8SAPIClient) ExecPod(ctx context.Context, in *ExecPodRequest, opts ...grpc.CallOption) (*ExecPodResponse, error) {
+	out := new(ExecPodResponse)
+	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/ExecPod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SAPIClient) CreateDebugContainer(ctx context.Context, in *CreateDebugContainerRequest, opts ...grpc.CallOption) (*CreateDebugContainerResponse, error) {
+	out := new(CreateDebugContainerResponse)
+	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/CreateDebugContainer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *k8SAPIClient) ResizeHPA(ctx context.Context, in *ResizeHPARequest, opts ...grpc.CallOption) (*ResizeHPAResponse, error) {
 	out := new(ResizeHPAResponse)
 	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/ResizeHPA", in, out, opts...)
@@ -226,6 +274,70 @@ func (c *k8SAPIClient) DeleteStatefulSet(ctx context.Context, in *DeleteStateful
 	return out, nil
 }
 
+func (c *k8SAPIClient) ScaleWorkload(ctx context.Context, in *ScaleWorkloadRequest, opts ...grpc.CallOption) (K8SAPI_ScaleWorkloadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &K8SAPI_ServiceDesc.Streams[0], "/clutch.k8s.v1.K8sAPI/ScaleWorkload", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &k8SAPIScaleWorkloadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type K8SAPI_ScaleWorkloadClient interface {
+	Recv() (*ScaleWorkloadResponse, error)
+	grpc.ClientStream
+}
+
+type k8SAPIScaleWorkloadClient struct {
+	grpc.ClientStream
+}
+
+func (x *k8SAPIScaleWorkloadClient) Recv() (*ScaleWorkloadResponse, error) {
+	m := new(ScaleWorkloadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *k8SAPIClient) SetContainerImage(ctx context.Context, in *SetContainerImageRequest, opts ...grpc.CallOption) (K8SAPI_SetContainerImageClient, error) {
+	stream, err := c.cc.NewStream(ctx, &K8SAPI_ServiceDesc.Streams[1], "/clutch.k8s.v1.K8sAPI/SetContainerImage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &k8SAPISetContainerImageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type K8SAPI_SetContainerImageClient interface {
+	Recv() (*SetContainerImageResponse, error)
+	grpc.ClientStream
+}
+
+type k8SAPISetContainerImageClient struct {
+	grpc.ClientStream
+}
+
+func (x *k8SAPISetContainerImageClient) Recv() (*SetContainerImageResponse, error) {
+	m := new(SetContainerImageResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *k8SAPIClient) DescribeCronJob(ctx context.Context, in *DescribeCronJobRequest, opts ...grpc.CallOption) (*DescribeCronJobResponse, error) {
 	out := new(DescribeCronJobResponse)
 	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/DescribeCronJob", in, out, opts...)
@@ -253,6 +365,33 @@ func (c *k8SAPIClient) DeleteCronJob(ctx context.Context, in *DeleteCronJobReque
 	return out, nil
 }
 
+func (c *k8SAPIClient) SuspendCronJob(ctx context.Context, in *SuspendCronJobRequest, opts ...grpc.CallOption) (*SuspendCronJobResponse, error) {
+	out := new(SuspendCronJobResponse)
+	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/SuspendCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SAPIClient) ResumeCronJob(ctx context.Context, in *ResumeCronJobRequest, opts ...grpc.CallOption) (*ResumeCronJobResponse, error) {
+	out := new(ResumeCronJobResponse)
+	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/ResumeCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SAPIClient) TriggerCronJob(ctx context.Context, in *TriggerCronJobRequest, opts ...grpc.CallOption) (*TriggerCronJobResponse, error) {
+	out := new(TriggerCronJobResponse)
+	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/TriggerCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *k8SAPIClient) ListConfigMaps(ctx context.Context, in *ListConfigMapsRequest, opts ...grpc.CallOption) (*ListConfigMapsResponse, error) {
 	out := new(ListConfigMapsResponse)
 	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/ListConfigMaps", in, out, opts...)
@@ -280,6 +419,51 @@ func (c *k8SAPIClient) DeleteConfigMap(ctx context.Context, in *DeleteConfigMapR
 	return out, nil
 }
 
+func (c *k8SAPIClient) CreateConfigMap(ctx context.Context, in *CreateConfigMapRequest, opts ...grpc.CallOption) (*CreateConfigMapResponse, error) {
+	out := new(CreateConfigMapResponse)
+	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/CreateConfigMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SAPIClient) UpdateConfigMap(ctx context.Context, in *UpdateConfigMapRequest, opts ...grpc.CallOption) (*UpdateConfigMapResponse, error) {
+	out := new(UpdateConfigMapResponse)
+	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/UpdateConfigMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SAPIClient) ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error) {
+	out := new(ListSecretsResponse)
+	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/ListSecrets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SAPIClient) DescribeSecret(ctx context.Context, in *DescribeSecretRequest, opts ...grpc.CallOption) (*DescribeSecretResponse, error) {
+	out := new(DescribeSecretResponse)
+	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/DescribeSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SAPIClient) UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...grpc.CallOption) (*UpdateSecretResponse, error) {
+	out := new(UpdateSecretResponse)
+	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/UpdateSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *k8SAPIClient) DescribeJob(ctx context.Context, in *DescribeJobRequest, opts ...grpc.CallOption) (*DescribeJobResponse, error) {
 	out := new(DescribeJobResponse)
 	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/DescribeJob", in, out, opts...)
@@ -352,6 +536,211 @@ func (c *k8SAPIClient) UpdateNode(ctx context.Context, in *UpdateNodeRequest, op
 	return out, nil
 }
 
+func (c *k8SAPIClient) ListAPIResources(ctx context.Context, in *ListAPIResourcesRequest, opts ...grpc.CallOption) (*ListAPIResourcesResponse, error) {
+	out := new(ListAPIResourcesResponse)
+	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/ListAPIResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SAPIClient) DescribeResource(ctx context.Context, in *DescribeResourceRequest, opts ...grpc.CallOption) (*DescribeResourceResponse, error) {
+	out := new(DescribeResourceResponse)
+	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/DescribeResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SAPIClient) ListResources(ctx context.Context, in *ListResourcesRequest, opts ...grpc.CallOption) (*ListResourcesResponse, error) {
+	out := new(ListResourcesResponse)
+	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/ListResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SAPIClient) PatchResource(ctx context.Context, in *PatchResourceRequest, opts ...grpc.CallOption) (*PatchResourceResponse, error) {
+	out := new(PatchResourceResponse)
+	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/PatchResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SAPIClient) DeleteResource(ctx context.Context, in *DeleteResourceRequest, opts ...grpc.CallOption) (*DeleteResourceResponse, error) {
+	out := new(DeleteResourceResponse)
+	err := c.cc.Invoke(ctx, "/clutch.k8s.v1.K8sAPI/DeleteResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *k8SAPIClient) WatchPods(ctx context.Context, in *WatchPodsRequest, opts ...grpc.CallOption) (K8SAPI_WatchPodsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &K8SAPI_ServiceDesc.Streams[2], "/clutch.k8s.v1.K8sAPI/WatchPods", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &k8SAPIWatchPodsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type K8SAPI_WatchPodsClient interface {
+	Recv() (*WatchPodsResponse, error)
+	grpc.ClientStream
+}
+
+type k8SAPIWatchPodsClient struct {
+	grpc.ClientStream
+}
+
+func (x *k8SAPIWatchPodsClient) Recv() (*WatchPodsResponse, error) {
+	m := new(WatchPodsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *k8SAPIClient) WatchDeployments(ctx context.Context, in *WatchDeploymentsRequest, opts ...grpc.CallOption) (K8SAPI_WatchDeploymentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &K8SAPI_ServiceDesc.Streams[3], "/clutch.k8s.v1.K8sAPI/WatchDeployments", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &k8SAPIWatchDeploymentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type K8SAPI_WatchDeploymentsClient interface {
+	Recv() (*WatchDeploymentsResponse, error)
+	grpc.ClientStream
+}
+
+type k8SAPIWatchDeploymentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *k8SAPIWatchDeploymentsClient) Recv() (*WatchDeploymentsResponse, error) {
+	m := new(WatchDeploymentsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *k8SAPIClient) WatchHPAs(ctx context.Context, in *WatchHPAsRequest, opts ...grpc.CallOption) (K8SAPI_WatchHPAsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &K8SAPI_ServiceDesc.Streams[4], "/clutch.k8s.v1.K8sAPI/WatchHPAs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &k8SAPIWatchHPAsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type K8SAPI_WatchHPAsClient interface {
+	Recv() (*WatchHPAsResponse, error)
+	grpc.ClientStream
+}
+
+type k8SAPIWatchHPAsClient struct {
+	grpc.ClientStream
+}
+
+func (x *k8SAPIWatchHPAsClient) Recv() (*WatchHPAsResponse, error) {
+	m := new(WatchHPAsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *k8SAPIClient) WatchNodes(ctx context.Context, in *WatchNodesRequest, opts ...grpc.CallOption) (K8SAPI_WatchNodesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &K8SAPI_ServiceDesc.Streams[5], "/clutch.k8s.v1.K8sAPI/WatchNodes", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &k8SAPIWatchNodesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type K8SAPI_WatchNodesClient interface {
+	Recv() (*WatchNodesResponse, error)
+	grpc.ClientStream
+}
+
+type k8SAPIWatchNodesClient struct {
+	grpc.ClientStream
+}
+
+func (x *k8SAPIWatchNodesClient) Recv() (*WatchNodesResponse, error) {
+	m := new(WatchNodesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *k8SAPIClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (K8SAPI_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &K8SAPI_ServiceDesc.Streams[6], "/clutch.k8s.v1.K8sAPI/WatchEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &k8SAPIWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type K8SAPI_WatchEventsClient interface {
+	Recv() (*WatchEventsResponse, error)
+	grpc.ClientStream
+}
+
+type k8SAPIWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *k8SAPIWatchEventsClient) Recv() (*WatchEventsResponse, error) {
+	m := new(WatchEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // K8SAPIServer is the server API for K8SAPI service.
 // All implementations should embed UnimplementedK8SAPIServer
 // for forward compatibility
@@ -361,6 +750,11 @@ type K8SAPIServer interface {
 	DeletePod(context.Context, *DeletePodRequest) (*DeletePodResponse, error)
 	UpdatePod(context.Context, *UpdatePodRequest) (*UpdatePodResponse, error)
 	GetPodLogs(context.Context, *GetPodLogsRequest) (*GetPodLogsResponse, error)
+	// Runs a non-interactive command in a container of a pod. Only the commands allowed by the configuration can be run.
+	ExecPod(context.Context, *ExecPodRequest) (*ExecPodResponse, error)
+	// Adds an ephemeral debug container to a running pod. Only the images and commands allowed by the configuration can
+	// be run.
+	CreateDebugContainer(context.Context, *CreateDebugContainerRequest) (*CreateDebugContainerResponse, error)
 	ResizeHPA(context.Context, *ResizeHPARequest) (*ResizeHPAResponse, error)
 	DeleteHPA(context.Context, *DeleteHPARequest) (*DeleteHPAResponse, error)
 	DescribeDeployment(context.Context, *DescribeDeploymentRequest) (*DescribeDeploymentResponse, error)
@@ -374,12 +768,27 @@ type K8SAPIServer interface {
 	ListStatefulSets(context.Context, *ListStatefulSetsRequest) (*ListStatefulSetsResponse, error)
 	UpdateStatefulSet(context.Context, *UpdateStatefulSetRequest) (*UpdateStatefulSetResponse, error)
 	DeleteStatefulSet(context.Context, *DeleteStatefulSetRequest) (*DeleteStatefulSetResponse, error)
+	// Scales a workload through its scale subresource. A single response is sent unless the request waits for the
+	// rollout, in which case a response is sent whenever the progress of the rollout changes. Replica sets managed by a
+	// deployment and workloads targeted by a horizontal pod autoscaler can't be scaled, since their replicas would be
+	// scaled back.
+	ScaleWorkload(*ScaleWorkloadRequest, K8SAPI_ScaleWorkloadServer) error
+	// Sets the image of a container of a workload, streaming the progress of the rollout like ScaleWorkload.
+	SetContainerImage(*SetContainerImageRequest, K8SAPI_SetContainerImageServer) error
 	DescribeCronJob(context.Context, *DescribeCronJobRequest) (*DescribeCronJobResponse, error)
 	ListCronJobs(context.Context, *ListCronJobsRequest) (*ListCronJobsResponse, error)
 	DeleteCronJob(context.Context, *DeleteCronJobRequest) (*DeleteCronJobResponse, error)
+	SuspendCronJob(context.Context, *SuspendCronJobRequest) (*SuspendCronJobResponse, error)
+	ResumeCronJob(context.Context, *ResumeCronJobRequest) (*ResumeCronJobResponse, error)
+	TriggerCronJob(context.Context, *TriggerCronJobRequest) (*TriggerCronJobResponse, error)
 	ListConfigMaps(context.Context, *ListConfigMapsRequest) (*ListConfigMapsResponse, error)
 	DescribeConfigMap(context.Context, *DescribeConfigMapRequest) (*DescribeConfigMapResponse, error)
 	DeleteConfigMap(context.Context, *DeleteConfigMapRequest) (*DeleteConfigMapResponse, error)
+	CreateConfigMap(context.Context, *CreateConfigMapRequest) (*CreateConfigMapResponse, error)
+	UpdateConfigMap(context.Context, *UpdateConfigMapRequest) (*UpdateConfigMapResponse, error)
+	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
+	DescribeSecret(context.Context, *DescribeSecretRequest) (*DescribeSecretResponse, error)
+	UpdateSecret(context.Context, *UpdateSecretRequest) (*UpdateSecretResponse, error)
 	DescribeJob(context.Context, *DescribeJobRequest) (*DescribeJobResponse, error)
 	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
 	DeleteJob(context.Context, *DeleteJobRequest) (*DeleteJobResponse, error)
@@ -388,6 +797,16 @@ type K8SAPIServer interface {
 	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
 	DescribeNode(context.Context, *DescribeNodeRequest) (*DescribeNodeResponse, error)
 	UpdateNode(context.Context, *UpdateNodeRequest) (*UpdateNodeResponse, error)
+	ListAPIResources(context.Context, *ListAPIResourcesRequest) (*ListAPIResourcesResponse, error)
+	DescribeResource(context.Context, *DescribeResourceRequest) (*DescribeResourceResponse, error)
+	ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error)
+	PatchResource(context.Context, *PatchResourceRequest) (*PatchResourceResponse, error)
+	DeleteResource(context.Context, *DeleteResourceRequest) (*DeleteResourceResponse, error)
+	WatchPods(*WatchPodsRequest, K8SAPI_WatchPodsServer) error
+	WatchDeployments(*WatchDeploymentsRequest, K8SAPI_WatchDeploymentsServer) error
+	WatchHPAs(*WatchHPAsRequest, K8SAPI_WatchHPAsServer) error
+	WatchNodes(*WatchNodesRequest, K8SAPI_WatchNodesServer) error
+	WatchEvents(*WatchEventsRequest, K8SAPI_WatchEventsServer) error
 }
 
 // UnimplementedK8SAPIServer should be embedded to have forward compatible implementations.
@@ -409,6 +828,12 @@ func (UnimplementedK8SAPIServer) UpdatePod(context.Context, *UpdatePodRequest) (
 func (UnimplementedK8SAPIServer) GetPodLogs(context.Context, *GetPodLogsRequest) (*GetPodLogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPodLogs not implemented")
 }
+func (UnimplementedK8SAPIServer) ExecPod(context.Context, *ExecPodRequest) (*ExecPodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecPod not implemented")
+}
+func (UnimplementedK8SAPIServer) CreateDebugContainer(context.Context, *CreateDebugContainerRequest) (*CreateDebugContainerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDebugContainer not implemented")
+}
 func (UnimplementedK8SAPIServer) ResizeHPA(context.Context, *ResizeHPARequest) (*ResizeHPAResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResizeHPA not implemented")
 }
@@ -448,6 +873,12 @@ func (UnimplementedK8SAPIServer) UpdateStatefulSet(context.Context, *UpdateState
 func (UnimplementedK8SAPIServer) DeleteStatefulSet(context.Context, *DeleteStatefulSetRequest) (*DeleteStatefulSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteStatefulSet not implemented")
 }
+func (UnimplementedK8SAPIServer) ScaleWorkload(*ScaleWorkloadRequest, K8SAPI_ScaleWorkloadServer) error {
+	return status.Errorf(codes.Unimplemented, "method ScaleWorkload not implemented")
+}
+func (UnimplementedK8SAPIServer) SetContainerImage(*SetContainerImageRequest, K8SAPI_SetContainerImageServer) error {
+	return status.Errorf(codes.Unimplemented, "method SetContainerImage not implemented")
+}
 func (UnimplementedK8SAPIServer) DescribeCronJob(context.Context, *DescribeCronJobRequest) (*DescribeCronJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeCronJob not implemented")
 }
@@ -457,6 +888,15 @@ func (UnimplementedK8SAPIServer) ListCronJobs(context.Context, *ListCronJobsRequ
 func (UnimplementedK8SAPIServer) DeleteCronJob(context.Context, *DeleteCronJobRequest) (*DeleteCronJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCronJob not implemented")
 }
+func (UnimplementedK8SAPIServer) SuspendCronJob(context.Context, *SuspendCronJobRequest) (*SuspendCronJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendCronJob not implemented")
+}
+func (UnimplementedK8SAPIServer) ResumeCronJob(context.Context, *ResumeCronJobRequest) (*ResumeCronJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeCronJob not implemented")
+}
+func (UnimplementedK8SAPIServer) TriggerCronJob(context.Context, *TriggerCronJobRequest) (*TriggerCronJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TriggerCronJob not implemented")
+}
 func (UnimplementedK8SAPIServer) ListConfigMaps(context.Context, *ListConfigMapsRequest) (*ListConfigMapsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListConfigMaps not implemented")
 }
@@ -466,6 +906,21 @@ func (UnimplementedK8SAPIServer) DescribeConfigMap(context.Context, *DescribeCon
 func (UnimplementedK8SAPIServer) DeleteConfigMap(context.Context, *DeleteConfigMapRequest) (*DeleteConfigMapResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigMap not implemented")
 }
+func (UnimplementedK8SAPIServer) CreateConfigMap(context.Context, *CreateConfigMapRequest) (*CreateConfigMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigMap not implemented")
+}
+func (UnimplementedK8SAPIServer) UpdateConfigMap(context.Context, *UpdateConfigMapRequest) (*UpdateConfigMapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigMap not implemented")
+}
+func (UnimplementedK8SAPIServer) ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecrets not implemented")
+}
+func (UnimplementedK8SAPIServer) DescribeSecret(context.Context, *DescribeSecretRequest) (*DescribeSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeSecret not implemented")
+}
+func (UnimplementedK8SAPIServer) UpdateSecret(context.Context, *UpdateSecretRequest) (*UpdateSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSecret not implemented")
+}
 func (UnimplementedK8SAPIServer) DescribeJob(context.Context, *DescribeJobRequest) (*DescribeJobResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeJob not implemented")
 }
@@ -490,6 +945,36 @@ func (UnimplementedK8SAPIServer) DescribeNode(context.Context, *DescribeNodeRequ
 func (UnimplementedK8SAPIServer) UpdateNode(context.Context, *UpdateNodeRequest) (*UpdateNodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateNode not implemented")
 }
+func (UnimplementedK8SAPIServer) ListAPIResources(context.Context, *ListAPIResourcesRequest) (*ListAPIResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAPIResources not implemented")
+}
+func (UnimplementedK8SAPIServer) DescribeResource(context.Context, *DescribeResourceRequest) (*DescribeResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeResource not implemented")
+}
+func (UnimplementedK8SAPIServer) ListResources(context.Context, *ListResourcesRequest) (*ListResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResources not implemented")
+}
+func (UnimplementedK8SAPIServer) PatchResource(context.Context, *PatchResourceRequest) (*PatchResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchResource not implemented")
+}
+func (UnimplementedK8SAPIServer) DeleteResource(context.Context, *DeleteResourceRequest) (*DeleteResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteResource not implemented")
+}
+func (UnimplementedK8SAPIServer) WatchPods(*WatchPodsRequest, K8SAPI_WatchPodsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPods not implemented")
+}
+func (UnimplementedK8SAPIServer) WatchDeployments(*WatchDeploymentsRequest, K8SAPI_WatchDeploymentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDeployments not implemented")
+}
+func (UnimplementedK8SAPIServer) WatchHPAs(*WatchHPAsRequest, K8SAPI_WatchHPAsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchHPAs not implemented")
+}
+func (UnimplementedK8SAPIServer) WatchNodes(*WatchNodesRequest, K8SAPI_WatchNodesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNodes not implemented")
+}
+func (UnimplementedK8SAPIServer) WatchEvents(*WatchEventsRequest, K8SAPI_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
 
 // UnsafeK8SAPIServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to K8SAPIServer will
@@ -592,67 +1077,103 @@ func _K8SAPI_GetPodLogs_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-func _K8SAPI_ResizeHPA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResizeHPARequest)
+func _K8SAPI_ExecPod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecPodRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(K8SAPIServer).ResizeHPA(ctx, in)
+		return srv.(K8SAPIServer).ExecPod(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/clutch.k8s.v1.K8sAPI/ResizeHPA",
+		FullMethod: "/clutch.k8s.v1.K8sAPI/ExecPod",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(K8SAPIServer).ResizeHPA(ctx, req.(*ResizeHPARequest))
+		return srv.(K8SAPIServer).ExecPod(ctx, req.(*ExecPodRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _K8SAPI_DeleteHPA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteHPARequest)
+func _K8SAPI_CreateDebugContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDebugContainerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(K8SAPIServer).DeleteHPA(ctx, in)
+		return srv.(K8SAPIServer).CreateDebugContainer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/clutch.k8s.v1.K8sAPI/DeleteHPA",
+		FullMethod: "/clutch.k8s.v1.K8sAPI/CreateDebugContainer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(K8SAPIServer).DeleteHPA(ctx, req.(*DeleteHPARequest))
+		return srv.(K8SAPIServer).CreateDebugContainer(ctx, req.(*CreateDebugContainerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _K8SAPI_DescribeDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DescribeDeploymentRequest)
+func _K8SAPI_ResizeHPA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeHPARequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(K8SAPIServer).DescribeDeployment(ctx, in)
+		return srv.(K8SAPIServer).ResizeHPA(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/clutch.k8s.v1.K8sAPI/DescribeDeployment",
+		FullMethod: "/clutch.k8s.v1.K8sAPI/ResizeHPA",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(K8SAPIServer).DescribeDeployment(ctx, req.(*DescribeDeploymentRequest))
+		return srv.(K8SAPIServer).ResizeHPA(ctx, req.(*ResizeHPARequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _K8SAPI_ListDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListDeploymentsRequest)
+func _K8SAPI_DeleteHPA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHPARequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(K8SAPIServer).ListDeployments(ctx, in)
+		return srv.(K8SAPIServer).DeleteHPA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.k8s.v1.K8sAPI/DeleteHPA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SAPIServer).DeleteHPA(ctx, req.(*DeleteHPARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SAPI_DescribeDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SAPIServer).DescribeDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.k8s.v1.K8sAPI/DescribeDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SAPIServer).DescribeDeployment(ctx, req.(*DescribeDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SAPI_ListDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeploymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SAPIServer).ListDeployments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
@@ -826,6 +1347,48 @@ func _K8SAPI_DeleteStatefulSet_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _K8SAPI_ScaleWorkload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScaleWorkloadRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(K8SAPIServer).ScaleWorkload(m, &k8SAPIScaleWorkloadServer{stream})
+}
+
+type K8SAPI_ScaleWorkloadServer interface {
+	Send(*ScaleWorkloadResponse) error
+	grpc.ServerStream
+}
+
+type k8SAPIScaleWorkloadServer struct {
+	grpc.ServerStream
+}
+
+func (x *k8SAPIScaleWorkloadServer) Send(m *ScaleWorkloadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _K8SAPI_SetContainerImage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SetContainerImageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(K8SAPIServer).SetContainerImage(m, &k8SAPISetContainerImageServer{stream})
+}
+
+type K8SAPI_SetContainerImageServer interface {
+	Send(*SetContainerImageResponse) error
+	grpc.ServerStream
+}
+
+type k8SAPISetContainerImageServer struct {
+	grpc.ServerStream
+}
+
+func (x *k8SAPISetContainerImageServer) Send(m *SetContainerImageResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _K8SAPI_DescribeCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeCronJobRequest)
 	if err := dec(in); err != nil {
@@ -880,6 +1443,60 @@ func _K8SAPI_DeleteCronJob_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _K8SAPI_SuspendCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SAPIServer).SuspendCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.k8s.v1.K8sAPI/SuspendCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SAPIServer).SuspendCronJob(ctx, req.(*SuspendCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SAPI_ResumeCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SAPIServer).ResumeCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.k8s.v1.K8sAPI/ResumeCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SAPIServer).ResumeCronJob(ctx, req.(*ResumeCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SAPI_TriggerCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TriggerCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SAPIServer).TriggerCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.k8s.v1.K8sAPI/TriggerCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SAPIServer).TriggerCronJob(ctx, req.(*TriggerCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _K8SAPI_ListConfigMaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListConfigMapsRequest)
 	if err := dec(in); err != nil {
@@ -934,6 +1551,96 @@ func _K8SAPI_DeleteConfigMap_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _K8SAPI_CreateConfigMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConfigMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SAPIServer).CreateConfigMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.k8s.v1.K8sAPI/CreateConfigMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SAPIServer).CreateConfigMap(ctx, req.(*CreateConfigMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SAPI_UpdateConfigMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConfigMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SAPIServer).UpdateConfigMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.k8s.v1.K8sAPI/UpdateConfigMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SAPIServer).UpdateConfigMap(ctx, req.(*UpdateConfigMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SAPI_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SAPIServer).ListSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.k8s.v1.K8sAPI/ListSecrets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SAPIServer).ListSecrets(ctx, req.(*ListSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SAPI_DescribeSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SAPIServer).DescribeSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.k8s.v1.K8sAPI/DescribeSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SAPIServer).DescribeSecret(ctx, req.(*DescribeSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SAPI_UpdateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SAPIServer).UpdateSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.k8s.v1.K8sAPI/UpdateSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SAPIServer).UpdateSecret(ctx, req.(*UpdateSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _K8SAPI_DescribeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DescribeJobRequest)
 	if err := dec(in); err != nil {
@@ -1078,6 +1785,201 @@ func _K8SAPI_UpdateNode_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _K8SAPI_ListAPIResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAPIResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SAPIServer).ListAPIResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.k8s.v1.K8sAPI/ListAPIResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SAPIServer).ListAPIResources(ctx, req.(*ListAPIResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SAPI_DescribeResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SAPIServer).DescribeResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.k8s.v1.K8sAPI/DescribeResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SAPIServer).DescribeResource(ctx, req.(*DescribeResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SAPI_ListResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SAPIServer).ListResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.k8s.v1.K8sAPI/ListResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SAPIServer).ListResources(ctx, req.(*ListResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SAPI_PatchResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SAPIServer).PatchResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.k8s.v1.K8sAPI/PatchResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SAPIServer).PatchResource(ctx, req.(*PatchResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SAPI_DeleteResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(K8SAPIServer).DeleteResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.k8s.v1.K8sAPI/DeleteResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(K8SAPIServer).DeleteResource(ctx, req.(*DeleteResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _K8SAPI_WatchPods_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPodsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(K8SAPIServer).WatchPods(m, &k8SAPIWatchPodsServer{stream})
+}
+
+type K8SAPI_WatchPodsServer interface {
+	Send(*WatchPodsResponse) error
+	grpc.ServerStream
+}
+
+type k8SAPIWatchPodsServer struct {
+	grpc.ServerStream
+}
+
+func (x *k8SAPIWatchPodsServer) Send(m *WatchPodsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _K8SAPI_WatchDeployments_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDeploymentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(K8SAPIServer).WatchDeployments(m, &k8SAPIWatchDeploymentsServer{stream})
+}
+
+type K8SAPI_WatchDeploymentsServer interface {
+	Send(*WatchDeploymentsResponse) error
+	grpc.ServerStream
+}
+
+type k8SAPIWatchDeploymentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *k8SAPIWatchDeploymentsServer) Send(m *WatchDeploymentsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _K8SAPI_WatchHPAs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchHPAsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(K8SAPIServer).WatchHPAs(m, &k8SAPIWatchHPAsServer{stream})
+}
+
+type K8SAPI_WatchHPAsServer interface {
+	Send(*WatchHPAsResponse) error
+	grpc.ServerStream
+}
+
+type k8SAPIWatchHPAsServer struct {
+	grpc.ServerStream
+}
+
+func (x *k8SAPIWatchHPAsServer) Send(m *WatchHPAsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _K8SAPI_WatchNodes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchNodesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(K8SAPIServer).WatchNodes(m, &k8SAPIWatchNodesServer{stream})
+}
+
+type K8SAPI_WatchNodesServer interface {
+	Send(*WatchNodesResponse) error
+	grpc.ServerStream
+}
+
+type k8SAPIWatchNodesServer struct {
+	grpc.ServerStream
+}
+
+func (x *k8SAPIWatchNodesServer) Send(m *WatchNodesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _K8SAPI_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(K8SAPIServer).WatchEvents(m, &k8SAPIWatchEventsServer{stream})
+}
+
+type K8SAPI_WatchEventsServer interface {
+	Send(*WatchEventsResponse) error
+	grpc.ServerStream
+}
+
+type k8SAPIWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *k8SAPIWatchEventsServer) Send(m *WatchEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // K8SAPI_ServiceDesc is the grpc.ServiceDesc for K8SAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1105,6 +2007,14 @@ var K8SAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPodLogs",
 			Handler:    _K8SAPI_GetPodLogs_Handler,
 		},
+		{
+			MethodName: "ExecPod",
+			Handler:    _K8SAPI_ExecPod_Handler,
+		},
+		{
+			MethodName: "CreateDebugContainer",
+			Handler:    _K8SAPI_CreateDebugContainer_Handler,
+		},
 		{
 			MethodName: "ResizeHPA",
 			Handler:    _K8SAPI_ResizeHPA_Handler,
@@ -1169,6 +2079,18 @@ var K8SAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCronJob",
 			Handler:    _K8SAPI_DeleteCronJob_Handler,
 		},
+		{
+			MethodName: "SuspendCronJob",
+			Handler:    _K8SAPI_SuspendCronJob_Handler,
+		},
+		{
+			MethodName: "ResumeCronJob",
+			Handler:    _K8SAPI_ResumeCronJob_Handler,
+		},
+		{
+			MethodName: "TriggerCronJob",
+			Handler:    _K8SAPI_TriggerCronJob_Handler,
+		},
 		{
 			MethodName: "ListConfigMaps",
 			Handler:    _K8SAPI_ListConfigMaps_Handler,
@@ -1181,6 +2103,26 @@ var K8SAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteConfigMap",
 			Handler:    _K8SAPI_DeleteConfigMap_Handler,
 		},
+		{
+			MethodName: "CreateConfigMap",
+			Handler:    _K8SAPI_CreateConfigMap_Handler,
+		},
+		{
+			MethodName: "UpdateConfigMap",
+			Handler:    _K8SAPI_UpdateConfigMap_Handler,
+		},
+		{
+			MethodName: "ListSecrets",
+			Handler:    _K8SAPI_ListSecrets_Handler,
+		},
+		{
+			MethodName: "DescribeSecret",
+			Handler:    _K8SAPI_DescribeSecret_Handler,
+		},
+		{
+			MethodName: "UpdateSecret",
+			Handler:    _K8SAPI_UpdateSecret_Handler,
+		},
 		{
 			MethodName: "DescribeJob",
 			Handler:    _K8SAPI_DescribeJob_Handler,
@@ -1213,7 +2155,63 @@ var K8SAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateNode",
 			Handler:    _K8SAPI_UpdateNode_Handler,
 		},
+		{
+			MethodName: "ListAPIResources",
+			Handler:    _K8SAPI_ListAPIResources_Handler,
+		},
+		{
+			MethodName: "DescribeResource",
+			Handler:    _K8SAPI_DescribeResource_Handler,
+		},
+		{
+			MethodName: "ListResources",
+			Handler:    _K8SAPI_ListResources_Handler,
+		},
+		{
+			MethodName: "PatchResource",
+			Handler:    _K8SAPI_PatchResource_Handler,
+		},
+		{
+			MethodName: "DeleteResource",
+			Handler:    _K8SAPI_DeleteResource_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ScaleWorkload",
+			Handler:       _K8SAPI_ScaleWorkload_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SetContainerImage",
+			Handler:       _K8SAPI_SetContainerImage_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchPods",
+			Handler:       _K8SAPI_WatchPods_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchDeployments",
+			Handler:       _K8SAPI_WatchDeployments_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchHPAs",
+			Handler:       _K8SAPI_WatchHPAs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchNodes",
+			Handler:       _K8SAPI_WatchNodes_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _K8SAPI_WatchEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "k8s/v1/k8s.proto",
 }
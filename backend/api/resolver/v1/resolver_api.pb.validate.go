@@ -993,6 +993,288 @@ var _ interface {
 	ErrorName() string
 } = SearchResponseValidationError{}
 
+// Validate checks the field values on FederatedSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FederatedSearchRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FederatedSearchRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FederatedSearchRequestMultiError, or nil if none found.
+func (m *FederatedSearchRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FederatedSearchRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetQuery()) < 1 {
+		err := FederatedSearchRequestValidationError{
+			field:  "Query",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Limit
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = FederatedSearchRequestValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			lte := time.Duration(60*time.Second + 0*time.Nanosecond)
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt || dur > lte {
+				err := FederatedSearchRequestValidationError{
+					field:  "Timeout",
+					reason: "value must be inside range (0s, 1m0s]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return FederatedSearchRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FederatedSearchRequestMultiError is an error wrapping multiple validation
+// errors returned by FederatedSearchRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FederatedSearchRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FederatedSearchRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FederatedSearchRequestMultiError) AllErrors() []error { return m }
+
+// FederatedSearchRequestValidationError is the validation error returned by
+// FederatedSearchRequest.Validate if the designated constraints aren't met.
+type FederatedSearchRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FederatedSearchRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FederatedSearchRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FederatedSearchRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FederatedSearchRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FederatedSearchRequestValidationError) ErrorName() string {
+	return "FederatedSearchRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FederatedSearchRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFederatedSearchRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FederatedSearchRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FederatedSearchRequestValidationError{}
+
+// Validate checks the field values on FederatedSearchResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FederatedSearchResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FederatedSearchResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FederatedSearchResponseMultiError, or nil if none found.
+func (m *FederatedSearchResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FederatedSearchResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FederatedSearchResponseValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FederatedSearchResponseValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FederatedSearchResponseValidationError{
+					field:  fmt.Sprintf("Groups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FederatedSearchResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FederatedSearchResponseMultiError is an error wrapping multiple validation
+// errors returned by FederatedSearchResponse.ValidateAll() if the designated
+// constraints aren't met.
+type FederatedSearchResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FederatedSearchResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FederatedSearchResponseMultiError) AllErrors() []error { return m }
+
+// FederatedSearchResponseValidationError is the validation error returned by
+// FederatedSearchResponse.Validate if the designated constraints aren't met.
+type FederatedSearchResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FederatedSearchResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FederatedSearchResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FederatedSearchResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FederatedSearchResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FederatedSearchResponseValidationError) ErrorName() string {
+	return "FederatedSearchResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FederatedSearchResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFederatedSearchResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FederatedSearchResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FederatedSearchResponseValidationError{}
+
 // Validate checks the field values on GetObjectSchemasRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1243,3 +1525,178 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetObjectSchemasResponseValidationError{}
+
+// Validate checks the field values on FederatedSearchResponse_Group with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FederatedSearchResponse_Group) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FederatedSearchResponse_Group with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// FederatedSearchResponse_GroupMultiError, or nil if none found.
+func (m *FederatedSearchResponse_Group) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FederatedSearchResponse_Group) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TypeUrl
+
+	// no validation rules for Resolver
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FederatedSearchResponse_GroupValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FederatedSearchResponse_GroupValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FederatedSearchResponse_GroupValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPartialFailures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FederatedSearchResponse_GroupValidationError{
+						field:  fmt.Sprintf("PartialFailures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FederatedSearchResponse_GroupValidationError{
+						field:  fmt.Sprintf("PartialFailures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FederatedSearchResponse_GroupValidationError{
+					field:  fmt.Sprintf("PartialFailures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FederatedSearchResponse_GroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// FederatedSearchResponse_GroupMultiError is an error wrapping multiple
+// validation errors returned by FederatedSearchResponse_Group.ValidateAll()
+// if the designated constraints aren't met.
+type FederatedSearchResponse_GroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FederatedSearchResponse_GroupMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FederatedSearchResponse_GroupMultiError) AllErrors() []error { return m }
+
+// FederatedSearchResponse_GroupValidationError is the validation error
+// returned by FederatedSearchResponse_Group.Validate if the designated
+// constraints aren't met.
+type FederatedSearchResponse_GroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FederatedSearchResponse_GroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FederatedSearchResponse_GroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FederatedSearchResponse_GroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FederatedSearchResponse_GroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FederatedSearchResponse_GroupValidationError) ErrorName() string {
+	return "FederatedSearchResponse_GroupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FederatedSearchResponse_GroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFederatedSearchResponse_Group.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FederatedSearchResponse_GroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FederatedSearchResponse_GroupValidationError{}
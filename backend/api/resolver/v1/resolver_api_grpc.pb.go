@@ -24,6 +24,7 @@ const _ = grpc.SupportPackageIsVersion7
 type ResolverAPIClient interface {
 	GetObjectSchemas(ctx context.Context, in *GetObjectSchemasRequest, opts ...grpc.CallOption) (*GetObjectSchemasResponse, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	FederatedSearch(ctx context.Context, in *FederatedSearchRequest, opts ...grpc.CallOption) (*FederatedSearchResponse, error)
 	Resolve(ctx context.Context, in *ResolveRequest, opts ...grpc.CallOption) (*ResolveResponse, error)
 	Autocomplete(ctx context.Context, in *AutocompleteRequest, opts ...grpc.CallOption) (*AutocompleteResponse, error)
 }
@@ -54,6 +55,15 @@ func (c *resolverAPIClient) Search(ctx context.Context, in *SearchRequest, opts
 	return out, nil
 }
 
+func (c *resolverAPIClient) FederatedSearch(ctx context.Context, in *FederatedSearchRequest, opts ...grpc.CallOption) (*FederatedSearchResponse, error) {
+	out := new(FederatedSearchResponse)
+	err := c.cc.Invoke(ctx, "/clutch.resolver.v1.ResolverAPI/FederatedSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resolverAPIClient) Resolve(ctx context.Context, in *ResolveRequest, opts ...grpc.CallOption) (*ResolveResponse, error) {
 	out := new(ResolveResponse)
 	err := c.cc.Invoke(ctx, "/clutch.resolver.v1.ResolverAPI/Resolve", in, out, opts...)
@@ -78,6 +88,7 @@ func (c *resolverAPIClient) Autocomplete(ctx context.Context, in *AutocompleteRe
 type ResolverAPIServer interface {
 	GetObjectSchemas(context.Context, *GetObjectSchemasRequest) (*GetObjectSchemasResponse, error)
 	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+	FederatedSearch(context.Context, *FederatedSearchRequest) (*FederatedSearchResponse, error)
 	Resolve(context.Context, *ResolveRequest) (*ResolveResponse, error)
 	Autocomplete(context.Context, *AutocompleteRequest) (*AutocompleteResponse, error)
 }
@@ -92,6 +103,9 @@ func (UnimplementedResolverAPIServer) GetObjectSchemas(context.Context, *GetObje
 func (UnimplementedResolverAPIServer) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (UnimplementedResolverAPIServer) FederatedSearch(context.Context, *FederatedSearchRequest) (*FederatedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FederatedSearch not implemented")
+}
 func (UnimplementedResolverAPIServer) Resolve(context.Context, *ResolveRequest) (*ResolveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Resolve not implemented")
 }
@@ -146,6 +160,24 @@ func _ResolverAPI_Search_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResolverAPI_FederatedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FederatedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResolverAPIServer).FederatedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.resolver.v1.ResolverAPI/FederatedSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResolverAPIServer).FederatedSearch(ctx, req.(*FederatedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResolverAPI_Resolve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResolveRequest)
 	if err := dec(in); err != nil {
@@ -197,6 +229,10 @@ var ResolverAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Search",
 			Handler:    _ResolverAPI_Search_Handler,
 		},
+		{
+			MethodName: "FederatedSearch",
+			Handler:    _ResolverAPI_FederatedSearch_Handler,
+		},
 		{
 			MethodName: "Resolve",
 			Handler:    _ResolverAPI_Resolve_Handler,
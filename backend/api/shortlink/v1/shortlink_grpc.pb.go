@@ -24,6 +24,14 @@ const _ = grpc.SupportPackageIsVersion7
 type ShortlinkAPIClient interface {
 	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*CreateResponse, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	// List shortlinks created by a user. Expired shortlinks are not returned.
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// Delete a shortlink. Only the creator of a shortlink and members of the admin groups configured for the shortlink
+	// service can delete it.
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// Extend the expiration of a shortlink. Only the creator of a shortlink and members of the admin groups configured
+	// for the shortlink service can extend it.
+	Extend(ctx context.Context, in *ExtendRequest, opts ...grpc.CallOption) (*ExtendResponse, error)
 }
 
 type shortlinkAPIClient struct {
@@ -52,12 +60,47 @@ func (c *shortlinkAPIClient) Get(ctx context.Context, in *GetRequest, opts ...gr
 	return out, nil
 }
 
+func (c *shortlinkAPIClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/clutch.shortlink.v1.ShortlinkAPI/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortlinkAPIClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/clutch.shortlink.v1.ShortlinkAPI/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shortlinkAPIClient) Extend(ctx context.Context, in *ExtendRequest, opts ...grpc.CallOption) (*ExtendResponse, error) {
+	out := new(ExtendResponse)
+	err := c.cc.Invoke(ctx, "/clutch.shortlink.v1.ShortlinkAPI/Extend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ShortlinkAPIServer is the server API for ShortlinkAPI service.
 // All implementations should embed UnimplementedShortlinkAPIServer
 // for forward compatibility
 type ShortlinkAPIServer interface {
 	Create(context.Context, *CreateRequest) (*CreateResponse, error)
 	Get(context.Context, *GetRequest) (*GetResponse, error)
+	// List shortlinks created by a user. Expired shortlinks are not returned.
+	List(context.Context, *ListRequest) (*ListResponse, error)
+	// Delete a shortlink. Only the creator of a shortlink and members of the admin groups configured for the shortlink
+	// service can delete it.
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	// Extend the expiration of a shortlink. Only the creator of a shortlink and members of the admin groups configured
+	// for the shortlink service can extend it.
+	Extend(context.Context, *ExtendRequest) (*ExtendResponse, error)
 }
 
 // UnimplementedShortlinkAPIServer should be embedded to have forward compatible implementations.
@@ -70,6 +113,15 @@ func (UnimplementedShortlinkAPIServer) Create(context.Context, *CreateRequest) (
 func (UnimplementedShortlinkAPIServer) Get(context.Context, *GetRequest) (*GetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedShortlinkAPIServer) List(context.Context, *ListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedShortlinkAPIServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedShortlinkAPIServer) Extend(context.Context, *ExtendRequest) (*ExtendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Extend not implemented")
+}
 
 // UnsafeShortlinkAPIServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ShortlinkAPIServer will
@@ -118,6 +170,60 @@ func _ShortlinkAPI_Get_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShortlinkAPI_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortlinkAPIServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.shortlink.v1.ShortlinkAPI/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortlinkAPIServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortlinkAPI_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortlinkAPIServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.shortlink.v1.ShortlinkAPI/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortlinkAPIServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShortlinkAPI_Extend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShortlinkAPIServer).Extend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/clutch.shortlink.v1.ShortlinkAPI/Extend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShortlinkAPIServer).Extend(ctx, req.(*ExtendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ShortlinkAPI_ServiceDesc is the grpc.ServiceDesc for ShortlinkAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -133,6 +239,18 @@ var ShortlinkAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _ShortlinkAPI_Get_Handler,
 		},
+		{
+			MethodName: "List",
+			Handler:    _ShortlinkAPI_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ShortlinkAPI_Delete_Handler,
+		},
+		{
+			MethodName: "Extend",
+			Handler:    _ShortlinkAPI_Extend_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "shortlink/v1/shortlink.proto",
@@ -0,0 +1,2147 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: workflow/v1/workflow.proto
+
+package workflowv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on HPARollout with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HPARollout) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HPARollout with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HPARolloutMultiError, or
+// nil if none found.
+func (m *HPARollout) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HPARollout) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetClientset()) < 1 {
+		err := HPARolloutValidationError{
+			field:  "Clientset",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCluster()) < 1 {
+		err := HPARolloutValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) < 1 {
+		err := HPARolloutValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := HPARolloutValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetSizing() == nil {
+		err := HPARolloutValidationError{
+			field:  "Sizing",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSizing()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HPARolloutValidationError{
+					field:  "Sizing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HPARolloutValidationError{
+					field:  "Sizing",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSizing()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HPARolloutValidationError{
+				field:  "Sizing",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if d := m.GetReadyTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = HPARolloutValidationError{
+				field:  "ReadyTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := HPARolloutValidationError{
+					field:  "ReadyTimeout",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if m.GetVerifyUrl() != "" {
+
+		if uri, err := url.Parse(m.GetVerifyUrl()); err != nil {
+			err = HPARolloutValidationError{
+				field:  "VerifyUrl",
+				reason: "value must be a valid URI",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else if !uri.IsAbs() {
+			err := HPARolloutValidationError{
+				field:  "VerifyUrl",
+				reason: "value must be absolute",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for RequireApproval
+
+	if len(errors) > 0 {
+		return HPARolloutMultiError(errors)
+	}
+
+	return nil
+}
+
+// HPARolloutMultiError is an error wrapping multiple validation errors
+// returned by HPARollout.ValidateAll() if the designated constraints aren't met.
+type HPARolloutMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HPARolloutMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HPARolloutMultiError) AllErrors() []error { return m }
+
+// HPARolloutValidationError is the validation error returned by
+// HPARollout.Validate if the designated constraints aren't met.
+type HPARolloutValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HPARolloutValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HPARolloutValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HPARolloutValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HPARolloutValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HPARolloutValidationError) ErrorName() string { return "HPARolloutValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HPARolloutValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHPARollout.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HPARolloutValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HPARolloutValidationError{}
+
+// Validate checks the field values on InstanceReplacement with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InstanceReplacement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InstanceReplacement with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InstanceReplacementMultiError, or nil if none found.
+func (m *InstanceReplacement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InstanceReplacement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Account
+
+	if utf8.RuneCountInString(m.GetRegion()) < 1 {
+		err := InstanceReplacementValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAutoscalingGroup()) < 1 {
+		err := InstanceReplacementValidationError{
+			field:  "AutoscalingGroup",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetInstanceIds()) < 1 {
+		err := InstanceReplacementValidationError{
+			field:  "InstanceIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_InstanceReplacement_InstanceIds_Unique := make(map[string]struct{}, len(m.GetInstanceIds()))
+
+	for idx, item := range m.GetInstanceIds() {
+		_, _ = idx, item
+
+		if _, exists := _InstanceReplacement_InstanceIds_Unique[item]; exists {
+			err := InstanceReplacementValidationError{
+				field:  fmt.Sprintf("InstanceIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_InstanceReplacement_InstanceIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for InstanceIds[idx]
+	}
+
+	// no validation rules for BatchSize
+
+	if d := m.GetReadyTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = InstanceReplacementValidationError{
+				field:  "ReadyTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := InstanceReplacementValidationError{
+					field:  "ReadyTimeout",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for RequireApproval
+
+	if len(errors) > 0 {
+		return InstanceReplacementMultiError(errors)
+	}
+
+	return nil
+}
+
+// InstanceReplacementMultiError is an error wrapping multiple validation
+// errors returned by InstanceReplacement.ValidateAll() if the designated
+// constraints aren't met.
+type InstanceReplacementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InstanceReplacementMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InstanceReplacementMultiError) AllErrors() []error { return m }
+
+// InstanceReplacementValidationError is the validation error returned by
+// InstanceReplacement.Validate if the designated constraints aren't met.
+type InstanceReplacementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InstanceReplacementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InstanceReplacementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InstanceReplacementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InstanceReplacementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InstanceReplacementValidationError) ErrorName() string {
+	return "InstanceReplacementValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InstanceReplacementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInstanceReplacement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InstanceReplacementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InstanceReplacementValidationError{}
+
+// Validate checks the field values on StartWorkflowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartWorkflowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartWorkflowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartWorkflowRequestMultiError, or nil if none found.
+func (m *StartWorkflowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartWorkflowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkflowId
+
+	oneofWorkflowPresent := false
+	switch v := m.Workflow.(type) {
+	case *StartWorkflowRequest_HpaRollout:
+		if v == nil {
+			err := StartWorkflowRequestValidationError{
+				field:  "Workflow",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofWorkflowPresent = true
+
+		if all {
+			switch v := interface{}(m.GetHpaRollout()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartWorkflowRequestValidationError{
+						field:  "HpaRollout",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartWorkflowRequestValidationError{
+						field:  "HpaRollout",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHpaRollout()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartWorkflowRequestValidationError{
+					field:  "HpaRollout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StartWorkflowRequest_InstanceReplacement:
+		if v == nil {
+			err := StartWorkflowRequestValidationError{
+				field:  "Workflow",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofWorkflowPresent = true
+
+		if all {
+			switch v := interface{}(m.GetInstanceReplacement()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartWorkflowRequestValidationError{
+						field:  "InstanceReplacement",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartWorkflowRequestValidationError{
+						field:  "InstanceReplacement",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInstanceReplacement()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartWorkflowRequestValidationError{
+					field:  "InstanceReplacement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofWorkflowPresent {
+		err := StartWorkflowRequestValidationError{
+			field:  "Workflow",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StartWorkflowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartWorkflowRequestMultiError is an error wrapping multiple validation
+// errors returned by StartWorkflowRequest.ValidateAll() if the designated
+// constraints aren't met.
+type StartWorkflowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartWorkflowRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartWorkflowRequestMultiError) AllErrors() []error { return m }
+
+// StartWorkflowRequestValidationError is the validation error returned by
+// StartWorkflowRequest.Validate if the designated constraints aren't met.
+type StartWorkflowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartWorkflowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartWorkflowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartWorkflowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartWorkflowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartWorkflowRequestValidationError) ErrorName() string {
+	return "StartWorkflowRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartWorkflowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartWorkflowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartWorkflowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartWorkflowRequestValidationError{}
+
+// Validate checks the field values on StartWorkflowResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartWorkflowResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartWorkflowResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartWorkflowResponseMultiError, or nil if none found.
+func (m *StartWorkflowResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartWorkflowResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkflowId
+
+	// no validation rules for RunId
+
+	// no validation rules for Resource
+
+	if len(errors) > 0 {
+		return StartWorkflowResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartWorkflowResponseMultiError is an error wrapping multiple validation
+// errors returned by StartWorkflowResponse.ValidateAll() if the designated
+// constraints aren't met.
+type StartWorkflowResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartWorkflowResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartWorkflowResponseMultiError) AllErrors() []error { return m }
+
+// StartWorkflowResponseValidationError is the validation error returned by
+// StartWorkflowResponse.Validate if the designated constraints aren't met.
+type StartWorkflowResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartWorkflowResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartWorkflowResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartWorkflowResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartWorkflowResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartWorkflowResponseValidationError) ErrorName() string {
+	return "StartWorkflowResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartWorkflowResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartWorkflowResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartWorkflowResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartWorkflowResponseValidationError{}
+
+// Validate checks the field values on Step with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Step) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Step with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in StepMultiError, or nil if none found.
+func (m *Step) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Step) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for State
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StepValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StepValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StepValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StepValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StepValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StepValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StepMultiError(errors)
+	}
+
+	return nil
+}
+
+// StepMultiError is an error wrapping multiple validation errors returned by
+// Step.ValidateAll() if the designated constraints aren't met.
+type StepMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StepMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StepMultiError) AllErrors() []error { return m }
+
+// StepValidationError is the validation error returned by Step.Validate if the
+// designated constraints aren't met.
+type StepValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StepValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StepValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StepValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StepValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StepValidationError) ErrorName() string { return "StepValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StepValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStep.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StepValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StepValidationError{}
+
+// Validate checks the field values on Progress with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Progress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Progress with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProgressMultiError, or nil
+// if none found.
+func (m *Progress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Progress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSteps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ProgressValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ProgressValidationError{
+						field:  fmt.Sprintf("Steps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ProgressValidationError{
+					field:  fmt.Sprintf("Steps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for AwaitingApproval
+
+	if len(errors) > 0 {
+		return ProgressMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProgressMultiError is an error wrapping multiple validation errors returned
+// by Progress.ValidateAll() if the designated constraints aren't met.
+type ProgressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProgressMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProgressMultiError) AllErrors() []error { return m }
+
+// ProgressValidationError is the validation error returned by
+// Progress.Validate if the designated constraints aren't met.
+type ProgressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProgressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProgressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProgressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProgressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProgressValidationError) ErrorName() string { return "ProgressValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProgressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProgress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProgressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProgressValidationError{}
+
+// Validate checks the field values on Workflow with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Workflow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Workflow with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WorkflowMultiError, or nil
+// if none found.
+func (m *Workflow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Workflow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for RunId
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkflowValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkflowValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkflowValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCloseTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkflowValidationError{
+					field:  "CloseTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkflowValidationError{
+					field:  "CloseTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCloseTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkflowValidationError{
+				field:  "CloseTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for StartedBy
+
+	if all {
+		switch v := interface{}(m.GetProgress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkflowValidationError{
+					field:  "Progress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkflowValidationError{
+					field:  "Progress",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProgress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkflowValidationError{
+				field:  "Progress",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Resource
+
+	if len(errors) > 0 {
+		return WorkflowMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkflowMultiError is an error wrapping multiple validation errors returned
+// by Workflow.ValidateAll() if the designated constraints aren't met.
+type WorkflowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkflowMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkflowMultiError) AllErrors() []error { return m }
+
+// WorkflowValidationError is the validation error returned by
+// Workflow.Validate if the designated constraints aren't met.
+type WorkflowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkflowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkflowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkflowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkflowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkflowValidationError) ErrorName() string { return "WorkflowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WorkflowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkflow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkflowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkflowValidationError{}
+
+// Validate checks the field values on GetWorkflowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWorkflowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWorkflowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWorkflowRequestMultiError, or nil if none found.
+func (m *GetWorkflowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWorkflowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetWorkflowId()) < 1 {
+		err := GetWorkflowRequestValidationError{
+			field:  "WorkflowId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for RunId
+
+	if utf8.RuneCountInString(m.GetResource()) < 1 {
+		err := GetWorkflowRequestValidationError{
+			field:  "Resource",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetWorkflowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWorkflowRequestMultiError is an error wrapping multiple validation errors
+// returned by GetWorkflowRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetWorkflowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWorkflowRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWorkflowRequestMultiError) AllErrors() []error { return m }
+
+// GetWorkflowRequestValidationError is the validation error returned by
+// GetWorkflowRequest.Validate if the designated constraints aren't met.
+type GetWorkflowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWorkflowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWorkflowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWorkflowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWorkflowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWorkflowRequestValidationError) ErrorName() string {
+	return "GetWorkflowRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWorkflowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWorkflowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWorkflowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWorkflowRequestValidationError{}
+
+// Validate checks the field values on GetWorkflowResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetWorkflowResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWorkflowResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetWorkflowResponseMultiError, or nil if none found.
+func (m *GetWorkflowResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWorkflowResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWorkflow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetWorkflowResponseValidationError{
+					field:  "Workflow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetWorkflowResponseValidationError{
+					field:  "Workflow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkflow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetWorkflowResponseValidationError{
+				field:  "Workflow",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetWorkflowResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWorkflowResponseMultiError is an error wrapping multiple validation
+// errors returned by GetWorkflowResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetWorkflowResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWorkflowResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWorkflowResponseMultiError) AllErrors() []error { return m }
+
+// GetWorkflowResponseValidationError is the validation error returned by
+// GetWorkflowResponse.Validate if the designated constraints aren't met.
+type GetWorkflowResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWorkflowResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWorkflowResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWorkflowResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWorkflowResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWorkflowResponseValidationError) ErrorName() string {
+	return "GetWorkflowResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWorkflowResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWorkflowResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWorkflowResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWorkflowResponseValidationError{}
+
+// Validate checks the field values on Signal with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Signal) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Signal with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SignalMultiError, or nil if none found.
+func (m *Signal) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Signal) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Username
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return SignalMultiError(errors)
+	}
+
+	return nil
+}
+
+// SignalMultiError is an error wrapping multiple validation errors returned by
+// Signal.ValidateAll() if the designated constraints aren't met.
+type SignalMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SignalMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SignalMultiError) AllErrors() []error { return m }
+
+// SignalValidationError is the validation error returned by Signal.Validate if
+// the designated constraints aren't met.
+type SignalValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SignalValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SignalValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SignalValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SignalValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SignalValidationError) ErrorName() string { return "SignalValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SignalValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSignal.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SignalValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SignalValidationError{}
+
+// Validate checks the field values on SignalWorkflowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SignalWorkflowRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SignalWorkflowRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SignalWorkflowRequestMultiError, or nil if none found.
+func (m *SignalWorkflowRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SignalWorkflowRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetWorkflowId()) < 1 {
+		err := SignalWorkflowRequestValidationError{
+			field:  "WorkflowId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for RunId
+
+	if _, ok := _SignalWorkflowRequest_Type_NotInLookup[m.GetType()]; ok {
+		err := SignalWorkflowRequestValidationError{
+			field:  "Type",
+			reason: "value must not be in list [0]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := Signal_Type_name[int32(m.GetType())]; !ok {
+		err := SignalWorkflowRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Reason
+
+	if utf8.RuneCountInString(m.GetResource()) < 1 {
+		err := SignalWorkflowRequestValidationError{
+			field:  "Resource",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SignalWorkflowRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SignalWorkflowRequestMultiError is an error wrapping multiple validation
+// errors returned by SignalWorkflowRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SignalWorkflowRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SignalWorkflowRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SignalWorkflowRequestMultiError) AllErrors() []error { return m }
+
+// SignalWorkflowRequestValidationError is the validation error returned by
+// SignalWorkflowRequest.Validate if the designated constraints aren't met.
+type SignalWorkflowRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SignalWorkflowRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SignalWorkflowRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SignalWorkflowRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SignalWorkflowRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SignalWorkflowRequestValidationError) ErrorName() string {
+	return "SignalWorkflowRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SignalWorkflowRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSignalWorkflowRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SignalWorkflowRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SignalWorkflowRequestValidationError{}
+
+var _SignalWorkflowRequest_Type_NotInLookup = map[Signal_Type]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on SignalWorkflowResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SignalWorkflowResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SignalWorkflowResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SignalWorkflowResponseMultiError, or nil if none found.
+func (m *SignalWorkflowResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SignalWorkflowResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SignalWorkflowResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SignalWorkflowResponseMultiError is an error wrapping multiple validation
+// errors returned by SignalWorkflowResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SignalWorkflowResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SignalWorkflowResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SignalWorkflowResponseMultiError) AllErrors() []error { return m }
+
+// SignalWorkflowResponseValidationError is the validation error returned by
+// SignalWorkflowResponse.Validate if the designated constraints aren't met.
+type SignalWorkflowResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SignalWorkflowResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SignalWorkflowResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SignalWorkflowResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SignalWorkflowResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SignalWorkflowResponseValidationError) ErrorName() string {
+	return "SignalWorkflowResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SignalWorkflowResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSignalWorkflowResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SignalWorkflowResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SignalWorkflowResponseValidationError{}
+
+// Validate checks the field values on ListWorkflowsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWorkflowsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWorkflowsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWorkflowsRequestMultiError, or nil if none found.
+func (m *ListWorkflowsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWorkflowsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if m.GetPageSize() > 1000 {
+		err := ListWorkflowsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 1000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListWorkflowsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWorkflowsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListWorkflowsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListWorkflowsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWorkflowsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWorkflowsRequestMultiError) AllErrors() []error { return m }
+
+// ListWorkflowsRequestValidationError is the validation error returned by
+// ListWorkflowsRequest.Validate if the designated constraints aren't met.
+type ListWorkflowsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWorkflowsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWorkflowsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWorkflowsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWorkflowsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWorkflowsRequestValidationError) ErrorName() string {
+	return "ListWorkflowsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWorkflowsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWorkflowsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWorkflowsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWorkflowsRequestValidationError{}
+
+// Validate checks the field values on ListWorkflowsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWorkflowsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWorkflowsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWorkflowsResponseMultiError, or nil if none found.
+func (m *ListWorkflowsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWorkflowsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetWorkflows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWorkflowsResponseValidationError{
+						field:  fmt.Sprintf("Workflows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWorkflowsResponseValidationError{
+						field:  fmt.Sprintf("Workflows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWorkflowsResponseValidationError{
+					field:  fmt.Sprintf("Workflows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListWorkflowsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWorkflowsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListWorkflowsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListWorkflowsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWorkflowsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWorkflowsResponseMultiError) AllErrors() []error { return m }
+
+// ListWorkflowsResponseValidationError is the validation error returned by
+// ListWorkflowsResponse.Validate if the designated constraints aren't met.
+type ListWorkflowsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWorkflowsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWorkflowsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWorkflowsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWorkflowsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWorkflowsResponseValidationError) ErrorName() string {
+	return "ListWorkflowsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWorkflowsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWorkflowsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWorkflowsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWorkflowsResponseValidationError{}
@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS shortlink_expires_at;
+DROP INDEX IF EXISTS shortlink_created_by;
+
+ALTER TABLE shortlink
+  DROP COLUMN IF EXISTS last_accessed_at,
+  DROP COLUMN IF EXISTS access_count,
+  DROP COLUMN IF EXISTS expires_at,
+  DROP COLUMN IF EXISTS created_at,
+  DROP COLUMN IF EXISTS created_by;
@@ -0,0 +1,11 @@
+ALTER TABLE shortlink
+  -- created_by is the subject of the user that created the shortlink
+  ADD COLUMN IF NOT EXISTS created_by text,
+  ADD COLUMN IF NOT EXISTS created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+  -- expires_at is null if the shortlink does not expire
+  ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP WITH TIME ZONE,
+  ADD COLUMN IF NOT EXISTS access_count bigint NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS last_accessed_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS shortlink_created_by ON shortlink (created_by, created_at);
+CREATE INDEX IF NOT EXISTS shortlink_expires_at ON shortlink (expires_at);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS rate_limit_bucket (
+  -- key identifies the limit and the subject or group it is counted for
+  bucket_key text PRIMARY KEY,
+  -- tokens remaining as of updated_at
+  tokens double precision NOT NULL,
+  updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+-- expired buckets are deleted by the time they were last updated
+CREATE INDEX IF NOT EXISTS rate_limit_bucket_updated_at_idx ON rate_limit_bucket (updated_at);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS authn_service_tokens (
+  -- matches the jti claim of the issued token
+  id text PRIMARY KEY,
+  subject text NOT NULL,
+  creator text NOT NULL,
+  description text NOT NULL DEFAULT '',
+  -- method patterns the token is restricted to, empty if unrestricted
+  scopes text[] NOT NULL DEFAULT '{}',
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+  expires_at TIMESTAMP WITH TIME ZONE,
+  last_used_at TIMESTAMP WITH TIME ZONE,
+  revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS authn_service_tokens_subject_idx ON authn_service_tokens (subject);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS authn_local_codes;
+DROP TABLE IF EXISTS authn_local_users;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS authn_local_users (
+  username text PRIMARY KEY,
+  -- bcrypt hash of the user's password
+  password_hash text NOT NULL,
+  groups text[] NOT NULL DEFAULT '{}'
+);
+
+-- login codes of the local provider that were exchanged for a token, kept until they expire
+CREATE TABLE IF NOT EXISTS authn_local_codes (
+  id text PRIMARY KEY,
+  expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
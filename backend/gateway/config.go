@@ -44,11 +44,16 @@ type Flags struct {
 
 // Link register the struct vars globally for parsing by the flag library.
 func (f *Flags) Link() {
-	flag.StringVar(&f.ConfigPath, "c", "clutch-config.yaml", "path to YAML configuration")
-	flag.BoolVar(&f.Template, "template", false, "executes go templates on the configuration file")
+	f.linkConfigFlags(flag.CommandLine)
 	flag.BoolVar(&f.Validate, "validate", false, "validates the configuration file and exits")
 	flag.BoolVar(&f.Debug, "debug", false, "print the final composed configuration file to stdout")
-	flag.Var(&f.EnvFiles, "env", "path to additional .env files to load")
+}
+
+// linkConfigFlags registers the vars that determine how the configuration is read.
+func (f *Flags) linkConfigFlags(fs *flag.FlagSet) {
+	fs.StringVar(&f.ConfigPath, "c", "clutch-config.yaml", "path to YAML configuration")
+	fs.BoolVar(&f.Template, "template", false, "executes go templates on the configuration file")
+	fs.Var(&f.EnvFiles, "env", "path to additional .env files to load")
 }
 
 // Parse command line arguments.
@@ -65,7 +70,9 @@ func MustReadOrValidateConfig(f *Flags) *gatewayv1.Config {
 
 	var cfg gatewayv1.Config
 	var seenCfgs []string
-	consolidateConfigs(filepath.Dir(f.ConfigPath), filepath.Base(f.ConfigPath), &cfg, f, &seenCfgs)
+	if err := consolidateConfigs(filepath.Dir(f.ConfigPath), filepath.Base(f.ConfigPath), &cfg, f, &seenCfgs); err != nil {
+		tmpLogger.Fatal("parsing configuration failed", zap.Error(err))
+	}
 	if err := cfg.Validate(); err != nil {
 		tmpLogger.Fatal("configuration proto validation failed", zap.Error(err))
 	}
@@ -91,6 +98,32 @@ func MustReadOrValidateConfig(f *Flags) *gatewayv1.Config {
 	return &cfg
 }
 
+// readConfig composes and validates the configuration without exiting on failure. It also returns the paths of all
+// files that were read, including those extended.
+func readConfig(f *Flags) (*gatewayv1.Config, []string, error) {
+	cfg, seenCfgs, err := composeConfig(f)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, nil, err
+	}
+	if err := ensureUnique(cfg); err != nil {
+		return nil, nil, err
+	}
+	return cfg, seenCfgs, nil
+}
+
+// composeConfig merges the configuration with those it extends, without validating the result.
+func composeConfig(f *Flags) (*gatewayv1.Config, []string, error) {
+	var cfg gatewayv1.Config
+	var seenCfgs []string
+	if err := consolidateConfigs(filepath.Dir(f.ConfigPath), filepath.Base(f.ConfigPath), &cfg, f, &seenCfgs); err != nil {
+		return nil, nil, err
+	}
+	return &cfg, seenCfgs, nil
+}
+
 // Ensure gateway config has unique modules and service entries
 func ensureUnique(cfg *gatewayv1.Config) error {
 	svcs := make(map[string]bool)
@@ -129,34 +162,37 @@ func contains(s *[]string, str string) bool {
 	return false
 }
 
-func consolidateConfigs(cfgBaseDir, cfgFile string, cfg *gatewayv1.Config, f *Flags, seen *[]string) {
+func consolidateConfigs(cfgBaseDir, cfgFile string, cfg *gatewayv1.Config, f *Flags, seen *[]string) error {
 	// Use a temporary logger to parse the configuration and output.
 	cfgPath := filepath.Join(cfgBaseDir, cfgFile)
 	tmpLogger := newTmpLogger().With(zap.String("file", cfgPath))
 
 	if contains(seen, cfgPath) {
 		tmpLogger.Warn("ignoring duplicate extended config")
-		return
+		return nil
 	}
 
 	var curCfg gatewayv1.Config
 	if err := parseFile(cfgPath, &curCfg, f.Template); err != nil {
-		tmpLogger.Fatal("parsing configuration failed", zap.Error(err))
+		return fmt.Errorf("%s: %w", cfgPath, err)
 	}
 
 	*seen = append(*seen, cfgPath)
 	if len(curCfg.Extends) == 0 {
 		proto.Merge(cfg, &curCfg)
-		return
+		return nil
 	}
 
 	for _, c := range curCfg.Extends {
 		if c == cfgPath {
 			continue
 		}
-		consolidateConfigs(filepath.Dir(cfgPath), c, cfg, f, seen)
+		if err := consolidateConfigs(filepath.Dir(cfgPath), c, cfg, f, seen); err != nil {
+			return err
+		}
 	}
 	proto.Merge(cfg, &curCfg)
+	return nil
 }
 
 func executeTemplate(contents []byte) ([]byte, error) {
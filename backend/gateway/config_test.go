@@ -105,7 +105,8 @@ gateway:
 
 	var cfg gatewayv1.Config
 	var seenCfgs []string
-	consolidateConfigs(filepath.Dir(cc.Name()), filepath.Base(cc.Name()), &cfg, &Flags{Template: false}, &seenCfgs)
+	err := consolidateConfigs(filepath.Dir(cc.Name()), filepath.Base(cc.Name()), &cfg, &Flags{Template: false}, &seenCfgs)
+	assert.NoError(t, err)
 
 	assert.Equal(t, true, cfg.GetGateway().GetLogger().GetPretty())
 	assert.Equal(t, gatewayv1.Logger_DEBUG, cfg.GetGateway().GetLogger().GetLevel())
@@ -148,7 +149,8 @@ gateway:
 
 	var cfg gatewayv1.Config
 	var seenCfgs []string
-	consolidateConfigs(filepath.Dir(cc.Name()), filepath.Base(cc.Name()), &cfg, &Flags{Template: false}, &seenCfgs)
+	err := consolidateConfigs(filepath.Dir(cc.Name()), filepath.Base(cc.Name()), &cfg, &Flags{Template: false}, &seenCfgs)
+	assert.NoError(t, err)
 
 	assert.Equal(t, false, cfg.GetGateway().GetLogger().GetPretty())
 	assert.Equal(t, gatewayv1.Logger_WARN, cfg.GetGateway().GetLogger().GetLevel())
@@ -183,7 +185,8 @@ gateway:
 
 	var cfg gatewayv1.Config
 	var seenCfgs []string
-	consolidateConfigs(filepath.Dir(cc.Name()), filepath.Base(cc.Name()), &cfg, &Flags{Template: false}, &seenCfgs)
+	err := consolidateConfigs(filepath.Dir(cc.Name()), filepath.Base(cc.Name()), &cfg, &Flags{Template: false}, &seenCfgs)
+	assert.NoError(t, err)
 
 	assert.Equal(t, true, cfg.GetGateway().GetLogger().GetPretty())
 	assert.Equal(t, gatewayv1.Logger_WARN, cfg.GetGateway().GetLogger().GetLevel())
@@ -0,0 +1,276 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
+	"gopkg.in/yaml.v3"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+const configCommandUsage = `usage: %s config <command> [flags]
+
+Commands:
+  print        print the merged configuration
+  lint         validate the configuration against the available components
+  diff OTHER   compare the merged configuration with the configuration in OTHER, which is read with the
+               -other-env and -other-template flags instead of -env and -template
+
+Flags:
+`
+
+// RunConfigCommand runs the config subcommand with the given arguments and returns the exit code. The configuration is
+// checked against the components in the factory, so custom gateways should pass their own.
+func RunConfigCommand(args []string, cf *ComponentFactory) int {
+	return runConfigCommand(args, cf, os.Stdout, os.Stderr)
+}
+
+func runConfigCommand(args []string, cf *ComponentFactory, stdout, stderr io.Writer) int {
+	f := &Flags{}
+	other := &Flags{}
+	fs := flag.NewFlagSet("config", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	f.linkConfigFlags(fs)
+	fs.BoolVar(&other.Template, "other-template", false, "diff: executes go templates on the OTHER configuration file")
+	fs.Var(&other.EnvFiles, "other-env", "diff: path to additional .env files to load for the OTHER configuration")
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, configCommandUsage, os.Args[0])
+		fs.PrintDefaults()
+	}
+
+	if len(args) == 0 {
+		fs.Usage()
+		return 2
+	}
+	command := args[0]
+	if err := fs.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	switch command {
+	case "print":
+		loadEnv(f)
+		return printConfig(f, stdout, stderr)
+	case "lint":
+		loadEnv(f)
+		return lintConfigCommand(f, cf, stdout, stderr)
+	case "diff":
+		if fs.NArg() != 1 {
+			fs.Usage()
+			return 2
+		}
+		other.ConfigPath = fs.Arg(0)
+		return diffConfig(f, other, stdout, stderr)
+	default:
+		fs.Usage()
+		return 2
+	}
+}
+
+func printConfig(f *Flags, stdout, stderr io.Writer) int {
+	cfg, _, err := composeConfig(f)
+	if err != nil {
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 1
+	}
+
+	b, err := marshalConfigYAML(cfg)
+	if err != nil {
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 1
+	}
+	_, _ = stdout.Write(b)
+	return 0
+}
+
+func lintConfigCommand(f *Flags, cf *ComponentFactory, stdout, stderr io.Writer) int {
+	cfg, _, err := composeConfig(f)
+	if err != nil {
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 1
+	}
+
+	problems := lintConfig(cfg, cf)
+	for _, p := range problems {
+		fmt.Fprintln(stdout, p)
+	}
+	if len(problems) > 0 {
+		fmt.Fprintf(stderr, "found %d problem(s) in %s\n", len(problems), f.ConfigPath)
+		return 1
+	}
+	fmt.Fprintf(stderr, "%s is valid\n", f.ConfigPath)
+	return 0
+}
+
+// diffConfig prints a unified diff of the two merged configurations. As with diff(1), the exit code is 1 if they
+// differ and 2 on error.
+func diffConfig(f, other *Flags, stdout, stderr io.Writer) int {
+	var rendered []string
+	for _, flags := range []*Flags{f, other} {
+		cfg, err := composeConfigWithEnv(flags)
+		if err != nil {
+			fmt.Fprintf(stderr, "error: %v\n", err)
+			return 2
+		}
+		b, err := marshalConfigYAML(cfg)
+		if err != nil {
+			fmt.Fprintf(stderr, "error: %v\n", err)
+			return 2
+		}
+		rendered = append(rendered, string(b))
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(rendered[0]),
+		B:        difflib.SplitLines(rendered[1]),
+		FromFile: f.ConfigPath,
+		ToFile:   other.ConfigPath,
+		Context:  3,
+	})
+	if err != nil {
+		fmt.Fprintf(stderr, "error: %v\n", err)
+		return 2
+	}
+	if diff == "" {
+		return 0
+	}
+	fmt.Fprint(stdout, diff)
+	return 1
+}
+
+// composeConfigWithEnv composes the configuration with only the env files of its flags loaded on top of the process
+// environment, which is restored afterwards.
+func composeConfigWithEnv(f *Flags) (*gatewayv1.Config, error) {
+	environ := os.Environ()
+	defer func() {
+		os.Clearenv()
+		for _, kv := range environ {
+			if k, v, ok := strings.Cut(kv, "="); ok {
+				_ = os.Setenv(k, v)
+			}
+		}
+	}()
+
+	loadEnv(f)
+	cfg, _, err := composeConfig(f)
+	return cfg, err
+}
+
+// marshalConfigYAML renders the configuration as YAML with sorted keys, so that equal configurations render equally.
+func marshalConfigYAML(cfg *gatewayv1.Config) ([]byte, error) {
+	b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// lintConfig returns the problems with the configuration that would prevent the gateway from starting.
+func lintConfig(cfg *gatewayv1.Config, cf *ComponentFactory) []error {
+	var problems []error
+
+	if err := cfg.ValidateAll(); err != nil {
+		if multi, ok := err.(interface{ AllErrors() []error }); ok {
+			problems = append(problems, multi.AllErrors()...)
+		} else {
+			problems = append(problems, err)
+		}
+	}
+	if err := ensureUnique(cfg); err != nil {
+		problems = append(problems, err)
+	}
+
+	if tcpCfg := cfg.GetGateway().GetListener().GetTcp(); tcpCfg.GetSecure() && tcpCfg.GetTls() == nil {
+		problems = append(problems, fmt.Errorf("gateway: 'secure' set to true but 'tls' is not configured"))
+	}
+	if loopbackTCP := cfg.GetGateway().GetJsonGrpcLoopbackListener().GetTcp(); loopbackTCP != nil {
+		if loopbackTCP.GetTls() != nil {
+			problems = append(problems, fmt.Errorf("gateway: TLS is not supported on the loopback listener"))
+		}
+		if ip := net.ParseIP(loopbackTCP.Address); loopbackTCP.Address != "localhost" && (ip == nil || !ip.IsLoopback()) {
+			problems = append(problems, fmt.Errorf("gateway: the loopback listener must listen on a loopback address, not '%s'", loopbackTCP.Address))
+		}
+	}
+
+	// The position of each service, since services are instantiated in order.
+	services := make(map[string]int, len(cfg.Services))
+	for i, s := range cfg.Services {
+		if _, ok := services[s.Name]; !ok {
+			services[s.Name] = i
+		}
+	}
+
+	// Components other than services are instantiated after all services, which is represented by a position of -1.
+	checkDependencies := func(kind, name string, position int) {
+		for _, dep := range cf.Dependencies[name] {
+			depPosition, ok := services[dep]
+			switch {
+			case !ok:
+				problems = append(problems, fmt.Errorf("%s %s: requires service '%s', which is not configured", kind, name, dep))
+			case position >= 0 && depPosition > position:
+				problems = append(problems, fmt.Errorf("%s %s: requires service '%s', which must be listed before it", kind, name, dep))
+			}
+		}
+	}
+
+	for i, s := range cfg.Services {
+		factory, ok := cf.Services[s.Name]
+		problems = append(problems, lintComponent("service", s.Name, ok, factory == nil, s.TypedConfig)...)
+		checkDependencies("service", s.Name, i)
+	}
+	for _, r := range cfg.Resolvers {
+		factory, ok := cf.Resolvers[r.Name]
+		problems = append(problems, lintComponent("resolver", r.Name, ok, factory == nil, r.TypedConfig)...)
+		checkDependencies("resolver", r.Name, -1)
+	}
+	for _, m := range cfg.GetGateway().GetMiddleware() {
+		factory, ok := cf.Middleware[m.Name]
+		problems = append(problems, lintComponent("middleware", m.Name, ok, factory == nil, m.TypedConfig)...)
+		checkDependencies("middleware", m.Name, -1)
+	}
+	for _, m := range cfg.Modules {
+		factory, ok := cf.Modules[m.Name]
+		problems = append(problems, lintComponent("module", m.Name, ok, factory == nil, m.TypedConfig)...)
+		checkDependencies("module", m.Name, -1)
+	}
+
+	return problems
+}
+
+func lintComponent(kind, name string, registered, nilFactory bool, typedConfig *anypb.Any) []error {
+	if !registered {
+		return []error{fmt.Errorf("%s %s: not found in registry", kind, name)}
+	}
+	if nilFactory {
+		return []error{fmt.Errorf("%s %s: has nil factory", kind, name)}
+	}
+	if err := validateAny(typedConfig); err != nil {
+		return []error{fmt.Errorf("%s %s: invalid typed_config: %w", kind, name, err)}
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package gateway
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	"github.com/lyft/clutch/backend/module"
+	"github.com/lyft/clutch/backend/service"
+)
+
+func TestLintConfig(t *testing.T) {
+	invalidTimeouts, _ := anypb.New(&gatewayv1.Timeouts{Default: durationpb.New(-time.Second)})
+
+	cf := &ComponentFactory{
+		Services: service.Factory{
+			"db":      nil,
+			"storage": Services["clutch.service.audit.sink.logger"],
+			"cache":   Services["clutch.service.audit.sink.logger"],
+		},
+		Modules: module.Factory{
+			"ui": Modules["clutch.module.healthcheck"],
+		},
+		Dependencies: map[string][]string{
+			"storage": {"cache"},
+			"ui":      {"storage", "search"},
+		},
+	}
+
+	cfg := &gatewayv1.Config{
+		Gateway: &gatewayv1.GatewayOptions{
+			Listener: &gatewayv1.Listener{Socket: &gatewayv1.Listener_Tcp{Tcp: &gatewayv1.TCPSocket{
+				Address: "0.0.0.0",
+				Secure:  true,
+			}}},
+			JsonGrpcLoopbackListener: &gatewayv1.Listener{Socket: &gatewayv1.Listener_Tcp{Tcp: &gatewayv1.TCPSocket{
+				Address: "0.0.0.0",
+				Port:    8081,
+			}}},
+			Logger: &gatewayv1.Logger{},
+			Stats:  &gatewayv1.Stats{},
+		},
+		Services: []*gatewayv1.Service{
+			{Name: "db"},
+			{Name: "storage"},
+			{Name: "cache"},
+			{Name: "unknown"},
+		},
+		Modules: []*gatewayv1.Module{
+			{Name: "ui", TypedConfig: invalidTimeouts},
+		},
+	}
+
+	var problems []string
+	for _, err := range lintConfig(cfg, cf) {
+		problems = append(problems, err.Error())
+	}
+	assert.Equal(t, []string{
+		"gateway: 'secure' set to true but 'tls' is not configured",
+		"gateway: the loopback listener must listen on a loopback address, not '0.0.0.0'",
+		"service db: has nil factory",
+		"service storage: requires service 'cache', which must be listed before it",
+		"service unknown: not found in registry",
+		"module ui: invalid typed_config: invalid Timeouts.Default: value must be greater than or equal to 1s",
+		"module ui: requires service 'search', which is not configured",
+	}, problems)
+}
+
+func TestLintConfigValid(t *testing.T) {
+	problems := lintConfig(&gatewayv1.Config{
+		Gateway: &gatewayv1.GatewayOptions{
+			Listener: &gatewayv1.Listener{Socket: &gatewayv1.Listener_Tcp{Tcp: &gatewayv1.TCPSocket{Address: "0.0.0.0"}}},
+			JsonGrpcLoopbackListener: &gatewayv1.Listener{Socket: &gatewayv1.Listener_Tcp{Tcp: &gatewayv1.TCPSocket{
+				Address: "127.0.0.1",
+				Port:    8081,
+			}}},
+			Logger: &gatewayv1.Logger{},
+			Stats:  &gatewayv1.Stats{},
+		},
+		Modules: []*gatewayv1.Module{{Name: "clutch.module.healthcheck"}},
+	}, CoreComponentFactory)
+	assert.Empty(t, problems)
+}
+
+func TestConfigCommand(t *testing.T) {
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.yaml")
+	b := filepath.Join(dir, "b.yaml")
+	writeReloadTestConfig(t, a, 8080, "15s", false)
+	writeReloadTestConfig(t, b, 8080, "15s", true)
+
+	var stdout, stderr bytes.Buffer
+	assert.Equal(t, 0, runConfigCommand([]string{"print", "-c", a}, CoreComponentFactory, &stdout, &stderr))
+	assert.Contains(t, stdout.String(), "foo: false")
+
+	stdout.Reset()
+	assert.Equal(t, 1, runConfigCommand([]string{"lint", "-c", a}, CoreComponentFactory, &stdout, &stderr))
+	assert.Equal(t, "module clutch.module.other: not found in registry\n", stdout.String())
+
+	stdout.Reset()
+	assert.Equal(t, 0, runConfigCommand([]string{"diff", "-c", a, a}, CoreComponentFactory, &stdout, &stderr))
+	assert.Empty(t, stdout.String())
+
+	assert.Equal(t, 1, runConfigCommand([]string{"diff", "-c", a, b}, CoreComponentFactory, &stdout, &stderr))
+	assert.Contains(t, stdout.String(), "-          foo: false\n+          foo: true\n")
+
+	// Each side is read with its own env files and template setting.
+	tmpl := filepath.Join(dir, "tmpl.yaml")
+	writeTestFile(t, tmpl, []byte(`gateway:
+  listener:
+    tcp:
+      address: 0.0.0.0
+      port: {{ getenv "CONFIG_DIFF_PORT" }}
+  logger: {}
+  stats: {}
+`), time.Now())
+	envA := filepath.Join(dir, "a.env")
+	envB := filepath.Join(dir, "b.env")
+	writeTestFile(t, envA, []byte("CONFIG_DIFF_PORT=8080\n"), time.Now())
+	writeTestFile(t, envB, []byte("CONFIG_DIFF_PORT=9090\n"), time.Now())
+
+	stdout.Reset()
+	assert.Equal(t, 0, runConfigCommand([]string{"diff", "-c", tmpl, "-template", "-env", envA, "-other-template", "-other-env", envA, tmpl},
+		CoreComponentFactory, &stdout, &stderr))
+	assert.Empty(t, stdout.String())
+
+	assert.Equal(t, 1, runConfigCommand([]string{"diff", "-c", tmpl, "-template", "-env", envA, "-other-template", "-other-env", envB, tmpl},
+		CoreComponentFactory, &stdout, &stderr))
+	assert.Contains(t, stdout.String(), "-      port: 8080\n+      port: 9090\n")
+	// The env files don't leak into the environment.
+	assert.Empty(t, os.Getenv("CONFIG_DIFF_PORT"))
+
+	assert.Equal(t, 2, runConfigCommand([]string{"diff", "-c", a}, CoreComponentFactory, &stdout, &stderr))
+	assert.Equal(t, 2, runConfigCommand([]string{"unknown"}, CoreComponentFactory, &stdout, &stderr))
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/lyft/clutch/backend/middleware/audit"
 	"github.com/lyft/clutch/backend/middleware/authn"
 	"github.com/lyft/clutch/backend/middleware/authz"
+	"github.com/lyft/clutch/backend/middleware/ratelimit"
 	"github.com/lyft/clutch/backend/middleware/stats"
 	"github.com/lyft/clutch/backend/middleware/validate"
 	"github.com/lyft/clutch/backend/module"
@@ -31,6 +32,7 @@ import (
 	shortlinkmod "github.com/lyft/clutch/backend/module/shortlink"
 	"github.com/lyft/clutch/backend/module/sourcecontrol"
 	topologymod "github.com/lyft/clutch/backend/module/topology"
+	workflowmod "github.com/lyft/clutch/backend/module/workflow"
 	"github.com/lyft/clutch/backend/resolver"
 	awsresolver "github.com/lyft/clutch/backend/resolver/aws"
 	coreresolver "github.com/lyft/clutch/backend/resolver/core"
@@ -58,11 +60,12 @@ import (
 )
 
 var Middleware = middleware.Factory{
-	audit.Name:    audit.New,
-	authn.Name:    authn.New,
-	authz.Name:    authz.New,
-	stats.Name:    stats.New,
-	validate.Name: validate.New,
+	audit.Name:     audit.New,
+	authn.Name:     authn.New,
+	authz.Name:     authz.New,
+	ratelimit.Name: ratelimit.New,
+	stats.Name:     stats.New,
+	validate.Name:  validate.New,
 }
 
 var Modules = module.Factory{
@@ -88,6 +91,7 @@ var Modules = module.Factory{
 	slackbotmod.Name:           slackbotmod.New,
 	sourcecontrol.Name:         sourcecontrol.New,
 	topologymod.Name:           topologymod.New,
+	workflowmod.Name:           workflowmod.New,
 	xdsmod.Name:                xdsmod.New,
 }
 
@@ -120,9 +124,47 @@ var Resolvers = resolver.Factory{
 	k8sresolver.Name:  k8sresolver.New,
 }
 
+// Dependencies lists the services that components fail to instantiate without. Services that are only required by some
+// configurations are not listed.
+var Dependencies = map[string][]string{
+	audit.Name: {auditservice.Name},
+	authn.Name: {authnservice.Name},
+	authz.Name: {authzservice.Name},
+
+	auditmod.Name:              {auditservice.Name},
+	authnmod.Name:              {authnservice.Name},
+	authzmod.Name:              {authzservice.Name},
+	awsmod.Name:                {awsservice.Name},
+	dynamodbmod.Name:           {awsservice.Name},
+	envoytriage.Name:           {envoyadmin.Name},
+	experimentationapi.Name:    {experimentstore.Name},
+	feedbackmod.Name:           {feedbackservice.Name},
+	k8smod.Name:                {k8sservice.Name},
+	kinesismod.Name:            {awsservice.Name},
+	redisexperimentation.Name:  {experimentstore.Name},
+	serverexperimentation.Name: {experimentstore.Name},
+	shortlinkmod.Name:          {shortlinkservice.Name},
+	slackbotmod.Name:           {bot.Name},
+	sourcecontrol.Name:         {github.Name},
+	topologymod.Name:           {topologyservice.Name},
+	workflowmod.Name:           {temporal.Name},
+	xdsmod.Name:                {experimentstore.Name},
+
+	authnservice.StorageName: {pgservice.Name},
+	experimentstore.Name:     {pgservice.Name},
+	feedbackservice.Name:     {pgservice.Name},
+	shortlinkservice.Name:    {pgservice.Name},
+	terminator.Name:          {experimentstore.Name},
+	topologyservice.Name:     {pgservice.Name},
+
+	awsresolver.Name: {awsservice.Name},
+	k8sresolver.Name: {k8sservice.Name},
+}
+
 var CoreComponentFactory = &ComponentFactory{
-	Services:   Services,
-	Resolvers:  Resolvers,
-	Middleware: Middleware,
-	Modules:    Modules,
+	Services:     Services,
+	Resolvers:    Resolvers,
+	Middleware:   Middleware,
+	Modules:      Modules,
+	Dependencies: Dependencies,
 }
@@ -0,0 +1,23 @@
+package gateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDependencies(t *testing.T) {
+	for name, deps := range Dependencies {
+		_, isService := Services[name]
+		_, isModule := Modules[name]
+		_, isMiddleware := Middleware[name]
+		_, isResolver := Resolvers[name]
+		assert.True(t, isService || isModule || isMiddleware || isResolver, "%s is not a registered component", name)
+
+		for _, dep := range deps {
+			_, ok := Services[dep]
+			assert.True(t, ok, "%s depends on %s, which is not a registered service", name, dep)
+			assert.NotEqual(t, name, dep)
+		}
+	}
+}
@@ -2,7 +2,7 @@ package gateway
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"net/http"
 	"os"
 	"os/signal"
@@ -16,16 +16,17 @@ import (
 	tallyprom "github.com/uber-go/tally/v4/prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
 	"github.com/lyft/clutch/backend/gateway/meta"
 	"github.com/lyft/clutch/backend/gateway/mux"
 	"github.com/lyft/clutch/backend/gateway/stats"
+	gatewaytracing "github.com/lyft/clutch/backend/gateway/tracing"
 	"github.com/lyft/clutch/backend/middleware"
 	"github.com/lyft/clutch/backend/middleware/accesslog"
 	"github.com/lyft/clutch/backend/middleware/errorintercept"
 	"github.com/lyft/clutch/backend/middleware/timeouts"
+	"github.com/lyft/clutch/backend/middleware/tracing"
 	"github.com/lyft/clutch/backend/module"
 	"github.com/lyft/clutch/backend/resolver"
 	"github.com/lyft/clutch/backend/service"
@@ -34,7 +35,12 @@ import (
 // The purpose of this identifier is to trim the prefix off clutch component names,
 // such as services, modules and resolver.
 // This is used when constructing the stats namespace for a given component.
-const clutchComponentPrefix = "clutch."
+const (
+	clutchComponentPrefix = "clutch."
+
+	// How long to wait for buffered spans to be exported on shutdown.
+	tracingShutdownTimeout = 5 * time.Second
+)
 
 // All available components supply their factory here.
 // Whether or not they are used at runtime is dependent on the configuration passed to the Gateway.
@@ -43,6 +49,9 @@ type ComponentFactory struct {
 	Resolvers  resolver.Factory
 	Middleware middleware.Factory
 	Modules    module.Factory
+
+	// The services each component requires, used to check configurations before they are run.
+	Dependencies map[string][]string
 }
 
 func loadEnv(f *Flags) {
@@ -101,12 +110,30 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 	initScope := scope.SubScope("gateway")
 	initScope.Counter("start").Inc(1)
 
+	// Init tracing before services so their clients propagate the trace context.
+	if cfg.Gateway.Tracing != nil {
+		shutdownTracing, err := gatewaytracing.New(context.Background(), cfg.Gateway.Tracing)
+		if err != nil {
+			logger.Fatal("could not initialize tracing", zap.Error(err))
+		}
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+			defer cancel()
+			if err := shutdownTracing(ctx); err != nil {
+				logger.Warn("failed to flush traces", zap.Error(err))
+			}
+		}()
+	}
+
 	// Create the error interceptor so services can register error interceptors if desired.
 	errorInterceptMiddleware, err := errorintercept.NewMiddleware(nil, logger, initScope)
 	if err != nil {
 		logger.Fatal("could not create error interceptor middleware", zap.Error(err))
 	}
 
+	// Components by name, for handing them a reloaded configuration.
+	components := make(map[string]interface{})
+
 	// Instantiate and register services.
 	for _, svcConfig := range cfg.Services {
 		factory, ok := cf.Services[svcConfig.Name]
@@ -129,6 +156,7 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 			logger.Fatal("service instantiation failed", zap.Error(err))
 		}
 		service.Registry[svcConfig.Name] = svc
+		components[componentName("service", svcConfig.Name)] = svc
 
 		if ei, ok := svc.(errorintercept.Interceptor); ok {
 			logger.Info("service registered an error conversion interceptor")
@@ -159,10 +187,19 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 		resolver.Registry[resolverCfg.Name] = res
 	}
 
-	var interceptors []grpc.UnaryServerInterceptor
+	interceptors := &interceptorChain{}
+
+	// Tracing goes before error interceptors so that spans cover all other middleware and record the rewritten error.
+	if cfg.Gateway.Tracing != nil {
+		t, err := tracing.New(cfg.Gateway.Tracing, logger, scope)
+		if err != nil {
+			logger.Fatal("could not create tracing interceptor", zap.Error(err))
+		}
+		interceptors.add(t)
+	}
 
 	// Error interceptors should be first on the stack (last in chain).
-	interceptors = append(interceptors, errorInterceptMiddleware.UnaryInterceptor())
+	interceptors.add(errorInterceptMiddleware)
 
 	// Access log.
 	if cfg.Gateway.Accesslog != nil {
@@ -170,7 +207,8 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 		if err != nil {
 			logger.Fatal("could not create accesslog interceptor", zap.Error(err))
 		}
-		interceptors = append(interceptors, a.UnaryInterceptor())
+		interceptors.add(a)
+		components[accesslogComponent] = a
 	}
 
 	// Timeouts.
@@ -178,7 +216,8 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 	if err != nil {
 		logger.Fatal("could not create timeout interceptor", zap.Error(err))
 	}
-	interceptors = append(interceptors, timeoutInterceptor.UnaryInterceptor())
+	interceptors.add(timeoutInterceptor)
+	components[timeoutsComponent] = timeoutInterceptor
 
 	// All other configured middleware.
 	for _, mCfg := range cfg.Gateway.Middleware {
@@ -202,25 +241,52 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 			logger.Fatal("middleware instatiation failed", zap.Error(err))
 		}
 
-		interceptors = append(interceptors, m.UnaryInterceptor())
+		if err := interceptors.addConfigured(m, mCfg); err != nil {
+			logger.Fatal("could not add middleware", zap.Error(err))
+		}
+		components[componentName("middleware", mCfg.Name)] = m
 	}
 
 	// Instantiate and register modules listed in the configuration.
-	rpcMux, err := mux.New(interceptors, assets, metricsHandler, cfg.Gateway)
+	rpcMux, err := mux.New(interceptors.unary, interceptors.stream, assets, metricsHandler, cfg.Gateway)
 	if err != nil {
 		panic(err)
 	}
 	ctx := context.TODO()
 
-	// Create a client connection for the registrar to make grpc-gateway's handlers available.
-	// TODO: stand up a private loopback listener for the grpcServer and connect to that instead.
-	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	tcpCfg := cfg.Gateway.Listener.GetTcp()
+	var certs *certReloader
+	if tcpCfg.GetTls() != nil {
+		certs, err = newCertReloader(tcpCfg.Tls)
+		if err != nil {
+			logger.Fatal("failed to load TLS configuration", zap.Error(err))
+		}
+		go certs.watch(ctx, logger)
+	} else if tcpCfg.GetSecure() {
+		logger.Fatal("'secure' set to true but 'tls' is not configured")
 	}
+
+	// The JSON gateway reaches the gRPC server over a private loopback rather than the public listener. It is in-process
+	// unless a dedicated listener is configured.
+	if loopbackCfg := cfg.Gateway.JsonGrpcLoopbackListener; loopbackCfg != nil {
+		if loopbackCfg.GetTcp().GetTls() != nil {
+			logger.Fatal("TLS is not supported on the loopback listener")
+		}
+		l, err := newListener(loopbackCfg)
+		if err != nil {
+			logger.Fatal("failed to create loopback listener", zap.Error(err))
+		}
+		if err := rpcMux.SetLoopbackListener(l, listenerDialer(l)); err != nil {
+			logger.Fatal("invalid loopback listener", zap.Error(err))
+		}
+	}
+
+	// Create a client connection for the registrar to make grpc-gateway's handlers available.
+	var opts []grpc.DialOption
 	if cfg.Gateway.MaxResponseSizeBytes > 0 {
 		opts = append(opts, grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(int(cfg.Gateway.MaxResponseSizeBytes))))
 	}
-	conn, err := grpc.Dial(fmt.Sprintf("%s:%d", cfg.Gateway.Listener.GetTcp().Address, cfg.Gateway.Listener.GetTcp().Port), opts...)
+	conn, err := rpcMux.DialLoopback(ctx, opts...)
 	if err != nil {
 		logger.Fatal("failed to bring up gRPC transport for grpc-gateway handlers", zap.Error(err))
 	}
@@ -265,6 +331,7 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 		if err := mod.Register(reg); err != nil {
 			logger.Fatal("registration to gateway failed", zap.Error(err))
 		}
+		components[componentName("module", modCfg.Name)] = mod
 	}
 
 	// Now that everything is registered, enable gRPC reflection.
@@ -275,20 +342,18 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 		logger.Fatal("reflection on grpc server failed", zap.Error(err))
 	}
 
-	// Instantiate server and listen.
-	switch t := cfg.Gateway.Listener.Socket.(type) {
-	case *gatewayv1.Listener_Tcp:
-		// OK
-	default:
-		logger.Fatal("socket not supported", zap.String("type", fmt.Sprintf("%T", t)))
-	}
+	go func() {
+		if err := rpcMux.ServeLoopback(); err != nil {
+			logger.Fatal("error serving loopback listener", zap.Error(err))
+		}
+	}()
 
-	if cfg.Gateway.Listener.GetTcp().Secure {
-		logger.Fatal("'secure' set to true but listener security is not currently supported")
+	// Instantiate server and listen.
+	ln, err := newListener(cfg.Gateway.Listener)
+	if err != nil {
+		logger.Fatal("failed to create listener", zap.Error(err))
 	}
-
-	addr := fmt.Sprintf("%s:%d", cfg.Gateway.Listener.GetTcp().Address, cfg.Gateway.Listener.GetTcp().Port)
-	logger.Info("listening", zap.Namespace("tcp"), zap.String("addr", addr))
+	logger.Info("listening", zap.Namespace(ln.Addr().Network()), zap.String("addr", ln.Addr().String()), zap.Bool("tls", certs != nil))
 
 	// Figure out the maximum global timeout and set as a backstop (with 1s buffer).
 	timeout := computeMaximumTimeout(cfg.Gateway.Timeouts)
@@ -304,22 +369,44 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 
 	srv := &http.Server{
 		Handler:      mux.InsecureHandler(rpcMux),
-		Addr:         addr,
 		ReadTimeout:  timeout,
 		WriteTimeout: timeout,
 	}
+	if certs != nil {
+		srv.Handler = rpcMux
+		srv.TLSConfig = certs.tlsConfig()
+	}
+
+	// Reload the configuration on SIGHUP, and when the files change if enabled.
+	configReloader := newReloader(f, cfg, components, logger, initScope.SubScope("config_reload"))
+	if interval := cfg.Gateway.ConfigReload.GetWatchInterval(); interval != nil {
+		go configReloader.watch(ctx, interval.AsDuration())
+	}
+
+	reloadc := make(chan os.Signal, 1)
+	signal.Notify(reloadc, syscall.SIGHUP)
+	go func() {
+		for range reloadc {
+			configReloader.reloadAndRecord("signal")
+		}
+	}()
 
 	sc := make(chan os.Signal, 1)
 	signal.Notify(
 		sc,
-		syscall.SIGHUP,
 		syscall.SIGINT,
 		syscall.SIGQUIT,
 		syscall.SIGTERM,
 	)
 
 	go func() {
-		if err = srv.ListenAndServe(); err != http.ErrServerClosed {
+		if certs != nil {
+			// The certificate is provided by the TLS config.
+			err = srv.ServeTLS(ln, "", "")
+		} else {
+			err = srv.Serve(ln)
+		}
+		if err != http.ErrServerClosed {
 			// Only log an error if it's not due to shutdown or close
 			logger.Fatal("error bringing up listener", zap.Error(err))
 		}
@@ -328,6 +415,7 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 	<-sc
 
 	signal.Stop(sc)
+	signal.Stop(reloadc)
 
 	// Shutdown timeout should be max request timeout (with 1s buffer).
 	ctxShutDown, cancel := context.WithTimeout(context.Background(), timeout)
@@ -340,6 +428,30 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 	logger.Debug("server shutdown gracefully")
 }
 
+// interceptorChain collects the interceptors of each middleware in order.
+type interceptorChain struct {
+	unary  []grpc.UnaryServerInterceptor
+	stream []grpc.StreamServerInterceptor
+}
+
+// add appends the middleware's interceptors. Middleware that does not support streaming RPCs is skipped for them.
+func (c *interceptorChain) add(m middleware.Middleware) {
+	c.unary = append(c.unary, m.UnaryInterceptor())
+	if sm, ok := m.(middleware.StreamMiddleware); ok {
+		c.stream = append(c.stream, sm.StreamInterceptor())
+	}
+}
+
+// addConfigured appends the interceptors of configured middleware, which must support streaming RPCs unless the
+// configuration allows streams to bypass it.
+func (c *interceptorChain) addConfigured(m middleware.Middleware, cfg *gatewayv1.Middleware) error {
+	if _, ok := m.(middleware.StreamMiddleware); !ok && !cfg.UnaryOnly {
+		return errors.New("middleware does not support streaming RPCs, set 'unary_only' to allow streams to bypass it")
+	}
+	c.add(m)
+	return nil
+}
+
 func getStatsReporterConfiguration(cfg *gatewayv1.Config, logger *zap.Logger) (tally.ScopeOptions, http.Handler) {
 	var metricsHandler http.Handler
 	var scopeOpts tally.ScopeOptions
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
 
 	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
 )
@@ -135,3 +136,24 @@ func TestGetStatsReporterConfiguration(t *testing.T) {
 		}
 	}
 }
+
+type unaryMiddleware struct{}
+
+func (unaryMiddleware) UnaryInterceptor() grpc.UnaryServerInterceptor { return nil }
+
+type streamMiddleware struct{ unaryMiddleware }
+
+func (streamMiddleware) StreamInterceptor() grpc.StreamServerInterceptor { return nil }
+
+func TestInterceptorChainAddConfigured(t *testing.T) {
+	c := &interceptorChain{}
+	assert.NoError(t, c.addConfigured(streamMiddleware{}, &gatewayv1.Middleware{Name: "stream"}))
+
+	// Middleware that doesn't support streams can't be bypassed by them unless that's allowed.
+	assert.Error(t, c.addConfigured(unaryMiddleware{}, &gatewayv1.Middleware{Name: "unary"}))
+	assert.Len(t, c.unary, 1)
+
+	assert.NoError(t, c.addConfigured(unaryMiddleware{}, &gatewayv1.Middleware{Name: "unary", UnaryOnly: true}))
+	assert.Len(t, c.unary, 2)
+	assert.Len(t, c.stream, 1)
+}
@@ -0,0 +1,57 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+// newListener creates a listener for the configured socket.
+func newListener(cfg *gatewayv1.Listener) (net.Listener, error) {
+	switch t := cfg.Socket.(type) {
+	case *gatewayv1.Listener_Tcp:
+		return net.Listen("tcp", fmt.Sprintf("%s:%d", t.Tcp.Address, t.Tcp.Port))
+	case *gatewayv1.Listener_Unix:
+		return newUnixListener(t.Unix)
+	default:
+		return nil, fmt.Errorf("socket not supported: %T", t)
+	}
+}
+
+func newUnixListener(cfg *gatewayv1.UnixSocket) (net.Listener, error) {
+	// Remove a socket left behind by a previous process, but never anything else.
+	if info, err := os.Lstat(cfg.Path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("'%s' exists and is not a socket", cfg.Path)
+		}
+		if err := os.Remove(cfg.Path); err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	l, err := net.Listen("unix", cfg.Path)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Mode != 0 {
+		if err := os.Chmod(cfg.Path, os.FileMode(cfg.Mode)); err != nil {
+			l.Close()
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+// listenerDialer returns a function that connects to the listener.
+func listenerDialer(l net.Listener) func(ctx context.Context) (net.Conn, error) {
+	addr := l.Addr()
+	return func(ctx context.Context) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, addr.Network(), addr.String())
+	}
+}
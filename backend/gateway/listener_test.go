@@ -0,0 +1,66 @@
+package gateway
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+func TestNewListenerTCP(t *testing.T) {
+	l, err := newListener(&gatewayv1.Listener{Socket: &gatewayv1.Listener_Tcp{
+		Tcp: &gatewayv1.TCPSocket{Address: "127.0.0.1", Port: 0},
+	}})
+	assert.NoError(t, err)
+	defer l.Close()
+	assert.Equal(t, "tcp", l.Addr().Network())
+}
+
+func TestNewListenerUnix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "clutch.sock")
+	cfg := &gatewayv1.Listener{Socket: &gatewayv1.Listener_Unix{
+		Unix: &gatewayv1.UnixSocket{Path: path, Mode: 0600},
+	}}
+
+	// Leave a stale socket behind.
+	stale, err := net.Listen("unix", path)
+	assert.NoError(t, err)
+	stale.(*net.UnixListener).SetUnlinkOnClose(false)
+	stale.Close()
+
+	l, err := newListener(cfg)
+	assert.NoError(t, err)
+	defer l.Close()
+
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	go func() {
+		conn, err := l.Accept()
+		if err == nil {
+			conn.Close()
+		}
+	}()
+	conn, err := listenerDialer(l)(context.Background())
+	assert.NoError(t, err)
+	conn.Close()
+}
+
+func TestNewListenerUnixNotSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "clutch.sock")
+	assert.NoError(t, os.WriteFile(path, nil, 0600))
+
+	_, err := newListener(&gatewayv1.Listener{Socket: &gatewayv1.Listener_Unix{
+		Unix: &gatewayv1.UnixSocket{Path: path},
+	}})
+	assert.Error(t, err)
+
+	_, err = os.Stat(path)
+	assert.NoError(t, err)
+}
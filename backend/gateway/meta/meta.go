@@ -297,8 +297,11 @@ func resolvePattern(pb proto.Message, pattern *apiv1.Pattern) *auditv1.Resource
 		if fd == nil {
 			continue
 		}
-		v := m.Get(fd)
-		resourceName = strings.Replace(resourceName, name[0], v.String(), 1)
+		value := m.Get(fd).String()
+		if value == "" && pattern.WildcardEmptyFields {
+			value = "*"
+		}
+		resourceName = strings.Replace(resourceName, name[0], value, 1)
 	}
 	return &auditv1.Resource{TypeUrl: pattern.TypeUrl, Id: resourceName}
 }
@@ -117,6 +117,18 @@ func TestResourceNames(t *testing.T) {
 	}
 }
 
+func TestResourceNamesWildcardEmptyFields(t *testing.T) {
+	names := ResourceNames(&k8sapiv1.WatchPodsRequest{})
+	assert.Equal(t, []*auditv1.Resource{{TypeUrl: "clutch.k8s.v1.Namespace", Id: "*/*"}}, names)
+
+	names = ResourceNames(&k8sapiv1.WatchPodsRequest{Cluster: "prod", Namespace: "default"})
+	assert.Equal(t, []*auditv1.Resource{{TypeUrl: "clutch.k8s.v1.Namespace", Id: "prod/default"}}, names)
+
+	// Patterns without the option keep the empty fields.
+	names = ResourceNames(&k8sapiv1.DescribePodRequest{Name: "pod1"})
+	assert.Equal(t, []*auditv1.Resource{{TypeUrl: "clutch.k8s.v1.Pod", Id: "//pod1"}}, names)
+}
+
 func TestIsRedacted(t *testing.T) {
 	assert.True(t, IsRedacted(&authnv1.CallbackResponse{}))
 	assert.False(t, IsRedacted(&healthcheckv1.HealthcheckRequest{}))
@@ -0,0 +1,160 @@
+package mux
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	loopbackBufferSize = 1024 * 1024
+
+	// Metadata key used by the JSON gateway to pass the identity of a verified client certificate to the gRPC server.
+	// It is only trusted on requests arriving over the in-process loopback.
+	clientIdentityMetadataKey = "x-clutch-client-identity"
+)
+
+// loopback is the listener used by the JSON gateway to reach the gRPC server. Connections accepted from the in-process
+// loopback are marked so that metadata forwarded by the gateway can be trusted. Other local processes can connect to a
+// unix socket or loopback address too, so metadata arriving over a configured listener isn't trusted.
+type loopback struct {
+	net.Listener
+
+	dial    func(ctx context.Context) (net.Conn, error)
+	trusted bool
+}
+
+func newLoopback() *loopback {
+	l := bufconn.Listen(loopbackBufferSize)
+	return &loopback{Listener: l, dial: l.DialContext, trusted: true}
+}
+
+func (l *loopback) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+		// A listener on a loopback address only accepts local connections, but check the peer in case it doesn't.
+		if addr, ok := conn.RemoteAddr().(*net.TCPAddr); ok && !addr.IP.IsLoopback() {
+			_ = conn.Close()
+			continue
+		}
+		if !l.trusted {
+			return conn, nil
+		}
+		return &loopbackConn{Conn: conn}, nil
+	}
+}
+
+// checkLoopbackAddr returns an error unless other hosts can't connect to the address, i.e. unless it's a unix socket or
+// a loopback IP address.
+func checkLoopbackAddr(addr net.Addr) error {
+	switch a := addr.(type) {
+	case *net.UnixAddr:
+		return nil
+	case *net.TCPAddr:
+		if a.IP.IsLoopback() {
+			return nil
+		}
+	}
+	return fmt.Errorf("the loopback listener must be a unix socket or listen on a loopback address, not '%s'", addr)
+}
+
+type loopbackConn struct {
+	net.Conn
+}
+
+func (c *loopbackConn) RemoteAddr() net.Addr {
+	return loopbackAddr{c.Conn.RemoteAddr()}
+}
+
+type loopbackAddr struct {
+	net.Addr
+}
+
+// SetLoopbackListener replaces the default in-process loopback with a private listener, which must be a unix socket or
+// listen on a loopback address. Client certificate identities forwarded over it are ignored, since any local process
+// could connect to it. It must be called before DialLoopback and ServeLoopback.
+func (m *Mux) SetLoopbackListener(l net.Listener, dial func(ctx context.Context) (net.Conn, error)) error {
+	if err := checkLoopbackAddr(l.Addr()); err != nil {
+		return err
+	}
+	m.loopback = &loopback{Listener: l, dial: dial}
+	return nil
+}
+
+// ServeLoopback serves the gRPC server on the loopback listener. It must be called after all services are registered,
+// and blocks until the server is stopped.
+func (m *Mux) ServeLoopback() error {
+	return m.GRPCServer.Serve(m.loopback)
+}
+
+// DialLoopback connects to the loopback listener.
+func (m *Mux) DialLoopback(ctx context.Context, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	opts = append([]grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return m.loopback.dial(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}, opts...)
+	return grpc.DialContext(ctx, "passthrough:///loopback", opts...)
+}
+
+func isLoopbackPeer(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	_, ok = p.Addr.(loopbackAddr)
+	return ok
+}
+
+// certificateIdentity returns the first URI SAN of the certificate, or the subject common name if there are none.
+func certificateIdentity(state *tls.ConnectionState) string {
+	if state == nil || len(state.VerifiedChains) == 0 || len(state.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	leaf := state.VerifiedChains[0][0]
+	if len(leaf.URIs) > 0 {
+		return leaf.URIs[0].String()
+	}
+	return leaf.Subject.CommonName
+}
+
+// clientIdentityMetadata forwards the identity of a verified client certificate from the JSON gateway.
+func clientIdentityMetadata(_ context.Context, r *http.Request) metadata.MD {
+	if identity := certificateIdentity(r.TLS); identity != "" {
+		return metadata.Pairs(clientIdentityMetadataKey, identity)
+	}
+	return nil
+}
+
+// ClientIdentity returns the identity of the caller's verified TLS client certificate, if any. For JSON requests the
+// identity is forwarded by the gateway over the in-process loopback.
+func ClientIdentity(ctx context.Context) (string, bool) {
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			if identity := certificateIdentity(&info.State); identity != "" {
+				return identity, true
+			}
+		}
+	}
+
+	if isLoopbackPeer(ctx) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if v := md.Get(clientIdentityMetadataKey); len(v) > 0 && v[0] != "" {
+			return v[0], true
+		}
+	}
+	return "", false
+}
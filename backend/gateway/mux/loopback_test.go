@@ -0,0 +1,189 @@
+package mux
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
+)
+
+func verifiedState(cert *x509.Certificate) *tls.ConnectionState {
+	return &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+}
+
+func TestCertificateIdentity(t *testing.T) {
+	spiffe, _ := url.Parse("spiffe://example.com/ns/default/sa/deployer")
+
+	assert.Equal(t, "", certificateIdentity(nil))
+	assert.Equal(t, "", certificateIdentity(&tls.ConnectionState{}))
+	assert.Equal(t, "deployer", certificateIdentity(verifiedState(&x509.Certificate{Subject: pkix.Name{CommonName: "deployer"}})))
+	assert.Equal(t, spiffe.String(), certificateIdentity(verifiedState(&x509.Certificate{
+		Subject: pkix.Name{CommonName: "deployer"},
+		URIs:    []*url.URL{spiffe},
+	})))
+}
+
+func TestClientIdentity(t *testing.T) {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "deployer"}}
+	tcpAddr := &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 1234}
+
+	// gRPC caller with a verified certificate.
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr:     tcpAddr,
+		AuthInfo: credentials.TLSInfo{State: *verifiedState(cert)},
+	})
+	identity, ok := ClientIdentity(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "deployer", identity)
+
+	// Metadata is ignored unless it arrives over the loopback.
+	md := metadata.Pairs(clientIdentityMetadataKey, "spoofed")
+	ctx = metadata.NewIncomingContext(peer.NewContext(context.Background(), &peer.Peer{Addr: tcpAddr}), md)
+	_, ok = ClientIdentity(ctx)
+	assert.False(t, ok)
+
+	ctx = metadata.NewIncomingContext(peer.NewContext(context.Background(), &peer.Peer{Addr: loopbackAddr{tcpAddr}}), md)
+	identity, ok = ClientIdentity(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, "spoofed", identity)
+}
+
+func TestClientIdentityMetadata(t *testing.T) {
+	r := httptest.NewRequest("GET", "/v1/healthcheck", nil)
+	assert.Nil(t, clientIdentityMetadata(context.Background(), r))
+
+	r.TLS = verifiedState(&x509.Certificate{Subject: pkix.Name{CommonName: "deployer"}})
+	assert.Equal(t, []string{"deployer"}, clientIdentityMetadata(context.Background(), r).Get(clientIdentityMetadataKey))
+}
+
+type healthcheckServer struct {
+	healthcheckv1.HealthcheckAPIServer
+
+	identity string
+}
+
+func (h *healthcheckServer) Healthcheck(ctx context.Context, _ *healthcheckv1.HealthcheckRequest) (*healthcheckv1.HealthcheckResponse, error) {
+	h.identity, _ = ClientIdentity(ctx)
+	return &healthcheckv1.HealthcheckResponse{}, nil
+}
+
+func TestLoopback(t *testing.T) {
+	m, err := New(nil, nil, nil, nil, &gatewayv1.GatewayOptions{})
+	assert.NoError(t, err)
+
+	srv := &healthcheckServer{}
+	healthcheckv1.RegisterHealthcheckAPIServer(m.GRPCServer, srv)
+	go func() { _ = m.ServeLoopback() }()
+	defer m.GRPCServer.Stop()
+
+	conn, err := m.DialLoopback(context.Background(), grpc.WithBlock())
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), clientIdentityMetadataKey, "deployer")
+	_, err = healthcheckv1.NewHealthcheckAPIClient(conn).Healthcheck(ctx, &healthcheckv1.HealthcheckRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "deployer", srv.identity)
+}
+
+func TestLoopbackListener(t *testing.T) {
+	m, err := New(nil, nil, nil, nil, &gatewayv1.GatewayOptions{})
+	assert.NoError(t, err)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	assert.NoError(t, m.SetLoopbackListener(l, func(ctx context.Context) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, "tcp", l.Addr().String())
+	}))
+
+	srv := &healthcheckServer{}
+	healthcheckv1.RegisterHealthcheckAPIServer(m.GRPCServer, srv)
+	go func() { _ = m.ServeLoopback() }()
+	defer m.GRPCServer.Stop()
+
+	conn, err := m.DialLoopback(context.Background(), grpc.WithBlock())
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	// Any local process can connect to the listener, so it isn't trusted to forward identities.
+	ctx := metadata.AppendToOutgoingContext(context.Background(), clientIdentityMetadataKey, "deployer")
+	_, err = healthcheckv1.NewHealthcheckAPIClient(conn).Healthcheck(ctx, &healthcheckv1.HealthcheckRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, srv.identity)
+}
+
+func TestCheckLoopbackAddr(t *testing.T) {
+	assert.NoError(t, checkLoopbackAddr(&net.UnixAddr{Name: "/var/run/clutch.sock", Net: "unix"}))
+	assert.NoError(t, checkLoopbackAddr(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 8081}))
+	assert.NoError(t, checkLoopbackAddr(&net.TCPAddr{IP: net.IPv6loopback, Port: 8081}))
+	// Other hosts could connect.
+	assert.Error(t, checkLoopbackAddr(&net.TCPAddr{IP: net.IPv4zero, Port: 8081}))
+	assert.Error(t, checkLoopbackAddr(&net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 8081}))
+
+	m, err := New(nil, nil, nil, nil, &gatewayv1.GatewayOptions{})
+	assert.NoError(t, err)
+	l, err := net.Listen("tcp", "0.0.0.0:0")
+	assert.NoError(t, err)
+	defer l.Close()
+	assert.Error(t, m.SetLoopbackListener(l, nil))
+}
+
+type testListener struct {
+	net.Listener
+
+	conns []net.Conn
+}
+
+func (l *testListener) Accept() (net.Conn, error) {
+	if len(l.conns) == 0 {
+		return nil, net.ErrClosed
+	}
+	conn := l.conns[0]
+	l.conns = l.conns[1:]
+	return conn, nil
+}
+
+type testConn struct {
+	net.Conn
+
+	remote net.Addr
+	closed bool
+}
+
+func (c *testConn) RemoteAddr() net.Addr { return c.remote }
+
+func (c *testConn) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestLoopbackAcceptChecksPeer(t *testing.T) {
+	remote := &testConn{remote: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 1234}}
+	local := &testConn{remote: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234}}
+	l := &loopback{Listener: &testListener{conns: []net.Conn{remote, local}}}
+
+	conn, err := l.Accept()
+	assert.NoError(t, err)
+	assert.True(t, remote.closed)
+	// Connections from a configured listener aren't marked as trusted.
+	assert.Equal(t, local, conn)
+
+	l = &loopback{Listener: &testListener{conns: []net.Conn{local}}, trusted: true}
+	conn, err = l.Accept()
+	assert.NoError(t, err)
+	assert.Equal(t, loopbackAddr{local.remote}, conn.RemoteAddr())
+}
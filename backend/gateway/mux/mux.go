@@ -15,16 +15,20 @@ import (
 	"strings"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 
 	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	"github.com/lyft/clutch/backend/gateway/tracing"
 	"github.com/lyft/clutch/backend/service"
 	awsservice "github.com/lyft/clutch/backend/service/aws"
 )
@@ -215,7 +219,12 @@ func customHeaderMatcher(key string) (string, bool) {
 		}
 	}
 	// the the default header mapping rule
-	return runtime.DefaultHeaderMatcher(key)
+	key, ok := runtime.DefaultHeaderMatcher(key)
+	if ok && strings.EqualFold(key, clientIdentityMetadataKey) {
+		// Only the gateway may set the client identity.
+		return "", false
+	}
+	return key, ok
 }
 
 func customErrorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, req *http.Request, err error) {
@@ -230,13 +239,24 @@ func customErrorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Ma
 	runtime.DefaultHTTPErrorHandler(ctx, mux, m, w, req, err)
 }
 
-func New(unaryInterceptors []grpc.UnaryServerInterceptor, assets http.FileSystem, metricsHandler http.Handler, gatewayCfg *gatewayv1.GatewayOptions) (*Mux, error) {
+// traceMetadata forwards the trace context of JSON requests to the gRPC server.
+func traceMetadata(ctx context.Context, r *http.Request) metadata.MD {
+	propagator := otel.GetTextMapPropagator()
+	md := metadata.MD{}
+	propagator.Inject(propagator.Extract(ctx, propagation.HeaderCarrier(r.Header)), tracing.MetadataCarrier(md))
+	return md
+}
+
+func New(unaryInterceptors []grpc.UnaryServerInterceptor, streamInterceptors []grpc.StreamServerInterceptor, assets http.FileSystem, metricsHandler http.Handler, gatewayCfg *gatewayv1.GatewayOptions) (*Mux, error) {
 	secureCookies := true
 	if gatewayCfg.SecureCookies != nil {
 		secureCookies = gatewayCfg.SecureCookies.Value
 	}
 
-	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(unaryInterceptors...))
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 	jsonGateway := runtime.NewServeMux(
 		runtime.WithForwardResponseOption(newCustomResponseForwarder(secureCookies)),
 		runtime.WithErrorHandler(customErrorHandler),
@@ -253,6 +273,8 @@ func New(unaryInterceptors []grpc.UnaryServerInterceptor, assets http.FileSystem
 			},
 		),
 		runtime.WithIncomingHeaderMatcher(customHeaderMatcher),
+		runtime.WithMetadata(clientIdentityMetadata),
+		runtime.WithMetadata(traceMetadata),
 	)
 
 	// If there is a configured asset provider, we check to see if the service is configured before proceeding.
@@ -293,6 +315,7 @@ func New(unaryInterceptors []grpc.UnaryServerInterceptor, assets http.FileSystem
 		GRPCServer:  grpcServer,
 		JSONGateway: jsonGateway,
 		HTTPMux:     httpMux,
+		loopback:    newLoopback(),
 	}
 	return mux, nil
 }
@@ -303,6 +326,8 @@ type Mux struct {
 	JSONGateway *runtime.ServeMux
 	HTTPMux     http.Handler
 	GRPCServer  *grpc.Server
+
+	loopback *loopback
 }
 
 // Adapted from https://github.com/grpc/grpc-go/blob/197c621/server.go#L760-L778.
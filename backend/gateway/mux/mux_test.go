@@ -17,6 +17,7 @@ import (
 
 	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
 	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
+	"github.com/lyft/clutch/backend/mock/tracingmock"
 )
 
 func TestCopyHTTPResponse(t *testing.T) {
@@ -114,6 +115,12 @@ func TestCustomHeaderMatcher(t *testing.T) {
 			expectedKey:  "",
 			expectedBool: false,
 		},
+		// the client identity can only be set by the gateway
+		{
+			key:          "Grpc-Metadata-X-Clutch-Client-Identity",
+			expectedKey:  "",
+			expectedBool: false,
+		},
 		// doesn't match custom or default rules
 		{
 			key:          "Foo-Bar",
@@ -235,3 +242,14 @@ func TestCustomResponseForwarderAuthCookiesNonBrowser(t *testing.T) {
 	assert.Equal(t, 200, rec.Code)
 	assert.Equal(t, "", rec.Header().Get("Location"))
 }
+
+func TestTraceMetadata(t *testing.T) {
+	tracingmock.New()
+
+	r := httptest.NewRequest(http.MethodGet, "/v1/k8s/listPods", nil)
+	assert.Empty(t, traceMetadata(context.Background(), r))
+
+	traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	r.Header.Set("traceparent", traceparent)
+	assert.Equal(t, []string{traceparent}, traceMetadata(context.Background(), r).Get("traceparent"))
+}
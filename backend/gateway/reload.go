@@ -0,0 +1,284 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	accesslogv1 "github.com/lyft/clutch/backend/api/config/middleware/accesslog/v1"
+)
+
+// Reloadable is implemented by services, modules and middleware that can apply a new configuration without a restart.
+type Reloadable interface {
+	// PrepareReload validates the configuration and returns a function that applies it. The component must not change
+	// until the function is called, which only happens once every changed component has prepared successfully.
+	PrepareReload(cfg *anypb.Any) (apply func(), err error)
+}
+
+const (
+	timeoutsComponent  = "gateway timeouts"
+	accesslogComponent = "gateway accesslog"
+)
+
+func componentName(kind, name string) string {
+	return kind + " " + name
+}
+
+type section struct {
+	name string
+	cfg  *anypb.Any
+}
+
+// reloadableSections returns the parts of the configuration that are handed to components, in configuration order.
+func reloadableSections(cfg *gatewayv1.Config) ([]section, error) {
+	timeoutsCfg, err := optionalAny(cfg.Gateway.Timeouts)
+	if err != nil {
+		return nil, err
+	}
+	accesslogCfg, err := optionalAny(cfg.Gateway.Accesslog)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := []section{
+		{name: timeoutsComponent, cfg: timeoutsCfg},
+		{name: accesslogComponent, cfg: accesslogCfg},
+	}
+	for _, s := range cfg.Services {
+		ret = append(ret, section{name: componentName("service", s.Name), cfg: s.TypedConfig})
+	}
+	for _, m := range cfg.Gateway.Middleware {
+		ret = append(ret, section{name: componentName("middleware", m.Name), cfg: m.TypedConfig})
+	}
+	for _, m := range cfg.Modules {
+		ret = append(ret, section{name: componentName("module", m.Name), cfg: m.TypedConfig})
+	}
+	return ret, nil
+}
+
+// frozenConfig returns a copy of the configuration without the sections that can be reloaded. Adding or removing
+// components is not supported, so their names and the presence of the access log are kept.
+func frozenConfig(cfg *gatewayv1.Config) *gatewayv1.Config {
+	ret := proto.Clone(cfg).(*gatewayv1.Config)
+	ret.Extends = nil
+	for _, s := range ret.Services {
+		s.TypedConfig = nil
+	}
+	for _, m := range ret.Modules {
+		m.TypedConfig = nil
+	}
+	if ret.Gateway != nil {
+		for _, m := range ret.Gateway.Middleware {
+			m.TypedConfig = nil
+		}
+		ret.Gateway.Timeouts = nil
+		if ret.Gateway.Accesslog != nil {
+			ret.Gateway.Accesslog = &accesslogv1.Config{}
+		}
+	}
+	return ret
+}
+
+func optionalAny(m proto.Message) (*anypb.Any, error) {
+	if !m.ProtoReflect().IsValid() {
+		return nil, nil
+	}
+	return anypb.New(m)
+}
+
+func sectionChanged(prev, next *anypb.Any) (bool, error) {
+	if prev == nil || next == nil {
+		return prev != next, nil
+	}
+	if proto.Equal(prev, next) {
+		return false, nil
+	}
+
+	// The serialized bytes may differ for equal messages, e.g. due to map ordering.
+	p, err := prev.UnmarshalNew()
+	if err != nil {
+		return false, err
+	}
+	n, err := next.UnmarshalNew()
+	if err != nil {
+		return false, err
+	}
+	return !proto.Equal(p, n), nil
+}
+
+// reloader re-reads the configuration and hands changed sections to the components that support it. A reload either
+// applies to every changed component or to none of them.
+type reloader struct {
+	flags  *Flags
+	logger *zap.Logger
+	scope  tally.Scope
+
+	// The maximum timeout at startup, which the server's read and write timeouts are derived from.
+	maxTimeout time.Duration
+
+	mu         sync.Mutex
+	current    *gatewayv1.Config
+	components map[string]interface{}
+	files      []string
+	modTimes   map[string]time.Time
+}
+
+func newReloader(f *Flags, cfg *gatewayv1.Config, components map[string]interface{}, logger *zap.Logger, scope tally.Scope) *reloader {
+	return &reloader{
+		flags:      f,
+		logger:     logger,
+		scope:      scope,
+		maxTimeout: computeMaximumTimeout(cfg.Gateway.Timeouts),
+		current:    cfg,
+		components: components,
+		files:      []string{f.ConfigPath},
+	}
+}
+
+func (r *reloader) reload() ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cfg, files, err := readConfig(r.flags)
+	if err != nil {
+		return nil, err
+	}
+	r.files = files
+
+	if !proto.Equal(frozenConfig(r.current), frozenConfig(cfg)) {
+		return nil, errors.New("only component configuration, timeouts and access log filters can be reloaded, other changes require a restart")
+	}
+
+	if r.maxTimeout != 0 {
+		if max := computeMaximumTimeout(cfg.Gateway.Timeouts); max == 0 || max > r.maxTimeout {
+			return nil, fmt.Errorf("timeouts can't be raised above %s without a restart", r.maxTimeout)
+		}
+	}
+
+	prevSections, err := reloadableSections(r.current)
+	if err != nil {
+		return nil, err
+	}
+	prev := make(map[string]*anypb.Any, len(prevSections))
+	for _, s := range prevSections {
+		prev[s.name] = s.cfg
+	}
+
+	nextSections, err := reloadableSections(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []string
+	var applies []func()
+	for _, s := range nextSections {
+		ok, err := sectionChanged(prev[s.name], s.cfg)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", s.name, err)
+		}
+		if !ok {
+			continue
+		}
+
+		if err := validateAny(s.cfg); err != nil {
+			return nil, fmt.Errorf("%s: %w", s.name, err)
+		}
+
+		c, ok := r.components[s.name].(Reloadable)
+		if !ok {
+			return nil, fmt.Errorf("%s does not support reloading, changes to its configuration require a restart", s.name)
+		}
+		apply, err := c.PrepareReload(s.cfg)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", s.name, err)
+		}
+		changed = append(changed, s.name)
+		applies = append(applies, apply)
+	}
+
+	for _, apply := range applies {
+		apply()
+	}
+	r.current = cfg
+	return changed, nil
+}
+
+// reloadAndRecord reloads the configuration, logging and recording the outcome.
+func (r *reloader) reloadAndRecord(trigger string) {
+	logger := r.logger.With(zap.String("trigger", trigger))
+
+	changed, err := r.reload()
+	if err != nil {
+		r.scope.Counter("failure").Inc(1)
+		logger.Error("configuration reload failed, the current configuration remains in use", zap.Error(err))
+		return
+	}
+
+	r.scope.Counter("success").Inc(1)
+	r.scope.Gauge("last_success_timestamp").Update(float64(time.Now().Unix()))
+	if len(changed) == 0 {
+		logger.Info("reloaded configuration, no changes found")
+	} else {
+		logger.Info("reloaded configuration", zap.String("changed", strings.Join(changed, ", ")))
+	}
+}
+
+// filesChanged returns whether any of the configuration files changed since it was last called.
+func (r *reloader) filesChanged() bool {
+	r.mu.Lock()
+	files := r.files
+	r.mu.Unlock()
+
+	modTimes := make(map[string]time.Time, len(files))
+	for _, f := range files {
+		// A missing file is reported by the reload.
+		if info, err := os.Stat(f); err == nil {
+			modTimes[f] = info.ModTime()
+		}
+	}
+
+	first := r.modTimes == nil
+	changed := len(modTimes) != len(r.modTimes)
+	for f, t := range modTimes {
+		if prev, ok := r.modTimes[f]; !ok || !prev.Equal(t) {
+			changed = true
+		}
+	}
+	r.modTimes = modTimes
+	return changed && !first
+}
+
+// watch reloads the configuration when its files change until the context is done.
+func (r *reloader) watch(ctx context.Context, interval time.Duration) {
+	// Find the files extended by the configuration, which were not recorded at startup.
+	if _, files, err := readConfig(r.flags); err == nil {
+		r.mu.Lock()
+		r.files = files
+		r.mu.Unlock()
+	}
+	r.filesChanged()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if r.filesChanged() {
+				r.reloadAndRecord("watch")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
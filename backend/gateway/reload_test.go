@@ -0,0 +1,181 @@
+package gateway
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	featureflagcfgv1 "github.com/lyft/clutch/backend/api/config/module/featureflag/v1"
+)
+
+type fakeReloadable struct {
+	err     error
+	applied *anypb.Any
+}
+
+func (f *fakeReloadable) PrepareReload(cfg *anypb.Any) (func(), error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return func() { f.applied = cfg }, nil
+}
+
+const reloadTestConfig = `
+gateway:
+  listener:
+    tcp:
+      address: 0.0.0.0
+      port: %d
+  logger: {}
+  stats: {}
+  timeouts:
+    default: %s
+modules:
+  - name: clutch.module.featureflag
+    typed_config:
+      "@type": type.googleapis.com/clutch.config.module.featureflag.v1.Config
+      simple:
+        flags:
+          foo: %t
+  - name: clutch.module.other
+`
+
+func writeReloadTestConfig(t *testing.T, path string, port int, timeout string, flag bool) {
+	writeTestFile(t, path, []byte(fmt.Sprintf(reloadTestConfig, port, timeout, flag)), time.Now())
+}
+
+func newTestReloader(t *testing.T, components map[string]interface{}) (*reloader, string, tally.TestScope) {
+	path := filepath.Join(t.TempDir(), "clutch-config.yaml")
+	writeReloadTestConfig(t, path, 8080, "15s", false)
+
+	f := &Flags{ConfigPath: path}
+	cfg, _, err := readConfig(f)
+	assert.NoError(t, err)
+
+	scope := tally.NewTestScope("", nil)
+	return newReloader(f, cfg, components, zaptest.NewLogger(t), scope), path, scope
+}
+
+func TestReload(t *testing.T) {
+	flags := &fakeReloadable{}
+	timeoutsComp := &fakeReloadable{}
+	r, path, scope := newTestReloader(t, map[string]interface{}{
+		componentName("module", "clutch.module.featureflag"): flags,
+		timeoutsComponent: timeoutsComp,
+	})
+
+	// Nothing changed.
+	changed, err := r.reload()
+	assert.NoError(t, err)
+	assert.Empty(t, changed)
+
+	writeReloadTestConfig(t, path, 8080, "10s", true)
+	r.reloadAndRecord("test")
+	assert.Equal(t, int64(1), scope.Snapshot().Counters()["success+"].Value())
+	assert.NotZero(t, scope.Snapshot().Gauges()["last_success_timestamp+"].Value())
+
+	cfg := &featureflagcfgv1.Config{}
+	assert.NoError(t, flags.applied.UnmarshalTo(cfg))
+	assert.True(t, cfg.GetSimple().Flags["foo"])
+	assert.NotNil(t, timeoutsComp.applied)
+}
+
+func TestReloadIsAllOrNothing(t *testing.T) {
+	flags := &fakeReloadable{}
+	timeoutsComp := &fakeReloadable{err: errors.New("bad timeouts")}
+	r, path, scope := newTestReloader(t, map[string]interface{}{
+		componentName("module", "clutch.module.featureflag"): flags,
+		timeoutsComponent: timeoutsComp,
+	})
+
+	writeReloadTestConfig(t, path, 8080, "10s", true)
+	r.reloadAndRecord("test")
+	assert.Equal(t, int64(1), scope.Snapshot().Counters()["failure+"].Value())
+	assert.Nil(t, flags.applied)
+
+	// The previous configuration is kept, so the same change is attempted again.
+	timeoutsComp.err = nil
+	changed, err := r.reload()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{timeoutsComponent, "module clutch.module.featureflag"}, changed)
+}
+
+func TestReloadRejected(t *testing.T) {
+	testCases := []struct {
+		name       string
+		components map[string]interface{}
+		port       int
+		timeout    string
+		err        string
+	}{
+		{
+			name:    "frozen option",
+			port:    9090,
+			timeout: "15s",
+			err:     "only component configuration, timeouts and access log filters can be reloaded, other changes require a restart",
+		},
+		{
+			name:    "not reloadable",
+			port:    8080,
+			timeout: "15s",
+			err:     "module clutch.module.featureflag does not support reloading, changes to its configuration require a restart",
+		},
+		{
+			name: "timeout above backstop",
+			components: map[string]interface{}{
+				timeoutsComponent: &fakeReloadable{},
+			},
+			port:    8080,
+			timeout: "30s",
+			err:     "timeouts can't be raised above 15s without a restart",
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			r, path, _ := newTestReloader(t, tt.components)
+			writeReloadTestConfig(t, path, tt.port, tt.timeout, true)
+			_, err := r.reload()
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestReloadInvalidFile(t *testing.T) {
+	r, path, _ := newTestReloader(t, nil)
+	assert.NoError(t, os.WriteFile(path, []byte("gateway: ["), 0600))
+	_, err := r.reload()
+	assert.Error(t, err)
+}
+
+func TestReloaderFilesChanged(t *testing.T) {
+	r, path, _ := newTestReloader(t, nil)
+
+	// The first call records the files.
+	assert.False(t, r.filesChanged())
+	assert.False(t, r.filesChanged())
+
+	writeTestFile(t, path, []byte{}, time.Now().Add(time.Minute))
+	assert.True(t, r.filesChanged())
+	assert.False(t, r.filesChanged())
+}
+
+func TestFrozenConfigKeepsComponentNames(t *testing.T) {
+	r, path, _ := newTestReloader(t, nil)
+	prev := frozenConfig(r.current)
+
+	cfg, _, err := readConfig(&Flags{ConfigPath: path})
+	assert.NoError(t, err)
+	cfg.Modules = cfg.Modules[:1]
+	assert.False(t, proto.Equal(prev, frozenConfig(cfg)))
+}
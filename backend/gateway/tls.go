@@ -0,0 +1,157 @@
+package gateway
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+// How often the certificate files are checked for changes.
+const tlsReloadInterval = 10 * time.Second
+
+// certReloader serves the most recently loaded certificate and client CA bundle, reloading them when the files on
+// disk change.
+type certReloader struct {
+	cfg *gatewayv1.TLS
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+}
+
+func newCertReloader(cfg *gatewayv1.TLS) (*certReloader, error) {
+	if cfg.RequireClientCert && cfg.ClientCaFile == "" {
+		return nil, errors.New("'require_client_cert' requires 'client_ca_file' to be set")
+	}
+
+	r := &certReloader{cfg: cfg}
+	if _, err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *certReloader) files() []string {
+	ret := []string{r.cfg.CertFile, r.cfg.KeyFile}
+	if r.cfg.ClientCaFile != "" {
+		ret = append(ret, r.cfg.ClientCaFile)
+	}
+	return ret
+}
+
+// reload loads the files if any of them changed since the last load, returning whether a reload happened.
+func (r *certReloader) reload() (bool, error) {
+	modTimes := make(map[string]time.Time)
+	changed := false
+	for _, f := range r.files() {
+		info, err := os.Stat(f)
+		if err != nil {
+			return false, err
+		}
+		modTimes[f] = info.ModTime()
+
+		r.mu.RLock()
+		prev, ok := r.modTimes[f]
+		r.mu.RUnlock()
+		if !ok || !prev.Equal(info.ModTime()) {
+			changed = true
+		}
+	}
+	if !changed {
+		return false, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.cfg.CertFile, r.cfg.KeyFile)
+	if err != nil {
+		return false, err
+	}
+
+	var clientCAs *x509.CertPool
+	if r.cfg.ClientCaFile != "" {
+		pem, err := os.ReadFile(r.cfg.ClientCaFile)
+		if err != nil {
+			return false, err
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return false, fmt.Errorf("no certificates found in '%s'", r.cfg.ClientCaFile)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.modTimes = modTimes
+	return true, nil
+}
+
+// watch reloads the files until the context is done. Failed reloads keep serving the previous certificate.
+func (r *certReloader) watch(ctx context.Context, logger *zap.Logger) {
+	ticker := time.NewTicker(tlsReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			reloaded, err := r.reload()
+			if err != nil {
+				logger.Error("failed to reload TLS certificates", zap.Error(err))
+			} else if reloaded {
+				logger.Info("reloaded TLS certificates")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *certReloader) clientAuth() tls.ClientAuthType {
+	switch {
+	case r.cfg.RequireClientCert:
+		return tls.RequireAndVerifyClientCert
+	case r.cfg.ClientCaFile != "":
+		return tls.VerifyClientCertIfGiven
+	default:
+		return tls.NoClientCert
+	}
+}
+
+// tlsConfig returns a server config that picks up reloaded certificates on each handshake.
+func (r *certReloader) tlsConfig() *tls.Config {
+	base := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// The config returned from GetConfigForClient is used as is, so HTTP/2 must be advertised explicitly.
+		NextProtos: []string{"h2", "http/1.1"},
+		ClientAuth: r.clientAuth(),
+	}
+
+	ret := base.Clone()
+	// Not used during handshakes since GetConfigForClient takes precedence, but http.Server requires a certificate
+	// source to be configured.
+	ret.GetCertificate = func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		return r.cert, nil
+	}
+	ret.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+
+		c := base.Clone()
+		c.Certificates = []tls.Certificate{*r.cert}
+		c.ClientCAs = r.clientCAs
+		return c, nil
+	}
+	return ret
+}
@@ -0,0 +1,177 @@
+package gateway
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+// newTestCert creates a certificate signed by parent, or a self-signed CA if parent is nil.
+func newTestCert(t *testing.T, cn string, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := tmpl, key
+	if parent == nil {
+		tmpl.IsCA = true
+		tmpl.BasicConstraintsValid = true
+		tmpl.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	return &testCert{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+func (c *testCert) keyPEM(t *testing.T) []byte {
+	der, err := x509.MarshalECPrivateKey(c.key)
+	assert.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+func (c *testCert) tlsCertificate(t *testing.T) tls.Certificate {
+	cert, err := tls.X509KeyPair(c.pem, c.keyPEM(t))
+	assert.NoError(t, err)
+	return cert
+}
+
+func writeTestFile(t *testing.T, path string, data []byte, modTime time.Time) {
+	assert.NoError(t, os.WriteFile(path, data, 0600))
+	assert.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, "ca", nil)
+	server := newTestCert(t, "server", ca)
+
+	cfg := &gatewayv1.TLS{
+		CertFile:          filepath.Join(dir, "tls.crt"),
+		KeyFile:           filepath.Join(dir, "tls.key"),
+		ClientCaFile:      filepath.Join(dir, "ca.crt"),
+		RequireClientCert: true,
+	}
+	modTime := time.Now().Add(-time.Minute)
+	writeTestFile(t, cfg.CertFile, server.pem, modTime)
+	writeTestFile(t, cfg.KeyFile, server.keyPEM(t), modTime)
+	writeTestFile(t, cfg.ClientCaFile, ca.pem, modTime)
+
+	r, err := newCertReloader(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, tls.RequireAndVerifyClientCert, r.tlsConfig().ClientAuth)
+
+	reloaded, err := r.reload()
+	assert.NoError(t, err)
+	assert.False(t, reloaded)
+
+	// Rotate the server certificate.
+	rotated := newTestCert(t, "rotated", ca)
+	writeTestFile(t, cfg.CertFile, rotated.pem, time.Now())
+	writeTestFile(t, cfg.KeyFile, rotated.keyPEM(t), time.Now())
+	reloaded, err = r.reload()
+	assert.NoError(t, err)
+	assert.True(t, reloaded)
+
+	c, err := r.tlsConfig().GetConfigForClient(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, rotated.cert.Raw, c.Certificates[0].Certificate[0])
+	assert.NotNil(t, c.ClientCAs)
+
+	// A bad file keeps the previous certificate.
+	writeTestFile(t, cfg.KeyFile, []byte("garbage"), time.Now().Add(time.Minute))
+	_, err = r.reload()
+	assert.Error(t, err)
+	c, _ = r.tlsConfig().GetConfigForClient(nil)
+	assert.Equal(t, rotated.cert.Raw, c.Certificates[0].Certificate[0])
+}
+
+func TestCertReloaderInvalidConfig(t *testing.T) {
+	_, err := newCertReloader(&gatewayv1.TLS{CertFile: "tls.crt", KeyFile: "tls.key", RequireClientCert: true})
+	assert.EqualError(t, err, "'require_client_cert' requires 'client_ca_file' to be set")
+
+	_, err = newCertReloader(&gatewayv1.TLS{CertFile: "/nonexistent/tls.crt", KeyFile: "/nonexistent/tls.key"})
+	assert.Error(t, err)
+}
+
+func TestCertReloaderHandshake(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCert(t, "ca", nil)
+	server := newTestCert(t, "server", ca)
+	client := newTestCert(t, "deployer", ca)
+
+	cfg := &gatewayv1.TLS{
+		CertFile:     filepath.Join(dir, "tls.crt"),
+		KeyFile:      filepath.Join(dir, "tls.key"),
+		ClientCaFile: filepath.Join(dir, "ca.crt"),
+	}
+	writeTestFile(t, cfg.CertFile, server.pem, time.Now())
+	writeTestFile(t, cfg.KeyFile, server.keyPEM(t), time.Now())
+	writeTestFile(t, cfg.ClientCaFile, ca.pem, time.Now())
+
+	r, err := newCertReloader(cfg)
+	assert.NoError(t, err)
+
+	l, err := tls.Listen("tcp", "127.0.0.1:0", r.tlsConfig())
+	assert.NoError(t, err)
+	defer l.Close()
+
+	states := make(chan tls.ConnectionState, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		tlsConn := conn.(*tls.Conn)
+		_ = tlsConn.Handshake()
+		states <- tlsConn.ConnectionState()
+	}()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	conn, err := tls.Dial("tcp", l.Addr().String(), &tls.Config{
+		RootCAs:      roots,
+		ServerName:   "localhost",
+		Certificates: []tls.Certificate{client.tlsCertificate(t)},
+		NextProtos:   []string{"h2"},
+	})
+	assert.NoError(t, err)
+	defer conn.Close()
+	assert.Equal(t, "h2", conn.ConnectionState().NegotiatedProtocol)
+
+	state := <-states
+	assert.Len(t, state.VerifiedChains, 1)
+	assert.Equal(t, "deployer", state.VerifiedChains[0][0].Subject.CommonName)
+}
@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"google.golang.org/grpc/metadata"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+const defaultServiceName = "clutch"
+
+// New installs a global tracer provider that exports spans as configured. The returned function flushes any buffered
+// spans and stops the exporter.
+func New(ctx context.Context, cfg *gatewayv1.Tracing) (func(context.Context) error, error) {
+	var exporter sdktrace.SpanExporter
+	switch t := cfg.Exporter.(type) {
+	case *gatewayv1.Tracing_Otlp:
+		opts := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(t.Otlp.Endpoint),
+			otlptracegrpc.WithHeaders(t.Otlp.Headers),
+		}
+		if t.Otlp.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+
+		e, err := otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		exporter = e
+	default:
+		return nil, errors.New("no tracing exporter configured")
+	}
+
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = defaultServiceName
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(serviceName))),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	Install(tp)
+	return tp.Shutdown, nil
+}
+
+// Install sets the global tracer provider and the W3C trace context propagator.
+func Install(tp *sdktrace.TracerProvider) {
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+}
+
+// MetadataCarrier adapts gRPC metadata for use with a propagator.
+type MetadataCarrier metadata.MD
+
+func (c MetadataCarrier) Get(key string) string {
+	if v := metadata.MD(c).Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func (c MetadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c MetadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
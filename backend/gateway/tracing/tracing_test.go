@@ -0,0 +1,52 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+)
+
+func TestNew(t *testing.T) {
+	_, err := New(context.Background(), &gatewayv1.Tracing{})
+	assert.EqualError(t, err, "no tracing exporter configured")
+
+	shutdown, err := New(context.Background(), &gatewayv1.Tracing{
+		SampleRatio: 1,
+		Exporter: &gatewayv1.Tracing_Otlp{Otlp: &gatewayv1.Tracing_OTLPExporter{
+			Endpoint: "localhost:4317",
+			Insecure: true,
+		}},
+	})
+	assert.NoError(t, err)
+
+	_, span := otel.Tracer("test").Start(context.Background(), "test")
+	assert.True(t, span.SpanContext().IsSampled())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_ = shutdown(ctx)
+}
+
+func TestMetadataCarrier(t *testing.T) {
+	md := metadata.MD{}
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	propagator := propagation.TraceContext{}
+	propagator.Inject(trace.ContextWithSpanContext(context.Background(), sc), MetadataCarrier(md))
+	assert.Equal(t, []string{"traceparent"}, MetadataCarrier(md).Keys())
+
+	ctx := propagator.Extract(context.Background(), MetadataCarrier(md))
+	assert.Equal(t, sc.TraceID(), trace.SpanContextFromContext(ctx).TraceID())
+}
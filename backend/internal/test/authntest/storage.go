@@ -0,0 +1,102 @@
+// Package authntest holds the mocks used by the authn service tests. The authnmock package re-exports them, but
+// can't be imported by those tests since its issuer mock depends on the authn service.
+package authntest
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authnv1 "github.com/lyft/clutch/backend/api/authn/v1"
+)
+
+type MockAuthnStorage struct {
+	// mapping from provider -> user -> token
+	Tokens map[string]map[string]*oauth2.Token
+}
+
+func (m MockAuthnStorage) Store(ctx context.Context, userID, provider string, token *oauth2.Token) error {
+	if _, ok := m.Tokens[provider]; !ok {
+		m.Tokens[provider] = make(map[string]*oauth2.Token)
+	}
+
+	m.Tokens[provider][userID] = token
+
+	return nil
+}
+
+func (m MockAuthnStorage) Read(ctx context.Context, userID, provider string) (*oauth2.Token, error) {
+	if _, ok := m.Tokens[provider]; !ok {
+		return nil, fmt.Errorf("token provider '%s' not found for user '%s'", provider, userID)
+	}
+
+	token, ok := m.Tokens[provider][userID]
+	if !ok {
+		return nil, fmt.Errorf("token user '%s' not found for provider '%s'", userID, provider)
+	}
+
+	return token, nil
+}
+
+func NewMockStorage() *MockAuthnStorage {
+	return &MockAuthnStorage{
+		Tokens: map[string]map[string]*oauth2.Token{},
+	}
+}
+
+// MockServiceTokenStorage additionally stores service token metadata.
+type MockServiceTokenStorage struct {
+	*MockAuthnStorage
+
+	ServiceTokens map[string]*authnv1.ServiceToken
+}
+
+func (m MockServiceTokenStorage) CreateServiceToken(ctx context.Context, token *authnv1.ServiceToken) error {
+	m.ServiceTokens[token.Id] = token
+	return nil
+}
+
+func (m MockServiceTokenStorage) ListServiceTokens(ctx context.Context, includeRevoked bool) ([]*authnv1.ServiceToken, error) {
+	var ret []*authnv1.ServiceToken
+	for _, t := range m.ServiceTokens {
+		if includeRevoked || t.RevokedAt == nil {
+			ret = append(ret, t)
+		}
+	}
+	return ret, nil
+}
+
+func (m MockServiceTokenStorage) RevokeServiceToken(ctx context.Context, id string) error {
+	t, ok := m.ServiceTokens[id]
+	if !ok || t.RevokedAt != nil {
+		return status.Errorf(codes.NotFound, "token '%s' does not exist or was already revoked", id)
+	}
+	t.RevokedAt = timestamppb.Now()
+	return nil
+}
+
+func (m MockServiceTokenStorage) CheckServiceToken(ctx context.Context, id string) error {
+	t, ok := m.ServiceTokens[id]
+	if !ok || t.RevokedAt != nil {
+		return status.Error(codes.Unauthenticated, "token was revoked")
+	}
+	return nil
+}
+
+func (m MockServiceTokenStorage) RecordServiceTokenUse(ctx context.Context, id string) error {
+	if t, ok := m.ServiceTokens[id]; ok {
+		t.LastUsedAt = timestamppb.Now()
+	}
+	return nil
+}
+
+func NewMockServiceTokenStorage() *MockServiceTokenStorage {
+	return &MockServiceTokenStorage{
+		MockAuthnStorage: NewMockStorage(),
+		ServiceTokens:    map[string]*authnv1.ServiceToken{},
+	}
+}
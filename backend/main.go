@@ -1,11 +1,17 @@
 package main
 
 import (
+	"os"
+
 	"github.com/lyft/clutch/backend/cmd/assets"
 	"github.com/lyft/clutch/backend/gateway"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "config" {
+		os.Exit(gateway.RunConfigCommand(os.Args[2:], gateway.CoreComponentFactory))
+	}
+
 	flags := gateway.ParseFlags()
 	components := gateway.CoreComponentFactory
 
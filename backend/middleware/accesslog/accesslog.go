@@ -7,12 +7,14 @@ package accesslog
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	accesslogv1 "github.com/lyft/clutch/backend/api/config/middleware/accesslog/v1"
 	"github.com/lyft/clutch/backend/gateway/log"
@@ -23,6 +25,17 @@ import (
 const Name = "clutch.middleware.accesslog"
 
 func New(config *accesslogv1.Config, logger *zap.Logger, scope tally.Scope) (middleware.Middleware, error) {
+	statusCodes, err := statusCodeFilters(config)
+	if err != nil {
+		return nil, err
+	}
+	return &mid{
+		logger:      logger,
+		statusCodes: statusCodes,
+	}, nil
+}
+
+func statusCodeFilters(config *accesslogv1.Config) ([]codes.Code, error) {
 	var statusCodes []codes.Code
 	// if no filter is provided default to logging all status codes
 	if config != nil {
@@ -36,58 +49,102 @@ func New(config *accesslogv1.Config, logger *zap.Logger, scope tally.Scope) (mid
 			}
 		}
 	}
-	return &mid{
-		logger:      logger,
-		statusCodes: statusCodes,
-	}, nil
+	return statusCodes, nil
 }
 
 type mid struct {
 	logger *zap.Logger
+
+	mu sync.RWMutex
 	// TODO(perf): improve lookup efficiency using a lookup table
 	statusCodes []codes.Code
 }
 
+// PrepareReload parses new status code filters.
+func (m *mid) PrepareReload(cfg *anypb.Any) (func(), error) {
+	config := &accesslogv1.Config{}
+	if cfg != nil {
+		if err := cfg.UnmarshalTo(config); err != nil {
+			return nil, err
+		}
+	}
+
+	statusCodes, err := statusCodeFilters(config)
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.statusCodes = statusCodes
+	}, nil
+}
+
 func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		service, method, ok := middleware.SplitFullMethod(info.FullMethod)
-		if !ok {
-			m.logger.Warn("could not parse gRPC method", zap.String("fullMethod", info.FullMethod))
-		}
 		resp, err := handler(ctx, req)
-		s := status.Convert(err)
-		if s == nil {
-			s = status.New(codes.OK, "")
+		if logErr := m.log(info.FullMethod, req, err); logErr != nil {
+			return nil, logErr
 		}
-		code := s.Code()
-		// common logger context fields
-		fields := []zap.Field{
-			zap.String("service", service),
-			zap.String("method", method),
-			zap.Int("statusCode", int(code)),
-			zap.String("status", code.String()),
+		return resp, err
+	}
+}
+
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		// Requests are consumed by the handler, so the body is not logged for streams.
+		if logErr := m.log(info.FullMethod, nil, err); logErr != nil {
+			return logErr
 		}
+		return err
+	}
+}
 
-		if m.validStatusCode(code) {
-			// if err is returned from handler, log error details only
-			// as response body will be nil
+func (m *mid) log(fullMethod string, req interface{}, err error) error {
+	service, method, ok := middleware.SplitFullMethod(fullMethod)
+	if !ok {
+		m.logger.Warn("could not parse gRPC method", zap.String("fullMethod", fullMethod))
+	}
+	s := status.Convert(err)
+	if s == nil {
+		s = status.New(codes.OK, "")
+	}
+	code := s.Code()
+	// common logger context fields
+	fields := []zap.Field{
+		zap.String("service", service),
+		zap.String("method", method),
+		zap.Int("statusCode", int(code)),
+		zap.String("status", code.String()),
+	}
+
+	if !m.validStatusCode(code) {
+		return nil
+	}
+
+	// if err is returned from handler, log error details only
+	// as response body will be nil
+	if err != nil {
+		if req != nil {
+			reqBody, err := meta.APIBody(req)
 			if err != nil {
-				reqBody, err := meta.APIBody(req)
-				if err != nil {
-					return nil, err
-				}
-				fields = append(fields, log.ProtoField("requestBody", reqBody))
-				fields = append(fields, zap.String("error", s.Message()))
-				m.logger.Error("gRPC", fields...)
-			} else {
-				m.logger.Info("gRPC", fields...)
+				return err
 			}
+			fields = append(fields, log.ProtoField("requestBody", reqBody))
 		}
-		return resp, err
+		fields = append(fields, zap.String("error", s.Message()))
+		m.logger.Error("gRPC", fields...)
+	} else {
+		m.logger.Info("gRPC", fields...)
 	}
+	return nil
 }
 
 func (m *mid) validStatusCode(c codes.Code) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
 	// If no filter is provided all status codes are valid
 	if len(m.statusCodes) == 0 {
 		return true
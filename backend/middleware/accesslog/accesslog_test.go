@@ -16,6 +16,7 @@ import (
 
 	accesslogv1 "github.com/lyft/clutch/backend/api/config/middleware/accesslog/v1"
 	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
+	"github.com/lyft/clutch/backend/mock/grpcmock"
 )
 
 func TestNew(t *testing.T) {
@@ -182,3 +183,22 @@ func TestLogContent(t *testing.T) {
 		assert.NotNil(t, method)
 	}
 }
+
+func TestStreamInterceptor(t *testing.T) {
+	core, recorded := observer.New(zapcore.DebugLevel)
+	m := &mid{logger: zap.New(core)}
+
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		return status.Error(codes.NotFound, "not found")
+	}
+
+	err := m.StreamInterceptor()(nil, &grpcmock.MockServerStream{}, &grpc.StreamServerInfo{FullMethod: "/foo/bar"}, handler)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	assert.Equal(t, 1, recorded.Len())
+	logEntry := recorded.All()[0]
+	assert.Equal(t, zapcore.ErrorLevel, logEntry.Level)
+	assert.Equal(t, int64(codes.NotFound), logEntry.ContextMap()["statusCode"])
+	assert.Equal(t, "not found", logEntry.ContextMap()["error"])
+	assert.NotContains(t, logEntry.ContextMap(), "requestBody")
+}
@@ -56,7 +56,7 @@ func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 			return handler(ctx, req)
 		}
 
-		event, err := m.eventFromRequest(ctx, req, info)
+		event, err := m.eventFromRequest(ctx, req, info.FullMethod)
 		if err != nil {
 			return nil, err
 		}
@@ -88,10 +88,78 @@ func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
-func (m *mid) eventFromRequest(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo) (*auditv1.RequestEvent, error) {
-	svc, method, ok := middleware.SplitFullMethod(info.FullMethod)
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if meta.IsAuditDisabled(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		// The request event is written when the first request is received, as the resources aren't known until then.
+		as := &auditedStream{ServerStream: ss, mid: m, fullMethod: info.FullMethod, id: -1}
+		err := handler(srv, as)
+
+		if as.id != -1 {
+			// Streamed responses are not recorded, only the final status.
+			update := m.eventFromStreamEnd(err)
+
+			if auditErr := m.audit.UpdateRequestEvent(as.ctx, as.id, update); auditErr != nil {
+				m.logger.Warn("error updating audit event",
+					zap.Int64("auditID", as.id),
+					log.ProtoField("updateEvent", update),
+				)
+			}
+		}
+
+		return err
+	}
+}
+
+type auditedStream struct {
+	grpc.ServerStream
+
+	mid        *mid
+	fullMethod string
+	received   bool
+
+	id  int64
+	ctx context.Context
+}
+
+func (s *auditedStream) Context() context.Context {
+	if s.ctx != nil {
+		return s.ctx
+	}
+	return s.ServerStream.Context()
+}
+
+func (s *auditedStream) RecvMsg(msg interface{}) error {
+	if err := s.ServerStream.RecvMsg(msg); err != nil {
+		return err
+	}
+	if s.received {
+		return nil
+	}
+	s.received = true
+
+	ctx := s.ServerStream.Context()
+	event, err := s.mid.eventFromRequest(ctx, msg, s.fullMethod)
+	if err != nil {
+		return err
+	}
+
+	id, err := s.mid.audit.WriteRequestEvent(ctx, event)
+	if err != nil && !errors.Is(err, auditservice.ErrFailedFilters) {
+		return fmt.Errorf("could not make call %s because failed to audit: %w", s.fullMethod, err)
+	}
+	s.id = id
+	s.ctx = context.WithValue(ctx, auditEntryContextKey{}, id)
+	return nil
+}
+
+func (m *mid) eventFromRequest(ctx context.Context, req interface{}, fullMethod string) (*auditv1.RequestEvent, error) {
+	svc, method, ok := middleware.SplitFullMethod(fullMethod)
 	if !ok {
-		m.logger.Warn("could not parse gRPC method", zap.String("fullMethod", info.FullMethod))
+		m.logger.Warn("could not parse gRPC method", zap.String("fullMethod", fullMethod))
 	}
 
 	username := "UNKNOWN"
@@ -108,7 +176,7 @@ func (m *mid) eventFromRequest(ctx context.Context, req interface{}, info *grpc.
 		Username:    username,
 		ServiceName: svc,
 		MethodName:  method,
-		Type:        meta.GetAction(info.FullMethod),
+		Type:        meta.GetAction(fullMethod),
 		Resources:   meta.ResourceNames(req.(proto.Message)),
 		RequestMetadata: &auditv1.RequestMetadata{
 			Body: reqBody,
@@ -116,6 +184,17 @@ func (m *mid) eventFromRequest(ctx context.Context, req interface{}, info *grpc.
 	}, nil
 }
 
+func (m *mid) eventFromStreamEnd(err error) *auditv1.RequestEvent {
+	s := status.Convert(err)
+	if s == nil {
+		s = status.New(codes.OK, "")
+	}
+	return &auditv1.RequestEvent{
+		Status:           s.Proto(),
+		ResponseMetadata: &auditv1.ResponseMetadata{},
+	}
+}
+
 func (m *mid) eventFromResponse(resp interface{}, err error) (*auditv1.RequestEvent, error) {
 	s := status.Convert(err)
 	if s == nil {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
@@ -14,6 +15,7 @@ import (
 	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
 	"github.com/lyft/clutch/backend/gateway/meta"
 	testpb "github.com/lyft/clutch/backend/internal/test/pb"
+	"github.com/lyft/clutch/backend/mock/grpcmock"
 	modulemock "github.com/lyft/clutch/backend/mock/module"
 	"github.com/lyft/clutch/backend/module/healthcheck"
 	"github.com/lyft/clutch/backend/service/audit"
@@ -84,6 +86,31 @@ func TestInterceptor(t *testing.T) {
 	assert.EqualValues(t, 1, a.updateCount)
 }
 
+func TestStreamInterceptor(t *testing.T) {
+	a := &mockAuditor{}
+	m := &mid{
+		audit: a,
+	}
+
+	var handlerCtx context.Context
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		if err := ss.RecvMsg(&healthcheckv1.HealthcheckRequest{}); err != nil {
+			return err
+		}
+		handlerCtx = ss.Context()
+		return ss.SendMsg(&healthcheckv1.HealthcheckResponse{})
+	}
+
+	ss := &grpcmock.MockServerStream{Requests: []proto.Message{&healthcheckv1.HealthcheckRequest{}}}
+	err := m.StreamInterceptor()(nil, ss, &grpc.StreamServerInfo{FullMethod: "/foo/bar", IsServerStream: true}, handler)
+	assert.NoError(t, err)
+	assert.Len(t, ss.Sent, 1)
+	assert.EqualValues(t, 0, handlerCtx.Value(auditEntryContextKey{}))
+
+	assert.EqualValues(t, 1, a.writeCount)
+	assert.EqualValues(t, 1, a.updateCount)
+}
+
 func TestInterceptorShortCircuitDisabled(t *testing.T) {
 	a := &mockAuditor{}
 	m := &mid{
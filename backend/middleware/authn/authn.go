@@ -9,6 +9,7 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/dgrijalva/jwt-go"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
@@ -19,12 +20,17 @@ import (
 
 	"github.com/lyft/clutch/backend/gateway/mux"
 	"github.com/lyft/clutch/backend/middleware"
+	"github.com/lyft/clutch/backend/middleware/tracing"
 	"github.com/lyft/clutch/backend/service"
 	"github.com/lyft/clutch/backend/service/authn"
 )
 
 const Name = "clutch.middleware.authn"
 
+// The prefix of the subjects of callers authenticated by a client certificate. It differs from the prefix of service
+// tokens, so that a certificate can't be issued for the name of a service token to get the token's permissions.
+const clientCertificateSubjectPrefix = "mtls:"
+
 func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (middleware.Middleware, error) {
 	svc, ok := service.Registry["clutch.service.authn"]
 	if !ok {
@@ -49,33 +55,60 @@ type mid struct {
 
 func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		// Check for auth.
-		authenticatedCtx, authErr := m.authenticate(ctx)
-
-		// Determine if it's on the allow list.
-		checkRequired := true
-		for _, allow := range authn.AlwaysAllowedMethods {
-			if middleware.MatchMethodOrResource(allow, info.FullMethod) {
-				checkRequired = false
-				break
-			}
+		ctx, err := m.claimsContext(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := m.claimsContext(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
 		}
+		return handler(srv, middleware.ServerStreamWithContext(ctx, ss))
+	}
+}
 
-		// Assert auth if required.
-		if checkRequired {
-			if authErr != nil {
-				return nil, status.New(codes.Unauthenticated, authErr.Error()).Err()
-			}
-			return handler(authenticatedCtx, req)
+// claimsContext returns the context with the caller's claims, or an error if authentication is required and failed.
+func (m *mid) claimsContext(ctx context.Context, fullMethod string) (context.Context, error) {
+	// Check for auth.
+	authenticatedCtx, authErr := m.authenticate(ctx)
+
+	// Determine if it's on the allow list.
+	checkRequired := true
+	for _, allow := range authn.AlwaysAllowedMethods {
+		if middleware.MatchMethodOrResource(allow, fullMethod) {
+			checkRequired = false
+			break
 		}
+	}
 
-		// If auth not required, we still append claims for logging purposes or anonymously accessible APIs.
-		if _, err := authn.ClaimsFromContext(authenticatedCtx); err != nil {
-			// Anonymous claims if there weren't any authenticated claims.
-			return handler(authn.ContextWithAnonymousClaims(ctx), req)
+	// Assert auth if required. Tokens with scopes may only call the methods matching them, which is enforced here
+	// so that it doesn't depend on the authz middleware being configured.
+	if checkRequired {
+		if authErr != nil {
+			return nil, status.New(codes.Unauthenticated, authErr.Error()).Err()
 		}
-		return handler(authenticatedCtx, req)
+		claims, err := authn.ClaimsFromContext(authenticatedCtx)
+		if err != nil {
+			return nil, err
+		}
+		if err := claims.CheckScopes(fullMethod); err != nil {
+			return nil, err
+		}
+		return authenticatedCtx, nil
+	}
+
+	// If auth not required, we still append claims for logging purposes or anonymously accessible APIs.
+	if _, err := authn.ClaimsFromContext(authenticatedCtx); err != nil {
+		// Anonymous claims if there weren't any authenticated claims.
+		return authn.ContextWithAnonymousClaims(ctx), nil
 	}
+	return authenticatedCtx, nil
 }
 
 // getToken looks for the token in the authorization header or cookies.
@@ -104,6 +137,12 @@ func (m *mid) authenticate(ctx context.Context) (context.Context, error) {
 
 	token, err := getToken(md)
 	if err != nil {
+		// Fall back to a verified client certificate for service-to-service callers.
+		if identity, ok := mux.ClientIdentity(ctx); ok {
+			claims := clientCertificateClaims(identity)
+			tracing.SetUser(ctx, claims.Subject)
+			return authn.ContextWithClaims(ctx, claims), nil
+		}
 		return nil, err
 	}
 
@@ -114,5 +153,12 @@ func (m *mid) authenticate(ctx context.Context) (context.Context, error) {
 	}
 
 	// Append claims information to context.
+	tracing.SetUser(ctx, claims.Subject)
 	return authn.ContextWithClaims(ctx, claims), nil
 }
+
+func clientCertificateClaims(identity string) *authn.Claims {
+	return &authn.Claims{
+		StandardClaims: &jwt.StandardClaims{Subject: clientCertificateSubjectPrefix + identity},
+	}
+}
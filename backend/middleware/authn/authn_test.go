@@ -1,11 +1,28 @@
 package authn
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"fmt"
+	"net"
 	"testing"
 
+	"github.com/dgrijalva/jwt-go"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
+	"github.com/lyft/clutch/backend/gateway/mux"
+	"github.com/lyft/clutch/backend/mock/grpcmock"
+	"github.com/lyft/clutch/backend/service/authn"
 )
 
 func TestGetToken(t *testing.T) {
@@ -38,3 +55,116 @@ func TestGetToken(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthenticateClientCertificate(t *testing.T) {
+	m := &mid{}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{})
+	_, err := m.authenticate(ctx)
+	assert.Error(t, err)
+
+	ctx = peer.NewContext(ctx, &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 1234},
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: "deployer"}}}},
+		}},
+	})
+	ctx, err = m.authenticate(ctx)
+	assert.NoError(t, err)
+
+	claims, err := authn.ClaimsFromContext(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "mtls:deployer", claims.Subject)
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	m := &mid{}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{})
+
+	called := false
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		called = true
+		_, err := authn.ClaimsFromContext(ss.Context())
+		assert.NoError(t, err)
+		return nil
+	}
+
+	// Streaming calls without credentials are denied before reaching the handler.
+	info := &grpc.StreamServerInfo{FullMethod: "/clutch.k8s.v1.K8sAPI/WatchPods", IsServerStream: true}
+	err := m.StreamInterceptor()(nil, &grpcmock.MockServerStream{Ctx: ctx}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.False(t, called)
+
+	// Allowlisted methods receive anonymous claims.
+	info = &grpc.StreamServerInfo{FullMethod: "/clutch.healthcheck.v1.HealthcheckAPI/Readiness", IsServerStream: true}
+	err = m.StreamInterceptor()(nil, &grpcmock.MockServerStream{Ctx: ctx}, info, handler)
+	assert.NoError(t, err)
+	assert.True(t, called)
+
+	// The deep healthcheck returns the errors of dependencies, so it isn't allowlisted.
+	called = false
+	info = &grpc.StreamServerInfo{FullMethod: "/clutch.healthcheck.v1.HealthcheckAPI/DeepHealthcheck", IsServerStream: true}
+	err = m.StreamInterceptor()(nil, &grpcmock.MockServerStream{Ctx: ctx}, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.False(t, called)
+}
+
+func TestStreamDeniedWithoutCredentials(t *testing.T) {
+	m := &mid{}
+	rpcMux, err := mux.New([]grpc.UnaryServerInterceptor{m.UnaryInterceptor()}, []grpc.StreamServerInterceptor{m.StreamInterceptor()}, nil, nil, &gatewayv1.GatewayOptions{})
+	assert.NoError(t, err)
+
+	called := false
+	rpcMux.GRPCServer.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "clutch.test.v1.TestAPI",
+		HandlerType: (*interface{})(nil),
+		Streams: []grpc.StreamDesc{{
+			StreamName: "Watch",
+			Handler: func(srv interface{}, ss grpc.ServerStream) error {
+				called = true
+				return nil
+			},
+			ServerStreams: true,
+		}},
+	}, struct{}{})
+	go func() { _ = rpcMux.ServeLoopback() }()
+	defer rpcMux.GRPCServer.Stop()
+
+	conn, err := rpcMux.DialLoopback(context.Background(), grpc.WithBlock())
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	stream, err := conn.NewStream(context.Background(), &grpc.StreamDesc{ServerStreams: true}, "/clutch.test.v1.TestAPI/Watch")
+	assert.NoError(t, err)
+	assert.NoError(t, stream.CloseSend())
+	err = stream.RecvMsg(&healthcheckv1.HealthcheckResponse{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.False(t, called)
+}
+
+type claimsProvider struct {
+	authn.Provider
+	claims *authn.Claims
+}
+
+func (p claimsProvider) Verify(context.Context, string) (*authn.Claims, error) {
+	return p.claims, nil
+}
+
+func TestScopesEnforced(t *testing.T) {
+	m := &mid{provider: claimsProvider{claims: &authn.Claims{
+		StandardClaims: &jwt.StandardClaims{Subject: "service:deployer"},
+		Scopes:         []string{"/clutch.k8s.v1.K8sAPI/Describe*"},
+	}}}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Token abc"))
+
+	// Scopes are enforced without the authz middleware.
+	_, err := m.claimsContext(ctx, "/clutch.k8s.v1.K8sAPI/DescribePod")
+	assert.NoError(t, err)
+	_, err = m.claimsContext(ctx, "/clutch.k8s.v1.K8sAPI/DeletePod")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Allowlisted methods don't require authentication, so the scopes don't apply.
+	_, err = m.claimsContext(ctx, "/clutch.healthcheck.v1.HealthcheckAPI/Healthcheck")
+	assert.NoError(t, err)
+}
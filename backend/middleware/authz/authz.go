@@ -59,50 +59,102 @@ func (m *mid) evaluate(ctx context.Context, r *authzv1.CheckRequest) error {
 
 func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		// Never interfere with allowlisted flows.
-		for _, allow := range authn.AlwaysAllowedMethods {
-			if middleware.MatchMethodOrResource(allow, info.FullMethod) {
-				return handler(ctx, req)
-			}
+		if isAllowed(info.FullMethod) {
+			return handler(ctx, req)
 		}
 
-		claims, err := authn.ClaimsFromContext(ctx)
-		if err != nil {
+		if err := m.authorize(ctx, info.FullMethod, req.(proto.Message)); err != nil {
 			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		actionType := meta.GetAction(info.FullMethod)
-		resources := meta.ResourceNames(req.(proto.Message))
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isAllowed(info.FullMethod) {
+			return handler(srv, ss)
+		}
 
-		subject := &authzv1.Subject{
-			User:   claims.Subject,
-			Groups: claims.Groups,
+		// Fail early if there are no claims or the method is out of scope, since resources are only known once
+		// requests are received.
+		claims, err := authn.ClaimsFromContext(ss.Context())
+		if err != nil {
+			return err
+		}
+		if err := claims.CheckScopes(info.FullMethod); err != nil {
+			return err
 		}
+		return handler(srv, &authorizedStream{ServerStream: ss, mid: m, fullMethod: info.FullMethod})
+	}
+}
 
-		if len(resources) == 0 {
-			check := &authzv1.CheckRequest{
-				Subject:    subject,
-				Method:     info.FullMethod,
-				ActionType: actionType,
-			}
-			if err := m.evaluate(ctx, check); err != nil {
-				return nil, err
-			}
+// authorizedStream checks each request received on the stream before it is passed to the handler.
+type authorizedStream struct {
+	grpc.ServerStream
+
+	mid        *mid
+	fullMethod string
+}
+
+func (s *authorizedStream) RecvMsg(msg interface{}) error {
+	if err := s.ServerStream.RecvMsg(msg); err != nil {
+		return err
+	}
+	return s.mid.authorize(s.Context(), s.fullMethod, msg.(proto.Message))
+}
+
+// Never interfere with allowlisted flows.
+func isAllowed(fullMethod string) bool {
+	for _, allow := range authn.AlwaysAllowedMethods {
+		if middleware.MatchMethodOrResource(allow, fullMethod) {
+			return true
 		}
+	}
+	return false
+}
 
-		for _, resource := range resources {
-			check := &authzv1.CheckRequest{
-				Subject:    subject,
-				Method:     info.FullMethod,
-				ActionType: actionType,
-				Resource:   resource.Id,
-			}
-
-			if err := m.evaluate(ctx, check); err != nil {
-				return nil, err
-			}
+func (m *mid) authorize(ctx context.Context, fullMethod string, req proto.Message) error {
+	claims, err := authn.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Scopes are also enforced by the authn middleware, this covers claims set by other means.
+	if err := claims.CheckScopes(fullMethod); err != nil {
+		return err
+	}
+
+	actionType := meta.GetAction(fullMethod)
+	resources := meta.ResourceNames(req)
+
+	subject := &authzv1.Subject{
+		User:   claims.Subject,
+		Groups: claims.Groups,
+	}
+
+	if len(resources) == 0 {
+		check := &authzv1.CheckRequest{
+			Subject:    subject,
+			Method:     fullMethod,
+			ActionType: actionType,
+		}
+		if err := m.evaluate(ctx, check); err != nil {
+			return err
 		}
+	}
 
-		return handler(ctx, req)
+	for _, resource := range resources {
+		check := &authzv1.CheckRequest{
+			Subject:    subject,
+			Method:     fullMethod,
+			ActionType: actionType,
+			Resource:   resource.Id,
+		}
+
+		if err := m.evaluate(ctx, check); err != nil {
+			return err
+		}
 	}
+	return nil
 }
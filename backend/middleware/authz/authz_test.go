@@ -8,10 +8,14 @@ import (
 	"github.com/dgrijalva/jwt-go"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	authzv1 "github.com/lyft/clutch/backend/api/authz/v1"
 	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
 	"github.com/lyft/clutch/backend/middleware"
+	"github.com/lyft/clutch/backend/mock/grpcmock"
 	"github.com/lyft/clutch/backend/mock/service/authzmock"
 	"github.com/lyft/clutch/backend/service"
 	"github.com/lyft/clutch/backend/service/authn"
@@ -107,3 +111,66 @@ func TestNoResources(t *testing.T) {
 	assert.Equal(t, claims.Subject, s.lastSubject.User)
 	assert.EqualValues(t, claims.Groups, s.lastSubject.Groups)
 }
+
+func TestScopes(t *testing.T) {
+	s := &svcMock{}
+	m, _ := newWithMock(s)
+	interceptor := m.UnaryInterceptor()
+
+	claims := &authn.Claims{
+		StandardClaims: &jwt.StandardClaims{Subject: "service:deployer"},
+		Scopes:         []string{"/clutch.k8s.v1.K8sAPI/Describe*", "/clutch.foo/*"},
+	}
+	ctx := authn.ContextWithClaims(context.Background(), claims)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &healthcheckv1.HealthcheckResponse{}, nil
+	}
+
+	testCases := []struct {
+		method string
+		code   codes.Code
+	}{
+		{method: "/clutch.k8s.v1.K8sAPI/DescribePod", code: codes.OK},
+		{method: "/clutch.foo/Bar", code: codes.OK},
+		{method: "/clutch.k8s.v1.K8sAPI/DeletePod", code: codes.PermissionDenied},
+	}
+
+	for _, tt := range testCases {
+		_, err := interceptor(ctx, &healthcheckv1.HealthcheckRequest{}, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+		assert.Equal(t, tt.code, status.Code(err), tt.method)
+	}
+	// Out of scope methods are denied before consulting the authz service.
+	assert.EqualValues(t, 2, s.called)
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	s := &svcMock{}
+	m, _ := newWithMock(s)
+	interceptor := m.(middleware.StreamMiddleware).StreamInterceptor()
+
+	info := &grpc.StreamServerInfo{FullMethod: "/clutch.foo/Bar", IsServerStream: true}
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		return ss.RecvMsg(&healthcheckv1.HealthcheckRequest{})
+	}
+
+	// No claims.
+	ss := &grpcmock.MockServerStream{Requests: []proto.Message{&healthcheckv1.HealthcheckRequest{}}}
+	err := interceptor(nil, ss, info, handler)
+	assert.Error(t, err)
+	assert.EqualValues(t, 0, s.called)
+
+	// Requests are checked as they are received.
+	claims := &authn.Claims{StandardClaims: &jwt.StandardClaims{Subject: "foo@example.com"}}
+	ss.Ctx = authn.ContextWithClaims(context.Background(), claims)
+	err = interceptor(nil, ss, info, handler)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, s.called)
+	assert.Equal(t, claims.Subject, s.lastSubject.User)
+
+	// Out of scope streams are rejected before any request is received.
+	claims.Scopes = []string{"/clutch.other/*"}
+	err = interceptor(nil, ss, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.EqualValues(t, 1, s.called)
+}
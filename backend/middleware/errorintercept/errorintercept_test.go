@@ -99,3 +99,19 @@ func TestMultipleInterceptors(t *testing.T) {
 	assert.Equal(t, []string{"b", "a"}, calls)
 	assert.Equal(t, err, errors.New("a"))
 }
+
+func TestStreamCallsIfErr(t *testing.T) {
+	m := &Middleware{}
+
+	newErr := errors.New("this is a rewritten error")
+	m.AddInterceptor(func(error) error {
+		return newErr
+	})
+
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		return errors.New("whoopsie daisy")
+	}
+
+	err := m.StreamInterceptor()(nil, nil, &grpc.StreamServerInfo{FullMethod: "/zip/zoom"}, handler)
+	assert.Equal(t, newErr, err)
+}
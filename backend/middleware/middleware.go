@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"strings"
 
 	"github.com/gobwas/glob"
@@ -16,6 +17,27 @@ type Middleware interface {
 	UnaryInterceptor() grpc.UnaryServerInterceptor
 }
 
+// StreamMiddleware is implemented by middleware that also applies to streaming RPCs. Middleware that does not implement
+// it can only be configured with unary_only set, and is skipped for streaming RPCs.
+type StreamMiddleware interface {
+	StreamInterceptor() grpc.StreamServerInterceptor
+}
+
+// ServerStreamWithContext returns the stream with its context replaced.
+func ServerStreamWithContext(ctx context.Context, ss grpc.ServerStream) grpc.ServerStream {
+	return &serverStream{ServerStream: ss, ctx: ctx}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
 func SplitFullMethod(fullMethod string) (service string, method string, ok bool) {
 	s := strings.SplitN(fullMethod, "/", 3)
 	if len(s) != 3 {
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -125,3 +126,11 @@ func TestDeeplyNestedMatching(t *testing.T) {
 		})
 	}
 }
+
+type ctxKey struct{}
+
+func TestServerStreamWithContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxKey{}, "bar")
+	ss := ServerStreamWithContext(ctx, nil)
+	assert.Equal(t, "bar", ss.Context().Value(ctxKey{}))
+}
@@ -0,0 +1,132 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	ratelimitv1 "github.com/lyft/clutch/backend/api/config/middleware/ratelimit/v1"
+)
+
+// bucket is a token bucket refilled at rate tokens per second, holding at most burst tokens.
+type bucket struct {
+	rate  float64
+	burst float64
+}
+
+func newBucket(cfg *ratelimitv1.Bucket) *bucket {
+	if cfg == nil {
+		return nil
+	}
+
+	burst := cfg.Burst
+	if burst == 0 {
+		burst = cfg.Requests
+	}
+	return &bucket{
+		rate:  float64(cfg.Requests) / cfg.Per.AsDuration().Seconds(),
+		burst: float64(burst),
+	}
+}
+
+// take refills the tokens for the elapsed time and takes one if available. It returns the remaining tokens and, if
+// none were available, how long until one will be.
+func (b *bucket) take(tokens float64, elapsed time.Duration) (float64, time.Duration, bool) {
+	tokens = math.Min(b.burst, tokens+elapsed.Seconds()*b.rate)
+	if tokens < 1 {
+		return tokens, time.Duration((1 - tokens) / b.rate * float64(time.Second)), false
+	}
+	return tokens - 1, 0, true
+}
+
+// refillTime is how long an empty bucket takes to fill up.
+func (b *bucket) refillTime() time.Duration {
+	return time.Duration(b.burst / b.rate * float64(time.Second))
+}
+
+const (
+	// How often buckets that have filled up since they were last used are removed. A full bucket is the same as a
+	// missing one.
+	expireInterval = 5 * time.Minute
+)
+
+type bucketRequest struct {
+	key    string
+	bucket *bucket
+}
+
+type store interface {
+	// take takes a token from each of the buckets if all of them have one available, so that a request rejected by one
+	// limit doesn't use up the tokens of the others. Otherwise no tokens are taken, and the index of the empty bucket
+	// with the longest wait is returned with how long to wait before retrying. The index is -1 if the tokens were
+	// taken.
+	take(ctx context.Context, requests []bucketRequest) (int, time.Duration, error)
+}
+
+type bucketState struct {
+	tokens  float64
+	updated time.Time
+}
+
+// memoryStore keeps buckets in memory, so each replica enforces limits separately.
+type memoryStore struct {
+	now func() time.Time
+
+	// Buckets that haven't been used for this long are full and removed.
+	expireAfter time.Duration
+
+	mu          sync.Mutex
+	buckets     map[string]*bucketState
+	lastExpired time.Time
+}
+
+func newMemoryStore(expireAfter time.Duration) *memoryStore {
+	return &memoryStore{now: time.Now, expireAfter: expireAfter, buckets: make(map[string]*bucketState)}
+}
+
+func (s *memoryStore) take(_ context.Context, requests []bucketRequest) (int, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	s.expire(now)
+
+	denied, retryAfter := -1, time.Duration(0)
+	tokens := make([]float64, len(requests))
+	for i, r := range requests {
+		current, elapsed := r.bucket.burst, time.Duration(0)
+		if state, ok := s.buckets[r.key]; ok {
+			current, elapsed = state.tokens, now.Sub(state.updated)
+		}
+
+		var wait time.Duration
+		var allowed bool
+		tokens[i], wait, allowed = r.bucket.take(current, elapsed)
+		if !allowed && (denied == -1 || wait > retryAfter) {
+			denied, retryAfter = i, wait
+		}
+	}
+	if denied != -1 {
+		return denied, retryAfter, nil
+	}
+
+	for i, r := range requests {
+		s.buckets[r.key] = &bucketState{tokens: tokens[i], updated: now}
+	}
+	return -1, 0, nil
+}
+
+// expire removes the buckets that have filled up. The lock must be held.
+func (s *memoryStore) expire(now time.Time) {
+	if now.Sub(s.lastExpired) < expireInterval {
+		return
+	}
+	s.lastExpired = now
+
+	for key, state := range s.buckets {
+		if now.Sub(state.updated) >= s.expireAfter {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	ratelimitv1 "github.com/lyft/clutch/backend/api/config/middleware/ratelimit/v1"
+)
+
+func TestNewBucket(t *testing.T) {
+	assert.Nil(t, newBucket(nil))
+
+	b := newBucket(&ratelimitv1.Bucket{Requests: 60, Per: durationpb.New(time.Minute)})
+	assert.Equal(t, 1.0, b.rate)
+	assert.Equal(t, 60.0, b.burst)
+
+	b = newBucket(&ratelimitv1.Bucket{Requests: 1, Per: durationpb.New(time.Second), Burst: 5})
+	assert.Equal(t, 5.0, b.burst)
+}
+
+func TestBucketTake(t *testing.T) {
+	b := &bucket{rate: 2, burst: 3}
+
+	tokens, _, ok := b.take(3, 0)
+	assert.True(t, ok)
+	assert.Equal(t, 2.0, tokens)
+
+	// Refills are capped at the burst.
+	tokens, _, ok = b.take(2, time.Hour)
+	assert.True(t, ok)
+	assert.Equal(t, 2.0, tokens)
+
+	tokens, retryAfter, ok := b.take(0, 0)
+	assert.False(t, ok)
+	assert.Equal(t, 0.0, tokens)
+	assert.Equal(t, 500*time.Millisecond, retryAfter)
+}
+
+func TestBucketRefillTime(t *testing.T) {
+	b := &bucket{rate: 2, burst: 3}
+	assert.Equal(t, 1500*time.Millisecond, b.refillTime())
+}
+
+func TestMemoryStore(t *testing.T) {
+	now := time.Unix(0, 0).Add(expireInterval)
+	s := newMemoryStore(2 * time.Second)
+	s.now = func() time.Time { return now }
+	b := &bucket{rate: 1, burst: 2}
+	take := func(keys ...string) (int, time.Duration) {
+		var requests []bucketRequest
+		for _, key := range keys {
+			requests = append(requests, bucketRequest{key: key, bucket: b})
+		}
+		denied, retryAfter, err := s.take(context.Background(), requests)
+		assert.NoError(t, err)
+		return denied, retryAfter
+	}
+
+	for i := 0; i < 2; i++ {
+		denied, _ := take("foo")
+		assert.Equal(t, -1, denied)
+	}
+	denied, retryAfter := take("foo")
+	assert.Equal(t, 0, denied)
+	assert.Equal(t, time.Second, retryAfter)
+
+	// Buckets are independent, but no tokens are taken unless all of the buckets have one.
+	denied, _ = take("bar", "foo")
+	assert.Equal(t, 1, denied)
+	denied, _ = take("bar")
+	assert.Equal(t, -1, denied)
+	assert.Equal(t, 1.0, s.buckets["bar"].tokens)
+
+	now = now.Add(time.Second)
+	denied, _ = take("foo")
+	assert.Equal(t, -1, denied)
+
+	// Buckets that have filled up are removed.
+	now = now.Add(expireInterval)
+	denied, _ = take("baz")
+	assert.Equal(t, -1, denied)
+	assert.Len(t, s.buckets, 1)
+}
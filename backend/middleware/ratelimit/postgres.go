@@ -0,0 +1,108 @@
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// postgresStore shares buckets between replicas. The database clock is used to measure elapsed time so that clock
+// skew between replicas doesn't matter.
+type postgresStore struct {
+	db     *sql.DB
+	logger *zap.Logger
+
+	// Buckets that haven't been used for this long are full and deleted.
+	expireAfter time.Duration
+
+	mu          sync.Mutex
+	lastExpired time.Time
+}
+
+const (
+	insertBucketQuery = `
+INSERT INTO rate_limit_bucket (bucket_key, tokens) VALUES ($1, $2)
+ON CONFLICT (bucket_key) DO NOTHING`
+
+	selectBucketQuery = `
+SELECT tokens, EXTRACT(EPOCH FROM now() - updated_at) FROM rate_limit_bucket WHERE bucket_key = $1 FOR UPDATE`
+
+	updateBucketQuery = `
+UPDATE rate_limit_bucket SET tokens = $2, updated_at = now() WHERE bucket_key = $1`
+
+	deleteExpiredBucketsQuery = `
+DELETE FROM rate_limit_bucket WHERE updated_at < now() - make_interval(secs => $1)`
+)
+
+func (s *postgresStore) take(ctx context.Context, requests []bucketRequest) (int, time.Duration, error) {
+	s.expire(ctx)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	// Rollback is a no-op after a successful commit.
+	defer func() { _ = tx.Rollback() }()
+
+	// Buckets are locked in the order of their keys so that concurrent requests for the same buckets don't deadlock.
+	order := make([]int, len(requests))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return requests[order[i]].key < requests[order[j]].key })
+
+	denied, retryAfter := -1, time.Duration(0)
+	tokens := make([]float64, len(requests))
+	for _, i := range order {
+		r := requests[i]
+		if _, err := tx.ExecContext(ctx, insertBucketQuery, r.key, r.bucket.burst); err != nil {
+			return 0, 0, err
+		}
+
+		var current, elapsed float64
+		if err := tx.QueryRowContext(ctx, selectBucketQuery, r.key).Scan(&current, &elapsed); err != nil {
+			return 0, 0, err
+		}
+
+		var wait time.Duration
+		var allowed bool
+		tokens[i], wait, allowed = r.bucket.take(current, time.Duration(elapsed*float64(time.Second)))
+		if !allowed && (denied == -1 || wait > retryAfter) {
+			denied, retryAfter = i, wait
+		}
+	}
+	if denied != -1 {
+		// Nothing is taken, so the buckets are left as they were.
+		return denied, retryAfter, nil
+	}
+
+	for _, i := range order {
+		if _, err := tx.ExecContext(ctx, updateBucketQuery, requests[i].key, tokens[i]); err != nil {
+			return 0, 0, err
+		}
+	}
+	return -1, 0, tx.Commit()
+}
+
+// expire deletes the buckets that have filled up, at most once per interval from each replica. Failures are logged,
+// since the buckets will be deleted the next time.
+func (s *postgresStore) expire(ctx context.Context) {
+	s.mu.Lock()
+	now := time.Now()
+	due := now.Sub(s.lastExpired) >= expireInterval
+	if due {
+		s.lastExpired = now
+	}
+	s.mu.Unlock()
+	if !due {
+		return
+	}
+
+	if _, err := s.db.ExecContext(ctx, deleteExpiredBucketsQuery, s.expireAfter.Seconds()); err != nil {
+		s.logger.Warn("failed to delete expired rate limit buckets", zap.Error(err))
+	}
+}
@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/lyft/clutch/backend/mock/service/dbmock"
+)
+
+func TestPostgresStore(t *testing.T) {
+	m := dbmock.NewMockDB()
+	s := &postgresStore{db: m.DB(), logger: zaptest.NewLogger(t), expireAfter: time.Minute, lastExpired: time.Now()}
+	b := &bucket{rate: 1, burst: 10}
+
+	expectBucket := func(key string, tokens, elapsed float64) {
+		m.Mock.ExpectExec(regexp.QuoteMeta(insertBucketQuery)).WithArgs(key, 10.0).WillReturnResult(sqlmock.NewResult(0, 0))
+		m.Mock.ExpectQuery(regexp.QuoteMeta(selectBucketQuery)).WithArgs(key).
+			WillReturnRows(sqlmock.NewRows([]string{"tokens", "elapsed"}).AddRow(tokens, elapsed))
+	}
+
+	// Buckets are locked in the order of their keys, and none are updated if any are empty.
+	m.Mock.ExpectBegin()
+	expectBucket("bar", 5, 0)
+	expectBucket("foo", 0.5, 0.25)
+	m.Mock.ExpectRollback()
+
+	denied, retryAfter, err := s.take(context.Background(), []bucketRequest{{key: "foo", bucket: b}, {key: "bar", bucket: b}})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, denied)
+	assert.Equal(t, 250*time.Millisecond, retryAfter)
+	m.MustMeetExpectations()
+
+	m.Mock.ExpectBegin()
+	expectBucket("bar", 5, 0)
+	expectBucket("foo", 0.5, 0.75)
+	m.Mock.ExpectExec(regexp.QuoteMeta(updateBucketQuery)).WithArgs("bar", 4.0).WillReturnResult(sqlmock.NewResult(0, 1))
+	m.Mock.ExpectExec(regexp.QuoteMeta(updateBucketQuery)).WithArgs("foo", 0.25).WillReturnResult(sqlmock.NewResult(0, 1))
+	m.Mock.ExpectCommit()
+
+	denied, _, err = s.take(context.Background(), []bucketRequest{{key: "foo", bucket: b}, {key: "bar", bucket: b}})
+	assert.NoError(t, err)
+	assert.Equal(t, -1, denied)
+	m.MustMeetExpectations()
+
+	m.Mock.ExpectBegin()
+	m.Mock.ExpectExec(regexp.QuoteMeta(insertBucketQuery)).WillReturnError(errors.New("connection refused"))
+	m.Mock.ExpectRollback()
+
+	_, _, err = s.take(context.Background(), []bucketRequest{{key: "foo", bucket: b}})
+	assert.Error(t, err)
+	m.MustMeetExpectations()
+}
+
+func TestPostgresStoreExpire(t *testing.T) {
+	m := dbmock.NewMockDB()
+	s := &postgresStore{db: m.DB(), logger: zaptest.NewLogger(t), expireAfter: time.Minute}
+
+	// Failing to delete expired buckets doesn't fail the request.
+	m.Mock.ExpectExec(regexp.QuoteMeta(deleteExpiredBucketsQuery)).WithArgs(60.0).WillReturnError(errors.New("timeout"))
+	m.Mock.ExpectBegin()
+	m.Mock.ExpectCommit()
+
+	denied, _, err := s.take(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, -1, denied)
+	m.MustMeetExpectations()
+
+	// Expired buckets are only deleted once per interval.
+	m.Mock.ExpectBegin()
+	m.Mock.ExpectCommit()
+	_, _, err = s.take(context.Background(), nil)
+	assert.NoError(t, err)
+	m.MustMeetExpectations()
+}
@@ -0,0 +1,235 @@
+package ratelimit
+
+// <!-- START clutchdoc -->
+// description: Limits the rate of requests per user or group using token buckets. Must be placed after the authn middleware.
+// <!-- END clutchdoc -->
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
+	ratelimitv1 "github.com/lyft/clutch/backend/api/config/middleware/ratelimit/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
+	"github.com/lyft/clutch/backend/middleware"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/authn"
+	"github.com/lyft/clutch/backend/service/db/postgres"
+)
+
+const Name = "clutch.middleware.ratelimit"
+
+func New(cfg *anypb.Any, logger *zap.Logger, scope tally.Scope) (middleware.Middleware, error) {
+	config := &ratelimitv1.Config{}
+	if err := cfg.UnmarshalTo(config); err != nil {
+		return nil, err
+	}
+
+	expireAfter := expireAfter(config)
+	var s store = newMemoryStore(expireAfter)
+	if config.Shared {
+		svc, ok := service.Registry[postgres.Name]
+		if !ok {
+			return nil, errors.New("shared rate limits require the postgres service")
+		}
+		pg, ok := svc.(postgres.Client)
+		if !ok {
+			return nil, errors.New("database service was not the correct type")
+		}
+		s = &postgresStore{db: pg.DB(), logger: logger, expireAfter: expireAfter}
+	}
+
+	return newMiddleware(config, s, logger, scope)
+}
+
+// expireAfter returns the longest time any of the configured buckets takes to fill up, after which unused buckets can
+// be removed.
+func expireAfter(config *ratelimitv1.Config) time.Duration {
+	var d time.Duration
+	for _, l := range config.Limits {
+		for _, b := range []*bucket{newBucket(l.Read), newBucket(l.Mutate)} {
+			if b != nil && b.refillTime() > d {
+				d = b.refillTime()
+			}
+		}
+	}
+	return d
+}
+
+func newMiddleware(config *ratelimitv1.Config, s store, logger *zap.Logger, scope tally.Scope) (*mid, error) {
+	limits := make([]*limit, len(config.Limits))
+	for i, l := range config.Limits {
+		if l.Key == ratelimitv1.Limit_GROUP && !hasGroupPrincipal(l) {
+			return nil, fmt.Errorf("limit '%s' is keyed by group but has no group principals", l.Name)
+		}
+		limits[i] = &limit{
+			Limit:  l,
+			read:   newBucket(l.Read),
+			mutate: newBucket(l.Mutate),
+		}
+	}
+
+	return &mid{
+		limits: limits,
+		store:  s,
+		logger: logger,
+		scope:  scope,
+	}, nil
+}
+
+type mid struct {
+	limits []*limit
+	store  store
+
+	logger *zap.Logger
+	scope  tally.Scope
+}
+
+type limit struct {
+	*ratelimitv1.Limit
+
+	read   *bucket
+	mutate *bucket
+}
+
+func hasGroupPrincipal(l *ratelimitv1.Limit) bool {
+	for _, p := range l.Principals {
+		if p.GetGroup() != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *limit) matchesMethod(fullMethod string) bool {
+	if len(l.Methods) == 0 {
+		return true
+	}
+	for _, m := range l.Methods {
+		if middleware.MatchMethodOrResource(m, fullMethod) {
+			return true
+		}
+	}
+	return false
+}
+
+// key returns the bucket key for the caller, or false if the limit does not apply to them.
+func (l *limit) key(subject string, groups []string) (string, bool) {
+	if l.Key == ratelimitv1.Limit_SUBJECT && len(l.Principals) == 0 {
+		return l.Name + "/user:" + subject, true
+	}
+
+	for _, p := range l.Principals {
+		switch t := p.Type.(type) {
+		case *ratelimitv1.Principal_User:
+			if l.Key == ratelimitv1.Limit_SUBJECT && middleware.MatchMethodOrResource(t.User, subject) {
+				return l.Name + "/user:" + subject, true
+			}
+		case *ratelimitv1.Principal_Group:
+			for _, g := range groups {
+				if !middleware.MatchMethodOrResource(t.Group, g) {
+					continue
+				}
+				if l.Key == ratelimitv1.Limit_GROUP {
+					return l.Name + "/group:" + g, true
+				}
+				return l.Name + "/user:" + subject, true
+			}
+		}
+	}
+	return "", false
+}
+
+func (m *mid) check(ctx context.Context, fullMethod string) error {
+	// Methods that don't require authentication, e.g. probes, aren't limited.
+	for _, allow := range authn.AlwaysAllowedMethods {
+		if middleware.MatchMethodOrResource(allow, fullMethod) {
+			return nil
+		}
+	}
+
+	subject, groups := authn.AnonymousSubject, []string(nil)
+	if claims, err := authn.ClaimsFromContext(ctx); err == nil {
+		subject, groups = claims.Subject, claims.Groups
+	}
+
+	class := "mutate"
+	if meta.GetAction(fullMethod) == apiv1.ActionType_READ {
+		class = "read"
+	}
+
+	var limits []*limit
+	var requests []bucketRequest
+	for _, l := range m.limits {
+		b := l.mutate
+		if class == "read" {
+			b = l.read
+		}
+		if b == nil || !l.matchesMethod(fullMethod) {
+			continue
+		}
+
+		key, ok := l.key(subject, groups)
+		if !ok {
+			continue
+		}
+
+		limits = append(limits, l)
+		requests = append(requests, bucketRequest{key: key + "/" + class, bucket: b})
+	}
+	if len(requests) == 0 {
+		return nil
+	}
+
+	denied, retryAfter, err := m.store.take(ctx, requests)
+	if err != nil {
+		// Fail open, an unavailable store shouldn't take down the API.
+		m.logger.Warn("failed to check rate limits", zap.Error(err))
+		m.scope.Counter("store_error").Inc(1)
+		return nil
+	}
+	if denied != -1 {
+		name := limits[denied].Name
+		m.scope.Tagged(map[string]string{"limit": name}).Counter("limited").Inc(1)
+		return exhaustedError(name, retryAfter)
+	}
+	return nil
+}
+
+// exhaustedError includes RetryInfo so that clients know when to retry.
+func exhaustedError(name string, retryAfter time.Duration) error {
+	s := status.New(codes.ResourceExhausted, fmt.Sprintf("rate limit '%s' exceeded, retry after %s", name, retryAfter.Round(time.Millisecond)))
+	if ds, err := s.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); err == nil {
+		s = ds
+	}
+	return s.Err()
+}
+
+func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := m.check(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := m.check(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
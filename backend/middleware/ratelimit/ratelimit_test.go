@@ -0,0 +1,189 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	ratelimitv1 "github.com/lyft/clutch/backend/api/config/middleware/ratelimit/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
+	"github.com/lyft/clutch/backend/mock/grpcmock"
+	modulemock "github.com/lyft/clutch/backend/mock/module"
+	"github.com/lyft/clutch/backend/module/healthcheck"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/authn"
+)
+
+const (
+	readMethod   = "/clutch.healthcheck.v1.HealthcheckAPI/DeepHealthcheck"
+	mutateMethod = "/clutch.aws.ec2.v1.EC2API/TerminateInstance"
+)
+
+func init() {
+	// Register a module so the action types of its methods are known.
+	server := grpc.NewServer()
+	hc, _ := healthcheck.New(nil, nil, nil)
+	_ = hc.Register(&modulemock.MockRegistrar{Server: server})
+	_ = meta.GenerateGRPCMetadata(server)
+}
+
+func perMinute(n uint32) *ratelimitv1.Bucket {
+	return &ratelimitv1.Bucket{Requests: n, Per: durationpb.New(time.Minute)}
+}
+
+func claimsContext(subject string, groups ...string) context.Context {
+	return authn.ContextWithClaims(context.Background(), &authn.Claims{
+		StandardClaims: &jwt.StandardClaims{Subject: subject},
+		Groups:         groups,
+	})
+}
+
+func newTestMiddleware(t *testing.T, limits ...*ratelimitv1.Limit) *mid {
+	m, err := newMiddleware(&ratelimitv1.Config{Limits: limits}, newMemoryStore(time.Minute), zaptest.NewLogger(t), tally.NewTestScope("", nil))
+	assert.NoError(t, err)
+	return m
+}
+
+func TestNew(t *testing.T) {
+	cfg, _ := anypb.New(&ratelimitv1.Config{})
+	m, err := New(cfg, nil, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, m)
+
+	delete(service.Registry, "clutch.service.db.postgres")
+	cfg, _ = anypb.New(&ratelimitv1.Config{Shared: true})
+	_, err = New(cfg, nil, nil)
+	assert.EqualError(t, err, "shared rate limits require the postgres service")
+
+	_, err = newMiddleware(&ratelimitv1.Config{Limits: []*ratelimitv1.Limit{
+		{Name: "teams", Key: ratelimitv1.Limit_GROUP, Principals: []*ratelimitv1.Principal{
+			{Type: &ratelimitv1.Principal_User{User: "foo"}},
+		}},
+	}}, newMemoryStore(time.Minute), nil, nil)
+	assert.EqualError(t, err, "limit 'teams' is keyed by group but has no group principals")
+}
+
+func TestLimitKey(t *testing.T) {
+	l := &limit{Limit: &ratelimitv1.Limit{Name: "all"}}
+	key, ok := l.key("foo@example.com", nil)
+	assert.True(t, ok)
+	assert.Equal(t, "all/user:foo@example.com", key)
+
+	l = &limit{Limit: &ratelimitv1.Limit{Name: "services", Principals: []*ratelimitv1.Principal{
+		{Type: &ratelimitv1.Principal_User{User: "service:*"}},
+		{Type: &ratelimitv1.Principal_Group{Group: "bots"}},
+	}}}
+	_, ok = l.key("foo@example.com", []string{"eng"})
+	assert.False(t, ok)
+	key, _ = l.key("service:deployer", nil)
+	assert.Equal(t, "services/user:service:deployer", key)
+	key, _ = l.key("bar@example.com", []string{"bots"})
+	assert.Equal(t, "services/user:bar@example.com", key)
+
+	l.Key = ratelimitv1.Limit_GROUP
+	_, ok = l.key("service:deployer", nil)
+	assert.False(t, ok)
+	key, _ = l.key("bar@example.com", []string{"eng", "bots"})
+	assert.Equal(t, "services/group:bots", key)
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	m := newTestMiddleware(t, &ratelimitv1.Limit{
+		Name:   "mutations",
+		Read:   perMinute(2),
+		Mutate: perMinute(1),
+	})
+	interceptor := m.UnaryInterceptor()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	call := func(ctx context.Context, method string) error {
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	foo := claimsContext("foo@example.com")
+	assert.NoError(t, call(foo, mutateMethod))
+	err := call(foo, mutateMethod)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// The retry delay is included in the error.
+	details := status.Convert(err).Details()
+	assert.Len(t, details, 1)
+	assert.InDelta(t, time.Minute.Seconds(), details[0].(*errdetails.RetryInfo).RetryDelay.AsDuration().Seconds(), 1)
+
+	// Reads have a separate quota.
+	assert.NoError(t, call(foo, readMethod))
+	assert.NoError(t, call(foo, readMethod))
+	assert.Error(t, call(foo, readMethod))
+
+	// Other users have their own buckets.
+	assert.NoError(t, call(claimsContext("bar@example.com"), mutateMethod))
+}
+
+func TestMethodsAndGroups(t *testing.T) {
+	m := newTestMiddleware(t, &ratelimitv1.Limit{
+		Name:    "ec2",
+		Methods: []string{"/clutch.aws.ec2.v1.EC2API/*"},
+		Key:     ratelimitv1.Limit_GROUP,
+		Principals: []*ratelimitv1.Principal{
+			{Type: &ratelimitv1.Principal_Group{Group: "oncall"}},
+		},
+		Mutate: perMinute(1),
+	})
+
+	ss := &grpcmock.MockServerStream{Ctx: claimsContext("foo@example.com", "oncall")}
+	handler := func(srv interface{}, ss grpc.ServerStream) error { return nil }
+	interceptor := m.StreamInterceptor()
+	assert.NoError(t, interceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: mutateMethod}, handler))
+
+	// The group shares a bucket.
+	ss.Ctx = claimsContext("bar@example.com", "oncall")
+	assert.Error(t, interceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: mutateMethod}, handler))
+
+	// Methods and callers that don't match are not limited.
+	assert.NoError(t, interceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: "/clutch.k8s.v1.K8sAPI/DeletePod"}, handler))
+	ss.Ctx = claimsContext("baz@example.com", "eng")
+	assert.NoError(t, interceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: mutateMethod}, handler))
+}
+
+func TestCheckAllLimitsBeforeTaking(t *testing.T) {
+	m := newTestMiddleware(t,
+		&ratelimitv1.Limit{Name: "all", Mutate: perMinute(2)},
+		&ratelimitv1.Limit{Name: "ec2", Methods: []string{"/clutch.aws.ec2.v1.EC2API/*"}, Mutate: perMinute(1)},
+	)
+	ctx := claimsContext("foo@example.com")
+
+	assert.NoError(t, m.check(ctx, mutateMethod))
+	err := m.check(ctx, mutateMethod)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "'ec2'")
+
+	// The rejected request didn't use up a token of the other limit.
+	assert.NoError(t, m.check(ctx, "/clutch.k8s.v1.K8sAPI/DeletePod"))
+}
+
+func TestAlwaysAllowedMethodsNotLimited(t *testing.T) {
+	m := newTestMiddleware(t, &ratelimitv1.Limit{Name: "all", Read: perMinute(1)})
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, m.check(context.Background(), "/clutch.healthcheck.v1.HealthcheckAPI/Readiness"))
+	}
+}
+
+func TestExpireAfter(t *testing.T) {
+	assert.Equal(t, time.Duration(0), expireAfter(&ratelimitv1.Config{}))
+	assert.Equal(t, 2*time.Hour, expireAfter(&ratelimitv1.Config{Limits: []*ratelimitv1.Limit{
+		{Name: "a", Read: perMinute(10)},
+		{Name: "b", Mutate: &ratelimitv1.Bucket{Requests: 1, Per: durationpb.New(time.Hour), Burst: 2}},
+	}}))
+}
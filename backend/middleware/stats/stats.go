@@ -32,15 +32,7 @@ type mid struct {
 
 func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		service, method, ok := middleware.SplitFullMethod(info.FullMethod)
-		if !ok {
-			m.logger.Warn("could not parse gRPC method", zap.String("fullMethod", info.FullMethod))
-		}
-
-		grpcScope := m.scope.Tagged(map[string]string{
-			"grpc_service": service,
-			"grpc_method":  method,
-		})
+		grpcScope := m.methodScope(info.FullMethod)
 
 		t := grpcScope.Timer("rpc_latency").Start()
 		resp, err := handler(ctx, req)
@@ -53,3 +45,32 @@ func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 		return resp, err
 	}
 }
+
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		grpcScope := m.methodScope(info.FullMethod)
+
+		// Latency of a stream is its total duration.
+		t := grpcScope.Timer("rpc_stream_duration").Start()
+		err := handler(srv, ss)
+		t.Stop()
+
+		grpcScope.Tagged(map[string]string{
+			"grpc_status": status.Convert(err).Code().String(),
+		}).Counter("rpc_total").Inc(1)
+
+		return err
+	}
+}
+
+func (m *mid) methodScope(fullMethod string) tally.Scope {
+	service, method, ok := middleware.SplitFullMethod(fullMethod)
+	if !ok {
+		m.logger.Warn("could not parse gRPC method", zap.String("fullMethod", fullMethod))
+	}
+
+	return m.scope.Tagged(map[string]string{
+		"grpc_service": service,
+		"grpc_method":  method,
+	})
+}
@@ -6,7 +6,9 @@ package timeouts
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/uber-go/tally/v4"
@@ -14,6 +16,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
@@ -28,37 +31,64 @@ const (
 )
 
 func New(config *gatewayv1.Timeouts, logger *zap.Logger, scope tally.Scope) (middleware.Middleware, error) {
+	defaultTimeout, overrides := newTimeouts(config)
+	return &mid{
+		logger:         logger,
+		defaultTimeout: defaultTimeout,
+		overrides:      overrides,
+	}, nil
+}
+
+func newTimeouts(config *gatewayv1.Timeouts) (time.Duration, map[string]time.Duration) {
 	if config == nil {
 		config = &gatewayv1.Timeouts{Default: durationpb.New(DefaultTimeout)}
 	}
 
-	defaultTimeout := config.Default.AsDuration()
-
 	overrides := make(map[string]time.Duration, len(config.Overrides))
 	for _, entry := range config.Overrides {
 		overrides[join(entry.Service, entry.Method)] = entry.Timeout.AsDuration()
 	}
-
-	return &mid{
-		logger:         logger,
-		defaultTimeout: defaultTimeout,
-		overrides:      overrides,
-	}, nil
+	return config.Default.AsDuration(), overrides
 }
 
 type mid struct {
-	logger         *zap.Logger
+	logger *zap.Logger
+
+	mu             sync.RWMutex
 	defaultTimeout time.Duration
 	overrides      map[string]time.Duration
 }
 
 func (m *mid) getDuration(service, method string) time.Duration {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
 	if override, ok := m.overrides[join(service, method)]; ok {
 		return override
 	}
 	return m.defaultTimeout
 }
 
+// PrepareReload parses a new timeouts config. Once applied, it is used for requests that start afterwards. A nil config
+// restores the default timeout.
+func (m *mid) PrepareReload(cfg *anypb.Any) (func(), error) {
+	var config *gatewayv1.Timeouts
+	if cfg != nil {
+		config = &gatewayv1.Timeouts{}
+		if err := cfg.UnmarshalTo(config); err != nil {
+			return nil, err
+		}
+	}
+
+	defaultTimeout, overrides := newTimeouts(config)
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.defaultTimeout = defaultTimeout
+		m.overrides = overrides
+	}, nil
+}
+
 type unaryHandlerReturn struct {
 	resp interface{}
 	err  error
@@ -117,6 +147,31 @@ func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// StreamInterceptor applies the deadline to the stream's context. Unlike unary calls the handler is not abandoned on
+// timeout, since it may still be writing to the stream. Long-lived streams should be given an override of 0.
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		service, method, ok := middleware.SplitFullMethod(info.FullMethod)
+		if !ok {
+			m.logger.Warn("could not parse gRPC method", zap.String("fullMethod", info.FullMethod))
+		}
+
+		timeout := m.getDuration(service, method)
+		if timeout == 0 {
+			return handler(srv, ss)
+		}
+
+		ctx, cancel := context.WithTimeout(ss.Context(), timeout)
+		defer cancel()
+
+		err := handler(srv, middleware.ServerStreamWithContext(ctx, ss))
+		if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return status.New(codes.DeadlineExceeded, "timeout exceeded").Err()
+		}
+		return err
+	}
+}
+
 func join(service, method string) string {
 	const pattern = "/%s/%s"
 	return fmt.Sprintf(pattern, service, method)
@@ -14,10 +14,12 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
 	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
+	"github.com/lyft/clutch/backend/mock/grpcmock"
 )
 
 func TestNew(t *testing.T) {
@@ -164,3 +166,58 @@ func TestInfiniteTimeout(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 }
+
+func TestStreamTimeout(t *testing.T) {
+	m, err := New(&gatewayv1.Timeouts{
+		Default: durationpb.New(time.Millisecond),
+		Overrides: []*gatewayv1.Timeouts_Entry{
+			{Service: "zip", Method: "watch", Timeout: durationpb.New(0)},
+		},
+	}, nil, nil)
+	assert.NoError(t, err)
+
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		select {
+		case <-ss.Context().Done():
+			return ss.Context().Err()
+		case <-time.After(boost):
+			return nil
+		}
+	}
+
+	midFn := m.(*mid).StreamInterceptor()
+	err = midFn(nil, &grpcmock.MockServerStream{}, &grpc.StreamServerInfo{FullMethod: "/zip/zoom"}, handler)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	// Streams with an infinite timeout are not given a deadline.
+	err = midFn(nil, &grpcmock.MockServerStream{}, &grpc.StreamServerInfo{FullMethod: "/zip/watch"}, handler)
+	assert.NoError(t, err)
+}
+
+func TestPrepareReload(t *testing.T) {
+	m, err := New(&gatewayv1.Timeouts{Default: durationpb.New(time.Second)}, nil, nil)
+	assert.NoError(t, err)
+	tm := m.(*mid)
+
+	cfg, err := anypb.New(&gatewayv1.Timeouts{
+		Default: durationpb.New(2 * time.Second),
+		Overrides: []*gatewayv1.Timeouts_Entry{
+			{Service: "foo", Method: "bar", Timeout: durationpb.New(time.Minute)},
+		},
+	})
+	assert.NoError(t, err)
+
+	apply, err := tm.PrepareReload(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Second, tm.getDuration("foo", "bar"))
+
+	apply()
+	assert.Equal(t, time.Minute, tm.getDuration("foo", "bar"))
+	assert.Equal(t, 2*time.Second, tm.getDuration("foo", "blue"))
+
+	// Removing the timeouts restores the default.
+	apply, err = tm.PrepareReload(nil)
+	assert.NoError(t, err)
+	apply()
+	assert.Equal(t, DefaultTimeout, tm.getDuration("foo", "bar"))
+}
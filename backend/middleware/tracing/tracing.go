@@ -0,0 +1,93 @@
+package tracing
+
+// <!-- START clutchdoc -->
+// description: Starts a span for each request, continuing the caller's trace if one was propagated.
+// <!-- END clutchdoc -->
+
+import (
+	"context"
+
+	"github.com/uber-go/tally/v4"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
+	"github.com/lyft/clutch/backend/gateway/tracing"
+	"github.com/lyft/clutch/backend/middleware"
+)
+
+const (
+	Name = "clutch.middleware.tracing"
+
+	instrumentationName = "github.com/lyft/clutch/backend/middleware/tracing"
+)
+
+// New returns middleware that traces each RPC. The user is added to the span by the authn middleware once the caller
+// is authenticated.
+func New(config *gatewayv1.Tracing, logger *zap.Logger, scope tally.Scope) (middleware.Middleware, error) {
+	return &mid{logger: logger}, nil
+}
+
+type mid struct {
+	logger *zap.Logger
+}
+
+func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := m.start(ctx, info.FullMethod)
+		defer span.End()
+
+		resp, err := handler(ctx, req)
+		end(span, err)
+		return resp, err
+	}
+}
+
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := m.start(ss.Context(), info.FullMethod)
+		defer span.End()
+
+		err := handler(srv, middleware.ServerStreamWithContext(ctx, ss))
+		end(span, err)
+		return err
+	}
+}
+
+func (m *mid) start(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, tracing.MetadataCarrier(md))
+
+	service, method, ok := middleware.SplitFullMethod(fullMethod)
+	if !ok {
+		m.logger.Warn("could not parse gRPC method", zap.String("fullMethod", fullMethod))
+	}
+
+	return otel.Tracer(instrumentationName).Start(ctx, service+"/"+method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.RPCSystemGRPC,
+			semconv.RPCService(service),
+			semconv.RPCMethod(method),
+		),
+	)
+}
+
+func end(span trace.Span, err error) {
+	s := status.Convert(err)
+	span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(s.Code())))
+	if err != nil {
+		span.SetStatus(otelcodes.Error, s.Message())
+	}
+}
+
+// SetUser records the authenticated user on the request's span.
+func SetUser(ctx context.Context, user string) {
+	trace.SpanFromContext(ctx).SetAttributes(semconv.EnduserID(user))
+}
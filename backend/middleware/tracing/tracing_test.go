@@ -0,0 +1,72 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
+	"github.com/lyft/clutch/backend/mock/grpcmock"
+	"github.com/lyft/clutch/backend/mock/tracingmock"
+)
+
+func TestUnaryInterceptor(t *testing.T) {
+	exporter := tracingmock.New()
+	m, err := New(nil, zaptest.NewLogger(t), nil)
+	assert.NoError(t, err)
+
+	// Continue the caller's trace.
+	md := metadata.Pairs("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		SetUser(ctx, "foo@example.com")
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	_, err = m.UnaryInterceptor()(ctx, &healthcheckv1.HealthcheckRequest{}, &grpc.UnaryServerInfo{FullMethod: "/clutch.k8s.v1.K8sAPI/ListPods"}, handler)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "clutch.k8s.v1.K8sAPI/ListPods", span.Name)
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext.TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", span.Parent.SpanID().String())
+	assert.Equal(t, otelcodes.Error, span.Status.Code)
+	assert.Subset(t, span.Attributes, []interface{}{
+		semconv.RPCService("clutch.k8s.v1.K8sAPI"),
+		semconv.RPCMethod("ListPods"),
+		semconv.RPCGRPCStatusCodeKey.Int(int(codes.NotFound)),
+		semconv.EnduserID("foo@example.com"),
+	})
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	exporter := tracingmock.New()
+	m, err := New(nil, zaptest.NewLogger(t), nil)
+	assert.NoError(t, err)
+
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		assert.True(t, trace.SpanContextFromContext(ss.Context()).IsValid())
+		return nil
+	}
+
+	err = m.(*mid).StreamInterceptor()(nil, &grpcmock.MockServerStream{}, &grpc.StreamServerInfo{FullMethod: "/clutch.k8s.v1.K8sAPI/WatchPods"}, handler)
+	assert.NoError(t, err)
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 1)
+	assert.Equal(t, "clutch.k8s.v1.K8sAPI/WatchPods", spans[0].Name)
+	assert.Equal(t, otelcodes.Unset, spans[0].Status.Code)
+}
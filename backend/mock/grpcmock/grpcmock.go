@@ -1,8 +1,12 @@
 package grpcmock
 
 import (
+	"context"
+	"io"
+
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 )
 
 type MockServerTransportStream struct {
@@ -21,3 +25,34 @@ func (m *MockServerTransportStream) SendHeader(md metadata.MD) error {
 func (MockServerTransportStream) SetTrailer(md metadata.MD) error { return nil }
 
 var _ grpc.ServerTransportStream = &MockServerTransportStream{}
+
+// MockServerStream returns the Requests in order from RecvMsg, followed by io.EOF, and records sent messages.
+type MockServerStream struct {
+	Ctx      context.Context
+	Requests []proto.Message
+	Sent     []interface{}
+}
+
+func (m *MockServerStream) Context() context.Context {
+	if m.Ctx == nil {
+		return context.Background()
+	}
+	return m.Ctx
+}
+func (m *MockServerStream) RecvMsg(msg interface{}) error {
+	if len(m.Requests) == 0 {
+		return io.EOF
+	}
+	proto.Merge(msg.(proto.Message), m.Requests[0])
+	m.Requests = m.Requests[1:]
+	return nil
+}
+func (m *MockServerStream) SendMsg(msg interface{}) error {
+	m.Sent = append(m.Sent, msg)
+	return nil
+}
+func (MockServerStream) SetHeader(md metadata.MD) error  { return nil }
+func (MockServerStream) SendHeader(md metadata.MD) error { return nil }
+func (MockServerStream) SetTrailer(md metadata.MD)       {}
+
+var _ grpc.ServerStream = &MockServerStream{}
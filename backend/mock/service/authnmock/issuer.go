@@ -8,23 +8,25 @@ import (
 	"golang.org/x/oauth2"
 
 	authnv1 "github.com/lyft/clutch/backend/api/authn/v1"
+	"github.com/lyft/clutch/backend/service/authn"
 )
 
 type MockIssuer struct {
 	AllowRefresh bool
 }
 
-func (MockIssuer) CreateToken(ctx context.Context, subject string, tokenType authnv1.CreateTokenRequest_TokenType, expiry *time.Duration) (token *oauth2.Token, err error) {
+func (MockIssuer) CreateToken(ctx context.Context, subject string, tokenType authnv1.CreateTokenRequest_TokenType, expiry *time.Duration, opts ...authn.TokenOption) (token *oauth2.Token, err error) {
+	tokenID := map[string]interface{}{"token_id": authnv1.CreateTokenRequest_TokenType_name[int32(tokenType)] + "_" + subject}
 	if expiry == nil {
-		return &oauth2.Token{
+		return (&oauth2.Token{
 			AccessToken: authnv1.CreateTokenRequest_TokenType_name[int32(tokenType)] + "_" + subject + "_token-without-expiry",
-		}, nil
+		}).WithExtra(tokenID), nil
 	}
 
-	return &oauth2.Token{
+	return (&oauth2.Token{
 		AccessToken: authnv1.CreateTokenRequest_TokenType_name[int32(tokenType)] + "_" + subject + "_token-with-expiry",
 		Expiry:      time.Now().Add(*expiry),
-	}, nil
+	}).WithExtra(tokenID), nil
 }
 
 func (m MockIssuer) RefreshToken(context.Context, *oauth2.Token) (*oauth2.Token, error) {
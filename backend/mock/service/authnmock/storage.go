@@ -1,42 +1,16 @@
 package authnmock
 
-import (
-	"context"
-	"fmt"
+import "github.com/lyft/clutch/backend/internal/test/authntest"
 
-	"golang.org/x/oauth2"
-)
+type MockAuthnStorage = authntest.MockAuthnStorage
 
-type MockAuthnStorage struct {
-	// mapping from provider -> user -> token
-	Tokens map[string]map[string]*oauth2.Token
-}
-
-func (m MockAuthnStorage) Store(ctx context.Context, userID, provider string, token *oauth2.Token) error {
-	if _, ok := m.Tokens[provider]; !ok {
-		m.Tokens[provider] = make(map[string]*oauth2.Token)
-	}
-
-	m.Tokens[provider][userID] = token
-
-	return nil
+func NewMockStorage() *MockAuthnStorage {
+	return authntest.NewMockStorage()
 }
 
-func (m MockAuthnStorage) Read(ctx context.Context, userID, provider string) (*oauth2.Token, error) {
-	if _, ok := m.Tokens[provider]; !ok {
-		return nil, fmt.Errorf("token provider '%s' not found for user '%s'", provider, userID)
-	}
+// MockServiceTokenStorage additionally stores service token metadata.
+type MockServiceTokenStorage = authntest.MockServiceTokenStorage
 
-	token, ok := m.Tokens[provider][userID]
-	if !ok {
-		return nil, fmt.Errorf("token user '%s' not found for provider '%s'", userID, provider)
-	}
-
-	return token, nil
-}
-
-func NewMockStorage() *MockAuthnStorage {
-	return &MockAuthnStorage{
-		Tokens: map[string]map[string]*oauth2.Token{},
-	}
+func NewMockServiceTokenStorage() *MockServiceTokenStorage {
+	return authntest.NewMockServiceTokenStorage()
 }
@@ -32,6 +32,13 @@ func (m *mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		resp = serverInfoResponse
 	case "/stats":
 		resp = statsResponse
+		if req.URL.Query().Get("format") == "json" {
+			resp = statsJSONResponse
+		}
+	case "/stats/prometheus":
+		resp = statsPrometheusResponse
+	case "/runtime_modify", "/healthcheck/fail", "/healthcheck/ok", "/drain_listeners", "/reset_counters":
+		resp = "OK\n"
 	default:
 		return nil, fmt.Errorf("path '%s' was not implemented in mock transport", req.URL.Path)
 	}
@@ -47,5 +54,5 @@ func (m *mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 func NewAsService(*anypb.Any, *zap.Logger, tally.Scope) (service.Service, error) {
 	httpClient := &http.Client{Transport: &mockTransport{}}
 	a, _ := anypb.New(&envoyadminv1.Config{Secure: false, DefaultRemotePort: 9999})
-	return envoyadmin.NewWithHTTPClient(a, nil, nil, httpClient)
+	return envoyadmin.NewWithHTTPClient(a, zap.NewNop(), tally.NoopScope, httpClient)
 }
@@ -188,3 +188,49 @@ server.memory_allocated: 9981232
 cluster.local_service.upstream_cx_connect_ms: No recorded values
 server.initialization_time_ms: No recorded values
 `
+
+const statsJSONResponse = `{
+ "stats": [
+  {"name": "cluster_manager.cluster_updated", "value": 0},
+  {"name": "http.admin.downstream_cx_active", "value": 1},
+  {"name": "runtime.num_keys", "value": 362},
+  {
+   "histograms": {
+    "supported_quantiles": [0, 25, 50, 75, 90, 95, 99, 99.5, 99.9, 100],
+    "computed_quantiles": [
+     {
+      "name": "http.admin.downstream_rq_time",
+      "values": [
+       {"interval": null, "cumulative": 0},
+       {"interval": null, "cumulative": 0},
+       {"interval": null, "cumulative": 1.05},
+       {"interval": null, "cumulative": 2.05},
+       {"interval": null, "cumulative": 3.06},
+       {"interval": null, "cumulative": 3.08},
+       {"interval": null, "cumulative": 3.09},
+       {"interval": null, "cumulative": 3.09},
+       {"interval": null, "cumulative": 3.09},
+       {"interval": null, "cumulative": 3.1}
+      ]
+     }
+    ]
+   }
+  }
+ ]
+}
+`
+
+const statsPrometheusResponse = `# TYPE envoy_cluster_manager_cluster_updated counter
+envoy_cluster_manager_cluster_updated{} 0
+# TYPE envoy_http_downstream_cx_active gauge
+envoy_http_downstream_cx_active{envoy_http_conn_manager_prefix="admin"} 1
+# TYPE envoy_runtime_num_keys gauge
+envoy_runtime_num_keys{} 362
+# TYPE envoy_http_downstream_rq_time histogram
+envoy_http_downstream_rq_time_bucket{envoy_http_conn_manager_prefix="admin",le="0.5"} 0
+envoy_http_downstream_rq_time_bucket{envoy_http_conn_manager_prefix="admin",le="1"} 1
+envoy_http_downstream_rq_time_bucket{envoy_http_conn_manager_prefix="admin",le="5"} 4
+envoy_http_downstream_rq_time_bucket{envoy_http_conn_manager_prefix="admin",le="+Inf"} 4
+envoy_http_downstream_rq_time_sum{envoy_http_conn_manager_prefix="admin"} 8.5
+envoy_http_downstream_rq_time_count{envoy_http_conn_manager_prefix="admin"} 4
+`
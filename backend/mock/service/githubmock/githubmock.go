@@ -81,6 +81,11 @@ func (s svc) GetOrgMembership(ctx context.Context, user, org string) (*githubv3.
 	return &githubv3.Membership{Role: &role}, nil
 }
 
+func (s svc) GetTeamMembership(ctx context.Context, org, team, user string) (*githubv3.Membership, error) {
+	state := "active"
+	return &githubv3.Membership{State: &state}, nil
+}
+
 func (s svc) GetUser(ctx context.Context, username string) (*githubv3.User, error) {
 	login := "user"
 	avatarURL := "https://clutch.sh/img/microsite/logo.svg"
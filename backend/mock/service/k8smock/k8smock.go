@@ -3,11 +3,15 @@ package k8smock
 import (
 	"context"
 	"math/rand"
+	"strings"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	k8sv1 "github.com/lyft/clutch/backend/api/k8s/v1"
 	"github.com/lyft/clutch/backend/service"
@@ -25,6 +29,14 @@ func (s *svc) GetPodLogs(ctx context.Context, clientset, cluster, namespace, nam
 	}}, nil
 }
 
+func (s *svc) ExecPod(_ context.Context, clientset, cluster, namespace, name, container string, command []string) (*k8sv1.ExecPodResponse, error) {
+	return &k8sv1.ExecPodResponse{Stdout: strings.Join(command, " ") + "\n"}, nil
+}
+
+func (s *svc) CreateDebugContainer(_ context.Context, clientset, cluster, namespace, name, image, targetContainer string, command []string) (string, error) {
+	return "debugger-abcde", nil
+}
+
 func (s *svc) DescribeHPA(ctx context.Context, clientset, cluster, namespace, name string) (*k8sv1.HPA, error) {
 	hpa := &k8sv1.HPA{
 		Cluster:   "fake-cluster-name",
@@ -129,6 +141,10 @@ func (*svc) UpdateDeployment(ctx context.Context, clientset, cluster, namespace,
 	return nil
 }
 
+func (*svc) PatchDeployment(ctx context.Context, clientset, cluster, namespace, name string, patch *k8sv1.ResourcePatch) (string, error) {
+	return "-  replicas: 1\n+  replicas: 2\n", nil
+}
+
 func (*svc) DeleteDeployment(ctx context.Context, clientset, cluster, namespace, name string) error {
 	return nil
 }
@@ -167,6 +183,10 @@ func (*svc) UpdateStatefulSet(ctx context.Context, clientset, cluster, namespace
 	return nil
 }
 
+func (*svc) PatchStatefulSet(ctx context.Context, clientset, cluster, namespace, name string, patch *k8sv1.ResourcePatch) (string, error) {
+	return "-  replicas: 1\n+  replicas: 2\n", nil
+}
+
 func (*svc) DeleteStatefulSet(ctx context.Context, clientset, cluster, namespace, name string) error {
 	return nil
 }
@@ -247,6 +267,26 @@ func (*svc) DeleteCronJob(ctx context.Context, clientset, cluster, namespace, na
 	return nil
 }
 
+func (s *svc) SetCronJobSuspended(_ context.Context, clientset, cluster, namespace, name string, suspended bool) (*k8sv1.CronJob, error) {
+	return &k8sv1.CronJob{
+		Cluster:   "fake-cluster-name",
+		Namespace: namespace,
+		Name:      name,
+		Schedule:  "0 0 1 1 *",
+		Suspend:   suspended,
+	}, nil
+}
+
+func (s *svc) TriggerCronJob(_ context.Context, clientset, cluster, namespace, name string) (*k8sv1.Job, error) {
+	return &k8sv1.Job{
+		Cluster:     "fake-cluster-name",
+		Namespace:   namespace,
+		Name:        name + "-manual-abcde",
+		Annotations: map[string]string{"cronjob.kubernetes.io/instantiate": "manual"},
+		CronJobName: name,
+	}, nil
+}
+
 func (s *svc) DescribeConfigMap(_ context.Context, clientset, cluster, namespace, name string) (*k8sv1.ConfigMap, error) {
 	return &k8sv1.ConfigMap{
 		Cluster:     "fake-cluster-name",
@@ -295,6 +335,89 @@ func (s *svc) ListConfigMaps(_ context.Context, clientset, cluster, namespace st
 	return configMaps, nil
 }
 
+func (s *svc) CreateConfigMap(_ context.Context, clientset, cluster, namespace, name string, labels, annotations, data map[string]string) (*k8sv1.ConfigMap, error) {
+	return &k8sv1.ConfigMap{
+		Cluster:     "fake-cluster-name",
+		Namespace:   namespace,
+		Name:        name,
+		Labels:      labels,
+		Annotations: annotations,
+		Data:        data,
+	}, nil
+}
+
+func (s *svc) UpdateConfigMap(_ context.Context, clientset, cluster, namespace, name string, data map[string]string, removeKeys []string, restartDependentDeployments bool, authorizeRestart func(deployments []string) error) (*k8sv1.ConfigMap, []string, error) {
+	var restarted []string
+	if restartDependentDeployments {
+		restarted = []string{"deployment1"}
+		if authorizeRestart != nil {
+			if err := authorizeRestart(restarted); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	return &k8sv1.ConfigMap{
+		Cluster:   "fake-cluster-name",
+		Namespace: namespace,
+		Name:      name,
+		Data:      data,
+	}, restarted, nil
+}
+
+func (s *svc) ListSecrets(_ context.Context, clientset, cluster, namespace string, listOptions *k8sv1.ListOptions) ([]*k8sv1.Secret, error) {
+	secrets := []*k8sv1.Secret{
+		{
+			Cluster:   "fake-cluster-name",
+			Namespace: namespace,
+			Name:      "secret1",
+			Labels:    listOptions.Labels,
+			Type:      "Opaque",
+			Keys:      []*k8sv1.Secret_Key{{Name: "password", SizeBytes: 8, HmacSha256: "56feb7851d51c82a0904b7100d044d09b28710f627dc97ed9418c6bb833960e2"}},
+		},
+		{
+			Cluster:   "fake-cluster-name",
+			Namespace: namespace,
+			Name:      "secret2",
+			Labels:    listOptions.Labels,
+			Type:      "kubernetes.io/tls",
+			Keys:      []*k8sv1.Secret_Key{{Name: "tls.crt", SizeBytes: 1024}, {Name: "tls.key", SizeBytes: 1024}},
+		},
+	}
+	return secrets, nil
+}
+
+func (s *svc) DescribeSecret(_ context.Context, clientset, cluster, namespace, name string) (*k8sv1.Secret, error) {
+	return &k8sv1.Secret{
+		Cluster:   "fake-cluster-name",
+		Namespace: namespace,
+		Name:      name,
+		Type:      "Opaque",
+		Keys:      []*k8sv1.Secret_Key{{Name: "password", SizeBytes: 8, HmacSha256: "56feb7851d51c82a0904b7100d044d09b28710f627dc97ed9418c6bb833960e2"}},
+	}, nil
+}
+
+func (s *svc) UpdateSecret(_ context.Context, clientset, cluster, namespace, name string, data map[string][]byte, removeKeys []string, restartDependentDeployments bool, authorizeRestart func(deployments []string) error) (*k8sv1.Secret, []string, error) {
+	secret := &k8sv1.Secret{
+		Cluster:   "fake-cluster-name",
+		Namespace: namespace,
+		Name:      name,
+		Type:      "Opaque",
+	}
+	for key, value := range data {
+		secret.Keys = append(secret.Keys, &k8sv1.Secret_Key{Name: key, SizeBytes: uint64(len(value))})
+	}
+	var restarted []string
+	if restartDependentDeployments {
+		restarted = []string{"deployment1"}
+		if authorizeRestart != nil {
+			if err := authorizeRestart(restarted); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	return secret, restarted, nil
+}
+
 func (s *svc) DescribeJob(_ context.Context, clientset, cluster, namespace, name string) (*k8sv1.Job, error) {
 	return &k8sv1.Job{
 		Cluster:     "fake-cluster-name",
@@ -370,6 +493,130 @@ func (s *svc) UpdateNode(_ context.Context, clientset, cluster, name string, uns
 	return nil
 }
 
+// mockWatch sends the object as an existing resource followed by a bookmark, then ends the watch.
+func (s *svc) ScaleWorkload(_ context.Context, clientset, cluster, namespace, name string, kind k8sv1.WorkloadKind, replicas uint32) (*k8sv1.Workload, error) {
+	return &k8sv1.Workload{
+		Kind:      kind,
+		Cluster:   "fake-cluster-name",
+		Namespace: namespace,
+		Name:      name,
+		Replicas:  replicas,
+	}, nil
+}
+
+func (s *svc) SetContainerImage(_ context.Context, clientset, cluster, namespace, name string, kind k8sv1.WorkloadKind, containerName, image string) (*k8sv1.Workload, error) {
+	return &k8sv1.Workload{
+		Kind:      kind,
+		Cluster:   "fake-cluster-name",
+		Namespace: namespace,
+		Name:      name,
+		Replicas:  2,
+	}, nil
+}
+
+func (s *svc) WaitForRollout(_ context.Context, clientset, cluster, namespace, name string, kind k8sv1.WorkloadKind, handler func(*k8sv1.Workload) error) error {
+	workload := &k8sv1.Workload{
+		Kind:      kind,
+		Cluster:   "fake-cluster-name",
+		Namespace: namespace,
+		Name:      name,
+		Replicas:  2,
+	}
+	for i := uint32(1); i <= workload.Replicas; i++ {
+		workload = proto.Clone(workload).(*k8sv1.Workload)
+		workload.UpdatedReplicas = i
+		workload.ReadyReplicas = i
+		workload.AvailableReplicas = i
+		workload.RolloutComplete = i == workload.Replicas
+		if err := handler(workload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *svc) ListAPIResources(_ context.Context, clientset, cluster string) ([]*k8sv1.APIResource, error) {
+	return []*k8sv1.APIResource{
+		{
+			Group:      "example.com",
+			Version:    "v1",
+			Kind:       "Widget",
+			Resource:   "widgets",
+			Namespaced: true,
+			Verbs:      []string{"get", "list", "patch", "delete"},
+		},
+	}, nil
+}
+
+func (s *svc) DescribeResource(_ context.Context, clientset, cluster, namespace string, gvk schema.GroupVersionKind, name string) (*k8sv1.Resource, error) {
+	object, _ := structpb.NewStruct(map[string]interface{}{
+		"apiVersion": gvk.GroupVersion().String(),
+		"kind":       gvk.Kind,
+		"metadata":   map[string]interface{}{"name": name, "namespace": namespace},
+		"spec":       map[string]interface{}{"size": 3},
+	})
+	return &k8sv1.Resource{
+		Cluster:   "fake-cluster-name",
+		Namespace: namespace,
+		Name:      name,
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Object:    object,
+	}, nil
+}
+
+func (s *svc) ListResources(ctx context.Context, clientset, cluster, namespace string, gvk schema.GroupVersionKind, listOptions *k8sv1.ListOptions) ([]*k8sv1.Resource, error) {
+	resource, _ := s.DescribeResource(ctx, clientset, cluster, namespace, gvk, "resource1")
+	return []*k8sv1.Resource{resource}, nil
+}
+
+func (s *svc) PatchResource(ctx context.Context, clientset, cluster, namespace string, gvk schema.GroupVersionKind, name string, patchType k8sv1.PatchResourceRequest_PatchType, patch *structpb.Value, dryRun, force bool) (*k8sv1.PatchResourceResponse, error) {
+	resource, _ := s.DescribeResource(ctx, clientset, cluster, namespace, gvk, name)
+	return &k8sv1.PatchResourceResponse{
+		Resource: resource,
+		Diff:     "--- original\n+++ patched\n@@ -6,2 +6,2 @@\n spec:\n-  size: 1\n+  size: 3\n",
+	}, nil
+}
+
+func (s *svc) DeleteResource(_ context.Context, clientset, cluster, namespace string, gvk schema.GroupVersionKind, name string) error {
+	return nil
+}
+
+func mockWatch[T interface{}](clientset string, object T, handler func(*k8sv1.WatchEvent, T) error) error {
+	event := &k8sv1.WatchEvent{Type: k8sv1.WatchEvent_ADDED, Clientset: clientset, Cluster: "fake-cluster-name", ResourceVersion: "1"}
+	if err := handler(event, object); err != nil {
+		return err
+	}
+	var empty T
+	return handler(&k8sv1.WatchEvent{Type: k8sv1.WatchEvent_BOOKMARK, Clientset: clientset, Cluster: "fake-cluster-name", ResourceVersion: "2"}, empty)
+}
+
+func (s *svc) WatchPods(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sv1.ListOptions, resourceVersions map[string]string, handler func(*k8sv1.WatchEvent, *k8sv1.Pod) error) error {
+	pod, _ := s.DescribePod(ctx, clientset, cluster, namespace, "pod1")
+	return mockWatch(clientset, pod, handler)
+}
+
+func (s *svc) WatchDeployments(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sv1.ListOptions, resourceVersions map[string]string, handler func(*k8sv1.WatchEvent, *k8sv1.Deployment) error) error {
+	deployment, _ := s.DescribeDeployment(ctx, clientset, cluster, namespace, "deployment1")
+	return mockWatch(clientset, deployment, handler)
+}
+
+func (s *svc) WatchHPAs(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sv1.ListOptions, resourceVersions map[string]string, handler func(*k8sv1.WatchEvent, *k8sv1.HPA) error) error {
+	hpa, _ := s.DescribeHPA(ctx, clientset, cluster, namespace, "hpa1")
+	return mockWatch(clientset, hpa, handler)
+}
+
+func (s *svc) WatchNodes(ctx context.Context, clientset, cluster string, listOptions *k8sv1.ListOptions, resourceVersions map[string]string, handler func(*k8sv1.WatchEvent, *k8sv1.Node) error) error {
+	node, _ := s.DescribeNode(ctx, clientset, cluster, "node1")
+	return mockWatch(clientset, node, handler)
+}
+
+func (s *svc) WatchEvents(ctx context.Context, clientset, cluster, namespace, object string, kind k8sv1.ObjectKind, listOptions *k8sv1.ListOptions, resourceVersions map[string]string, handler func(*k8sv1.WatchEvent, *k8sv1.Event) error) error {
+	events, _ := s.ListEvents(ctx, clientset, cluster, namespace, object, kind)
+	return mockWatch(clientset, events[0], handler)
+}
+
 func (*svc) Clientsets(ctx context.Context) ([]string, error) {
 	return []string{"fake-user@fake-cluster"}, nil
 }
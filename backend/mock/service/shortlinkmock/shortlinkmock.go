@@ -2,11 +2,13 @@ package shortlinkmock
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	shortlinkv1 "github.com/lyft/clutch/backend/api/shortlink/v1"
 	"github.com/lyft/clutch/backend/service"
@@ -23,12 +25,29 @@ func NewAsService(*any.Any, *zap.Logger, tally.Scope) (service.Service, error) {
 	return New(), nil
 }
 
-func (s *svc) Create(ctx context.Context, path string, state []*shortlinkv1.ShareableState) (string, error) {
-	return "mockhash", nil
+func mockShortlink() *shortlinkv1.Shortlink {
+	return &shortlinkv1.Shortlink{
+		Hash:      "mockhash",
+		Path:      "/mockpath",
+		CreatedBy: "mockuser@example.com",
+		CreatedAt: timestamppb.Now(),
+	}
 }
 
-func (s *svc) Get(ctx context.Context, hash string) (string, []*shortlinkv1.ShareableState, error) {
-	return "/mockpath", []*shortlinkv1.ShareableState{
+func (s *svc) Create(ctx context.Context, path string, state []*shortlinkv1.ShareableState, ttl time.Duration) (*shortlinkv1.Shortlink, error) {
+	ret := mockShortlink()
+	ret.Path = path
+	if ttl > 0 {
+		ret.ExpiresAt = timestamppb.New(time.Now().Add(ttl))
+	}
+	return ret, nil
+}
+
+func (s *svc) Get(ctx context.Context, hash string) (*shortlinkv1.Shortlink, []*shortlinkv1.ShareableState, error) {
+	ret := mockShortlink()
+	ret.AccessCount = 1
+	ret.LastAccessedAt = timestamppb.Now()
+	return ret, []*shortlinkv1.ShareableState{
 		{
 			Key: "mock",
 			State: &structpb.Value{
@@ -37,3 +56,17 @@ func (s *svc) Get(ctx context.Context, hash string) (string, []*shortlinkv1.Shar
 		},
 	}, nil
 }
+
+func (s *svc) List(ctx context.Context, owner string, pageToken string, limit uint64) ([]*shortlinkv1.Shortlink, string, error) {
+	return []*shortlinkv1.Shortlink{mockShortlink()}, "", nil
+}
+
+func (s *svc) Delete(ctx context.Context, hash string) error {
+	return nil
+}
+
+func (s *svc) Extend(ctx context.Context, hash string, ttl time.Duration) (*shortlinkv1.Shortlink, error) {
+	ret := mockShortlink()
+	ret.ExpiresAt = timestamppb.New(time.Now().Add(ttl))
+	return ret, nil
+}
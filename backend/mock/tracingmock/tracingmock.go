@@ -0,0 +1,18 @@
+package tracingmock
+
+import (
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/lyft/clutch/backend/gateway/tracing"
+)
+
+// New installs a global tracer provider that samples every span and records it in memory.
+func New() *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	tracing.Install(sdktrace.NewTracerProvider(
+		sdktrace.WithSyncer(exporter),
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+	))
+	return exporter
+}
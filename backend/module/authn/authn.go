@@ -1,7 +1,7 @@
 package authn
 
 // <!-- START clutchdoc -->
-// description: Registers login and callback endpoints for OAuth 2 flows.
+// description: Registers login and callback endpoints for OAuth 2 flows, and the login form for local users.
 // <!-- END clutchdoc -->
 
 import (
@@ -15,7 +15,9 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	authnv1 "github.com/lyft/clutch/backend/api/authn/v1"
 	"github.com/lyft/clutch/backend/gateway/log"
@@ -38,23 +40,40 @@ func New(cfg *any.Any, log *zap.Logger, scope tally.Scope) (module.Module, error
 		return nil, errors.New("authn service was not the correct type")
 	}
 
-	return &mod{
-		authnv1: &api{provider: p, issuer: p, logger: log},
-	}, nil
+	// Token management is only available when the service stores token metadata.
+	tokens, _ := svc.(authn.TokenManager)
+
+	ret := &mod{
+		authnv1: &api{provider: p, issuer: p, tokens: tokens, logger: log},
+	}
+
+	// Providers that authenticate users with a password need the login form.
+	if pa, ok := svc.(authn.PasswordAuthenticator); ok {
+		ret.loginForm = &loginFormHandler{provider: p, authenticator: pa, logger: log}
+	}
+	return ret, nil
 }
 
 type mod struct {
-	authnv1 authnv1.AuthnAPIServer
+	authnv1   authnv1.AuthnAPIServer
+	loginForm *loginFormHandler
 }
 
 func (m *mod) Register(r module.Registrar) error {
 	authnv1.RegisterAuthnAPIServer(r.GRPCServer(), m.authnv1)
-	return r.RegisterJSONGateway(authnv1.RegisterAuthnAPIHandler)
+	if err := r.RegisterJSONGateway(authnv1.RegisterAuthnAPIHandler); err != nil {
+		return err
+	}
+	if m.loginForm != nil {
+		return r.RegisterJSONGateway(m.loginForm.register)
+	}
+	return nil
 }
 
 type api struct {
 	provider authn.Provider
 	issuer   authn.Issuer
+	tokens   authn.TokenManager
 	logger   *zap.Logger
 }
 
@@ -169,12 +188,40 @@ func (a *api) CreateToken(ctx context.Context, request *authnv1.CreateTokenReque
 		expiry = &convertedExpiry
 	}
 
-	token, err := a.issuer.CreateToken(ctx, request.Subject, request.TokenType, expiry)
+	token, err := a.issuer.CreateToken(ctx, request.Subject, request.TokenType, expiry,
+		authn.WithTokenDescription(request.Description),
+		authn.WithTokenScopes(request.Scopes...),
+	)
 	if err != nil {
 		return nil, err
 	}
 
+	tokenID, _ := token.Extra("token_id").(string)
 	return &authnv1.CreateTokenResponse{
 		AccessToken: token.AccessToken,
+		TokenId:     tokenID,
 	}, nil
 }
+
+func (a *api) ListTokens(ctx context.Context, request *authnv1.ListTokensRequest) (*authnv1.ListTokensResponse, error) {
+	if a.tokens == nil {
+		return nil, status.Error(codes.Unimplemented, "the authn service does not support token management")
+	}
+
+	tokens, err := a.tokens.ListTokens(ctx, request.IncludeRevoked)
+	if err != nil {
+		return nil, err
+	}
+	return &authnv1.ListTokensResponse{Tokens: tokens}, nil
+}
+
+func (a *api) RevokeToken(ctx context.Context, request *authnv1.RevokeTokenRequest) (*authnv1.RevokeTokenResponse, error) {
+	if a.tokens == nil {
+		return nil, status.Error(codes.Unimplemented, "the authn service does not support token management")
+	}
+
+	if err := a.tokens.RevokeToken(ctx, request.Id); err != nil {
+		return nil, err
+	}
+	return &authnv1.RevokeTokenResponse{}, nil
+}
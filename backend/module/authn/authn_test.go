@@ -11,7 +11,9 @@ import (
 	"go.uber.org/zap/zaptest"
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 
@@ -30,11 +32,19 @@ func TestAllRequestResponseAreRedacted(t *testing.T) {
 		&authnv1.LoginResponse{},
 		&authnv1.CreateTokenRequest{},
 		&authnv1.CreateTokenResponse{},
+		&authnv1.ListTokensRequest{},
+		&authnv1.ListTokensResponse{},
 	}
 
 	for _, o := range obj {
 		assert.True(t, meta.IsRedacted(o))
 	}
+
+	// Revocations are audited with the ID of the token.
+	assert.False(t, meta.IsRedacted(&authnv1.RevokeTokenRequest{}))
+	body, err := meta.APIBody(&authnv1.RevokeTokenRequest{Id: "abc"})
+	assert.NoError(t, err)
+	assert.Contains(t, body.String(), "abc")
 }
 
 func TestAPILogin(t *testing.T) {
@@ -170,6 +180,52 @@ func TestAPICreateToken(t *testing.T) {
 	})
 	assert.NoError(t, err)
 	assert.Equal(t, "SERVICE_name_token-with-expiry", response.AccessToken)
+	assert.Equal(t, "SERVICE_name", response.TokenId)
+}
+
+func TestAPIListAndRevokeTokens(t *testing.T) {
+	api := api{
+		provider: MockProvider{},
+		issuer:   authnmock.MockIssuer{},
+	}
+
+	_, err := api.ListTokens(context.Background(), &authnv1.ListTokensRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	_, err = api.RevokeToken(context.Background(), &authnv1.RevokeTokenRequest{Id: "foo"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	tokens := &MockTokenManager{tokens: []*authnv1.ServiceToken{{Id: "foo"}, {Id: "bar"}}}
+	api.tokens = tokens
+
+	response, err := api.ListTokens(context.Background(), &authnv1.ListTokensRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, response.Tokens, 2)
+
+	_, err = api.RevokeToken(context.Background(), &authnv1.RevokeTokenRequest{Id: "foo"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo"}, tokens.revoked)
+
+	_, err = api.RevokeToken(context.Background(), &authnv1.RevokeTokenRequest{Id: "baz"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+type MockTokenManager struct {
+	tokens  []*authnv1.ServiceToken
+	revoked []string
+}
+
+func (m *MockTokenManager) ListTokens(ctx context.Context, includeRevoked bool) ([]*authnv1.ServiceToken, error) {
+	return m.tokens, nil
+}
+
+func (m *MockTokenManager) RevokeToken(ctx context.Context, id string) error {
+	for _, t := range m.tokens {
+		if t.Id == id {
+			m.revoked = append(m.revoked, id)
+			return nil
+		}
+	}
+	return status.Error(codes.NotFound, "token not found")
 }
 
 // TODO(snowp): Ideally this should be in the authmocks package, but this introduces a circular dep
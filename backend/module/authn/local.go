@@ -0,0 +1,124 @@
+package authn
+
+import (
+	"context"
+	"html/template"
+	"net/http"
+	"net/url"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/lyft/clutch/backend/gateway/log"
+	"github.com/lyft/clutch/backend/service/authn"
+)
+
+const callbackPath = "/v1/authn/callback"
+
+var loginFormTemplate = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>Clutch - Log in</title>
+  <style>
+    body { font-family: sans-serif; background: #f5f6fd; display: flex; justify-content: center; padding-top: 10vh; }
+    form { background: #fff; padding: 32px; border-radius: 8px; box-shadow: 0 4px 12px rgba(0, 0, 0, 0.1); width: 320px; }
+    label, input, button { display: block; width: 100%; box-sizing: border-box; }
+    input { margin: 4px 0 16px; padding: 8px; }
+    button { padding: 8px; }
+    .error { color: #db3615; }
+  </style>
+</head>
+<body>
+  <form method="post" action="{{ .Action }}">
+    <h2>Log in to Clutch</h2>
+    {{ if .Error }}<p class="error">{{ .Error }}</p>{{ end }}
+    <input type="hidden" name="state" value="{{ .State }}">
+    <label for="username">Username</label>
+    <input id="username" name="username" autocomplete="username" value="{{ .Username }}" required autofocus>
+    <label for="password">Password</label>
+    <input id="password" name="password" type="password" autocomplete="current-password" required>
+    <button type="submit">Log in</button>
+  </form>
+</body>
+</html>
+`))
+
+type loginForm struct {
+	Action   string
+	State    string
+	Username string
+	Error    string
+}
+
+// loginFormHandler serves the login form for providers that authenticate users with a password. A successful login
+// redirects to the callback with a code, so the rest of the flow is the same as for external providers.
+type loginFormHandler struct {
+	provider      authn.Provider
+	authenticator authn.PasswordAuthenticator
+	logger        *zap.Logger
+}
+
+func (h *loginFormHandler) register(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	if err := mux.HandlePath(http.MethodGet, authn.LocalLoginPath, h.serveForm); err != nil {
+		return err
+	}
+	return mux.HandlePath(http.MethodPost, authn.LocalLoginPath, h.login)
+}
+
+func (h *loginFormHandler) render(w http.ResponseWriter, code int, form *loginForm) {
+	form.Action = authn.LocalLoginPath
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	if err := loginFormTemplate.Execute(w, form); err != nil {
+		h.logger.Error("failed to render login form", log.ErrorField(err))
+	}
+}
+
+func (h *loginFormHandler) serveForm(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	state := r.URL.Query().Get("state")
+	if _, err := h.provider.ValidateStateNonce(state); err != nil {
+		http.Error(w, "invalid or expired login request, please try logging in again", http.StatusBadRequest)
+		return
+	}
+	h.render(w, http.StatusOK, &loginForm{State: state})
+}
+
+func (h *loginFormHandler) login(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	state := r.PostForm.Get("state")
+	if _, err := h.provider.ValidateStateNonce(state); err != nil {
+		http.Error(w, "invalid or expired login request, please try logging in again", http.StatusBadRequest)
+		return
+	}
+
+	username := r.PostForm.Get("username")
+	clientIP := h.authenticator.ClientIP(r.RemoteAddr, r.Header.Values("X-Forwarded-For"))
+	code, err := h.authenticator.Authenticate(r.Context(), username, r.PostForm.Get("password"), clientIP)
+	if err != nil {
+		form := &loginForm{State: state, Username: username, Error: "Invalid username or password."}
+		httpStatus := http.StatusUnauthorized
+		switch status.Code(err) {
+		case codes.Unauthenticated:
+		case codes.ResourceExhausted:
+			form.Error = "Too many failed login attempts, please try again later."
+			httpStatus = http.StatusTooManyRequests
+		default:
+			h.logger.Error("local login failed", log.ErrorField(err))
+			form.Error = "Login failed, please try again."
+		}
+		h.render(w, httpStatus, form)
+		return
+	}
+
+	http.Redirect(w, r, callbackPath+"?"+url.Values{"code": {code}, "state": {state}}.Encode(), http.StatusFound)
+}
@@ -0,0 +1,77 @@
+package authn
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/lyft/clutch/backend/service/authn"
+)
+
+type mockPasswordAuthenticator struct{}
+
+func (mockPasswordAuthenticator) Authenticate(ctx context.Context, username, password, clientIP string) (string, error) {
+	if clientIP != "192.0.2.1" {
+		return "", status.Error(codes.Internal, "unexpected client IP")
+	}
+	if username == "locked" {
+		return "", status.Error(codes.ResourceExhausted, "too many failed login attempts")
+	}
+	if username != "foo" || password != "hunter2" {
+		return "", status.Error(codes.Unauthenticated, "invalid username or password")
+	}
+	return "code-" + username, nil
+}
+
+func (mockPasswordAuthenticator) ClientIP(remoteAddr string, forwardedFor []string) string {
+	host, _, _ := net.SplitHostPort(remoteAddr)
+	return host
+}
+
+func TestLoginForm(t *testing.T) {
+	h := &loginFormHandler{
+		provider:      MockProvider{},
+		authenticator: mockPasswordAuthenticator{},
+		logger:        zaptest.NewLogger(t),
+	}
+
+	// The form is only served for valid login requests.
+	rec := httptest.NewRecorder()
+	h.serveForm(rec, httptest.NewRequest(http.MethodGet, authn.LocalLoginPath+"?state=bad", nil), nil)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	h.serveForm(rec, httptest.NewRequest(http.MethodGet, authn.LocalLoginPath+"?state=nonce-foo.com", nil), nil)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `name="state" value="nonce-foo.com"`)
+
+	post := func(form url.Values) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, authn.LocalLoginPath, strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		rec := httptest.NewRecorder()
+		h.login(rec, r, nil)
+		return rec
+	}
+
+	rec = post(url.Values{"state": {"nonce-foo.com"}, "username": {"foo"}, "password": {"wrong"}})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Invalid username or password.")
+	assert.Contains(t, rec.Body.String(), `value="foo"`)
+
+	rec = post(url.Values{"state": {"nonce-foo.com"}, "username": {"locked"}, "password": {"hunter2"}})
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Too many failed login attempts")
+
+	rec = post(url.Values{"state": {"nonce-foo.com"}, "username": {"foo"}, "password": {"hunter2"}})
+	assert.Equal(t, http.StatusFound, rec.Code)
+	assert.Equal(t, "/v1/authn/callback?code=code-foo&state=nonce-foo.com", rec.Header().Get("Location"))
+}
@@ -0,0 +1,338 @@
+package envoytriage
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+)
+
+// Fields in the config dump that are expected to differ between hosts and are not useful when diffing.
+var ignoredConfigDumpFields = map[string]bool{
+	"last_updated": true,
+}
+
+func compareResults(target, baseline *envoytriagev1.Result, statPrefixes []string) *envoytriagev1.Comparison {
+	ret := &envoytriagev1.Comparison{
+		Baseline:             baseline.Address,
+		BaselineNodeMetadata: baseline.NodeMetadata,
+	}
+
+	t, b := target.GetOutput(), baseline.GetOutput()
+	if t.GetRuntime() != nil && b.GetRuntime() != nil {
+		ret.Runtime = diffRuntime(t.Runtime, b.Runtime)
+	}
+	if t.GetClusters() != nil && b.GetClusters() != nil {
+		ret.Clusters = diffClusters(t.Clusters, b.Clusters)
+	}
+	if t.GetListeners() != nil && b.GetListeners() != nil {
+		ret.Listeners = diffListeners(t.Listeners, b.Listeners)
+	}
+	if t.GetStats() != nil && b.GetStats() != nil {
+		ret.Stats = diffStats(t.Stats, b.Stats, statPrefixes)
+	}
+	if t.GetConfigDump() != nil && b.GetConfigDump() != nil {
+		ret.ConfigDump = diffConfigDump(t.ConfigDump, b.ConfigDump)
+	}
+	return ret
+}
+
+func sortedKeys[V any](maps ...map[string]V) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, m := range maps {
+		for k := range m {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func runtimeValues(r *envoytriagev1.Runtime) map[string]string {
+	ret := make(map[string]string, len(r.Entries))
+	for _, e := range r.Entries {
+		ret[e.Key] = e.GetValue()
+	}
+	return ret
+}
+
+func diffRuntime(target, baseline *envoytriagev1.Runtime) *envoytriagev1.RuntimeDiff {
+	t, b := runtimeValues(target), runtimeValues(baseline)
+
+	ret := &envoytriagev1.RuntimeDiff{}
+	for _, key := range sortedKeys(t, b) {
+		tv, tok := t[key]
+		bv, bok := b[key]
+		if tok && bok && tv == bv {
+			continue
+		}
+
+		entry := &envoytriagev1.RuntimeDiff_Entry{Key: key}
+		if tok {
+			entry.Target = wrapperspb.String(tv)
+		}
+		if bok {
+			entry.Baseline = wrapperspb.String(bv)
+		}
+		ret.Entries = append(ret.Entries, entry)
+	}
+	return ret
+}
+
+func clusterHosts(cs *envoytriagev1.ClusterStatus) map[string]*envoytriagev1.HostStatus {
+	ret := make(map[string]*envoytriagev1.HostStatus, len(cs.HostStatuses))
+	for _, hs := range cs.HostStatuses {
+		ret[hs.Address] = hs
+	}
+	return ret
+}
+
+func diffClusters(target, baseline *envoytriagev1.Clusters) *envoytriagev1.ClustersDiff {
+	t := make(map[string]*envoytriagev1.ClusterStatus, len(target.ClusterStatuses))
+	for _, cs := range target.ClusterStatuses {
+		t[cs.Name] = cs
+	}
+	b := make(map[string]*envoytriagev1.ClusterStatus, len(baseline.ClusterStatuses))
+	for _, cs := range baseline.ClusterStatuses {
+		b[cs.Name] = cs
+	}
+
+	ret := &envoytriagev1.ClustersDiff{}
+	for _, name := range sortedKeys(t, b) {
+		tc, tok := t[name]
+		bc, bok := b[name]
+		switch {
+		case !bok:
+			ret.TargetOnly = append(ret.TargetOnly, name)
+			continue
+		case !tok:
+			ret.BaselineOnly = append(ret.BaselineOnly, name)
+			continue
+		}
+
+		th, bh := clusterHosts(tc), clusterHosts(bc)
+		cluster := &envoytriagev1.ClustersDiff_Cluster{Name: name}
+		for _, addr := range sortedKeys(th, bh) {
+			ths, tok := th[addr]
+			bhs, bok := bh[addr]
+			switch {
+			case !bok:
+				cluster.TargetOnlyHosts = append(cluster.TargetOnlyHosts, ths)
+			case !tok:
+				cluster.BaselineOnlyHosts = append(cluster.BaselineOnlyHosts, bhs)
+			case ths.Healthy != bhs.Healthy:
+				cluster.HealthMismatches = append(cluster.HealthMismatches, &envoytriagev1.ClustersDiff_HostHealth{
+					Address:         addr,
+					TargetHealthy:   ths.Healthy,
+					BaselineHealthy: bhs.Healthy,
+				})
+			}
+		}
+
+		if len(cluster.TargetOnlyHosts) > 0 || len(cluster.BaselineOnlyHosts) > 0 || len(cluster.HealthMismatches) > 0 {
+			ret.Clusters = append(ret.Clusters, cluster)
+		}
+	}
+	return ret
+}
+
+func diffListeners(target, baseline *envoytriagev1.Listeners) *envoytriagev1.ListenersDiff {
+	t := make(map[string]*envoytriagev1.ListenerStatus, len(target.ListenerStatuses))
+	for _, ls := range target.ListenerStatuses {
+		t[ls.Name] = ls
+	}
+	b := make(map[string]*envoytriagev1.ListenerStatus, len(baseline.ListenerStatuses))
+	for _, ls := range baseline.ListenerStatuses {
+		b[ls.Name] = ls
+	}
+
+	ret := &envoytriagev1.ListenersDiff{}
+	for _, name := range sortedKeys(t, b) {
+		tl, tok := t[name]
+		bl, bok := b[name]
+		switch {
+		case !bok:
+			ret.TargetOnly = append(ret.TargetOnly, tl)
+		case !tok:
+			ret.BaselineOnly = append(ret.BaselineOnly, bl)
+		case tl.LocalAddress != bl.LocalAddress:
+			ret.AddressMismatches = append(ret.AddressMismatches, &envoytriagev1.ListenersDiff_AddressMismatch{
+				Name:                 name,
+				TargetLocalAddress:   tl.LocalAddress,
+				BaselineLocalAddress: bl.LocalAddress,
+			})
+		}
+	}
+	return ret
+}
+
+func statValues(s *envoytriagev1.Stats, prefixes []string) map[string]uint64 {
+	ret := make(map[string]uint64, len(s.Stats))
+	for _, stat := range s.Stats {
+		if hasAnyPrefix(stat.Key, prefixes) {
+			ret[stat.Key] = stat.Value
+		}
+	}
+	return ret
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func diffStats(target, baseline *envoytriagev1.Stats, prefixes []string) *envoytriagev1.StatsDiff {
+	t, b := statValues(target, prefixes), statValues(baseline, prefixes)
+
+	ret := &envoytriagev1.StatsDiff{}
+	for _, key := range sortedKeys(t, b) {
+		tv, tok := t[key]
+		bv, bok := b[key]
+		if tok && bok && tv == bv {
+			continue
+		}
+
+		entry := &envoytriagev1.StatsDiff_Entry{Key: key, Delta: int64(tv) - int64(bv)}
+		if tok {
+			entry.Target = wrapperspb.UInt64(tv)
+		}
+		if bok {
+			entry.Baseline = wrapperspb.UInt64(bv)
+		}
+		ret.Entries = append(ret.Entries, entry)
+	}
+	return ret
+}
+
+// configDumpSections splits a config dump into its sections keyed by type URL.
+func configDumpSections(cd *envoytriagev1.ConfigDump) map[string]interface{} {
+	ret := make(map[string]interface{})
+	root, ok := cd.GetValue().AsInterface().(map[string]interface{})
+	if !ok {
+		return ret
+	}
+	configs, _ := root["configs"].([]interface{})
+	for idx, config := range configs {
+		section, ok := config.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		typeURL, _ := section["@type"].(string)
+		if typeURL == "" {
+			typeURL = fmt.Sprintf("configs[%d]", idx)
+		}
+		ret[typeURL] = section
+	}
+	return ret
+}
+
+func diffConfigDump(target, baseline *envoytriagev1.ConfigDump) []*envoytriagev1.ConfigDumpDiff {
+	t, b := configDumpSections(target), configDumpSections(baseline)
+
+	var ret []*envoytriagev1.ConfigDumpDiff
+	for _, typeURL := range sortedKeys(t, b) {
+		d := &envoytriagev1.ConfigDumpDiff{TypeUrl: typeURL}
+		diffValues("", t[typeURL], b[typeURL], &d.Fields)
+		if len(d.Fields) > 0 {
+			ret = append(ret, d)
+		}
+	}
+	return ret
+}
+
+// elementName returns the name of a list element in the config dump. Resources are either named directly or wrapped
+// in an object carrying metadata, e.g. {"version_info": "1", "cluster": {"name": "foo"}}.
+func elementName(v interface{}) string {
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	if name, ok := obj["name"].(string); ok {
+		return name
+	}
+	for _, child := range obj {
+		if c, ok := child.(map[string]interface{}); ok {
+			if name, ok := c["name"].(string); ok {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// listElements keys the elements of a list by name, falling back to the index if any element is unnamed or names
+// collide.
+func listElements(l []interface{}) map[string]interface{} {
+	ret := make(map[string]interface{}, len(l))
+	for _, v := range l {
+		name := elementName(v)
+		if _, exists := ret[name]; name == "" || exists {
+			ret = make(map[string]interface{}, len(l))
+			for idx, v := range l {
+				ret[fmt.Sprint(idx)] = v
+			}
+			return ret
+		}
+		ret[name] = v
+	}
+	return ret
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func diffValues(path string, target, baseline interface{}, out *[]*envoytriagev1.ConfigDumpDiff_Field) {
+	switch t := target.(type) {
+	case map[string]interface{}:
+		if b, ok := baseline.(map[string]interface{}); ok {
+			for _, key := range sortedKeys(t, b) {
+				if ignoredConfigDumpFields[key] {
+					continue
+				}
+				diffValues(joinPath(path, key), t[key], b[key], out)
+			}
+			return
+		}
+	case []interface{}:
+		if b, ok := baseline.([]interface{}); ok {
+			te, be := listElements(t), listElements(b)
+			for _, key := range sortedKeys(te, be) {
+				diffValues(fmt.Sprintf("%s[%s]", path, key), te[key], be[key], out)
+			}
+			return
+		}
+	}
+
+	if reflect.DeepEqual(target, baseline) {
+		return
+	}
+
+	field := &envoytriagev1.ConfigDumpDiff_Field{Path: path}
+	if target != nil {
+		field.Target, _ = structpb.NewValue(target)
+	}
+	if baseline != nil {
+		field.Baseline, _ = structpb.NewValue(baseline)
+	}
+	*out = append(*out, field)
+}
@@ -0,0 +1,232 @@
+package envoytriage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+)
+
+type fakeClient struct {
+	results map[string]*envoytriagev1.Result
+}
+
+func (f *fakeClient) Get(_ context.Context, op *envoytriagev1.ReadOperation) (*envoytriagev1.Result, error) {
+	r, ok := f.results[op.Address.Host]
+	if !ok {
+		return nil, errors.New("connection refused")
+	}
+	return r, nil
+}
+
+func (f *fakeClient) Update(context.Context, *envoytriagev1.UpdateOperation) (*envoytriagev1.UpdateResult, error) {
+	return nil, errors.New("not implemented")
+}
+
+func runtimeOf(kv ...string) *envoytriagev1.Runtime {
+	ret := &envoytriagev1.Runtime{}
+	for i := 0; i < len(kv); i += 2 {
+		ret.Entries = append(ret.Entries, &envoytriagev1.Runtime_Entry{
+			Key:  kv[i],
+			Type: &envoytriagev1.Runtime_Entry_Value{Value: kv[i+1]},
+		})
+	}
+	return ret
+}
+
+func TestDiffRuntime(t *testing.T) {
+	d := diffRuntime(runtimeOf("a", "1", "b", "2", "c", "3"), runtimeOf("a", "1", "b", "5", "d", "4"))
+	assert.Len(t, d.Entries, 3)
+
+	assert.Equal(t, "b", d.Entries[0].Key)
+	assert.Equal(t, "2", d.Entries[0].Target.Value)
+	assert.Equal(t, "5", d.Entries[0].Baseline.Value)
+
+	assert.Equal(t, "c", d.Entries[1].Key)
+	assert.Nil(t, d.Entries[1].Baseline)
+
+	assert.Equal(t, "d", d.Entries[2].Key)
+	assert.Nil(t, d.Entries[2].Target)
+}
+
+func TestDiffClusters(t *testing.T) {
+	target := &envoytriagev1.Clusters{ClusterStatuses: []*envoytriagev1.ClusterStatus{
+		{Name: "same", HostStatuses: []*envoytriagev1.HostStatus{{Address: "tcp://10.0.0.1:80", Healthy: true}}},
+		{Name: "changed", HostStatuses: []*envoytriagev1.HostStatus{
+			{Address: "tcp://10.0.0.2:80", Healthy: false},
+			{Address: "tcp://10.0.0.3:80", Healthy: true},
+		}},
+		{Name: "target-only"},
+	}}
+	baseline := &envoytriagev1.Clusters{ClusterStatuses: []*envoytriagev1.ClusterStatus{
+		{Name: "same", HostStatuses: []*envoytriagev1.HostStatus{{Address: "tcp://10.0.0.1:80", Healthy: true}}},
+		{Name: "changed", HostStatuses: []*envoytriagev1.HostStatus{
+			{Address: "tcp://10.0.0.2:80", Healthy: true},
+			{Address: "tcp://10.0.0.4:80", Healthy: true},
+		}},
+		{Name: "baseline-only"},
+	}}
+
+	d := diffClusters(target, baseline)
+	assert.Equal(t, []string{"target-only"}, d.TargetOnly)
+	assert.Equal(t, []string{"baseline-only"}, d.BaselineOnly)
+	assert.Len(t, d.Clusters, 1)
+
+	c := d.Clusters[0]
+	assert.Equal(t, "changed", c.Name)
+	assert.Equal(t, "tcp://10.0.0.3:80", c.TargetOnlyHosts[0].Address)
+	assert.Equal(t, "tcp://10.0.0.4:80", c.BaselineOnlyHosts[0].Address)
+	assert.Len(t, c.HealthMismatches, 1)
+	assert.Equal(t, "tcp://10.0.0.2:80", c.HealthMismatches[0].Address)
+	assert.False(t, c.HealthMismatches[0].TargetHealthy)
+	assert.True(t, c.HealthMismatches[0].BaselineHealthy)
+}
+
+func TestDiffListeners(t *testing.T) {
+	target := &envoytriagev1.Listeners{ListenerStatuses: []*envoytriagev1.ListenerStatus{
+		{Name: "ingress", LocalAddress: "tcp://0.0.0.0:80"},
+		{Name: "admin", LocalAddress: "tcp://0.0.0.0:9901"},
+	}}
+	baseline := &envoytriagev1.Listeners{ListenerStatuses: []*envoytriagev1.ListenerStatus{
+		{Name: "ingress", LocalAddress: "tcp://0.0.0.0:8080"},
+		{Name: "egress", LocalAddress: "tcp://127.0.0.1:9001"},
+	}}
+
+	d := diffListeners(target, baseline)
+	assert.Equal(t, "admin", d.TargetOnly[0].Name)
+	assert.Equal(t, "egress", d.BaselineOnly[0].Name)
+	assert.Len(t, d.AddressMismatches, 1)
+	assert.Equal(t, "tcp://0.0.0.0:80", d.AddressMismatches[0].TargetLocalAddress)
+	assert.Equal(t, "tcp://0.0.0.0:8080", d.AddressMismatches[0].BaselineLocalAddress)
+}
+
+func TestDiffStats(t *testing.T) {
+	target := &envoytriagev1.Stats{Stats: []*envoytriagev1.Stats_Stat{
+		{Key: "cluster.foo.upstream_rq_5xx", Value: 10},
+		{Key: "cluster.foo.upstream_rq_2xx", Value: 5},
+		{Key: "server.uptime", Value: 100},
+	}}
+	baseline := &envoytriagev1.Stats{Stats: []*envoytriagev1.Stats_Stat{
+		{Key: "cluster.foo.upstream_rq_2xx", Value: 5},
+		{Key: "server.uptime", Value: 300},
+	}}
+
+	d := diffStats(target, baseline, []string{"cluster.foo."})
+	assert.Len(t, d.Entries, 1)
+	assert.Equal(t, "cluster.foo.upstream_rq_5xx", d.Entries[0].Key)
+	assert.Equal(t, uint64(10), d.Entries[0].Target.Value)
+	assert.Nil(t, d.Entries[0].Baseline)
+	assert.Equal(t, int64(10), d.Entries[0].Delta)
+
+	d = diffStats(target, baseline, nil)
+	assert.Len(t, d.Entries, 2)
+	assert.Equal(t, "server.uptime", d.Entries[1].Key)
+	assert.Equal(t, int64(-200), d.Entries[1].Delta)
+}
+
+func configDumpOf(t *testing.T, v interface{}) *envoytriagev1.ConfigDump {
+	pb, err := structpb.NewValue(v)
+	assert.NoError(t, err)
+	return &envoytriagev1.ConfigDump{Value: pb}
+}
+
+func TestDiffConfigDump(t *testing.T) {
+	target := configDumpOf(t, map[string]interface{}{
+		"configs": []interface{}{
+			map[string]interface{}{
+				"@type": "type.googleapis.com/envoy.admin.v3.ClustersConfigDump",
+				"dynamic_active_clusters": []interface{}{
+					map[string]interface{}{
+						"last_updated": "2021-01-01T00:00:00Z",
+						"cluster":      map[string]interface{}{"name": "foo", "connect_timeout": "1s"},
+					},
+					map[string]interface{}{
+						"cluster": map[string]interface{}{"name": "bar", "connect_timeout": "1s"},
+					},
+				},
+			},
+			map[string]interface{}{
+				"@type":      "type.googleapis.com/envoy.admin.v3.BootstrapConfigDump",
+				"bootstrap":  map[string]interface{}{"node": map[string]interface{}{"id": "a"}},
+				"extensions": []interface{}{"x", "y"},
+			},
+		},
+	})
+	baseline := configDumpOf(t, map[string]interface{}{
+		"configs": []interface{}{
+			map[string]interface{}{
+				"@type": "type.googleapis.com/envoy.admin.v3.ClustersConfigDump",
+				"dynamic_active_clusters": []interface{}{
+					map[string]interface{}{
+						"last_updated": "2021-02-02T00:00:00Z",
+						"cluster":      map[string]interface{}{"name": "bar", "connect_timeout": "1s"},
+					},
+					map[string]interface{}{
+						"cluster": map[string]interface{}{"name": "foo", "connect_timeout": "5s"},
+					},
+				},
+			},
+			map[string]interface{}{
+				"@type":      "type.googleapis.com/envoy.admin.v3.BootstrapConfigDump",
+				"bootstrap":  map[string]interface{}{"node": map[string]interface{}{"id": "b"}},
+				"extensions": []interface{}{"x"},
+			},
+		},
+	})
+
+	d := diffConfigDump(target, baseline)
+	assert.Len(t, d, 2)
+
+	assert.Equal(t, "type.googleapis.com/envoy.admin.v3.BootstrapConfigDump", d[0].TypeUrl)
+	assert.Len(t, d[0].Fields, 2)
+	assert.Equal(t, "bootstrap.node.id", d[0].Fields[0].Path)
+	assert.Equal(t, "a", d[0].Fields[0].Target.GetStringValue())
+	assert.Equal(t, "b", d[0].Fields[0].Baseline.GetStringValue())
+	assert.Equal(t, "extensions[1]", d[0].Fields[1].Path)
+	assert.Nil(t, d[0].Fields[1].Baseline)
+
+	assert.Equal(t, "type.googleapis.com/envoy.admin.v3.ClustersConfigDump", d[1].TypeUrl)
+	assert.Len(t, d[1].Fields, 1)
+	assert.Equal(t, "dynamic_active_clusters[foo].cluster.connect_timeout", d[1].Fields[0].Path)
+	assert.Equal(t, "1s", d[1].Fields[0].Target.GetStringValue())
+	assert.Equal(t, "5s", d[1].Fields[0].Baseline.GetStringValue())
+}
+
+func TestCompare(t *testing.T) {
+	c := &fakeClient{results: map[string]*envoytriagev1.Result{
+		"target": {
+			Address:      &envoytriagev1.Address{Host: "target"},
+			NodeMetadata: &envoytriagev1.NodeMetadata{ServiceNode: "target"},
+			Output:       &envoytriagev1.Result_Output{Runtime: runtimeOf("a", "1")},
+		},
+		"healthy": {
+			Address:      &envoytriagev1.Address{Host: "healthy"},
+			NodeMetadata: &envoytriagev1.NodeMetadata{ServiceNode: "healthy"},
+			Output:       &envoytriagev1.Result_Output{Runtime: runtimeOf("a", "2")},
+		},
+	}}
+	a := newAPI(c)
+
+	resp, err := a.Compare(context.Background(), &envoytriagev1.CompareRequest{
+		Target:    &envoytriagev1.Address{Host: "target"},
+		Baselines: []*envoytriagev1.Address{{Host: "healthy"}},
+		Include:   &envoytriagev1.ReadOperation_Include{Runtime: true},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "target", resp.TargetNodeMetadata.ServiceNode)
+	assert.Len(t, resp.Comparisons, 1)
+	assert.Equal(t, "healthy", resp.Comparisons[0].BaselineNodeMetadata.ServiceNode)
+	assert.Len(t, resp.Comparisons[0].Runtime.Entries, 1)
+	assert.Nil(t, resp.Comparisons[0].Clusters)
+
+	_, err = a.Compare(context.Background(), &envoytriagev1.CompareRequest{
+		Target:    &envoytriagev1.Address{Host: "target"},
+		Baselines: []*envoytriagev1.Address{{Host: "unreachable"}},
+		Include:   &envoytriagev1.ReadOperation_Include{Runtime: true},
+	})
+	assert.ErrorContains(t, err, "unreachable:0: connection refused")
+}
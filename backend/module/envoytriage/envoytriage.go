@@ -7,10 +7,13 @@ package envoytriage
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
 
 	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
 	"github.com/lyft/clutch/backend/module"
@@ -72,3 +75,56 @@ func (a *api) Read(ctx context.Context, request *envoytriagev1.ReadRequest) (*en
 
 	return resp, nil
 }
+
+func (a *api) Compare(ctx context.Context, request *envoytriagev1.CompareRequest) (*envoytriagev1.CompareResponse, error) {
+	// Server info is always fetched by the client for node metadata but is host-specific, so it is never diffed.
+	include := proto.Clone(request.Include).(*envoytriagev1.ReadOperation_Include)
+	include.ServerInfo = false
+
+	addresses := append([]*envoytriagev1.Address{request.Target}, request.Baselines...)
+	results := make([]*envoytriagev1.Result, len(addresses))
+
+	g, ctx := errgroup.WithContext(ctx)
+	for idx, address := range addresses {
+		idx, address := idx, address
+		g.Go(func() error {
+			res, err := a.client.Get(ctx, &envoytriagev1.ReadOperation{Address: address, Include: include})
+			if err != nil {
+				return fmt.Errorf("%s:%d: %w", address.Host, address.Port, err)
+			}
+			results[idx] = res
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	target := results[0]
+	resp := &envoytriagev1.CompareResponse{
+		Target:             target.Address,
+		TargetNodeMetadata: target.NodeMetadata,
+		Comparisons:        make([]*envoytriagev1.Comparison, 0, len(results)-1),
+	}
+	for _, baseline := range results[1:] {
+		resp.Comparisons = append(resp.Comparisons, compareResults(target, baseline, request.StatPrefixes))
+	}
+
+	return resp, nil
+}
+
+func (a *api) Update(ctx context.Context, request *envoytriagev1.UpdateRequest) (*envoytriagev1.UpdateResponse, error) {
+	resp := &envoytriagev1.UpdateResponse{
+		Results: make([]*envoytriagev1.UpdateResult, len(request.Operations)),
+	}
+
+	for idx, op := range request.Operations {
+		res, err := a.client.Update(ctx, op)
+		if err != nil {
+			return nil, err
+		}
+		resp.Results[idx] = res
+	}
+
+	return resp, nil
+}
@@ -2,10 +2,12 @@ package featureflag
 
 import (
 	"context"
+	"sync"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	featureflagcfgv1 "github.com/lyft/clutch/backend/api/config/module/featureflag/v1"
 	featureflagv1 "github.com/lyft/clutch/backend/api/featureflag/v1"
@@ -17,6 +19,15 @@ const (
 )
 
 func New(cfg *any.Any, log *zap.Logger, scope tally.Scope) (module.Module, error) {
+	config, err := configFromAny(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return newModuleImpl(config.GetSimple())
+}
+
+func configFromAny(cfg *anypb.Any) (*featureflagcfgv1.Config, error) {
 	config := &featureflagcfgv1.Config{}
 
 	if cfg != nil {
@@ -24,8 +35,7 @@ func New(cfg *any.Any, log *zap.Logger, scope tally.Scope) (module.Module, error
 			return nil, err
 		}
 	}
-
-	return newModuleImpl(config.GetSimple())
+	return config, nil
 }
 
 type featureFlagIface interface {
@@ -38,20 +48,38 @@ func newModuleImpl(simple *featureflagcfgv1.Simple) (featureFlagIface, error) {
 }
 
 type moduleImpl struct {
+	mu     sync.RWMutex
 	simple *featureflagcfgv1.Simple
 }
 
+// PrepareReload parses new flag values, which are served from the next GetFlags call once applied.
+func (m *moduleImpl) PrepareReload(cfg *anypb.Any) (func(), error) {
+	config, err := configFromAny(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.simple = config.GetSimple()
+	}, nil
+}
+
 func (m *moduleImpl) Register(r module.Registrar) error {
 	featureflagv1.RegisterFeatureFlagAPIServer(r.GRPCServer(), m)
 	return r.RegisterJSONGateway(featureflagv1.RegisterFeatureFlagAPIHandler)
 }
 
 func (m *moduleImpl) GetFlags(ctx context.Context, req *featureflagv1.GetFlagsRequest) (*featureflagv1.GetFlagsResponse, error) {
+	m.mu.RLock()
+	simple := m.simple
+	m.mu.RUnlock()
+
 	flags := make(map[string]*featureflagv1.Flag)
-	if m.simple == nil {
+	if simple == nil {
 		return &featureflagv1.GetFlagsResponse{Flags: flags}, nil
 	}
-	for i, flag := range m.simple.Flags {
+	for i, flag := range simple.Flags {
 		flags[i] = &featureflagv1.Flag{Type: &featureflagv1.Flag_BooleanValue{BooleanValue: flag}}
 	}
 
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	featureflagv1 "github.com/lyft/clutch/backend/api/config/module/featureflag/v1"
 	"github.com/lyft/clutch/backend/module/moduletest"
@@ -58,3 +59,20 @@ func TestAPIWithoutFlags(t *testing.T) {
 	_, respErr := m.GetFlags(context.Background(), nil)
 	assert.NoError(t, respErr)
 }
+
+func TestPrepareReload(t *testing.T) {
+	m, err := newModuleImpl(&featureflagv1.Simple{Flags: map[string]bool{"foo": false}})
+	assert.NoError(t, err)
+
+	cfg, err := anypb.New(&featureflagv1.Config{
+		Type: &featureflagv1.Config_Simple{Simple: &featureflagv1.Simple{Flags: map[string]bool{"foo": true}}},
+	})
+	assert.NoError(t, err)
+	apply, err := m.(*moduleImpl).PrepareReload(cfg)
+	assert.NoError(t, err)
+	apply()
+
+	resp, err := m.GetFlags(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, true, resp.Flags["foo"].GetBooleanValue())
+}
@@ -1,27 +1,46 @@
 package healthcheck
 
 // <!-- START clutchdoc -->
-// description: Simple healthcheck endpoint.
+// description: Healthcheck endpoints, including liveness, readiness and a deep check of service dependencies.
 // <!-- END clutchdoc -->
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 
+	healthcheckcfgv1 "github.com/lyft/clutch/backend/api/config/module/healthcheck/v1"
 	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
 	"github.com/lyft/clutch/backend/module"
+	"github.com/lyft/clutch/backend/service"
 )
 
 const (
 	Name = "clutch.module.healthcheck"
+
+	defaultCheckTimeout = 5 * time.Second
 )
 
-func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
+func New(cfg *any.Any, _ *zap.Logger, _ tally.Scope) (module.Module, error) {
+	config := &healthcheckcfgv1.Config{}
+	if cfg != nil {
+		if err := cfg.UnmarshalTo(config); err != nil {
+			return nil, err
+		}
+	}
+
 	mod := &mod{
-		api: newAPI(),
+		api: newAPI(config, service.Registry),
 	}
 	return mod, nil
 }
@@ -35,12 +54,133 @@ func (m *mod) Register(r module.Registrar) error {
 	return r.RegisterJSONGateway(healthcheckv1.RegisterHealthcheckAPIHandler)
 }
 
-func newAPI() healthcheckv1.HealthcheckAPIServer {
-	return &healthcheckAPI{}
+type checker struct {
+	name      string
+	readiness bool
+	service.Checker
 }
 
-type healthcheckAPI struct{}
+// newAPI collects the services implementing service.Checker. The registry is fully populated before modules are
+// instantiated, so it is only inspected once.
+func newAPI(config *healthcheckcfgv1.Config, registry map[string]service.Service) *healthcheckAPI {
+	timeout := defaultCheckTimeout
+	if config.CheckTimeout != nil {
+		timeout = config.CheckTimeout.AsDuration()
+	}
+
+	readiness := make(map[string]bool, len(config.ReadinessServices))
+	for _, name := range config.ReadinessServices {
+		readiness[name] = true
+	}
+
+	var checkers, readinessCheckers []checker
+	for name, svc := range registry {
+		if c, ok := svc.(service.Checker); ok {
+			checkers = append(checkers, checker{name: name, readiness: readiness[name], Checker: c})
+		}
+	}
+	sort.Slice(checkers, func(i, j int) bool { return checkers[i].name < checkers[j].name })
+	for _, c := range checkers {
+		if c.readiness {
+			readinessCheckers = append(readinessCheckers, c)
+		}
+	}
+
+	return &healthcheckAPI{timeout: timeout, checkers: checkers, readinessCheckers: readinessCheckers}
+}
+
+type healthcheckAPI struct {
+	timeout           time.Duration
+	checkers          []checker
+	readinessCheckers []checker
+}
 
 func (a *healthcheckAPI) Healthcheck(context.Context, *healthcheckv1.HealthcheckRequest) (*healthcheckv1.HealthcheckResponse, error) {
 	return &healthcheckv1.HealthcheckResponse{}, nil
 }
+
+func (a *healthcheckAPI) Liveness(context.Context, *healthcheckv1.LivenessRequest) (*healthcheckv1.LivenessResponse, error) {
+	return &healthcheckv1.LivenessResponse{}, nil
+}
+
+func (a *healthcheckAPI) Readiness(ctx context.Context, _ *healthcheckv1.ReadinessRequest) (*healthcheckv1.ReadinessResponse, error) {
+	results := a.runChecks(ctx, a.readinessCheckers)
+
+	// Readiness is served without authentication, so the errors and latencies of the checks are left out.
+	var failed []string
+	for i, r := range results {
+		if r.Status != healthcheckv1.CheckResult_HEALTHY {
+			failed = append(failed, r.Name)
+		}
+		results[i] = &healthcheckv1.CheckResult{Name: r.Name, Status: r.Status, Readiness: r.Readiness}
+	}
+
+	resp := &healthcheckv1.ReadinessResponse{Results: results}
+	if len(failed) > 0 {
+		s := status.New(codes.Unavailable, fmt.Sprintf("unhealthy dependencies: %s", strings.Join(failed, ", ")))
+		s, _ = s.WithDetails(resp)
+		return nil, s.Err()
+	}
+	return resp, nil
+}
+
+func (a *healthcheckAPI) DeepHealthcheck(ctx context.Context, _ *healthcheckv1.DeepHealthcheckRequest) (*healthcheckv1.DeepHealthcheckResponse, error) {
+	results := a.runChecks(ctx, a.checkers)
+
+	healthy := true
+	for _, r := range results {
+		if r.Status != healthcheckv1.CheckResult_HEALTHY {
+			healthy = false
+		}
+	}
+	return &healthcheckv1.DeepHealthcheckResponse{Healthy: healthy, Results: results}, nil
+}
+
+// runChecks runs the checks concurrently, returning results in the same order as the checkers.
+func (a *healthcheckAPI) runChecks(ctx context.Context, checkers []checker) []*healthcheckv1.CheckResult {
+	results := make([]*healthcheckv1.CheckResult, len(checkers))
+	done := make(chan struct{}, len(checkers))
+	for idx, c := range checkers {
+		go func(idx int, c checker) {
+			results[idx] = a.runCheck(ctx, c)
+			done <- struct{}{}
+		}(idx, c)
+	}
+	for range checkers {
+		<-done
+	}
+	return results
+}
+
+// runCheck does not wait for the check to return after the timeout, so that checks which do not respect their
+// context cannot hold up the response.
+func (a *healthcheckAPI) runCheck(ctx context.Context, c checker) *healthcheckv1.CheckResult {
+	ctx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	result := &healthcheckv1.CheckResult{Name: c.name, Readiness: c.readiness}
+
+	start := time.Now()
+	errCh := make(chan error, 1)
+	go func() { errCh <- c.Check(ctx) }()
+
+	var err error
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	result.Latency = durationpb.New(time.Since(start))
+
+	switch {
+	case err == nil:
+		result.Status = healthcheckv1.CheckResult_HEALTHY
+	case errors.Is(err, context.DeadlineExceeded):
+		result.Status = healthcheckv1.CheckResult_TIMEOUT
+		result.Error = err.Error()
+	default:
+		result.Status = healthcheckv1.CheckResult_UNHEALTHY
+		result.Error = err.Error()
+	}
+	return result
+}
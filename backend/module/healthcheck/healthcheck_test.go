@@ -2,14 +2,21 @@ package healthcheck
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 
+	healthcheckcfgv1 "github.com/lyft/clutch/backend/api/config/module/healthcheck/v1"
 	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
 	"github.com/lyft/clutch/backend/module/moduletest"
+	"github.com/lyft/clutch/backend/service"
 )
 
 func TestModule(t *testing.T) {
@@ -26,8 +33,92 @@ func TestModule(t *testing.T) {
 }
 
 func TestAPI(t *testing.T) {
-	api := newAPI()
+	api := newAPI(&healthcheckcfgv1.Config{}, nil)
 	resp, err := api.Healthcheck(context.Background(), &healthcheckv1.HealthcheckRequest{})
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
+
+	liveness, err := api.Liveness(context.Background(), &healthcheckv1.LivenessRequest{})
+	assert.NoError(t, err)
+	assert.NotNil(t, liveness)
+}
+
+type checkFunc func(ctx context.Context) error
+
+func (f checkFunc) Check(ctx context.Context) error { return f(ctx) }
+
+var (
+	healthy   = checkFunc(func(context.Context) error { return nil })
+	unhealthy = checkFunc(func(context.Context) error { return errors.New("connection refused") })
+	// Ignores its context to make sure the check timeout is enforced by the module.
+	hanging = checkFunc(func(context.Context) error {
+		time.Sleep(time.Second)
+		return nil
+	})
+)
+
+func TestDeepHealthcheck(t *testing.T) {
+	registry := map[string]service.Service{
+		"c.hanging":   hanging,
+		"a.healthy":   healthy,
+		"b.unhealthy": unhealthy,
+		"d.nochecker": struct{}{},
+	}
+	api := newAPI(&healthcheckcfgv1.Config{CheckTimeout: durationpb.New(10 * time.Millisecond)}, registry)
+
+	resp, err := api.DeepHealthcheck(context.Background(), &healthcheckv1.DeepHealthcheckRequest{})
+	assert.NoError(t, err)
+	assert.False(t, resp.Healthy)
+	assert.Len(t, resp.Results, 3)
+
+	assert.Equal(t, "a.healthy", resp.Results[0].Name)
+	assert.Equal(t, healthcheckv1.CheckResult_HEALTHY, resp.Results[0].Status)
+	assert.Empty(t, resp.Results[0].Error)
+
+	assert.Equal(t, "b.unhealthy", resp.Results[1].Name)
+	assert.Equal(t, healthcheckv1.CheckResult_UNHEALTHY, resp.Results[1].Status)
+	assert.Equal(t, "connection refused", resp.Results[1].Error)
+
+	assert.Equal(t, "c.hanging", resp.Results[2].Name)
+	assert.Equal(t, healthcheckv1.CheckResult_TIMEOUT, resp.Results[2].Status)
+	assert.Less(t, resp.Results[2].Latency.AsDuration(), time.Second)
+}
+
+func TestReadiness(t *testing.T) {
+	registry := map[string]service.Service{
+		"healthy":   healthy,
+		"unhealthy": unhealthy,
+	}
+
+	api := newAPI(&healthcheckcfgv1.Config{ReadinessServices: []string{"healthy", "unhealthy"}}, registry)
+	_, err := api.Readiness(context.Background(), &healthcheckv1.ReadinessRequest{})
+	s := status.Convert(err)
+	assert.Equal(t, codes.Unavailable, s.Code())
+	assert.Equal(t, "unhealthy dependencies: unhealthy", s.Message())
+	assert.Len(t, s.Details(), 1)
+	results := s.Details()[0].(*healthcheckv1.ReadinessResponse).Results
+	assert.Len(t, results, 2)
+	assert.Equal(t, healthcheckv1.CheckResult_UNHEALTHY, results[1].Status)
+	// Errors aren't returned to unauthenticated callers.
+	assert.Empty(t, results[1].Error)
+	assert.Nil(t, results[1].Latency)
+
+	// Only the configured checks gate readiness.
+	api = newAPI(&healthcheckcfgv1.Config{ReadinessServices: []string{"healthy"}}, registry)
+	resp, err := api.Readiness(context.Background(), &healthcheckv1.ReadinessRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Results, 1)
+	assert.True(t, resp.Results[0].Readiness)
+
+	deep, err := api.DeepHealthcheck(context.Background(), &healthcheckv1.DeepHealthcheckRequest{})
+	assert.NoError(t, err)
+	assert.False(t, deep.Healthy)
+	assert.Len(t, deep.Results, 2)
+	assert.False(t, deep.Results[1].Readiness)
+
+	// No dependency is checked by default.
+	api = newAPI(&healthcheckcfgv1.Config{}, registry)
+	resp, err = api.Readiness(context.Background(), &healthcheckv1.ReadinessRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Results)
 }
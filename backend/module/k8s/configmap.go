@@ -2,10 +2,24 @@ package k8s
 
 import (
 	"context"
+	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
+	authzv1 "github.com/lyft/clutch/backend/api/authz/v1"
 	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
+	"github.com/lyft/clutch/backend/service/authn"
+	k8sservice "github.com/lyft/clutch/backend/service/k8s"
 )
 
+// Restarting a deployment requires the same permission as updating it.
+const updateDeploymentMethod = "/clutch.k8s.v1.K8sAPI/UpdateDeployment"
+
 func (a *k8sAPI) DescribeConfigMap(ctx context.Context, req *k8sapiv1.DescribeConfigMapRequest) (*k8sapiv1.DescribeConfigMapResponse, error) {
 	configMap, err := a.k8s.DescribeConfigMap(ctx, req.Clientset, req.Cluster, req.Namespace, req.Name)
 	if err != nil {
@@ -29,3 +43,70 @@ func (a *k8sAPI) ListConfigMaps(ctx context.Context, req *k8sapiv1.ListConfigMap
 	}
 	return &k8sapiv1.ListConfigMapsResponse{ConfigMaps: configMaps}, nil
 }
+
+func (a *k8sAPI) CreateConfigMap(ctx context.Context, req *k8sapiv1.CreateConfigMapRequest) (*k8sapiv1.CreateConfigMapResponse, error) {
+	configMap, err := a.k8s.CreateConfigMap(ctx, req.Clientset, req.Cluster, req.Namespace, req.Name, req.Labels, req.Annotations, req.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &k8sapiv1.CreateConfigMapResponse{ConfigMap: configMap}, nil
+}
+
+func (a *k8sAPI) UpdateConfigMap(ctx context.Context, req *k8sapiv1.UpdateConfigMapRequest) (*k8sapiv1.UpdateConfigMapResponse, error) {
+	configMap, restarted, err := a.k8s.UpdateConfigMap(ctx, req.Clientset, req.Cluster, req.Namespace, req.Name, req.Data, req.RemoveKeys, req.RestartDependentDeployments,
+		a.authorizeRestart(ctx, req.Cluster, req.Namespace))
+	if err != nil {
+		if configMap != nil {
+			return nil, restartError(err, &k8sapiv1.UpdateConfigMapResponse{ConfigMap: configMap, RestartedDeployments: restarted})
+		}
+		return nil, err
+	}
+	return &k8sapiv1.UpdateConfigMapResponse{ConfigMap: configMap, RestartedDeployments: restarted}, nil
+}
+
+// restartError is returned when an update succeeded but restarting the dependent deployments failed. The response of
+// the update is attached as a detail, so that callers can tell the update apart from the deployments that were
+// restarted before the failure.
+func restartError(err error, resp proto.Message) error {
+	s := status.Convert(k8sservice.ConvertError(err)).Proto()
+	s.Message = "the update succeeded, but restarting the dependent deployments failed: " + s.Message
+	if detail, err := anypb.New(resp); err == nil {
+		s.Details = append(s.Details, detail)
+	}
+	return status.FromProto(s).Err()
+}
+
+// authorizeRestart returns a check that the caller is allowed to update each of the deployments that an update of a
+// config map or secret restarts, since the authz middleware only checks the config map or secret. It returns nil if
+// authz isn't configured.
+func (a *k8sAPI) authorizeRestart(ctx context.Context, cluster, namespace string) func(deployments []string) error {
+	if a.authz == nil {
+		return nil
+	}
+
+	return func(deployments []string) error {
+		claims, err := authn.ClaimsFromContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, name := range deployments {
+			check := &authzv1.CheckRequest{
+				Subject:    &authzv1.Subject{User: claims.Subject, Groups: claims.Groups},
+				Method:     updateDeploymentMethod,
+				ActionType: apiv1.ActionType_UPDATE,
+				Resource:   meta.ResourceNames(&k8sapiv1.UpdateDeploymentRequest{Cluster: cluster, Namespace: namespace, Name: name})[0].Id,
+			}
+			resp, err := a.authz.Check(ctx, check)
+			if err != nil {
+				return err
+			}
+			if resp.Decision != authzv1.Decision_ALLOW {
+				s := status.New(codes.PermissionDenied, fmt.Sprintf("permission to restart deployment '%s' denied by authz", name))
+				s, _ = s.WithDetails(check)
+				return s.Err()
+			}
+		}
+		return nil
+	}
+}
@@ -29,3 +29,27 @@ func (a *k8sAPI) DeleteCronJob(ctx context.Context, req *k8sapiv1.DeleteCronJobR
 	}
 	return &k8sapiv1.DeleteCronJobResponse{}, nil
 }
+
+func (a *k8sAPI) SuspendCronJob(ctx context.Context, req *k8sapiv1.SuspendCronJobRequest) (*k8sapiv1.SuspendCronJobResponse, error) {
+	cronJob, err := a.k8s.SetCronJobSuspended(ctx, req.Clientset, req.Cluster, req.Namespace, req.Name, true)
+	if err != nil {
+		return nil, err
+	}
+	return &k8sapiv1.SuspendCronJobResponse{CronJob: cronJob}, nil
+}
+
+func (a *k8sAPI) ResumeCronJob(ctx context.Context, req *k8sapiv1.ResumeCronJobRequest) (*k8sapiv1.ResumeCronJobResponse, error) {
+	cronJob, err := a.k8s.SetCronJobSuspended(ctx, req.Clientset, req.Cluster, req.Namespace, req.Name, false)
+	if err != nil {
+		return nil, err
+	}
+	return &k8sapiv1.ResumeCronJobResponse{CronJob: cronJob}, nil
+}
+
+func (a *k8sAPI) TriggerCronJob(ctx context.Context, req *k8sapiv1.TriggerCronJobRequest) (*k8sapiv1.TriggerCronJobResponse, error) {
+	job, err := a.k8s.TriggerCronJob(ctx, req.Clientset, req.Cluster, req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &k8sapiv1.TriggerCronJobResponse{Job: job}, nil
+}
@@ -3,6 +3,9 @@ package k8s
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
 )
 
@@ -23,6 +26,18 @@ func (a *k8sAPI) ListDeployments(ctx context.Context, req *k8sapiv1.ListDeployme
 }
 
 func (a *k8sAPI) UpdateDeployment(ctx context.Context, req *k8sapiv1.UpdateDeploymentRequest) (*k8sapiv1.UpdateDeploymentResponse, error) {
+	if (req.Fields == nil) == (req.Patch == nil) {
+		return nil, status.Error(codes.InvalidArgument, "either fields or patch is required")
+	}
+
+	if req.Patch != nil {
+		diff, err := a.k8s.PatchDeployment(ctx, req.Clientset, req.Cluster, req.Namespace, req.Name, req.Patch)
+		if err != nil {
+			return nil, err
+		}
+		return &k8sapiv1.UpdateDeploymentResponse{Diff: diff}, nil
+	}
+
 	err := a.k8s.UpdateDeployment(ctx, req.Clientset, req.Cluster, req.Namespace, req.Name, req.Fields)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,19 @@
+package k8s
+
+import (
+	"context"
+
+	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+func (a *k8sAPI) ExecPod(ctx context.Context, req *k8sapiv1.ExecPodRequest) (*k8sapiv1.ExecPodResponse, error) {
+	return a.k8s.ExecPod(ctx, req.Clientset, req.Cluster, req.Namespace, req.Name, req.Container, req.Command)
+}
+
+func (a *k8sAPI) CreateDebugContainer(ctx context.Context, req *k8sapiv1.CreateDebugContainerRequest) (*k8sapiv1.CreateDebugContainerResponse, error) {
+	containerName, err := a.k8s.CreateDebugContainer(ctx, req.Clientset, req.Cluster, req.Namespace, req.Name, req.Image, req.TargetContainer, req.Command)
+	if err != nil {
+		return nil, err
+	}
+	return &k8sapiv1.CreateDebugContainerResponse{ContainerName: containerName}, nil
+}
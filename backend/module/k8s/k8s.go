@@ -14,6 +14,7 @@ import (
 	k8sv1 "github.com/lyft/clutch/backend/api/k8s/v1"
 	"github.com/lyft/clutch/backend/module"
 	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/authz"
 	k8sservice "github.com/lyft/clutch/backend/service/k8s"
 )
 
@@ -32,8 +33,17 @@ func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
 		return nil, errors.New("service was not the correct type")
 	}
 
+	// Authz is optional, but if it's configured the restarts of dependent deployments are checked too.
+	var zclient authz.Client
+	if zsvc, ok := service.Registry["clutch.service.authz"]; ok {
+		zclient, ok = zsvc.(authz.Client)
+		if !ok {
+			return nil, errors.New("authz service was not the correct type")
+		}
+	}
+
 	mod := &mod{
-		k8s: newK8sAPI(svc),
+		k8s: &k8sAPI{k8s: svc, authz: zclient},
 	}
 
 	return mod, nil
@@ -49,7 +59,8 @@ func (m *mod) Register(r module.Registrar) error {
 }
 
 type k8sAPI struct {
-	k8s k8sservice.Service
+	k8s   k8sservice.Service
+	authz authz.Client
 }
 
 func newK8sAPI(svc k8sservice.Service) k8sv1.K8SAPIServer {
@@ -2,17 +2,30 @@ package k8s
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/dgrijalva/jwt-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
+	authzv1 "github.com/lyft/clutch/backend/api/authz/v1"
 	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
 	"github.com/lyft/clutch/backend/mock/service/k8smock"
 	"github.com/lyft/clutch/backend/module/moduletest"
 	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/authn"
 )
 
 func TestModule(t *testing.T) {
@@ -121,3 +134,230 @@ func TestK8SAPICreateJob(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 }
+
+func TestK8SAPIDescribeResource(t *testing.T) {
+	c := k8smock.New()
+	api := newK8sAPI(c)
+	resp, err := api.DescribeResource(context.Background(), &k8sapiv1.DescribeResourceRequest{
+		Namespace: "ns",
+		Group:     "example.com",
+		Version:   "v1",
+		Kind:      "Widget",
+		Name:      "w1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Widget", resp.Resource.Kind)
+	assert.Equal(t, "w1", resp.Resource.Name)
+	assert.Equal(t, "example.com/v1", resp.Resource.Object.Fields["apiVersion"].GetStringValue())
+}
+
+func TestK8SAPIPatchResource(t *testing.T) {
+	c := k8smock.New()
+	api := newK8sAPI(c)
+	patch, _ := structpb.NewValue(map[string]interface{}{"spec": map[string]interface{}{"size": 3}})
+	resp, err := api.PatchResource(context.Background(), &k8sapiv1.PatchResourceRequest{Name: "w1", Patch: patch, DryRun: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "w1", resp.Resource.Name)
+	assert.Contains(t, resp.Diff, "+  size: 3")
+}
+
+func TestK8SAPIUpdateWorkloadPatch(t *testing.T) {
+	api := newK8sAPI(k8smock.New())
+	patch, _ := structpb.NewValue(map[string]interface{}{"spec": map[string]interface{}{"replicas": 2}})
+
+	deployment, err := api.UpdateDeployment(context.Background(), &k8sapiv1.UpdateDeploymentRequest{Name: "d1", Patch: &k8sapiv1.ResourcePatch{Patch: patch}})
+	assert.NoError(t, err)
+	assert.Contains(t, deployment.Diff, "+  replicas: 2")
+
+	statefulSet, err := api.UpdateStatefulSet(context.Background(), &k8sapiv1.UpdateStatefulSetRequest{Name: "s1", Patch: &k8sapiv1.ResourcePatch{Patch: patch}})
+	assert.NoError(t, err)
+	assert.Contains(t, statefulSet.Diff, "+  replicas: 2")
+
+	// Either fields or a patch is required.
+	_, err = api.UpdateDeployment(context.Background(), &k8sapiv1.UpdateDeploymentRequest{Name: "d1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = api.UpdateStatefulSet(context.Background(), &k8sapiv1.UpdateStatefulSetRequest{
+		Name:   "s1",
+		Fields: &k8sapiv1.UpdateStatefulSetRequest_Fields{},
+		Patch:  &k8sapiv1.ResourcePatch{Patch: patch},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+type fakeWatchPodsStream struct {
+	k8sapiv1.K8SAPI_WatchPodsServer
+
+	sent []*k8sapiv1.WatchPodsResponse
+}
+
+func (f *fakeWatchPodsStream) Context() context.Context { return context.Background() }
+
+func (f *fakeWatchPodsStream) Send(resp *k8sapiv1.WatchPodsResponse) error {
+	f.sent = append(f.sent, resp)
+	return nil
+}
+
+func TestK8SAPIWatchPods(t *testing.T) {
+	c := k8smock.New()
+	api := newK8sAPI(c)
+	stream := &fakeWatchPodsStream{}
+	assert.NoError(t, api.WatchPods(&k8sapiv1.WatchPodsRequest{Clientset: "cs", Namespace: "ns"}, stream))
+	assert.Len(t, stream.sent, 2)
+	assert.Equal(t, k8sapiv1.WatchEvent_ADDED, stream.sent[0].Event.Type)
+	assert.Equal(t, "ns", stream.sent[0].Pod.Namespace)
+	assert.Equal(t, k8sapiv1.WatchEvent_BOOKMARK, stream.sent[1].Event.Type)
+	assert.Nil(t, stream.sent[1].Pod)
+}
+
+type fakeScaleWorkloadStream struct {
+	k8sapiv1.K8SAPI_ScaleWorkloadServer
+
+	sent []*k8sapiv1.ScaleWorkloadResponse
+}
+
+func (f *fakeScaleWorkloadStream) Context() context.Context { return context.Background() }
+
+func (f *fakeScaleWorkloadStream) Send(resp *k8sapiv1.ScaleWorkloadResponse) error {
+	f.sent = append(f.sent, resp)
+	return nil
+}
+
+func TestK8SAPIScaleWorkload(t *testing.T) {
+	c := k8smock.New()
+	api := newK8sAPI(c)
+	req := &k8sapiv1.ScaleWorkloadRequest{Namespace: "ns", Name: "app", Kind: k8sapiv1.WorkloadKind_DEPLOYMENT, Replicas: 2}
+
+	stream := &fakeScaleWorkloadStream{}
+	assert.NoError(t, api.ScaleWorkload(req, stream))
+	assert.Len(t, stream.sent, 1)
+	assert.Equal(t, uint32(2), stream.sent[0].Workload.Replicas)
+
+	// The progress of the rollout is sent until it's complete.
+	req.WaitForRollout = true
+	stream = &fakeScaleWorkloadStream{}
+	assert.NoError(t, api.ScaleWorkload(req, stream))
+	assert.Len(t, stream.sent, 2)
+	assert.False(t, stream.sent[0].Workload.RolloutComplete)
+	assert.True(t, stream.sent[1].Workload.RolloutComplete)
+}
+
+func TestRolloutTimeout(t *testing.T) {
+	assert.Equal(t, defaultRolloutTimeout, rolloutTimeout(context.Background(), nil))
+	assert.Equal(t, time.Minute, rolloutTimeout(context.Background(), durationpb.New(time.Minute)))
+
+	// The wait ends before the deadline of the method.
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	d := rolloutTimeout(ctx, nil)
+	assert.True(t, d <= 14*time.Second && d > 13*time.Second, d)
+	assert.Equal(t, 5*time.Second, rolloutTimeout(ctx, durationpb.New(5*time.Second)))
+}
+
+func TestK8SAPIExecPod(t *testing.T) {
+	c := k8smock.New()
+	api := newK8sAPI(c)
+
+	resp, err := api.ExecPod(context.Background(), &k8sapiv1.ExecPodRequest{Namespace: "ns", Name: "pod", Command: []string{"cat", "/etc/hosts"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "cat /etc/hosts\n", resp.Stdout)
+
+	debug, err := api.CreateDebugContainer(context.Background(), &k8sapiv1.CreateDebugContainerRequest{Namespace: "ns", Name: "pod", Image: "busybox"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, debug.ContainerName)
+}
+
+func TestK8SAPIUpdateSecret(t *testing.T) {
+	c := k8smock.New()
+	api := newK8sAPI(c)
+
+	req := &k8sapiv1.UpdateSecretRequest{
+		Namespace:                   "ns",
+		Name:                        "secret",
+		Data:                        map[string][]byte{"password": []byte("hunter2")},
+		RestartDependentDeployments: true,
+	}
+	resp, err := api.UpdateSecret(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "password", resp.Secret.Keys[0].Name)
+	assert.Equal(t, []string{"deployment1"}, resp.RestartedDeployments)
+
+	// Secret values never make it into the audit log.
+	assert.True(t, meta.IsRedacted(req))
+	body, err := meta.APIBody(req)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(body.Value), "hunter2")
+}
+
+type testAuthzClient struct {
+	checks []*authzv1.CheckRequest
+	allow  bool
+}
+
+func (c *testAuthzClient) Check(ctx context.Context, req *authzv1.CheckRequest) (*authzv1.CheckResponse, error) {
+	c.checks = append(c.checks, req)
+	if c.allow {
+		return &authzv1.CheckResponse{Decision: authzv1.Decision_ALLOW}, nil
+	}
+	return &authzv1.CheckResponse{Decision: authzv1.Decision_DENY}, nil
+}
+
+func TestK8SAPIAuthorizeRestart(t *testing.T) {
+	zclient := &testAuthzClient{}
+	api := &k8sAPI{k8s: k8smock.New(), authz: zclient}
+	ctx := authn.ContextWithClaims(context.Background(), &authn.Claims{
+		StandardClaims: &jwt.StandardClaims{Subject: "user@example.com"},
+		Groups:         []string{"team"},
+	})
+
+	req := &k8sapiv1.UpdateConfigMapRequest{Cluster: "cluster", Namespace: "ns", Name: "cm", RestartDependentDeployments: true}
+	_, err := api.UpdateConfigMap(ctx, req)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Len(t, zclient.checks, 1)
+	check := zclient.checks[0]
+	assert.Equal(t, "user@example.com", check.Subject.User)
+	assert.Equal(t, []string{"team"}, check.Subject.Groups)
+	assert.Equal(t, "/clutch.k8s.v1.K8sAPI/UpdateDeployment", check.Method)
+	assert.Equal(t, apiv1.ActionType_UPDATE, check.ActionType)
+	assert.Equal(t, "cluster/ns/deployment1", check.Resource)
+
+	zclient.allow = true
+	resp, err := api.UpdateSecret(ctx, &k8sapiv1.UpdateSecretRequest{Cluster: "cluster", Namespace: "ns", Name: "secret", RestartDependentDeployments: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"deployment1"}, resp.RestartedDeployments)
+
+	// Nothing is checked unless deployments are restarted.
+	zclient.checks = nil
+	_, err = api.UpdateConfigMap(ctx, &k8sapiv1.UpdateConfigMapRequest{Cluster: "cluster", Namespace: "ns", Name: "cm"})
+	assert.NoError(t, err)
+	assert.Empty(t, zclient.checks)
+}
+
+func TestRestartError(t *testing.T) {
+	resp := &k8sapiv1.UpdateConfigMapResponse{ConfigMap: &k8sapiv1.ConfigMap{Name: "cm"}, RestartedDeployments: []string{"a"}}
+	err := restartError(k8serrors.NewForbidden(schema.GroupResource{Resource: "deployments"}, "b", errors.New("denied")), resp)
+
+	s := status.Convert(err)
+	assert.Equal(t, codes.PermissionDenied, s.Code())
+	assert.Contains(t, s.Message(), "the update succeeded, but restarting the dependent deployments failed: ")
+	details := s.Details()
+	assert.Len(t, details, 2)
+	assert.IsType(t, &k8sapiv1.Status{}, details[0])
+	assert.True(t, proto.Equal(resp, details[1].(proto.Message)))
+}
+
+func TestK8SAPICronJobOperations(t *testing.T) {
+	c := k8smock.New()
+	api := newK8sAPI(c)
+
+	suspended, err := api.SuspendCronJob(context.Background(), &k8sapiv1.SuspendCronJobRequest{Namespace: "ns", Name: "cron"})
+	assert.NoError(t, err)
+	assert.True(t, suspended.CronJob.Suspend)
+
+	resumed, err := api.ResumeCronJob(context.Background(), &k8sapiv1.ResumeCronJobRequest{Namespace: "ns", Name: "cron"})
+	assert.NoError(t, err)
+	assert.False(t, resumed.CronJob.Suspend)
+
+	triggered, err := api.TriggerCronJob(context.Background(), &k8sapiv1.TriggerCronJobRequest{Namespace: "ns", Name: "cron"})
+	assert.NoError(t, err)
+	assert.Equal(t, "cron", triggered.Job.CronJobName)
+}
@@ -0,0 +1,49 @@
+package k8s
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+func (a *k8sAPI) ListAPIResources(ctx context.Context, req *k8sapiv1.ListAPIResourcesRequest) (*k8sapiv1.ListAPIResourcesResponse, error) {
+	resources, err := a.k8s.ListAPIResources(ctx, req.Clientset, req.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	return &k8sapiv1.ListAPIResourcesResponse{Resources: resources}, nil
+}
+
+func (a *k8sAPI) DescribeResource(ctx context.Context, req *k8sapiv1.DescribeResourceRequest) (*k8sapiv1.DescribeResourceResponse, error) {
+	gvk := schema.GroupVersionKind{Group: req.Group, Version: req.Version, Kind: req.Kind}
+	resource, err := a.k8s.DescribeResource(ctx, req.Clientset, req.Cluster, req.Namespace, gvk, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &k8sapiv1.DescribeResourceResponse{Resource: resource}, nil
+}
+
+func (a *k8sAPI) ListResources(ctx context.Context, req *k8sapiv1.ListResourcesRequest) (*k8sapiv1.ListResourcesResponse, error) {
+	gvk := schema.GroupVersionKind{Group: req.Group, Version: req.Version, Kind: req.Kind}
+	resources, err := a.k8s.ListResources(ctx, req.Clientset, req.Cluster, req.Namespace, gvk, req.Options)
+	if err != nil {
+		return nil, err
+	}
+	return &k8sapiv1.ListResourcesResponse{Resources: resources}, nil
+}
+
+func (a *k8sAPI) PatchResource(ctx context.Context, req *k8sapiv1.PatchResourceRequest) (*k8sapiv1.PatchResourceResponse, error) {
+	gvk := schema.GroupVersionKind{Group: req.Group, Version: req.Version, Kind: req.Kind}
+	return a.k8s.PatchResource(ctx, req.Clientset, req.Cluster, req.Namespace, gvk, req.Name, req.PatchType, req.Patch, req.DryRun, req.Force)
+}
+
+func (a *k8sAPI) DeleteResource(ctx context.Context, req *k8sapiv1.DeleteResourceRequest) (*k8sapiv1.DeleteResourceResponse, error) {
+	gvk := schema.GroupVersionKind{Group: req.Group, Version: req.Version, Kind: req.Kind}
+	err := a.k8s.DeleteResource(ctx, req.Clientset, req.Cluster, req.Namespace, gvk, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &k8sapiv1.DeleteResourceResponse{}, nil
+}
@@ -0,0 +1,35 @@
+package k8s
+
+import (
+	"context"
+
+	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+func (a *k8sAPI) ListSecrets(ctx context.Context, req *k8sapiv1.ListSecretsRequest) (*k8sapiv1.ListSecretsResponse, error) {
+	secrets, err := a.k8s.ListSecrets(ctx, req.Clientset, req.Cluster, req.Namespace, req.Options)
+	if err != nil {
+		return nil, err
+	}
+	return &k8sapiv1.ListSecretsResponse{Secrets: secrets}, nil
+}
+
+func (a *k8sAPI) DescribeSecret(ctx context.Context, req *k8sapiv1.DescribeSecretRequest) (*k8sapiv1.DescribeSecretResponse, error) {
+	secret, err := a.k8s.DescribeSecret(ctx, req.Clientset, req.Cluster, req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &k8sapiv1.DescribeSecretResponse{Secret: secret}, nil
+}
+
+func (a *k8sAPI) UpdateSecret(ctx context.Context, req *k8sapiv1.UpdateSecretRequest) (*k8sapiv1.UpdateSecretResponse, error) {
+	secret, restarted, err := a.k8s.UpdateSecret(ctx, req.Clientset, req.Cluster, req.Namespace, req.Name, req.Data, req.RemoveKeys, req.RestartDependentDeployments,
+		a.authorizeRestart(ctx, req.Cluster, req.Namespace))
+	if err != nil {
+		if secret != nil {
+			return nil, restartError(err, &k8sapiv1.UpdateSecretResponse{Secret: secret, RestartedDeployments: restarted})
+		}
+		return nil, err
+	}
+	return &k8sapiv1.UpdateSecretResponse{Secret: secret, RestartedDeployments: restarted}, nil
+}
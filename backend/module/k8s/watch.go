@@ -0,0 +1,40 @@
+package k8s
+
+import (
+	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+func (a *k8sAPI) WatchPods(req *k8sapiv1.WatchPodsRequest, stream k8sapiv1.K8SAPI_WatchPodsServer) error {
+	return a.k8s.WatchPods(stream.Context(), req.Clientset, req.Cluster, req.Namespace, req.Options, req.ResourceVersions,
+		func(event *k8sapiv1.WatchEvent, pod *k8sapiv1.Pod) error {
+			return stream.Send(&k8sapiv1.WatchPodsResponse{Event: event, Pod: pod})
+		})
+}
+
+func (a *k8sAPI) WatchDeployments(req *k8sapiv1.WatchDeploymentsRequest, stream k8sapiv1.K8SAPI_WatchDeploymentsServer) error {
+	return a.k8s.WatchDeployments(stream.Context(), req.Clientset, req.Cluster, req.Namespace, req.Options, req.ResourceVersions,
+		func(event *k8sapiv1.WatchEvent, deployment *k8sapiv1.Deployment) error {
+			return stream.Send(&k8sapiv1.WatchDeploymentsResponse{Event: event, Deployment: deployment})
+		})
+}
+
+func (a *k8sAPI) WatchHPAs(req *k8sapiv1.WatchHPAsRequest, stream k8sapiv1.K8SAPI_WatchHPAsServer) error {
+	return a.k8s.WatchHPAs(stream.Context(), req.Clientset, req.Cluster, req.Namespace, req.Options, req.ResourceVersions,
+		func(event *k8sapiv1.WatchEvent, hpa *k8sapiv1.HPA) error {
+			return stream.Send(&k8sapiv1.WatchHPAsResponse{Event: event, Hpa: hpa})
+		})
+}
+
+func (a *k8sAPI) WatchNodes(req *k8sapiv1.WatchNodesRequest, stream k8sapiv1.K8SAPI_WatchNodesServer) error {
+	return a.k8s.WatchNodes(stream.Context(), req.Clientset, req.Cluster, req.Options, req.ResourceVersions,
+		func(event *k8sapiv1.WatchEvent, node *k8sapiv1.Node) error {
+			return stream.Send(&k8sapiv1.WatchNodesResponse{Event: event, Node: node})
+		})
+}
+
+func (a *k8sAPI) WatchEvents(req *k8sapiv1.WatchEventsRequest, stream k8sapiv1.K8SAPI_WatchEventsServer) error {
+	return a.k8s.WatchEvents(stream.Context(), req.Clientset, req.Cluster, req.Namespace, req.ObjectName, req.Kind, req.Options, req.ResourceVersions,
+		func(event *k8sapiv1.WatchEvent, k8sEvent *k8sapiv1.Event) error {
+			return stream.Send(&k8sapiv1.WatchEventsResponse{Event: event, K8SEvent: k8sEvent})
+		})
+}
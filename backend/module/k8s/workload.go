@@ -0,0 +1,66 @@
+package k8s
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+const (
+	defaultRolloutTimeout = 10 * time.Minute
+
+	// Time left before the deadline of the method to report that the rollout timed out.
+	rolloutTimeoutMargin = time.Second
+)
+
+// rolloutTimeout returns how long to wait for the rollout. The wait ends before the deadline of the method, if any, so
+// that the progress and the timeout are reported rather than the method timing out.
+func rolloutTimeout(ctx context.Context, timeout *durationpb.Duration) time.Duration {
+	d := defaultRolloutTimeout
+	if timeout.AsDuration() > 0 {
+		d = timeout.AsDuration()
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline) - rolloutTimeoutMargin; remaining < d {
+			d = remaining
+		}
+	}
+	return d
+}
+
+// sendRollout sends the updated workload, or its progress until the rollout is complete if the request waits for it.
+func (a *k8sAPI) sendRollout(ctx context.Context, clientset, cluster, namespace string, workload *k8sapiv1.Workload, wait bool, timeout *durationpb.Duration, send func(*k8sapiv1.Workload) error) error {
+	if !wait {
+		return send(workload)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, rolloutTimeout(ctx, timeout))
+	defer cancel()
+
+	return a.k8s.WaitForRollout(ctx, clientset, cluster, namespace, workload.Name, workload.Kind, send)
+}
+
+func (a *k8sAPI) ScaleWorkload(req *k8sapiv1.ScaleWorkloadRequest, stream k8sapiv1.K8SAPI_ScaleWorkloadServer) error {
+	workload, err := a.k8s.ScaleWorkload(stream.Context(), req.Clientset, req.Cluster, req.Namespace, req.Name, req.Kind, req.Replicas)
+	if err != nil {
+		return err
+	}
+	return a.sendRollout(stream.Context(), req.Clientset, req.Cluster, req.Namespace, workload, req.WaitForRollout, req.RolloutTimeout,
+		func(workload *k8sapiv1.Workload) error {
+			return stream.Send(&k8sapiv1.ScaleWorkloadResponse{Workload: workload})
+		})
+}
+
+func (a *k8sAPI) SetContainerImage(req *k8sapiv1.SetContainerImageRequest, stream k8sapiv1.K8SAPI_SetContainerImageServer) error {
+	workload, err := a.k8s.SetContainerImage(stream.Context(), req.Clientset, req.Cluster, req.Namespace, req.Name, req.Kind, req.ContainerName, req.Image)
+	if err != nil {
+		return err
+	}
+	return a.sendRollout(stream.Context(), req.Clientset, req.Cluster, req.Namespace, workload, req.WaitForRollout, req.RolloutTimeout,
+		func(workload *k8sapiv1.Workload) error {
+			return stream.Send(&k8sapiv1.SetContainerImageResponse{Workload: workload})
+		})
+}
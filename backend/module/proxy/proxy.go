@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
@@ -16,6 +17,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	proxyv1cfg "github.com/lyft/clutch/backend/api/config/module/proxy/v1"
@@ -29,6 +31,22 @@ const (
 )
 
 func New(cfg *any.Any, log *zap.Logger, scope tally.Scope) (module.Module, error) {
+	services, err := servicesFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &mod{
+		client:   &http.Client{},
+		services: services,
+		logger:   log,
+		scope:    scope,
+	}
+
+	return m, nil
+}
+
+func servicesFromConfig(cfg *anypb.Any) ([]*proxyv1cfg.Service, error) {
 	config := &proxyv1cfg.Config{}
 	err := cfg.UnmarshalTo(config)
 	if err != nil {
@@ -44,22 +62,36 @@ func New(cfg *any.Any, log *zap.Logger, scope tally.Scope) (module.Module, error
 			}
 		}
 	}
-
-	m := &mod{
-		client:   &http.Client{},
-		services: config.Services,
-		logger:   log,
-		scope:    scope,
-	}
-
-	return m, nil
+	return config.Services, nil
 }
 
 type mod struct {
-	client   *http.Client
+	client *http.Client
+
+	mu       sync.RWMutex
 	services []*proxyv1cfg.Service
-	logger   *zap.Logger
-	scope    tally.Scope
+
+	logger *zap.Logger
+	scope  tally.Scope
+}
+
+// PrepareReload validates a new set of proxied services.
+func (m *mod) PrepareReload(cfg *anypb.Any) (func(), error) {
+	services, err := servicesFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		m.services = services
+	}, nil
+}
+
+func (m *mod) getServices() []*proxyv1cfg.Service {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.services
 }
 
 func (m *mod) Register(r module.Registrar) error {
@@ -68,7 +100,8 @@ func (m *mod) Register(r module.Registrar) error {
 }
 
 func (m *mod) RequestProxy(ctx context.Context, req *proxyv1.RequestProxyRequest) (*proxyv1.RequestProxyResponse, error) {
-	isAllowed, err := isAllowedRequest(m.services, req.Service, req.Path, req.HttpMethod)
+	services := m.getServices()
+	isAllowed, err := isAllowedRequest(services, req.Service, req.Path, req.HttpMethod)
 	if err != nil {
 		m.logger.Error("Unable to parse the configured URL", zap.Error(err))
 		return nil, fmt.Errorf("unable to parse the configured URL for service [%s]", req.Service)
@@ -80,7 +113,7 @@ func (m *mod) RequestProxy(ctx context.Context, req *proxyv1.RequestProxyRequest
 
 	// If its allowed lookup the service
 	var service *proxyv1cfg.Service
-	for _, s := range m.services {
+	for _, s := range services {
 		if s.Name == req.Service {
 			service = s
 		}
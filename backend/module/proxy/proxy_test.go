@@ -306,3 +306,16 @@ func TestAddExcludedHeaders(t *testing.T) {
 		assert.Equal(t, test.expected, req.Host)
 	}
 }
+
+func TestPrepareReload(t *testing.T) {
+	m := &mod{services: generateServicesConfig("http://test.test")}
+
+	cfg, _ := anypb.New(&proxyv1cfg.Config{Services: generateServicesConfig("http://other.test")[:1]})
+	apply, err := m.PrepareReload(cfg)
+	assert.NoError(t, err)
+	assert.Len(t, m.getServices(), 2)
+
+	apply()
+	assert.Len(t, m.getServices(), 1)
+	assert.Equal(t, "http://other.test", m.getServices()[0].Host)
+}
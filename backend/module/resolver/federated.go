@@ -0,0 +1,241 @@
+package resolver
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	resolverv1 "github.com/lyft/clutch/backend/api/resolver/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
+	"github.com/lyft/clutch/backend/resolver"
+)
+
+const (
+	defaultFederatedSearchTimeout = 10 * time.Second
+
+	// Resolvers return the results found so far when the deadline passes, so they're given a moment to do so before
+	// they're reported as failed.
+	federatedSearchGracePeriod = 500 * time.Millisecond
+)
+
+// How closely a result matches the query, lower is better.
+const (
+	matchExact = iota
+	matchPrefix
+	matchOther
+)
+
+// A type searched with one resolver.
+type searchTarget struct {
+	resolverName string
+	res          resolver.Resolver
+	typeURL      string
+}
+
+type searchGroup struct {
+	*resolverv1.FederatedSearchResponse_Group
+	rank int
+}
+
+func isSearchable(schemas []*resolverv1.Schema) bool {
+	for _, ss := range schemas {
+		if ss.Metadata.Searchable || (ss.Metadata.Search != nil && ss.Metadata.Search.Enabled) {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns every searchable type of every registered resolver, optionally limited to the wanted types.
+func federatedSearchTargets(want []string) []searchTarget {
+	wanted := make(map[string]bool, len(want))
+	for _, w := range want {
+		wanted[w] = true
+	}
+
+	var targets []searchTarget
+	for name, res := range resolver.Registry {
+		for typeURL, schemas := range res.Schemas() {
+			if len(wanted) > 0 && !wanted[typeURL] {
+				continue
+			}
+			if isSearchable(schemas) {
+				targets = append(targets, searchTarget{resolverName: name, res: res, typeURL: typeURL})
+			}
+		}
+	}
+	return targets
+}
+
+// matchRank compares the query to the ID and name of the result.
+func matchRank(m proto.Message, query string) int {
+	var candidates []string
+	if id, err := meta.HydratedPatternForProto(m); err == nil && id != "" {
+		candidates = append(candidates, id, id[strings.LastIndex(id, "/")+1:])
+	}
+	if fd := m.ProtoReflect().Descriptor().Fields().ByName("name"); fd != nil && fd.Kind() == protoreflect.StringKind {
+		candidates = append(candidates, m.ProtoReflect().Get(fd).String())
+	}
+
+	rank := matchOther
+	for _, c := range candidates {
+		switch {
+		case strings.EqualFold(c, query):
+			return matchExact
+		case c != "" && strings.HasPrefix(strings.ToLower(c), strings.ToLower(query)):
+			rank = matchPrefix
+		}
+	}
+	return rank
+}
+
+// Searches one target, returning nil if the query doesn't apply to the type or nothing was found.
+func searchOne(ctx context.Context, t searchTarget, query string, limit uint32) (*searchGroup, error) {
+	results, err := t.res.Search(ctx, t.typeURL, query, limit)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument, codes.NotFound:
+			// The query isn't in a format the type can be searched by, or there were no matches.
+			return nil, nil
+		}
+		return &searchGroup{
+			FederatedSearchResponse_Group: &resolverv1.FederatedSearchResponse_Group{
+				TypeUrl:         t.typeURL,
+				Resolver:        t.resolverName,
+				PartialFailures: []*statuspb.Status{status.Convert(err).Proto()},
+			},
+			rank: matchOther,
+		}, nil
+	}
+
+	// A resource usually exists in only some clusters or regions, so not finding it in the others isn't a failure.
+	var failures []*statuspb.Status
+	for _, f := range results.PartialFailures {
+		if f.Code() != codes.NotFound {
+			failures = append(failures, f.Proto())
+		}
+	}
+	if len(results.Messages) == 0 && len(failures) == 0 {
+		return nil, nil
+	}
+
+	ranks := make([]int, len(results.Messages))
+	order := make([]int, len(results.Messages))
+	for i, m := range results.Messages {
+		ranks[i] = matchRank(m, query)
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return ranks[order[i]] < ranks[order[j]] })
+
+	g := &searchGroup{
+		FederatedSearchResponse_Group: &resolverv1.FederatedSearchResponse_Group{
+			TypeUrl:         t.typeURL,
+			Resolver:        t.resolverName,
+			Results:         make([]*anypb.Any, 0, len(results.Messages)),
+			PartialFailures: failures,
+		},
+		rank: matchOther,
+	}
+	for _, i := range order {
+		asAny, err := anypb.New(results.Messages[i])
+		if err != nil {
+			return nil, err
+		}
+		g.Results = append(g.Results, asAny)
+		if ranks[i] < g.rank {
+			g.rank = ranks[i]
+		}
+	}
+	return g, nil
+}
+
+// FederatedSearch searches every searchable type of every resolver at once. Resolvers fan out across clientsets,
+// accounts and regions with their FanoutHandler, which returns the results found so far once the deadline passes.
+func (r *resolverAPI) FederatedSearch(ctx context.Context, req *resolverv1.FederatedSearchRequest) (*resolverv1.FederatedSearchResponse, error) {
+	targets := federatedSearchTargets(req.Want)
+	if len(targets) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no searchable types were found")
+	}
+
+	timeout := defaultFederatedSearchTimeout
+	if req.Timeout != nil {
+		timeout = req.Timeout.AsDuration()
+	}
+	searchCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	deadline := time.NewTimer(timeout + federatedSearchGracePeriod)
+	defer deadline.Stop()
+
+	type searchResult struct {
+		target int
+		group  *searchGroup
+		err    error
+	}
+	// Buffered so that searches that outlive the deadline don't block.
+	ch := make(chan searchResult, len(targets))
+	pending := make(map[int]bool, len(targets))
+	for i, t := range targets {
+		pending[i] = true
+		go func(i int, t searchTarget) {
+			g, err := searchOne(searchCtx, t, req.Query, req.Limit)
+			ch <- searchResult{target: i, group: g, err: err}
+		}(i, t)
+	}
+
+	var groups []*searchGroup
+	for len(pending) > 0 {
+		select {
+		case result := <-ch:
+			delete(pending, result.target)
+			if result.err != nil {
+				return nil, result.err
+			}
+			if result.group != nil {
+				groups = append(groups, result.group)
+			}
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		case <-deadline.C:
+			// Resolvers that didn't return in time are reported as failures.
+			for i := range pending {
+				t := targets[i]
+				groups = append(groups, &searchGroup{
+					FederatedSearchResponse_Group: &resolverv1.FederatedSearchResponse_Group{
+						TypeUrl:  t.typeURL,
+						Resolver: t.resolverName,
+						PartialFailures: []*statuspb.Status{
+							status.New(codes.DeadlineExceeded, "search did not complete before the deadline").Proto(),
+						},
+					},
+					rank: matchOther,
+				})
+			}
+			pending = nil
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		a, b := groups[i], groups[j]
+		if a.rank != b.rank {
+			return a.rank < b.rank
+		}
+		if a.TypeUrl != b.TypeUrl {
+			return a.TypeUrl < b.TypeUrl
+		}
+		return a.Resolver < b.Resolver
+	})
+
+	resp := &resolverv1.FederatedSearchResponse{Groups: make([]*resolverv1.FederatedSearchResponse_Group, len(groups))}
+	for i, g := range groups {
+		resp.Groups[i] = g.FederatedSearchResponse_Group
+	}
+	return resp, nil
+}
@@ -0,0 +1,171 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	k8sv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+	resolverv1 "github.com/lyft/clutch/backend/api/resolver/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
+	"github.com/lyft/clutch/backend/resolver"
+)
+
+type fakeSearchResolver struct {
+	resolver.Resolver
+
+	schemas resolver.TypeURLToSchemasMap
+	search  func(ctx context.Context, typeURL, query string) (*resolver.Results, error)
+}
+
+func (f *fakeSearchResolver) Schemas() resolver.TypeURLToSchemasMap { return f.schemas }
+
+func (f *fakeSearchResolver) Search(ctx context.Context, typeURL, query string, limit uint32) (*resolver.Results, error) {
+	return f.search(ctx, typeURL, query)
+}
+
+func searchableSchemas(typeURLs ...string) resolver.TypeURLToSchemasMap {
+	ret := resolver.TypeURLToSchemasMap{}
+	for _, typeURL := range typeURLs {
+		ret[typeURL] = []*resolverv1.Schema{
+			{Metadata: &resolverv1.SchemaMetadata{Search: &resolverv1.SearchMetadata{Enabled: true}}},
+		}
+	}
+	return ret
+}
+
+func withResolvers(t *testing.T, resolvers map[string]resolver.Resolver) {
+	original := resolver.Registry
+	resolver.Registry = resolvers
+	t.Cleanup(func() { resolver.Registry = original })
+}
+
+func TestFederatedSearch(t *testing.T) {
+	typeURLDeployment := meta.TypeURL((*k8sv1.Deployment)(nil))
+	typeURLService := meta.TypeURL((*k8sv1.Service)(nil))
+	typeURLPod := meta.TypeURL((*k8sv1.Pod)(nil))
+	typeURLNode := meta.TypeURL((*k8sv1.Node)(nil))
+
+	withResolvers(t, map[string]resolver.Resolver{
+		"k8s": &fakeSearchResolver{
+			schemas: searchableSchemas(typeURLDeployment, typeURLService, typeURLPod, typeURLNode),
+			search: func(ctx context.Context, typeURL, query string) (*resolver.Results, error) {
+				switch typeURL {
+				case typeURLDeployment:
+					return &resolver.Results{
+						Messages: []proto.Message{
+							&k8sv1.Deployment{Cluster: "c", Namespace: "ns", Name: "checkout-service-canary"},
+							&k8sv1.Deployment{Cluster: "c", Namespace: "ns", Name: "checkout-service"},
+						},
+						PartialFailures: []*status.Status{
+							status.New(codes.NotFound, "not found"),
+							status.New(codes.Unavailable, "cluster is unreachable"),
+						},
+					}, nil
+				case typeURLService:
+					return &resolver.Results{Messages: []proto.Message{
+						&k8sv1.Service{Cluster: "c", Namespace: "ns", Name: "checkout-service-internal"},
+					}}, nil
+				case typeURLPod:
+					return nil, status.Error(codes.NotFound, "not found")
+				default:
+					return nil, status.Error(codes.InvalidArgument, "did not understand input")
+				}
+			},
+		},
+		"aws": &fakeSearchResolver{
+			schemas: searchableSchemas("type.googleapis.com/clutch.aws.ec2.v1.AutoscalingGroup"),
+			search: func(ctx context.Context, typeURL, query string) (*resolver.Results, error) {
+				return nil, errors.New("boom")
+			},
+		},
+	})
+
+	api := newAPI().(*resolverAPI)
+	resp, err := api.FederatedSearch(context.Background(), &resolverv1.FederatedSearchRequest{Query: "checkout-service"})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Groups, 3)
+
+	// Exact matches come first.
+	deployments := resp.Groups[0]
+	assert.Equal(t, typeURLDeployment, deployments.TypeUrl)
+	assert.Equal(t, "k8s", deployments.Resolver)
+	assert.Len(t, deployments.Results, 2)
+	first := &k8sv1.Deployment{}
+	assert.NoError(t, deployments.Results[0].UnmarshalTo(first))
+	assert.Equal(t, "checkout-service", first.Name)
+	assert.Len(t, deployments.PartialFailures, 1)
+	assert.Equal(t, int32(codes.Unavailable), deployments.PartialFailures[0].Code)
+
+	assert.Equal(t, typeURLService, resp.Groups[1].TypeUrl)
+	assert.Len(t, resp.Groups[1].Results, 1)
+
+	failed := resp.Groups[2]
+	assert.Equal(t, "aws", failed.Resolver)
+	assert.Empty(t, failed.Results)
+	assert.Len(t, failed.PartialFailures, 1)
+
+	// Limited to the wanted types.
+	resp, err = api.FederatedSearch(context.Background(), &resolverv1.FederatedSearchRequest{
+		Query: "checkout-service",
+		Want:  []string{typeURLService},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Groups, 1)
+	assert.Equal(t, typeURLService, resp.Groups[0].TypeUrl)
+
+	_, err = api.FederatedSearch(context.Background(), &resolverv1.FederatedSearchRequest{
+		Query: "checkout-service",
+		Want:  []string{"type.googleapis.com/foo"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestFederatedSearchDeadline(t *testing.T) {
+	withResolvers(t, map[string]resolver.Resolver{
+		"slow": &fakeSearchResolver{
+			schemas: searchableSchemas("type.googleapis.com/slow"),
+			search: func(ctx context.Context, typeURL, query string) (*resolver.Results, error) {
+				// Ignores the deadline.
+				time.Sleep(time.Second)
+				return &resolver.Results{}, nil
+			},
+		},
+		"fast": &fakeSearchResolver{
+			schemas: searchableSchemas("type.googleapis.com/fast"),
+			search: func(ctx context.Context, typeURL, query string) (*resolver.Results, error) {
+				<-ctx.Done()
+				return &resolver.Results{Messages: []proto.Message{&k8sv1.Node{Name: "foo"}}}, nil
+			},
+		},
+	})
+
+	api := newAPI().(*resolverAPI)
+	resp, err := api.FederatedSearch(context.Background(), &resolverv1.FederatedSearchRequest{
+		Query:   "foo",
+		Timeout: durationpb.New(10 * time.Millisecond),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, resp.Groups, 2)
+
+	// Results found when the deadline passed are returned.
+	assert.Equal(t, "fast", resp.Groups[0].Resolver)
+	assert.Len(t, resp.Groups[0].Results, 1)
+
+	assert.Equal(t, "slow", resp.Groups[1].Resolver)
+	assert.Equal(t, int32(codes.DeadlineExceeded), resp.Groups[1].PartialFailures[0].Code)
+}
+
+func TestMatchRank(t *testing.T) {
+	assert.Equal(t, matchExact, matchRank(&k8sv1.Deployment{Cluster: "c", Namespace: "ns", Name: "foo"}, "FOO"))
+	assert.Equal(t, matchExact, matchRank(&k8sv1.Deployment{Cluster: "c", Namespace: "ns", Name: "foo"}, "c/ns/foo"))
+	assert.Equal(t, matchPrefix, matchRank(&k8sv1.Deployment{Cluster: "c", Namespace: "ns", Name: "foo-canary"}, "foo"))
+	assert.Equal(t, matchOther, matchRank(&k8sv1.Deployment{Cluster: "c", Namespace: "ns", Name: "my-foo"}, "foo"))
+}
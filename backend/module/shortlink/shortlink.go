@@ -56,24 +56,57 @@ func newShortlinkAPI(svc shortlink.Service) shortlinkv1.ShortlinkAPIServer {
 }
 
 func (s *shortlinkAPI) Create(ctx context.Context, req *shortlinkv1.CreateRequest) (*shortlinkv1.CreateResponse, error) {
-	hash, err := s.shortlink.Create(ctx, req.Path, req.State)
+	shortlink, err := s.shortlink.Create(ctx, req.Path, req.State, req.Ttl.AsDuration())
 	if err != nil {
 		return nil, err
 	}
 
 	return &shortlinkv1.CreateResponse{
-		Hash: hash,
+		Hash:      shortlink.Hash,
+		Shortlink: shortlink,
 	}, nil
 }
 
 func (s *shortlinkAPI) Get(ctx context.Context, req *shortlinkv1.GetRequest) (*shortlinkv1.GetResponse, error) {
-	path, state, err := s.shortlink.Get(ctx, req.Hash)
+	shortlink, state, err := s.shortlink.Get(ctx, req.Hash)
 	if err != nil {
 		return nil, err
 	}
 
 	return &shortlinkv1.GetResponse{
-		Path:  path,
-		State: state,
+		Path:      shortlink.Path,
+		State:     state,
+		Shortlink: shortlink,
+	}, nil
+}
+
+func (s *shortlinkAPI) List(ctx context.Context, req *shortlinkv1.ListRequest) (*shortlinkv1.ListResponse, error) {
+	shortlinks, nextPageToken, err := s.shortlink.List(ctx, req.Owner, req.PageToken, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &shortlinkv1.ListResponse{
+		Shortlinks:    shortlinks,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *shortlinkAPI) Delete(ctx context.Context, req *shortlinkv1.DeleteRequest) (*shortlinkv1.DeleteResponse, error) {
+	if err := s.shortlink.Delete(ctx, req.Hash); err != nil {
+		return nil, err
+	}
+
+	return &shortlinkv1.DeleteResponse{}, nil
+}
+
+func (s *shortlinkAPI) Extend(ctx context.Context, req *shortlinkv1.ExtendRequest) (*shortlinkv1.ExtendResponse, error) {
+	shortlink, err := s.shortlink.Extend(ctx, req.Hash, req.Ttl.AsDuration())
+	if err != nil {
+		return nil, err
+	}
+
+	return &shortlinkv1.ExtendResponse{
+		Shortlink: shortlink,
 	}, nil
 }
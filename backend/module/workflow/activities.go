@@ -0,0 +1,234 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+
+	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
+	ec2v1 "github.com/lyft/clutch/backend/api/aws/ec2/v1"
+	k8sv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+	workflowv1 "github.com/lyft/clutch/backend/api/workflow/v1"
+	"github.com/lyft/clutch/backend/service/audit"
+	"github.com/lyft/clutch/backend/service/aws"
+	"github.com/lyft/clutch/backend/service/k8s"
+)
+
+const (
+	notFoundErrorType   = "NotFound"
+	notInGroupErrorType = "NotInGroup"
+	invalidURLErrorType = "InvalidURL"
+	hostNotAllowedType  = "HostNotAllowed"
+)
+
+// How often activities that wait for a resource poll it.
+var pollInterval = 10 * time.Second
+
+// Used to reference the activities in workflows, which only need the activity names.
+var act *activities
+
+// activities are the steps of the workflows that interact with other services. Services that aren't configured are
+// nil, and the activities that need them fail.
+type activities struct {
+	k8s   k8s.Service
+	aws   aws.Client
+	audit audit.Auditor
+	http  *http.Client
+	// The hosts that Verify may request, either a host name or a host name and port.
+	verifyHosts map[string]bool
+}
+
+func errServiceNotConfigured(name string) error {
+	return temporal.NewNonRetryableApplicationError(fmt.Sprintf("the %s service is not configured", name), "ServiceNotConfigured", nil)
+}
+
+// poll calls fn until it returns true or an error, heartbeating in between so that the workflow notices if the
+// worker goes away.
+func poll(ctx context.Context, fn func() (bool, error)) error {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		ok, err := fn()
+		if err != nil || ok {
+			return err
+		}
+
+		activity.RecordHeartbeat(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *activities) ResizeHPA(ctx context.Context, in *workflowv1.HPARollout) error {
+	if a.k8s == nil {
+		return errServiceNotConfigured(k8s.Name)
+	}
+	return a.k8s.ResizeHPA(ctx, in.Clientset, in.Cluster, in.Namespace, in.Name, in.Sizing)
+}
+
+// WaitForHPA waits until the HPA has the number of replicas it wants, within its new bounds.
+func (a *activities) WaitForHPA(ctx context.Context, in *workflowv1.HPARollout) error {
+	if a.k8s == nil {
+		return errServiceNotConfigured(k8s.Name)
+	}
+	return poll(ctx, func() (bool, error) {
+		hpa, err := a.k8s.DescribeHPA(ctx, in.Clientset, in.Cluster, in.Namespace, in.Name)
+		if err != nil {
+			return false, err
+		}
+		return hpaReady(hpa, in.Sizing), nil
+	})
+}
+
+func hpaReady(hpa *k8sv1.HPA, sizing *k8sv1.ResizeHPARequest_Sizing) bool {
+	s := hpa.GetSizing()
+	return s.GetMinReplicas() == sizing.Min && s.GetMaxReplicas() == sizing.Max &&
+		s.GetCurrentReplicas() == s.GetDesiredReplicas() &&
+		s.GetCurrentReplicas() >= sizing.Min && s.GetCurrentReplicas() <= sizing.Max
+}
+
+// Verify fails unless the URL returns a 2xx status.
+func (a *activities) Verify(ctx context.Context, rawURL string) error {
+	u, err := a.checkVerifyURL(rawURL)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), invalidURLErrorType, err)
+	}
+
+	resp, err := a.http.Do(req)
+	if err != nil {
+		var appErr *temporal.ApplicationError
+		if errors.As(err, &appErr) {
+			return appErr
+		}
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("verification returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// checkVerifyURL only allows requests to the configured hosts, so that verification can't be used to reach other
+// services from the worker.
+func (a *activities) checkVerifyURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), invalidURLErrorType, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("unsupported verify URL scheme '%s'", u.Scheme), invalidURLErrorType, nil)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	if !a.verifyHosts[host] && !a.verifyHosts[strings.ToLower(u.Host)] {
+		return nil, temporal.NewNonRetryableApplicationError(fmt.Sprintf("verify URL host '%s' is not allowed", u.Host), hostNotAllowedType, nil)
+	}
+	return u, nil
+}
+
+// checkRedirect re-checks the target of each redirect against the allowed hosts.
+func (a *activities) checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= 10 {
+		return errors.New("stopped after 10 redirects")
+	}
+	_, err := a.checkVerifyURL(req.URL.String())
+	return err
+}
+
+// checkInstancesInGroup fails unless all the instances are members of the autoscaling group, so that being allowed to
+// replace the instances of one group doesn't allow terminating any instance.
+func (a *activities) checkInstancesInGroup(ctx context.Context, account, region, group string, ids []string) error {
+	groups, err := a.aws.DescribeAutoscalingGroups(ctx, account, region, []string{group})
+	if err != nil {
+		return err
+	}
+	if len(groups) != 1 {
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("autoscaling group '%s' was not found", group), notFoundErrorType, nil)
+	}
+
+	members := make(map[string]bool, len(groups[0].Instances))
+	for _, i := range groups[0].Instances {
+		members[i.Id] = true
+	}
+	var outside []string
+	for _, id := range ids {
+		if !members[id] {
+			outside = append(outside, id)
+		}
+	}
+	if len(outside) > 0 {
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("instances %s are not members of autoscaling group '%s'", strings.Join(outside, ", "), group), notInGroupErrorType, nil)
+	}
+	return nil
+}
+
+// TerminateInstances terminates instances of the autoscaling group, checking that they are still members first.
+func (a *activities) TerminateInstances(ctx context.Context, account, region, group string, ids []string) error {
+	if a.aws == nil {
+		return errServiceNotConfigured(aws.Name)
+	}
+	if err := a.checkInstancesInGroup(ctx, account, region, group, ids); err != nil {
+		return err
+	}
+	return a.aws.TerminateInstances(ctx, account, region, ids)
+}
+
+// WaitForAutoscalingGroup waits until the group has as many healthy instances in service as it desires, not counting
+// the instances that were terminated.
+func (a *activities) WaitForAutoscalingGroup(ctx context.Context, account, region, name string, terminated []string) error {
+	if a.aws == nil {
+		return errServiceNotConfigured(aws.Name)
+	}
+
+	excluded := make(map[string]bool, len(terminated))
+	for _, id := range terminated {
+		excluded[id] = true
+	}
+
+	return poll(ctx, func() (bool, error) {
+		groups, err := a.aws.DescribeAutoscalingGroups(ctx, account, region, []string{name})
+		if err != nil {
+			return false, err
+		}
+		if len(groups) != 1 {
+			return false, temporal.NewNonRetryableApplicationError(
+				fmt.Sprintf("autoscaling group '%s' was not found", name), notFoundErrorType, nil)
+		}
+
+		var ready uint32
+		for _, i := range groups[0].Instances {
+			if !excluded[i.Id] && i.Healthy && i.LifecycleState == ec2v1.AutoscalingGroup_Instance_IN_SERVICE {
+				ready++
+			}
+		}
+		return ready >= groups[0].GetSize().GetDesired(), nil
+	})
+}
+
+func (a *activities) WriteAuditEvent(ctx context.Context, event *auditv1.RequestEvent) error {
+	if a.audit == nil {
+		return nil
+	}
+	_, err := a.audit.WriteRequestEvent(ctx, event)
+	return err
+}
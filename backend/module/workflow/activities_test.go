@@ -0,0 +1,85 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.temporal.io/sdk/temporal"
+
+	k8sv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+func TestHPAReady(t *testing.T) {
+	sizing := &k8sv1.ResizeHPARequest_Sizing{Min: 2, Max: 5}
+
+	testCases := []struct {
+		sizing *k8sv1.HPA_Sizing
+		expect bool
+	}{
+		{sizing: &k8sv1.HPA_Sizing{MinReplicas: 2, MaxReplicas: 5, CurrentReplicas: 3, DesiredReplicas: 3}, expect: true},
+		{sizing: &k8sv1.HPA_Sizing{MinReplicas: 2, MaxReplicas: 5, CurrentReplicas: 1, DesiredReplicas: 2}},
+		{sizing: &k8sv1.HPA_Sizing{MinReplicas: 2, MaxReplicas: 5, CurrentReplicas: 1, DesiredReplicas: 1}},
+		{sizing: &k8sv1.HPA_Sizing{MinReplicas: 1, MaxReplicas: 5, CurrentReplicas: 3, DesiredReplicas: 3}},
+		{},
+	}
+	for _, tt := range testCases {
+		assert.Equal(t, tt.expect, hpaReady(&k8sv1.HPA{Sizing: tt.sizing}, sizing), tt.sizing.String())
+	}
+}
+
+func TestVerify(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ok":
+		case "/redirect":
+			http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+		case "/redirect-ok":
+			http.Redirect(w, r, "/ok", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	u, _ := url.Parse(srv.URL)
+	a := &activities{verifyHosts: map[string]bool{u.Hostname(): true}}
+	a.http = &http.Client{CheckRedirect: a.checkRedirect}
+
+	assert.NoError(t, a.Verify(context.Background(), srv.URL+"/ok"))
+	assert.NoError(t, a.Verify(context.Background(), srv.URL+"/redirect-ok"))
+	assert.EqualError(t, a.Verify(context.Background(), srv.URL+"/metrics"), "verification returned status 503")
+	assert.Error(t, a.Verify(context.Background(), "://"))
+
+	// Only the allowed hosts are requested, including after redirects.
+	var appErr *temporal.ApplicationError
+	err := a.Verify(context.Background(), srv.URL+"/redirect")
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, hostNotAllowedType, appErr.Type())
+	assert.True(t, appErr.NonRetryable())
+
+	err = a.Verify(context.Background(), "http://localhost/ok")
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, hostNotAllowedType, appErr.Type())
+
+	err = a.Verify(context.Background(), "file:///etc/passwd")
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, invalidURLErrorType, appErr.Type())
+
+	// Entries with a port only allow that port.
+	a.verifyHosts = map[string]bool{u.Hostname() + ":1": true}
+	err = a.Verify(context.Background(), srv.URL+"/ok")
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, hostNotAllowedType, appErr.Type())
+	a.verifyHosts = map[string]bool{u.Host: true}
+	assert.NoError(t, a.Verify(context.Background(), srv.URL+"/ok"))
+}
+
+func TestWriteAuditEventWithoutAuditor(t *testing.T) {
+	a := &activities{}
+	assert.NoError(t, a.WriteAuditEvent(context.Background(), nil))
+}
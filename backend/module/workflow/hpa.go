@@ -0,0 +1,71 @@
+package workflow
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
+	workflowv1 "github.com/lyft/clutch/backend/api/workflow/v1"
+)
+
+const (
+	defaultHPAReadyTimeout = 10 * time.Minute
+
+	resizeHPAStep  = "resize_hpa"
+	waitForHPAStep = "wait_for_ready"
+	verifyStep     = "verify"
+)
+
+// hpaRolloutWorkflow resizes an HPA, waits for it to scale and verifies the result.
+func hpaRolloutWorkflow(ctx workflow.Context, in *workflowv1.HPARollout, startedBy string) error {
+	steps := []string{resizeHPAStep, waitForHPAStep}
+	if in.RequireApproval {
+		steps = append([]string{approvalStep}, steps...)
+	}
+	if in.VerifyUrl != "" {
+		steps = append(steps, verifyStep)
+	}
+
+	r, err := newRunner(ctx, in, startedBy, steps...)
+	if err != nil {
+		return err
+	}
+	defer r.skipRemaining()
+
+	if in.RequireApproval {
+		if err := r.awaitApproval(); err != nil {
+			return err
+		}
+	}
+
+	err = r.runStep(resizeHPAStep, apiv1.ActionType_UPDATE, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: 3},
+	}, act.ResizeHPA, in)
+	if err != nil {
+		return err
+	}
+
+	readyTimeout := defaultHPAReadyTimeout
+	if in.ReadyTimeout != nil {
+		readyTimeout = in.ReadyTimeout.AsDuration()
+	}
+	err = r.runStep(waitForHPAStep, apiv1.ActionType_READ, workflow.ActivityOptions{
+		StartToCloseTimeout: readyTimeout,
+		HeartbeatTimeout:    heartbeatTimeout,
+		RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: 1},
+	}, act.WaitForHPA, in)
+	if err != nil {
+		return err
+	}
+
+	if in.VerifyUrl != "" {
+		return r.runStep(verifyStep, apiv1.ActionType_READ, workflow.ActivityOptions{
+			StartToCloseTimeout: time.Minute,
+			RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: 3},
+		}, act.Verify, in.VerifyUrl)
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+
+	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
+	k8sv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+	workflowv1 "github.com/lyft/clutch/backend/api/workflow/v1"
+	"github.com/lyft/clutch/backend/service/audit"
+	"github.com/lyft/clutch/backend/service/k8s"
+)
+
+type fakeAuditor struct {
+	audit.Auditor
+
+	mu     sync.Mutex
+	events []*auditv1.RequestEvent
+}
+
+func (f *fakeAuditor) WriteRequestEvent(ctx context.Context, event *auditv1.RequestEvent) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = append(f.events, event)
+	return int64(len(f.events)), nil
+}
+
+// Methods are the steps that were audited, with their status codes.
+func (f *fakeAuditor) steps() map[string]int32 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ret := make(map[string]int32, len(f.events))
+	for _, e := range f.events {
+		ret[e.MethodName] = e.Status.Code
+	}
+	return ret
+}
+
+// fakeHPA reaches the requested size after a number of polls.
+type fakeHPA struct {
+	k8s.Service
+
+	mu         sync.Mutex
+	sizing     *k8sv1.HPA_Sizing
+	pollsLeft  int
+	resizeErr  error
+	neverReady bool
+}
+
+func (f *fakeHPA) ResizeHPA(ctx context.Context, clientset, cluster, namespace, name string, sizing *k8sv1.ResizeHPARequest_Sizing) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.resizeErr != nil {
+		return f.resizeErr
+	}
+	f.sizing = &k8sv1.HPA_Sizing{MinReplicas: sizing.Min, MaxReplicas: sizing.Max, CurrentReplicas: 1, DesiredReplicas: sizing.Min}
+	return nil
+}
+
+func (f *fakeHPA) DescribeHPA(ctx context.Context, clientset, cluster, namespace, name string) (*k8sv1.HPA, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.pollsLeft > 0 || f.neverReady {
+		f.pollsLeft--
+	} else {
+		f.sizing.CurrentReplicas = f.sizing.DesiredReplicas
+	}
+	return &k8sv1.HPA{Name: name, Sizing: f.sizing}, nil
+}
+
+func newTestEnv(t *testing.T, acts *activities) *testsuite.TestWorkflowEnvironment {
+	pollInterval = time.Millisecond
+
+	s := &testsuite.WorkflowTestSuite{}
+	env := s.NewTestWorkflowEnvironment()
+	for name, fn := range workflows {
+		env.RegisterWorkflowWithOptions(fn, workflow.RegisterOptions{Name: name})
+	}
+	env.RegisterActivity(acts)
+	return env
+}
+
+func stepStates(t *testing.T, env *testsuite.TestWorkflowEnvironment) (map[string]workflowv1.Step_State, *workflowv1.Progress) {
+	value, err := env.QueryWorkflow(progressQuery)
+	assert.NoError(t, err)
+	progress := &workflowv1.Progress{}
+	assert.NoError(t, value.Get(&progress))
+
+	states := make(map[string]workflowv1.Step_State, len(progress.Steps))
+	for _, s := range progress.Steps {
+		states[s.Name] = s.State
+	}
+	return states, progress
+}
+
+func testHPARollout() *workflowv1.HPARollout {
+	return &workflowv1.HPARollout{
+		Clientset: "cs",
+		Cluster:   "c",
+		Namespace: "ns",
+		Name:      "hpa1",
+		Sizing:    &k8sv1.ResizeHPARequest_Sizing{Min: 2, Max: 5},
+	}
+}
+
+func TestHPARollout(t *testing.T) {
+	auditor := &fakeAuditor{}
+	env := newTestEnv(t, &activities{k8s: &fakeHPA{pollsLeft: 3}, audit: auditor})
+
+	env.ExecuteWorkflow(hpaRolloutType, testHPARollout(), "user@example.com")
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	states, progress := stepStates(t, env)
+	assert.Equal(t, map[string]workflowv1.Step_State{
+		resizeHPAStep:  workflowv1.Step_SUCCEEDED,
+		waitForHPAStep: workflowv1.Step_SUCCEEDED,
+	}, states)
+	assert.False(t, progress.AwaitingApproval)
+	assert.NotNil(t, progress.Steps[0].StartTime)
+	assert.NotNil(t, progress.Steps[0].EndTime)
+
+	assert.Equal(t, map[string]int32{resizeHPAStep: 0, waitForHPAStep: 0}, auditor.steps())
+	event := auditor.events[0]
+	assert.Equal(t, "user@example.com", event.Username)
+	assert.Equal(t, hpaRolloutType, event.ServiceName)
+	assert.Equal(t, "c/ns/hpa1", event.Resources[0].Id)
+	assert.Equal(t, "clutch.k8s.v1.HPA", event.Resources[0].TypeUrl)
+}
+
+func TestHPARolloutFailure(t *testing.T) {
+	auditor := &fakeAuditor{}
+	env := newTestEnv(t, &activities{k8s: &fakeHPA{resizeErr: errors.New("forbidden")}, audit: auditor})
+
+	in := testHPARollout()
+	in.VerifyUrl = "http://localhost/verify"
+	env.ExecuteWorkflow(hpaRolloutType, in, "user@example.com")
+	assert.Error(t, env.GetWorkflowError())
+
+	states, progress := stepStates(t, env)
+	assert.Equal(t, map[string]workflowv1.Step_State{
+		resizeHPAStep:  workflowv1.Step_FAILED,
+		waitForHPAStep: workflowv1.Step_CANCELED,
+		verifyStep:     workflowv1.Step_CANCELED,
+	}, states)
+	assert.Equal(t, "forbidden", progress.Steps[0].Message)
+
+	// Steps that didn't run aren't audited.
+	assert.Equal(t, map[string]int32{resizeHPAStep: 13}, auditor.steps())
+}
+
+func TestHPARolloutApproval(t *testing.T) {
+	auditor := &fakeAuditor{}
+	env := newTestEnv(t, &activities{k8s: &fakeHPA{}, audit: auditor})
+
+	env.RegisterDelayedCallback(func() {
+		states, progress := stepStates(t, env)
+		assert.True(t, progress.AwaitingApproval)
+		assert.Equal(t, workflowv1.Step_RUNNING, states[approvalStep])
+		assert.Equal(t, workflowv1.Step_PENDING, states[resizeHPAStep])
+
+		env.SignalWorkflow(signalChannel, &workflowv1.Signal{Type: workflowv1.Signal_APPROVE, Username: "approver@example.com"})
+	}, time.Hour)
+
+	in := testHPARollout()
+	in.RequireApproval = true
+	env.ExecuteWorkflow(hpaRolloutType, in, "user@example.com")
+	assert.NoError(t, env.GetWorkflowError())
+
+	states, progress := stepStates(t, env)
+	assert.False(t, progress.AwaitingApproval)
+	assert.Equal(t, approvalStep, progress.Steps[0].Name)
+	assert.Equal(t, "approved by approver@example.com", progress.Steps[0].Message)
+	assert.Equal(t, workflowv1.Step_SUCCEEDED, states[approvalStep])
+	assert.Equal(t, workflowv1.Step_SUCCEEDED, states[waitForHPAStep])
+	assert.Len(t, auditor.steps(), 3)
+}
+
+func TestHPARolloutSelfApproval(t *testing.T) {
+	auditor := &fakeAuditor{}
+	env := newTestEnv(t, &activities{k8s: &fakeHPA{}, audit: auditor})
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(signalChannel, &workflowv1.Signal{Type: workflowv1.Signal_APPROVE, Username: "user@example.com"})
+	}, time.Hour)
+	env.RegisterDelayedCallback(func() {
+		_, progress := stepStates(t, env)
+		assert.True(t, progress.AwaitingApproval)
+
+		env.SignalWorkflow(signalChannel, &workflowv1.Signal{Type: workflowv1.Signal_APPROVE, Username: "approver@example.com"})
+	}, 2*time.Hour)
+
+	in := testHPARollout()
+	in.RequireApproval = true
+	env.ExecuteWorkflow(hpaRolloutType, in, "user@example.com")
+	assert.NoError(t, env.GetWorkflowError())
+
+	_, progress := stepStates(t, env)
+	assert.Equal(t, "approved by approver@example.com", progress.Steps[0].Message)
+}
+
+func TestHPARolloutAbortBeforeApproval(t *testing.T) {
+	auditor := &fakeAuditor{}
+	hpa := &fakeHPA{resizeErr: errors.New("should not be called")}
+	env := newTestEnv(t, &activities{k8s: hpa, audit: auditor})
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(signalChannel, &workflowv1.Signal{Type: workflowv1.Signal_ABORT, Username: "approver@example.com", Reason: "bad size"})
+	}, time.Hour)
+
+	in := testHPARollout()
+	in.RequireApproval = true
+	env.ExecuteWorkflow(hpaRolloutType, in, "user@example.com")
+	assert.ErrorContains(t, env.GetWorkflowError(), "aborted by approver@example.com: bad size")
+
+	states, _ := stepStates(t, env)
+	assert.Equal(t, map[string]workflowv1.Step_State{
+		approvalStep:   workflowv1.Step_CANCELED,
+		resizeHPAStep:  workflowv1.Step_CANCELED,
+		waitForHPAStep: workflowv1.Step_CANCELED,
+	}, states)
+	assert.Equal(t, map[string]int32{approvalStep: 10}, auditor.steps())
+}
+
+func TestHPARolloutAbortRunningStep(t *testing.T) {
+	auditor := &fakeAuditor{}
+	env := newTestEnv(t, &activities{k8s: &fakeHPA{neverReady: true}, audit: auditor})
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(signalChannel, &workflowv1.Signal{Type: workflowv1.Signal_ABORT, Username: "approver@example.com"})
+	}, time.Second)
+
+	env.ExecuteWorkflow(hpaRolloutType, testHPARollout(), "user@example.com")
+	assert.ErrorContains(t, env.GetWorkflowError(), "aborted by approver@example.com")
+
+	states, _ := stepStates(t, env)
+	assert.Equal(t, workflowv1.Step_SUCCEEDED, states[resizeHPAStep])
+	assert.Equal(t, workflowv1.Step_CANCELED, states[waitForHPAStep])
+	assert.Equal(t, map[string]int32{resizeHPAStep: 0, waitForHPAStep: 10}, auditor.steps())
+}
@@ -0,0 +1,86 @@
+package workflow
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
+	workflowv1 "github.com/lyft/clutch/backend/api/workflow/v1"
+)
+
+const defaultInstanceReadyTimeout = 15 * time.Minute
+
+func batchSteps(batch int) (terminate, wait string) {
+	return fmt.Sprintf("terminate_batch_%d", batch), fmt.Sprintf("wait_for_replacement_%d", batch)
+}
+
+// instanceReplacementWorkflow terminates instances of an autoscaling group in batches, waiting for each batch to be
+// replaced before terminating the next.
+func instanceReplacementWorkflow(ctx workflow.Context, in *workflowv1.InstanceReplacement, startedBy string) error {
+	batchSize := int(in.BatchSize)
+	if batchSize == 0 {
+		batchSize = 1
+	}
+
+	var batches [][]string
+	for i := 0; i < len(in.InstanceIds); i += batchSize {
+		end := i + batchSize
+		if end > len(in.InstanceIds) {
+			end = len(in.InstanceIds)
+		}
+		batches = append(batches, in.InstanceIds[i:end])
+	}
+
+	var steps []string
+	if in.RequireApproval {
+		steps = append(steps, approvalStep)
+	}
+	for i := range batches {
+		terminate, wait := batchSteps(i + 1)
+		steps = append(steps, terminate, wait)
+	}
+
+	r, err := newRunner(ctx, in, startedBy, steps...)
+	if err != nil {
+		return err
+	}
+	defer r.skipRemaining()
+
+	if in.RequireApproval {
+		if err := r.awaitApproval(); err != nil {
+			return err
+		}
+	}
+
+	readyTimeout := defaultInstanceReadyTimeout
+	if in.ReadyTimeout != nil {
+		readyTimeout = in.ReadyTimeout.AsDuration()
+	}
+
+	var terminated []string
+	for i, batch := range batches {
+		terminate, wait := batchSteps(i + 1)
+
+		err := r.runStep(terminate, apiv1.ActionType_DELETE, workflow.ActivityOptions{
+			StartToCloseTimeout: time.Minute,
+			RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: 3},
+		}, act.TerminateInstances, in.Account, in.Region, in.AutoscalingGroup, batch)
+		if err != nil {
+			return err
+		}
+		terminated = append(terminated, batch...)
+
+		err = r.runStep(wait, apiv1.ActionType_READ, workflow.ActivityOptions{
+			StartToCloseTimeout: readyTimeout,
+			HeartbeatTimeout:    heartbeatTimeout,
+			RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: 1},
+		}, act.WaitForAutoscalingGroup, in.Account, in.Region, in.AutoscalingGroup, terminated)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
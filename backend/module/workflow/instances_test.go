@@ -0,0 +1,129 @@
+package workflow
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	ec2v1 "github.com/lyft/clutch/backend/api/aws/ec2/v1"
+	workflowv1 "github.com/lyft/clutch/backend/api/workflow/v1"
+	"github.com/lyft/clutch/backend/service/aws"
+)
+
+// fakeAutoscalingGroup replaces terminated instances immediately.
+type fakeAutoscalingGroup struct {
+	aws.Client
+
+	mu         sync.Mutex
+	desired    uint32
+	instances  map[string]bool
+	terminated [][]string
+	replaced   int
+}
+
+func (f *fakeAutoscalingGroup) TerminateInstances(ctx context.Context, account, region string, ids []string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.terminated = append(f.terminated, ids)
+	for _, id := range ids {
+		delete(f.instances, id)
+	}
+	return nil
+}
+
+func (f *fakeAutoscalingGroup) DescribeAutoscalingGroups(ctx context.Context, account, region string, names []string) ([]*ec2v1.AutoscalingGroup, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if names[0] != "asg1" {
+		return nil, nil
+	}
+
+	for uint32(len(f.instances)) < f.desired {
+		f.replaced++
+		f.instances[string(rune('a'+f.replaced))] = true
+	}
+
+	group := &ec2v1.AutoscalingGroup{Name: names[0], Size: &ec2v1.AutoscalingGroupSize{Desired: f.desired}}
+	for id := range f.instances {
+		group.Instances = append(group.Instances, &ec2v1.AutoscalingGroup_Instance{
+			Id:             id,
+			Healthy:        true,
+			LifecycleState: ec2v1.AutoscalingGroup_Instance_IN_SERVICE,
+		})
+	}
+	return []*ec2v1.AutoscalingGroup{group}, nil
+}
+
+func TestInstanceReplacement(t *testing.T) {
+	asg := &fakeAutoscalingGroup{desired: 3, instances: map[string]bool{"i-1": true, "i-2": true, "i-3": true}}
+	auditor := &fakeAuditor{}
+	env := newTestEnv(t, &activities{aws: asg, audit: auditor})
+
+	env.ExecuteWorkflow(instanceReplacementType, &workflowv1.InstanceReplacement{
+		Account:          "default",
+		Region:           "us-east-1",
+		AutoscalingGroup: "asg1",
+		InstanceIds:      []string{"i-1", "i-2", "i-3"},
+		BatchSize:        2,
+	}, "user@example.com")
+	assert.NoError(t, env.GetWorkflowError())
+
+	assert.Equal(t, [][]string{{"i-1", "i-2"}, {"i-3"}}, asg.terminated)
+	assert.Equal(t, 3, asg.replaced)
+
+	states, progress := stepStates(t, env)
+	assert.Equal(t, map[string]workflowv1.Step_State{
+		"terminate_batch_1":      workflowv1.Step_SUCCEEDED,
+		"wait_for_replacement_1": workflowv1.Step_SUCCEEDED,
+		"terminate_batch_2":      workflowv1.Step_SUCCEEDED,
+		"wait_for_replacement_2": workflowv1.Step_SUCCEEDED,
+	}, states)
+	assert.Equal(t, "terminate_batch_1", progress.Steps[0].Name)
+	assert.Len(t, auditor.steps(), 4)
+	assert.Equal(t, "default/us-east-1/asg1", auditor.events[0].Resources[0].Id)
+}
+
+func TestInstanceReplacementNotFound(t *testing.T) {
+	asg := &fakeAutoscalingGroup{desired: 1, instances: map[string]bool{"i-1": true}}
+	env := newTestEnv(t, &activities{aws: asg})
+
+	env.ExecuteWorkflow(instanceReplacementType, &workflowv1.InstanceReplacement{
+		Region:           "us-east-1",
+		AutoscalingGroup: "asg2",
+		InstanceIds:      []string{"i-1"},
+	}, "user@example.com")
+	assert.ErrorContains(t, env.GetWorkflowError(), "autoscaling group 'asg2' was not found")
+
+	states, _ := stepStates(t, env)
+	assert.Equal(t, workflowv1.Step_FAILED, states["terminate_batch_1"])
+	assert.Empty(t, asg.terminated)
+}
+
+func TestInstanceReplacementOutsideGroup(t *testing.T) {
+	asg := &fakeAutoscalingGroup{desired: 2, instances: map[string]bool{"i-1": true, "i-2": true}}
+	env := newTestEnv(t, &activities{aws: asg})
+
+	env.ExecuteWorkflow(instanceReplacementType, &workflowv1.InstanceReplacement{
+		Region:           "us-east-1",
+		AutoscalingGroup: "asg1",
+		InstanceIds:      []string{"i-1", "i-other"},
+		BatchSize:        2,
+	}, "user@example.com")
+	assert.ErrorContains(t, env.GetWorkflowError(), "instances i-other are not members of autoscaling group 'asg1'")
+	assert.Empty(t, asg.terminated)
+
+	states, _ := stepStates(t, env)
+	assert.Equal(t, workflowv1.Step_FAILED, states["terminate_batch_1"])
+}
+
+func TestServiceNotConfigured(t *testing.T) {
+	env := newTestEnv(t, &activities{})
+	env.ExecuteWorkflow(instanceReplacementType, &workflowv1.InstanceReplacement{
+		Region:           "us-east-1",
+		AutoscalingGroup: "asg1",
+		InstanceIds:      []string{"i-1"},
+	}, "user@example.com")
+	assert.ErrorContains(t, env.GetWorkflowError(), "the clutch.service.aws service is not configured")
+}
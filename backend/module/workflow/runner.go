@@ -0,0 +1,215 @@
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
+	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
+	workflowv1 "github.com/lyft/clutch/backend/api/workflow/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
+)
+
+const (
+	// The query that returns the workflow's progress.
+	progressQuery = "progress"
+
+	// The signal channel that receives approvals and aborts.
+	signalChannel = "signal"
+
+	approvalStep = "approve"
+)
+
+const abortedErrorType = "Aborted"
+
+// runner runs the steps of a workflow, keeping track of their progress and writing an audit event for each.
+type runner struct {
+	ctx          workflow.Context
+	workflowType string
+	input        proto.Message
+	startedBy    string
+
+	progress *workflowv1.Progress
+	signals  workflow.ReceiveChannel
+}
+
+func newRunner(ctx workflow.Context, input proto.Message, startedBy string, steps ...string) (*runner, error) {
+	r := &runner{
+		ctx:          ctx,
+		workflowType: string(input.ProtoReflect().Descriptor().FullName()),
+		input:        input,
+		startedBy:    startedBy,
+		progress:     &workflowv1.Progress{},
+		signals:      workflow.GetSignalChannel(ctx, signalChannel),
+	}
+	for _, name := range steps {
+		r.progress.Steps = append(r.progress.Steps, &workflowv1.Step{Name: name, State: workflowv1.Step_PENDING})
+	}
+
+	err := workflow.SetQueryHandler(ctx, progressQuery, func() (*workflowv1.Progress, error) {
+		return r.progress, nil
+	})
+	return r, err
+}
+
+func (r *runner) startStep(name string) *workflowv1.Step {
+	var step *workflowv1.Step
+	for _, s := range r.progress.Steps {
+		if s.Name == name {
+			step = s
+			break
+		}
+	}
+	if step == nil {
+		step = &workflowv1.Step{Name: name}
+		r.progress.Steps = append(r.progress.Steps, step)
+	}
+
+	step.State = workflowv1.Step_RUNNING
+	step.StartTime = timestamppb.New(workflow.Now(r.ctx))
+	return step
+}
+
+func (r *runner) finishStep(step *workflowv1.Step, actionType apiv1.ActionType, err error) {
+	step.EndTime = timestamppb.New(workflow.Now(r.ctx))
+
+	var appErr *temporal.ApplicationError
+	switch {
+	case err == nil:
+		step.State = workflowv1.Step_SUCCEEDED
+	case errors.As(err, &appErr) && appErr.Type() == abortedErrorType:
+		step.State = workflowv1.Step_CANCELED
+		step.Message = appErr.Message()
+	default:
+		step.State = workflowv1.Step_FAILED
+		step.Message = err.Error()
+		if errors.As(err, &appErr) {
+			step.Message = appErr.Message()
+		}
+	}
+
+	r.audit(step, actionType)
+}
+
+// audit records the step, without failing the workflow if the event can't be written.
+func (r *runner) audit(step *workflowv1.Step, actionType apiv1.ActionType) {
+	body, anyErr := anypb.New(r.input)
+	if anyErr != nil {
+		workflow.GetLogger(r.ctx).Warn("unable to marshal workflow input for audit event", "error", anyErr)
+	}
+
+	stepStatus := status.New(codes.OK, step.Message)
+	switch step.State {
+	case workflowv1.Step_FAILED:
+		stepStatus = status.New(codes.Internal, step.Message)
+	case workflowv1.Step_CANCELED:
+		stepStatus = status.New(codes.Aborted, step.Message)
+	}
+
+	event := &auditv1.RequestEvent{
+		Username:        r.startedBy,
+		ServiceName:     r.workflowType,
+		MethodName:      step.Name,
+		Type:            actionType,
+		Status:          stepStatus.Proto(),
+		Resources:       meta.ResourceNames(r.input),
+		RequestMetadata: &auditv1.RequestMetadata{Body: body},
+	}
+
+	ctx := workflow.WithActivityOptions(r.ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Second,
+		RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: 3},
+	})
+	if err := workflow.ExecuteActivity(ctx, act.WriteAuditEvent, event).Get(ctx, nil); err != nil {
+		workflow.GetLogger(r.ctx).Warn("unable to write audit event for workflow step", "step", step.Name, "error", err)
+	}
+}
+
+func abortedError(signal *workflowv1.Signal) error {
+	msg := fmt.Sprintf("aborted by %s", signal.Username)
+	if signal.Reason != "" {
+		msg = fmt.Sprintf("%s: %s", msg, signal.Reason)
+	}
+	return temporal.NewNonRetryableApplicationError(msg, abortedErrorType, nil)
+}
+
+// awaitApproval blocks until the workflow is approved or aborted. Approvals from the user who started the workflow
+// are ignored.
+func (r *runner) awaitApproval() error {
+	step := r.startStep(approvalStep)
+	r.progress.AwaitingApproval = true
+	defer func() { r.progress.AwaitingApproval = false }()
+
+	for {
+		var signal *workflowv1.Signal
+		r.signals.Receive(r.ctx, &signal)
+
+		switch signal.GetType() {
+		case workflowv1.Signal_APPROVE:
+			if signal.Username == r.startedBy {
+				workflow.GetLogger(r.ctx).Warn("ignoring approval from the user who started the workflow", "username", signal.Username)
+				continue
+			}
+			step.Message = fmt.Sprintf("approved by %s", signal.Username)
+			r.finishStep(step, apiv1.ActionType_UPDATE, nil)
+			return nil
+		case workflowv1.Signal_ABORT:
+			err := abortedError(signal)
+			r.finishStep(step, apiv1.ActionType_UPDATE, err)
+			return err
+		}
+	}
+}
+
+// runStep executes an activity as a step, cancelling it if the workflow is aborted in the meantime.
+func (r *runner) runStep(name string, actionType apiv1.ActionType, opts workflow.ActivityOptions, activity interface{}, args ...interface{}) error {
+	step := r.startStep(name)
+
+	ctx, cancel := workflow.WithCancel(workflow.WithActivityOptions(r.ctx, opts))
+	defer cancel()
+
+	var err error
+	var aborted *workflowv1.Signal
+	done := false
+
+	selector := workflow.NewSelector(r.ctx)
+	selector.AddFuture(workflow.ExecuteActivity(ctx, activity, args...), func(f workflow.Future) {
+		err = f.Get(ctx, nil)
+		done = true
+	})
+	selector.AddReceive(r.signals, func(c workflow.ReceiveChannel, more bool) {
+		var signal *workflowv1.Signal
+		c.Receive(r.ctx, &signal)
+		if signal.GetType() == workflowv1.Signal_ABORT && aborted == nil {
+			aborted = signal
+			cancel()
+		}
+	})
+	for !done {
+		selector.Select(r.ctx)
+	}
+
+	if aborted != nil {
+		err = abortedError(aborted)
+	}
+	r.finishStep(step, actionType, err)
+	return err
+}
+
+// skipRemaining marks the steps that didn't run when the workflow stopped early.
+func (r *runner) skipRemaining() {
+	for _, s := range r.progress.Steps {
+		if s.State == workflowv1.Step_PENDING {
+			s.State = workflowv1.Step_CANCELED
+		}
+	}
+}
@@ -0,0 +1,434 @@
+package workflow
+
+// <!-- START clutchdoc -->
+// description: Runs multi-step actions, such as HPA rollouts and instance replacements, as durable Temporal workflows.
+// <!-- END clutchdoc -->
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/uber-go/tally/v4"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporalclient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	temporalsdk "go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	workflowcfgv1 "github.com/lyft/clutch/backend/api/config/module/workflow/v1"
+	workflowv1 "github.com/lyft/clutch/backend/api/workflow/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
+	"github.com/lyft/clutch/backend/module"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/audit"
+	"github.com/lyft/clutch/backend/service/authn"
+	"github.com/lyft/clutch/backend/service/aws"
+	"github.com/lyft/clutch/backend/service/k8s"
+	"github.com/lyft/clutch/backend/service/temporal"
+)
+
+const (
+	Name = "clutch.module.workflow"
+
+	defaultTaskQueue = "clutch-workflows"
+
+	// The memo field that records who started a workflow.
+	startedByMemo = "started_by"
+	// The memo field that records the ID of the resource a workflow changes, which signals are authorized against.
+	resourceMemo = "resource"
+
+	// How long activities that wait for a resource can go without heartbeating before they're retried or failed.
+	heartbeatTimeout = time.Minute
+
+	// How long to wait between attempts to connect the worker to the server.
+	workerRetryInterval = 30 * time.Second
+)
+
+// Workflow types are the full names of their input messages.
+var (
+	hpaRolloutType          = string((&workflowv1.HPARollout{}).ProtoReflect().Descriptor().FullName())
+	instanceReplacementType = string((&workflowv1.InstanceReplacement{}).ProtoReflect().Descriptor().FullName())
+)
+
+var workflows = map[string]interface{}{
+	hpaRolloutType:          hpaRolloutWorkflow,
+	instanceReplacementType: instanceReplacementWorkflow,
+}
+
+func New(cfg *any.Any, log *zap.Logger, scope tally.Scope) (module.Module, error) {
+	config := &workflowcfgv1.Config{}
+	if err := cfg.UnmarshalTo(config); err != nil {
+		return nil, err
+	}
+
+	svc, ok := service.Registry[temporal.Name]
+	if !ok {
+		return nil, errors.New("could not find temporal service")
+	}
+	manager, ok := svc.(temporal.ClientManager)
+	if !ok {
+		return nil, errors.New("service was not the correct type")
+	}
+	client, err := manager.GetNamespaceClient(config.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// The services used by the activities are optional, workflows that need a missing service fail when they use it.
+	acts := &activities{verifyHosts: make(map[string]bool, len(config.VerifyAllowedHosts))}
+	for _, host := range config.VerifyAllowedHosts {
+		acts.verifyHosts[strings.ToLower(host)] = true
+	}
+	acts.http = &http.Client{Timeout: time.Minute, CheckRedirect: acts.checkRedirect}
+	if svc, ok := service.Registry[k8s.Name]; ok {
+		if acts.k8s, ok = svc.(k8s.Service); !ok {
+			return nil, errors.New("k8s service was not the correct type")
+		}
+	}
+	if svc, ok := service.Registry[aws.Name]; ok {
+		if acts.aws, ok = svc.(aws.Client); !ok {
+			return nil, errors.New("aws service was not the correct type")
+		}
+	}
+	if svc, ok := service.Registry[audit.Name]; ok {
+		if acts.audit, ok = svc.(audit.Auditor); !ok {
+			return nil, errors.New("audit service was not the correct type")
+		}
+	}
+
+	taskQueue := config.TaskQueue
+	if taskQueue == "" {
+		taskQueue = defaultTaskQueue
+	}
+
+	return &mod{
+		api: &workflowAPI{
+			client:     client,
+			taskQueue:  taskQueue,
+			activities: acts,
+			logger:     log,
+		},
+		activities:    acts,
+		disableWorker: config.DisableWorker,
+		logger:        log,
+		scope:         scope,
+	}, nil
+}
+
+type mod struct {
+	api           *workflowAPI
+	activities    *activities
+	disableWorker bool
+	logger        *zap.Logger
+	scope         tally.Scope
+}
+
+func (m *mod) Register(r module.Registrar) error {
+	workflowv1.RegisterWorkflowAPIServer(r.GRPCServer(), m.api)
+	if err := r.RegisterJSONGateway(workflowv1.RegisterWorkflowAPIHandler); err != nil {
+		return err
+	}
+
+	if !m.disableWorker {
+		go m.runWorker()
+	}
+	return nil
+}
+
+func registerWorkflows(w worker.Registry, acts *activities) {
+	for name, fn := range workflows {
+		w.RegisterWorkflowWithOptions(fn, workflow.RegisterOptions{Name: name})
+	}
+	w.RegisterActivity(acts)
+}
+
+// runWorker connects to the server in the background, so that the gateway starts even if the server is unavailable.
+func (m *mod) runWorker() {
+	for {
+		c, err := m.api.client.GetConnection()
+		if err == nil {
+			w := worker.New(c, m.api.taskQueue, worker.Options{})
+			registerWorkflows(w, m.activities)
+			if err = w.Start(); err == nil {
+				m.logger.Info("started workflow worker", zap.String("taskQueue", m.api.taskQueue))
+				return
+			}
+		}
+
+		m.logger.Warn("unable to start workflow worker, retrying", zap.Error(err))
+		time.Sleep(workerRetryInterval)
+	}
+}
+
+type workflowAPI struct {
+	client    temporal.Client
+	taskQueue string
+	// Used to check the input of workflows before they start.
+	activities *activities
+	logger     *zap.Logger
+}
+
+// Converts errors from the server to gRPC statuses.
+func convertError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	s := serviceerror.ToStatus(err)
+	return status.Error(codes.Code(s.Code()), s.Message())
+}
+
+func (a *workflowAPI) connection() (temporalclient.Client, error) {
+	c, err := a.client.GetConnection()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "unable to connect to the workflow server: %s", err)
+	}
+	return c, nil
+}
+
+func username(ctx context.Context) string {
+	if claims, err := authn.ClaimsFromContext(ctx); err == nil {
+		return claims.Subject
+	}
+	return authn.AnonymousSubject
+}
+
+func (a *workflowAPI) StartWorkflow(ctx context.Context, req *workflowv1.StartWorkflowRequest) (*workflowv1.StartWorkflowResponse, error) {
+	c, err := a.connection()
+	if err != nil {
+		return nil, err
+	}
+
+	var workflowType string
+	var input proto.Message
+	switch w := req.Workflow.(type) {
+	case *workflowv1.StartWorkflowRequest_HpaRollout:
+		workflowType, input = hpaRolloutType, w.HpaRollout
+		if err := a.checkHPARollout(w.HpaRollout); err != nil {
+			return nil, err
+		}
+	case *workflowv1.StartWorkflowRequest_InstanceReplacement:
+		workflowType, input = instanceReplacementType, w.InstanceReplacement
+		if err := a.checkInstanceReplacement(ctx, w.InstanceReplacement); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown workflow '%T'", req.Workflow)
+	}
+
+	var resource string
+	if resources := meta.ResourceNames(input); len(resources) > 0 {
+		resource = resources[0].Id
+	}
+
+	user := username(ctx)
+	run, err := c.ExecuteWorkflow(ctx, temporalclient.StartWorkflowOptions{
+		ID:        req.WorkflowId,
+		TaskQueue: a.taskQueue,
+		Memo:      map[string]interface{}{startedByMemo: user, resourceMemo: resource},
+	}, workflowType, input, user)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &workflowv1.StartWorkflowResponse{WorkflowId: run.GetID(), RunId: run.GetRunID(), Resource: resource}, nil
+}
+
+// checkHPARollout rejects verify URLs that the workflow would refuse to request, before the HPA is resized.
+func (a *workflowAPI) checkHPARollout(in *workflowv1.HPARollout) error {
+	if in.VerifyUrl == "" {
+		return nil
+	}
+
+	_, err := a.activities.checkVerifyURL(in.VerifyUrl)
+	var appErr *temporalsdk.ApplicationError
+	if errors.As(err, &appErr) {
+		if appErr.Type() == hostNotAllowedType {
+			return status.Error(codes.PermissionDenied, appErr.Message())
+		}
+		return status.Error(codes.InvalidArgument, appErr.Message())
+	}
+	return err
+}
+
+// checkInstanceReplacement rejects instances outside of the autoscaling group before the workflow starts. The
+// workflow checks them again before terminating each batch.
+func (a *workflowAPI) checkInstanceReplacement(ctx context.Context, in *workflowv1.InstanceReplacement) error {
+	if a.activities.aws == nil {
+		return nil
+	}
+
+	err := a.activities.checkInstancesInGroup(ctx, in.Account, in.Region, in.AutoscalingGroup, in.InstanceIds)
+	var appErr *temporalsdk.ApplicationError
+	if errors.As(err, &appErr) {
+		switch appErr.Type() {
+		case notInGroupErrorType:
+			return status.Error(codes.PermissionDenied, appErr.Message())
+		case notFoundErrorType:
+			return status.Error(codes.NotFound, appErr.Message())
+		}
+	}
+	return err
+}
+
+func (a *workflowAPI) GetWorkflow(ctx context.Context, req *workflowv1.GetWorkflowRequest) (*workflowv1.GetWorkflowResponse, error) {
+	c, err := a.connection()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.DescribeWorkflowExecution(ctx, req.WorkflowId, req.RunId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if _, ok := workflows[resp.WorkflowExecutionInfo.GetType().GetName()]; !ok {
+		return nil, status.Errorf(codes.NotFound, "workflow '%s' was not started by clutch", req.WorkflowId)
+	}
+	wf := a.workflowFromInfo(resp.WorkflowExecutionInfo)
+	// The request was authorized against its resource, which must be the one the workflow changes.
+	if wf.Resource == "" || wf.Resource != req.Resource {
+		return nil, status.Errorf(codes.PermissionDenied, "workflow '%s' doesn't change resource '%s'", req.WorkflowId, req.Resource)
+	}
+
+	// The progress is only available while a worker can answer the query, so the workflow is returned without it
+	// otherwise.
+	value, err := c.QueryWorkflow(ctx, wf.Id, wf.RunId, progressQuery)
+	if err == nil {
+		progress := &workflowv1.Progress{}
+		if err = value.Get(&progress); err == nil {
+			wf.Progress = progress
+		}
+	}
+	if err != nil {
+		a.logger.Warn("unable to query workflow progress", zap.String("workflowId", wf.Id), zap.Error(err))
+	}
+
+	return &workflowv1.GetWorkflowResponse{Workflow: wf}, nil
+}
+
+func (a *workflowAPI) SignalWorkflow(ctx context.Context, req *workflowv1.SignalWorkflowRequest) (*workflowv1.SignalWorkflowResponse, error) {
+	c, err := a.connection()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.DescribeWorkflowExecution(ctx, req.WorkflowId, req.RunId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if _, ok := workflows[resp.WorkflowExecutionInfo.GetType().GetName()]; !ok {
+		return nil, status.Errorf(codes.NotFound, "workflow '%s' was not started by clutch", req.WorkflowId)
+	}
+	wf := a.workflowFromInfo(resp.WorkflowExecutionInfo)
+	// The request was authorized against its resource, which must be the one the workflow changes.
+	if wf.Resource == "" || wf.Resource != req.Resource {
+		return nil, status.Errorf(codes.PermissionDenied, "workflow '%s' doesn't change resource '%s'", req.WorkflowId, req.Resource)
+	}
+
+	user := username(ctx)
+	if req.Type == workflowv1.Signal_APPROVE && user == wf.StartedBy {
+		return nil, status.Error(codes.PermissionDenied, "workflows can't be approved by the user who started them")
+	}
+
+	signal := &workflowv1.Signal{Type: req.Type, Username: user, Reason: req.Reason}
+	if err := c.SignalWorkflow(ctx, req.WorkflowId, req.RunId, signalChannel, signal); err != nil {
+		return nil, convertError(err)
+	}
+	return &workflowv1.SignalWorkflowResponse{}, nil
+}
+
+func (a *workflowAPI) ListWorkflows(ctx context.Context, req *workflowv1.ListWorkflowsRequest) (*workflowv1.ListWorkflowsResponse, error) {
+	query := fmt.Sprintf("WorkflowType = '%s' OR WorkflowType = '%s'", hpaRolloutType, instanceReplacementType)
+	if req.Type != "" {
+		if _, ok := workflows[req.Type]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown workflow type '%s'", req.Type)
+		}
+		query = fmt.Sprintf("WorkflowType = '%s'", req.Type)
+	}
+
+	pageToken, err := base64.URLEncoding.DecodeString(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+
+	c, err := a.connection()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+		PageSize:      int32(req.PageSize),
+		NextPageToken: pageToken,
+		Query:         query,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	ret := &workflowv1.ListWorkflowsResponse{
+		Workflows:     make([]*workflowv1.Workflow, len(resp.Executions)),
+		NextPageToken: base64.URLEncoding.EncodeToString(resp.NextPageToken),
+	}
+	for i, info := range resp.Executions {
+		ret.Workflows[i] = a.workflowFromInfo(info)
+	}
+	return ret, nil
+}
+
+var statuses = map[enumspb.WorkflowExecutionStatus]workflowv1.Workflow_Status{
+	enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING:          workflowv1.Workflow_RUNNING,
+	enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED:        workflowv1.Workflow_COMPLETED,
+	enumspb.WORKFLOW_EXECUTION_STATUS_FAILED:           workflowv1.Workflow_FAILED,
+	enumspb.WORKFLOW_EXECUTION_STATUS_CANCELED:         workflowv1.Workflow_CANCELED,
+	enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED:       workflowv1.Workflow_TERMINATED,
+	enumspb.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW: workflowv1.Workflow_CONTINUED_AS_NEW,
+	enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT:        workflowv1.Workflow_TIMED_OUT,
+}
+
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func (a *workflowAPI) workflowFromInfo(info *workflowpb.WorkflowExecutionInfo) *workflowv1.Workflow {
+	return &workflowv1.Workflow{
+		Id:        info.GetExecution().GetWorkflowId(),
+		RunId:     info.GetExecution().GetRunId(),
+		Type:      info.GetType().GetName(),
+		Status:    statuses[info.GetStatus()],
+		StartTime: timestamp(info.GetStartTime()),
+		CloseTime: timestamp(info.GetCloseTime()),
+		StartedBy: a.memoString(info.GetMemo(), startedByMemo),
+		Resource:  a.memoString(info.GetMemo(), resourceMemo),
+	}
+}
+
+func (a *workflowAPI) memoString(memo *commonpb.Memo, field string) string {
+	payload, ok := memo.GetFields()[field]
+	if !ok {
+		return ""
+	}
+
+	var value string
+	if err := converter.GetDefaultDataConverter().FromPayload(payload, &value); err != nil {
+		a.logger.Warn("unable to decode workflow memo", zap.String("field", field), zap.Error(err))
+	}
+	return value
+}
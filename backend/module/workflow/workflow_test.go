@@ -0,0 +1,367 @@
+package workflow
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporalclient "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	workflowcfgv1 "github.com/lyft/clutch/backend/api/config/module/workflow/v1"
+	k8sv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+	workflowv1 "github.com/lyft/clutch/backend/api/workflow/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
+	"github.com/lyft/clutch/backend/module/moduletest"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/authn"
+	"github.com/lyft/clutch/backend/service/temporal"
+)
+
+type fakeClientManager struct {
+	client temporalclient.Client
+}
+
+func (f *fakeClientManager) GetNamespaceClient(namespace string) (temporal.Client, error) {
+	return f, nil
+}
+
+func (f *fakeClientManager) GetConnection() (temporalclient.Client, error) {
+	if f.client == nil {
+		return nil, errors.New("connection refused")
+	}
+	return f.client, nil
+}
+
+type fakeRun struct {
+	temporalclient.WorkflowRun
+
+	id string
+}
+
+func (f *fakeRun) GetID() string    { return f.id }
+func (f *fakeRun) GetRunID() string { return "run1" }
+
+type fakeValue struct {
+	converter.EncodedValue
+
+	progress *workflowv1.Progress
+}
+
+func (f *fakeValue) Get(valuePtr interface{}) error {
+	*valuePtr.(**workflowv1.Progress) = f.progress
+	return nil
+}
+
+type fakeClient struct {
+	temporalclient.Client
+
+	options  temporalclient.StartWorkflowOptions
+	args     []interface{}
+	signal   interface{}
+	query    string
+	queryErr error
+}
+
+func (f *fakeClient) ExecuteWorkflow(ctx context.Context, options temporalclient.StartWorkflowOptions, workflow interface{}, args ...interface{}) (temporalclient.WorkflowRun, error) {
+	if options.ID == "exists" {
+		return nil, serviceerror.NewWorkflowExecutionAlreadyStarted("already started", "", "")
+	}
+	f.options, f.args = options, args
+	return &fakeRun{id: options.ID}, nil
+}
+
+func workflowInfo(id string) *workflowpb.WorkflowExecutionInfo {
+	startedBy, _ := converter.GetDefaultDataConverter().ToPayload("user@example.com")
+	resource, _ := converter.GetDefaultDataConverter().ToPayload("c/ns/hpa1")
+	start := time.Unix(100, 0)
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: id, RunId: "run1"},
+		Type:      &commonpb.WorkflowType{Name: hpaRolloutType},
+		Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		StartTime: &start,
+		Memo:      &commonpb.Memo{Fields: map[string]*commonpb.Payload{startedByMemo: startedBy, resourceMemo: resource}},
+	}
+}
+
+func (f *fakeClient) DescribeWorkflowExecution(ctx context.Context, workflowID, runID string) (*workflowservice.DescribeWorkflowExecutionResponse, error) {
+	if workflowID == "missing" {
+		return nil, serviceerror.NewNotFound("workflow not found")
+	}
+	info := workflowInfo(workflowID)
+	if workflowID == "other" {
+		info.Type.Name = "OtherWorkflow"
+	}
+	return &workflowservice.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: info}, nil
+}
+
+func (f *fakeClient) QueryWorkflow(ctx context.Context, workflowID string, runID string, queryType string, args ...interface{}) (converter.EncodedValue, error) {
+	f.query = queryType
+	if f.queryErr != nil {
+		return nil, f.queryErr
+	}
+	return &fakeValue{progress: &workflowv1.Progress{AwaitingApproval: true}}, nil
+}
+
+func (f *fakeClient) SignalWorkflow(ctx context.Context, workflowID string, runID string, signalName string, arg interface{}) error {
+	if signalName != signalChannel {
+		return errors.New("unexpected signal")
+	}
+	f.signal = arg
+	return nil
+}
+
+func (f *fakeClient) ListWorkflow(ctx context.Context, req *workflowservice.ListWorkflowExecutionsRequest) (*workflowservice.ListWorkflowExecutionsResponse, error) {
+	f.query = req.Query
+	return &workflowservice.ListWorkflowExecutionsResponse{
+		Executions:    []*workflowpb.WorkflowExecutionInfo{workflowInfo("wf1"), workflowInfo("wf2")},
+		NextPageToken: append(req.NextPageToken, 'x'),
+	}, nil
+}
+
+func newTestAPI(t *testing.T, client temporalclient.Client) *workflowAPI {
+	service.Registry[temporal.Name] = &fakeClientManager{client: client}
+	defer delete(service.Registry, temporal.Name)
+
+	cfg, _ := anypb.New(&workflowcfgv1.Config{Namespace: "default", DisableWorker: true, VerifyAllowedHosts: []string{"Status.Example.com"}})
+	m, err := New(cfg, zaptest.NewLogger(t), tally.NewTestScope("", nil))
+	assert.NoError(t, err)
+	assert.True(t, m.(*mod).activities.verifyHosts["status.example.com"])
+	return m.(*mod).api
+}
+
+func TestModule(t *testing.T) {
+	cfg, _ := anypb.New(&workflowcfgv1.Config{Namespace: "default"})
+	_, err := New(cfg, zaptest.NewLogger(t), tally.NewTestScope("", nil))
+	assert.Error(t, err)
+
+	service.Registry[temporal.Name] = &fakeClientManager{}
+	defer delete(service.Registry, temporal.Name)
+
+	cfg, _ = anypb.New(&workflowcfgv1.Config{Namespace: "default", DisableWorker: true})
+	m, err := New(cfg, zaptest.NewLogger(t), tally.NewTestScope("", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, defaultTaskQueue, m.(*mod).api.taskQueue)
+
+	r := moduletest.NewRegisterChecker()
+	assert.NoError(t, m.Register(r))
+	assert.True(t, r.JSONRegistered())
+}
+
+func TestUnavailable(t *testing.T) {
+	api := newTestAPI(t, nil)
+	_, err := api.GetWorkflow(context.Background(), &workflowv1.GetWorkflowRequest{WorkflowId: "wf1", Resource: "c/ns/hpa1"})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestStartWorkflow(t *testing.T) {
+	client := &fakeClient{}
+	api := newTestAPI(t, client)
+
+	ctx := authn.ContextWithClaims(context.Background(), &authn.Claims{StandardClaims: &jwt.StandardClaims{Subject: "user@example.com"}})
+	in := &workflowv1.HPARollout{Name: "hpa1", Sizing: &k8sv1.ResizeHPARequest_Sizing{Min: 1, Max: 2}}
+	resp, err := api.StartWorkflow(ctx, &workflowv1.StartWorkflowRequest{
+		Workflow:   &workflowv1.StartWorkflowRequest_HpaRollout{HpaRollout: in},
+		WorkflowId: "wf1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "wf1", resp.WorkflowId)
+	assert.Equal(t, "run1", resp.RunId)
+	assert.Equal(t, defaultTaskQueue, client.options.TaskQueue)
+	assert.Equal(t, "user@example.com", client.options.Memo[startedByMemo])
+	assert.Equal(t, "//hpa1", client.options.Memo[resourceMemo])
+	assert.Equal(t, "//hpa1", resp.Resource)
+	assert.Equal(t, []interface{}{in, "user@example.com"}, client.args)
+
+	_, err = api.StartWorkflow(context.Background(), &workflowv1.StartWorkflowRequest{
+		Workflow:   &workflowv1.StartWorkflowRequest_InstanceReplacement{InstanceReplacement: &workflowv1.InstanceReplacement{}},
+		WorkflowId: "wf2",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, authn.AnonymousSubject, client.args[1])
+
+	_, err = api.StartWorkflow(context.Background(), &workflowv1.StartWorkflowRequest{
+		Workflow:   &workflowv1.StartWorkflowRequest_HpaRollout{HpaRollout: in},
+		WorkflowId: "exists",
+	})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestStartHPARolloutVerifyURL(t *testing.T) {
+	client := &fakeClient{}
+	api := newTestAPI(t, client)
+	api.activities.verifyHosts = map[string]bool{"status.example.com": true}
+
+	in := &workflowv1.HPARollout{Name: "hpa1", Sizing: &k8sv1.ResizeHPARequest_Sizing{Min: 1, Max: 2}, VerifyUrl: "https://status.example.com/ok"}
+	_, err := api.StartWorkflow(context.Background(), &workflowv1.StartWorkflowRequest{
+		Workflow: &workflowv1.StartWorkflowRequest_HpaRollout{HpaRollout: in},
+	})
+	assert.NoError(t, err)
+
+	client.args = nil
+	in.VerifyUrl = "http://169.254.169.254/latest/meta-data"
+	_, err = api.StartWorkflow(context.Background(), &workflowv1.StartWorkflowRequest{
+		Workflow: &workflowv1.StartWorkflowRequest_HpaRollout{HpaRollout: in},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, client.args)
+
+	in.VerifyUrl = "gopher://status.example.com"
+	_, err = api.StartWorkflow(context.Background(), &workflowv1.StartWorkflowRequest{
+		Workflow: &workflowv1.StartWorkflowRequest_HpaRollout{HpaRollout: in},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestStartInstanceReplacement(t *testing.T) {
+	client := &fakeClient{}
+	api := newTestAPI(t, client)
+	api.activities.aws = &fakeAutoscalingGroup{desired: 2, instances: map[string]bool{"i-1": true, "i-2": true}}
+
+	in := &workflowv1.InstanceReplacement{Region: "us-east-1", AutoscalingGroup: "asg1", InstanceIds: []string{"i-1"}}
+	_, err := api.StartWorkflow(context.Background(), &workflowv1.StartWorkflowRequest{
+		Workflow: &workflowv1.StartWorkflowRequest_InstanceReplacement{InstanceReplacement: in},
+	})
+	assert.NoError(t, err)
+
+	// Instances of other groups can't be terminated.
+	client.args = nil
+	in.InstanceIds = []string{"i-1", "i-other"}
+	_, err = api.StartWorkflow(context.Background(), &workflowv1.StartWorkflowRequest{
+		Workflow: &workflowv1.StartWorkflowRequest_InstanceReplacement{InstanceReplacement: in},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, client.args)
+
+	in.AutoscalingGroup = "asg2"
+	_, err = api.StartWorkflow(context.Background(), &workflowv1.StartWorkflowRequest{
+		Workflow: &workflowv1.StartWorkflowRequest_InstanceReplacement{InstanceReplacement: in},
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestGetWorkflow(t *testing.T) {
+	client := &fakeClient{}
+	api := newTestAPI(t, client)
+
+	resp, err := api.GetWorkflow(context.Background(), &workflowv1.GetWorkflowRequest{WorkflowId: "wf1", Resource: "c/ns/hpa1"})
+	assert.NoError(t, err)
+	assert.Equal(t, progressQuery, client.query)
+	assert.Equal(t, "wf1", resp.Workflow.Id)
+	assert.Equal(t, "run1", resp.Workflow.RunId)
+	assert.Equal(t, hpaRolloutType, resp.Workflow.Type)
+	assert.Equal(t, workflowv1.Workflow_RUNNING, resp.Workflow.Status)
+	assert.Equal(t, int64(100), resp.Workflow.StartTime.Seconds)
+	assert.Nil(t, resp.Workflow.CloseTime)
+	assert.Equal(t, "user@example.com", resp.Workflow.StartedBy)
+	assert.Equal(t, "c/ns/hpa1", resp.Workflow.Resource)
+	assert.True(t, resp.Workflow.Progress.AwaitingApproval)
+
+	// The request must name the resource of the workflow, which is what authorization checked.
+	_, err = api.GetWorkflow(context.Background(), &workflowv1.GetWorkflowRequest{WorkflowId: "wf1", Resource: "c/ns/other"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The workflow is still returned if its progress can't be queried.
+	client.queryErr = errors.New("no worker")
+	resp, err = api.GetWorkflow(context.Background(), &workflowv1.GetWorkflowRequest{WorkflowId: "wf1", Resource: "c/ns/hpa1"})
+	assert.NoError(t, err)
+	assert.Nil(t, resp.Workflow.Progress)
+
+	_, err = api.GetWorkflow(context.Background(), &workflowv1.GetWorkflowRequest{WorkflowId: "missing", Resource: "c/ns/hpa1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = api.GetWorkflow(context.Background(), &workflowv1.GetWorkflowRequest{WorkflowId: "other", Resource: "c/ns/hpa1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSignalWorkflow(t *testing.T) {
+	client := &fakeClient{}
+	api := newTestAPI(t, client)
+
+	_, err := api.SignalWorkflow(context.Background(), &workflowv1.SignalWorkflowRequest{
+		WorkflowId: "wf1",
+		Type:       workflowv1.Signal_ABORT,
+		Reason:     "wrong size",
+		Resource:   "c/ns/hpa1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &workflowv1.Signal{Type: workflowv1.Signal_ABORT, Username: authn.AnonymousSubject, Reason: "wrong size"}, client.signal)
+
+	// The signal must name the resource of the workflow, which is what authorization checked.
+	client.signal = nil
+	_, err = api.SignalWorkflow(context.Background(), &workflowv1.SignalWorkflowRequest{
+		WorkflowId: "wf1",
+		Type:       workflowv1.Signal_APPROVE,
+		Resource:   "c/ns/other",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, client.signal)
+
+	// Workflows can't be approved by the user who started them, but can be aborted.
+	ctx := authn.ContextWithClaims(context.Background(), &authn.Claims{StandardClaims: &jwt.StandardClaims{Subject: "user@example.com"}})
+	_, err = api.SignalWorkflow(ctx, &workflowv1.SignalWorkflowRequest{
+		WorkflowId: "wf1",
+		Type:       workflowv1.Signal_APPROVE,
+		Resource:   "c/ns/hpa1",
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Nil(t, client.signal)
+
+	_, err = api.SignalWorkflow(ctx, &workflowv1.SignalWorkflowRequest{
+		WorkflowId: "wf1",
+		Type:       workflowv1.Signal_ABORT,
+		Resource:   "c/ns/hpa1",
+	})
+	assert.NoError(t, err)
+
+	_, err = api.SignalWorkflow(context.Background(), &workflowv1.SignalWorkflowRequest{
+		WorkflowId: "missing",
+		Type:       workflowv1.Signal_ABORT,
+		Resource:   "c/ns/hpa1",
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListWorkflows(t *testing.T) {
+	client := &fakeClient{}
+	api := newTestAPI(t, client)
+
+	// Listing is authorized against every resource, since workflows of any resource are returned.
+	assert.Equal(t, "*", meta.ResourceNames(&workflowv1.ListWorkflowsRequest{})[0].Id)
+	assert.Equal(t, "c/ns/hpa1", meta.ResourceNames(&workflowv1.GetWorkflowRequest{Resource: "c/ns/hpa1"})[0].Id)
+
+	resp, err := api.ListWorkflows(context.Background(), &workflowv1.ListWorkflowsRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "WorkflowType = 'clutch.workflow.v1.HPARollout' OR WorkflowType = 'clutch.workflow.v1.InstanceReplacement'", client.query)
+	assert.Len(t, resp.Workflows, 2)
+	assert.Equal(t, "wf2", resp.Workflows[1].Id)
+	assert.Equal(t, "user@example.com", resp.Workflows[1].StartedBy)
+
+	resp, err = api.ListWorkflows(context.Background(), &workflowv1.ListWorkflowsRequest{
+		Type:      instanceReplacementType,
+		PageToken: resp.NextPageToken,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "WorkflowType = 'clutch.workflow.v1.InstanceReplacement'", client.query)
+	assert.Equal(t, base64.URLEncoding.EncodeToString([]byte("xx")), resp.NextPageToken)
+
+	_, err = api.ListWorkflows(context.Background(), &workflowv1.ListWorkflowsRequest{Type: "OtherWorkflow"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = api.ListWorkflows(context.Background(), &workflowv1.ListWorkflowsRequest{PageToken: "!"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -8,7 +8,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"regexp"
+	"strings"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
@@ -16,11 +18,13 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	protov2 "google.golang.org/protobuf/proto"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	k8sv1api "github.com/lyft/clutch/backend/api/k8s/v1"
 	k8sv1resolver "github.com/lyft/clutch/backend/api/resolver/k8s/v1"
 	resolverv1 "github.com/lyft/clutch/backend/api/resolver/v1"
+	topologyv1 "github.com/lyft/clutch/backend/api/topology/v1"
 	"github.com/lyft/clutch/backend/gateway/meta"
 	"github.com/lyft/clutch/backend/resolver"
 	"github.com/lyft/clutch/backend/service"
@@ -33,10 +37,18 @@ const Name = "clutch.resolver.k8s"
 var typeURLPod = meta.TypeURL((*k8sv1api.Pod)(nil))
 var typeURLHPA = meta.TypeURL((*k8sv1api.HPA)(nil))
 var typeURLNode = meta.TypeURL((*k8sv1api.Node)(nil))
+var typeURLDeployment = meta.TypeURL((*k8sv1api.Deployment)(nil))
+var typeURLStatefulSet = meta.TypeURL((*k8sv1api.StatefulSet)(nil))
+var typeURLService = meta.TypeURL((*k8sv1api.Service)(nil))
+var typeURLCronJob = meta.TypeURL((*k8sv1api.CronJob)(nil))
+var typeURLConfigMap = meta.TypeURL((*k8sv1api.ConfigMap)(nil))
+var typeURLJob = meta.TypeURL((*k8sv1api.Job)(nil))
+var typeURLNamespace = meta.TypeURL((*k8sv1api.Namespace)(nil))
 
 var typeSchemas = resolver.TypeURLToSchemaMessagesMap{
 	typeURLPod: {
 		(*k8sv1resolver.PodID)(nil),
+		(*k8sv1resolver.IPAddress)(nil),
 	},
 	typeURLHPA: {
 		(*k8sv1resolver.HPAName)(nil),
@@ -44,6 +56,27 @@ var typeSchemas = resolver.TypeURLToSchemaMessagesMap{
 	typeURLNode: {
 		(*k8sv1resolver.Node)(nil),
 	},
+	typeURLDeployment: {
+		(*k8sv1resolver.Deployment)(nil),
+	},
+	typeURLStatefulSet: {
+		(*k8sv1resolver.StatefulSet)(nil),
+	},
+	typeURLService: {
+		(*k8sv1resolver.Service)(nil),
+	},
+	typeURLCronJob: {
+		(*k8sv1resolver.CronJob)(nil),
+	},
+	typeURLConfigMap: {
+		(*k8sv1resolver.ConfigMap)(nil),
+	},
+	typeURLJob: {
+		(*k8sv1resolver.Job)(nil),
+	},
+	typeURLNamespace: {
+		(*k8sv1resolver.Namespace)(nil),
+	},
 }
 
 // Loosely https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#dns-subdomain-names
@@ -111,87 +144,155 @@ type res struct {
 
 func (r *res) Schemas() resolver.TypeURLToSchemasMap { return r.schemas }
 
-func (r *res) locateByPodID(ctx context.Context, in *k8sv1resolver.PodID) ([]*k8sv1api.Pod, error) {
-	// Only possible to get one at a time by PodID.
-	pod, err := r.svc.DescribePod(ctx, in.Clientset, "", in.Namespace, in.Name)
+// describeFunc returns a single resource by name. Cluster-scoped resources ignore the namespace.
+type describeFunc[T protov2.Message] func(ctx context.Context, clientset, cluster, namespace, name string) (T, error)
+
+func (r *res) describeNamespace(ctx context.Context, clientset, cluster, _, name string) (*k8sv1api.Namespace, error) {
+	return r.svc.DescribeNamespace(ctx, clientset, cluster, name)
+}
+
+func (r *res) describeNode(ctx context.Context, clientset, cluster, _, name string) (*k8sv1api.Node, error) {
+	return r.svc.DescribeNode(ctx, clientset, cluster, name)
+}
+
+// Only possible to get one at a time by name.
+func resolveByName[T protov2.Message](ctx context.Context, describe describeFunc[T], clientset, cluster, namespace, name string) (*resolver.Results, error) {
+	m, err := describe(ctx, clientset, cluster, namespace, name)
 	if err != nil {
 		return nil, err
 	}
-	return []*k8sv1api.Pod{pod}, nil
+	return &resolver.Results{Messages: []protov2.Message{m}}, nil
 }
 
-func (r *res) resolveForPod(ctx context.Context, input proto.Message) ([]*k8sv1api.Pod, error) {
+func (r *res) Resolve(ctx context.Context, typeURL string, input proto.Message, limit uint32) (*resolver.Results, error) {
 	switch i := input.(type) {
 	case *k8sv1resolver.PodID:
-		return r.locateByPodID(ctx, i)
-	default:
-		// TODO: IP address via List
-		return nil, status.Errorf(codes.Internal, "unrecognized input type %T", i)
+		if typeURL == typeURLPod {
+			return resolveByName(ctx, r.svc.DescribePod, i.Clientset, "", i.Namespace, i.Name)
+		}
+	case *k8sv1resolver.IPAddress:
+		if typeURL == typeURLPod {
+			return r.resolvePodsByIP(ctx, i.IpAddress, limit)
+		}
+	case *k8sv1resolver.HPAName:
+		if typeURL == typeURLHPA {
+			return resolveByName(ctx, r.svc.DescribeHPA, i.Clientset, "", i.Namespace, i.Name)
+		}
+	case *k8sv1resolver.Node:
+		if typeURL == typeURLNode {
+			return resolveByName(ctx, r.describeNode, i.Clientset, i.Cluster, "", i.Name)
+		}
+	case *k8sv1resolver.Deployment:
+		if typeURL == typeURLDeployment {
+			return resolveByName(ctx, r.svc.DescribeDeployment, i.Clientset, "", i.Namespace, i.Name)
+		}
+	case *k8sv1resolver.StatefulSet:
+		if typeURL == typeURLStatefulSet {
+			return resolveByName(ctx, r.svc.DescribeStatefulSet, i.Clientset, "", i.Namespace, i.Name)
+		}
+	case *k8sv1resolver.Service:
+		if typeURL == typeURLService {
+			return resolveByName(ctx, r.svc.DescribeService, i.Clientset, "", i.Namespace, i.Name)
+		}
+	case *k8sv1resolver.CronJob:
+		if typeURL == typeURLCronJob {
+			return resolveByName(ctx, r.svc.DescribeCronJob, i.Clientset, "", i.Namespace, i.Name)
+		}
+	case *k8sv1resolver.ConfigMap:
+		if typeURL == typeURLConfigMap {
+			return resolveByName(ctx, r.svc.DescribeConfigMap, i.Clientset, "", i.Namespace, i.Name)
+		}
+	case *k8sv1resolver.Job:
+		if typeURL == typeURLJob {
+			return resolveByName(ctx, r.svc.DescribeJob, i.Clientset, "", i.Namespace, i.Name)
+		}
+	case *k8sv1resolver.Namespace:
+		if typeURL == typeURLNamespace {
+			return resolveByName(ctx, r.describeNamespace, i.Clientset, "", "", i.Name)
+		}
+	}
+
+	if _, ok := typeSchemas[typeURL]; !ok {
+		return nil, status.Errorf(codes.Internal, "don't know how to resolve type %s", typeURL)
 	}
+	return nil, status.Errorf(codes.Internal, "unrecognized input type '%T' for type %s", input, typeURL)
 }
 
-func (r *res) locateByHPAName(ctx context.Context, in *k8sv1resolver.HPAName) ([]*k8sv1api.HPA, error) {
-	// Only possible to get one at a time by name.
-	hpa, err := r.svc.DescribeHPA(ctx, in.Clientset, "", in.Namespace, in.Name)
+// resolvePodsByIP looks up pods in the topology cache, which indexes them by IP address.
+func (r *res) resolvePodsByIP(ctx context.Context, ip string, limit uint32) (*resolver.Results, error) {
+	if r.topology == nil {
+		return nil, status.Error(codes.FailedPrecondition, "topology service must be enabled to locate pods by IP address")
+	}
+	if net.ParseIP(ip) == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "'%s' is not a valid IP address", ip)
+	}
+
+	resources, _, err := r.topology.Search(ctx, &topologyv1.SearchRequest{
+		Limit: uint64(limit),
+		Filter: &topologyv1.SearchRequest_Filter{
+			TypeUrl:  typeURLPod,
+			Metadata: map[string]string{k8s.PodIPMetadataKey: ip},
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
-	return []*k8sv1api.HPA{hpa}, nil
-}
 
-func (r *res) resolveForHPA(ctx context.Context, input proto.Message) ([]*k8sv1api.HPA, error) {
-	switch i := input.(type) {
-	case *k8sv1resolver.HPAName:
-		return r.locateByHPAName(ctx, i)
-	default:
-		return nil, status.Errorf(codes.Internal, "unrecognized input type '%T'", i)
+	ret := &resolver.Results{Messages: make([]protov2.Message, 0, len(resources))}
+	for _, resource := range resources {
+		pod := &k8sv1api.Pod{}
+		if err := resource.Pb.UnmarshalTo(pod); err != nil {
+			return nil, err
+		}
+		ret.Messages = append(ret.Messages, pod)
 	}
+	return ret, nil
 }
 
-func (r *res) locateByNodeName(ctx context.Context, in *k8sv1resolver.Node) ([]*k8sv1api.Node, error) {
-	// Only possible to get one at a time by name.
-	node, err := r.svc.DescribeNode(ctx, in.Clientset, in.Cluster, in.Name)
+// searchByName fans out across clientsets looking for a resource by name or by its ID, e.g. cluster/namespace/name.
+// Without a namespace in the query, namespaced resources are looked up in all namespaces.
+func searchByName[T protov2.Message](ctx context.Context, handler resolver.FanoutHandler, clientsets []string, query string, describe describeFunc[T]) error {
+	if !idPattern.MatchString(query) {
+		return status.Error(codes.InvalidArgument, "did not understand input")
+	}
+
+	var zero T
+	patternValues, ok, err := meta.ExtractPatternValuesFromString(zero, query)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return []*k8sv1api.Node{node}, nil
-}
 
-func (r *res) resolveForNode(ctx context.Context, input proto.Message) ([]*k8sv1api.Node, error) {
-	switch i := input.(type) {
-	case *k8sv1resolver.Node:
-		return r.locateByNodeName(ctx, i)
-	default:
-		return nil, status.Errorf(codes.Internal, "unrecognized input type '%T'", i)
+	namespace := metav1.NamespaceAll
+	name := query
+	cluster := ""
+
+	if ok {
+		namespace = patternValues["namespace"]
+		name = patternValues["name"]
+		cluster = patternValues["cluster"]
 	}
-}
 
-func (r *res) Resolve(ctx context.Context, typeURL string, input proto.Message, limit uint32) (*resolver.Results, error) {
-	switch typeURL {
-	case typeURLPod:
-		result, err := r.resolveForPod(ctx, input)
-		if err != nil {
-			return nil, err
-		}
-		return &resolver.Results{Messages: resolver.MessageSlice(result)}, nil
-	case typeURLHPA:
-		result, err := r.resolveForHPA(ctx, input)
-		if err != nil {
-			return nil, err
-		}
-		return &resolver.Results{Messages: resolver.MessageSlice(result)}, nil
-	case typeURLNode:
-		result, err := r.resolveForNode(ctx, input)
-		if err != nil {
-			return nil, err
-		}
-		return &resolver.Results{Messages: resolver.MessageSlice(result)}, nil
-	default:
-		return nil, status.Errorf(codes.Internal, "don't know how to resolve type %s", typeURL)
+	for _, clientset := range clientsets {
+		handler.Add(1)
+		go func(clientset string) {
+			defer handler.Done()
+			m, err := describe(ctx, clientset, cluster, namespace, name)
+			select {
+			case handler.Channel() <- resolver.NewSingleFanoutResult(m, err):
+				return
+			case <-handler.Cancelled():
+				return
+			}
+		}(clientset)
 	}
+	return nil
 }
 
 func (r *res) Search(ctx context.Context, typeURL, query string, limit uint32) (*resolver.Results, error) {
+	if typeURL == typeURLPod && r.topology != nil && net.ParseIP(query) != nil {
+		return r.resolvePodsByIP(ctx, query, limit)
+	}
+
 	clientsets, err := r.svc.Clientsets(ctx)
 	if err != nil {
 		return nil, err
@@ -200,105 +301,31 @@ func (r *res) Search(ctx context.Context, typeURL, query string, limit uint32) (
 	ctx, handler := resolver.NewFanoutHandler(ctx)
 	switch typeURL {
 	case typeURLPod:
-		if idPattern.MatchString(query) {
-			patternValues, ok, err := meta.ExtractPatternValuesFromString((*k8sv1api.Pod)(nil), query)
-			if err != nil {
-				return nil, err
-			}
-
-			namespace := metav1.NamespaceAll
-			podQuery := query
-			cluster := ""
-
-			if ok {
-				namespace = patternValues["namespace"]
-				podQuery = patternValues["name"]
-				cluster = patternValues["cluster"]
-			}
-
-			for _, clientset := range clientsets {
-				handler.Add(1)
-				go func(clientset, cluster, namespace, name string) {
-					defer handler.Done()
-					pod, err := r.svc.DescribePod(ctx, clientset, cluster, namespace, name)
-					select {
-					case handler.Channel() <- resolver.NewFanoutResult([]*k8sv1api.Pod{pod}, err):
-						return
-					case <-handler.Cancelled():
-						return
-					}
-				}(clientset, cluster, namespace, podQuery)
-			}
-		} else {
-			return nil, status.Error(codes.InvalidArgument, "did not understand input")
-		}
+		err = searchByName(ctx, handler, clientsets, query, r.svc.DescribePod)
 	case typeURLHPA:
-		if idPattern.MatchString(query) {
-			patternValues, ok, err := meta.ExtractPatternValuesFromString((*k8sv1api.HPA)(nil), query)
-			if err != nil {
-				return nil, err
-			}
-
-			namespace := metav1.NamespaceAll
-			hpaQuery := query
-			cluster := ""
-
-			if ok {
-				namespace = patternValues["namespace"]
-				hpaQuery = patternValues["name"]
-				cluster = patternValues["cluster"]
-			}
-
-			for _, clientset := range clientsets {
-				handler.Add(1)
-				go func(clientset, cluster, namespace, query string) {
-					defer handler.Done()
-					hpa, err := r.svc.DescribeHPA(ctx, clientset, cluster, namespace, query)
-					select {
-					case handler.Channel() <- resolver.NewFanoutResult([]*k8sv1api.HPA{hpa}, err):
-						return
-					case <-handler.Cancelled():
-						return
-					}
-				}(clientset, cluster, namespace, hpaQuery)
-			}
-		} else {
-			return nil, status.Error(codes.InvalidArgument, "did not understand input")
-		}
+		err = searchByName(ctx, handler, clientsets, query, r.svc.DescribeHPA)
 	case typeURLNode:
-		if idPattern.MatchString(query) {
-			patternValues, ok, err := meta.ExtractPatternValuesFromString((*k8sv1api.Node)(nil), query)
-			if err != nil {
-				return nil, err
-			}
-
-			nodeQuery := query
-			cluster := ""
-
-			if ok {
-				nodeQuery = patternValues["name"]
-				cluster = patternValues["cluster"]
-			}
-
-			for _, clientset := range clientsets {
-				handler.Add(1)
-				go func(clientset, cluster, query string) {
-					defer handler.Done()
-					node, err := r.svc.DescribeNode(ctx, clientset, cluster, query)
-					select {
-					case handler.Channel() <- resolver.NewFanoutResult([]*k8sv1api.Node{node}, err):
-						return
-					case <-handler.Cancelled():
-						return
-					}
-				}(clientset, cluster, nodeQuery)
-			}
-		} else {
-			return nil, status.Error(codes.InvalidArgument, "did not understand input")
-		}
+		err = searchByName(ctx, handler, clientsets, query, r.describeNode)
+	case typeURLDeployment:
+		err = searchByName(ctx, handler, clientsets, query, r.svc.DescribeDeployment)
+	case typeURLStatefulSet:
+		err = searchByName(ctx, handler, clientsets, query, r.svc.DescribeStatefulSet)
+	case typeURLService:
+		err = searchByName(ctx, handler, clientsets, query, r.svc.DescribeService)
+	case typeURLCronJob:
+		err = searchByName(ctx, handler, clientsets, query, r.svc.DescribeCronJob)
+	case typeURLConfigMap:
+		err = searchByName(ctx, handler, clientsets, query, r.svc.DescribeConfigMap)
+	case typeURLJob:
+		err = searchByName(ctx, handler, clientsets, query, r.svc.DescribeJob)
+	case typeURLNamespace:
+		err = searchByName(ctx, handler, clientsets, query, r.describeNamespace)
 	default:
 		return nil, status.Error(codes.Internal, fmt.Sprintf("cannot search for type '%s'", typeURL))
 	}
+	if err != nil {
+		return nil, err
+	}
 
 	return handler.Results(limit)
 }
@@ -321,12 +348,59 @@ func (r *res) Autocomplete(ctx context.Context, typeURL, search string, limit ui
 	autoCompleteValue := make([]*resolverv1.AutocompleteResult, len(results))
 	for i, r := range results {
 		autoCompleteValue[i] = &resolverv1.AutocompleteResult{
-			Id: r.Id,
-			// TODO (mcutalo): Add more detailed information to the label
-			// the labels value will vary based on resource
-			Label: "",
+			Id:    r.Id,
+			Label: autocompleteLabel(r),
 		}
 	}
 
 	return autoCompleteValue, nil
 }
+
+// autocompleteLabel summarizes the state of a cached resource, since its ID already includes the cluster, namespace
+// and name.
+func autocompleteLabel(resource *topologyv1.Resource) string {
+	if resource.Pb == nil {
+		return ""
+	}
+	m, err := resource.Pb.UnmarshalNew()
+	if err != nil {
+		return ""
+	}
+
+	var details []string
+	switch pb := m.(type) {
+	case *k8sv1api.Pod:
+		details = append(details, strings.ToLower(pb.State.String()))
+		if pb.PodIp != "" {
+			details = append(details, pb.PodIp)
+		}
+	case *k8sv1api.Deployment:
+		status := pb.GetDeploymentStatus()
+		details = append(details, fmt.Sprintf("%d/%d ready", status.GetReadyReplicas(), status.GetReplicas()))
+	case *k8sv1api.StatefulSet:
+		status := pb.GetStatus()
+		details = append(details, fmt.Sprintf("%d/%d ready", status.GetReadyReplicas(), status.GetReplicas()))
+	case *k8sv1api.HPA:
+		sizing := pb.GetSizing()
+		details = append(details,
+			fmt.Sprintf("%d replicas", sizing.GetCurrentReplicas()),
+			fmt.Sprintf("min %d, max %d", sizing.GetMinReplicas(), sizing.GetMaxReplicas()),
+		)
+	case *k8sv1api.Node:
+		if pb.Unschedulable {
+			details = append(details, "unschedulable")
+		} else {
+			details = append(details, "schedulable")
+		}
+	case *k8sv1api.Service:
+		details = append(details, strings.ToLower(pb.Type.String()))
+	case *k8sv1api.CronJob:
+		details = append(details, pb.Schedule)
+		if pb.Suspend {
+			details = append(details, "suspended")
+		}
+	case *k8sv1api.ConfigMap:
+		details = append(details, fmt.Sprintf("%d keys", len(pb.Data)+len(pb.BinaryData)))
+	}
+	return strings.Join(details, ", ")
+}
@@ -0,0 +1,187 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	k8sv1api "github.com/lyft/clutch/backend/api/k8s/v1"
+	k8sv1resolver "github.com/lyft/clutch/backend/api/resolver/k8s/v1"
+	topologyv1 "github.com/lyft/clutch/backend/api/topology/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
+	"github.com/lyft/clutch/backend/mock/service/k8smock"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/k8s"
+	"github.com/lyft/clutch/backend/service/topology"
+)
+
+func newTestResolver(t *testing.T, topologyService topology.Service) *res {
+	service.Registry["clutch.service.k8s"] = k8smock.New()
+	delete(service.Registry, topology.Name)
+	if topologyService != nil {
+		service.Registry[topology.Name] = topologyService
+		defer delete(service.Registry, topology.Name)
+	}
+
+	r, err := New(nil, zaptest.NewLogger(t), tally.NewTestScope("", nil))
+	assert.NoError(t, err)
+	return r.(*res)
+}
+
+func TestSchemas(t *testing.T) {
+	r := newTestResolver(t, nil)
+	for typeURL := range typeSchemas {
+		assert.NotEmpty(t, r.Schemas()[typeURL], typeURL)
+	}
+	assert.Len(t, r.Schemas()[typeURLPod], 2)
+}
+
+func TestResolve(t *testing.T) {
+	r := newTestResolver(t, nil)
+
+	inputs := map[string]proto.Message{
+		typeURLPod:         &k8sv1resolver.PodID{Name: "pod1", Clientset: "cs", Namespace: "ns"},
+		typeURLHPA:         &k8sv1resolver.HPAName{Name: "hpa1", Clientset: "cs", Namespace: "ns"},
+		typeURLNode:        &k8sv1resolver.Node{Name: "node1", Clientset: "cs", Cluster: "c"},
+		typeURLDeployment:  &k8sv1resolver.Deployment{Name: "deployment1", Clientset: "cs", Namespace: "ns"},
+		typeURLStatefulSet: &k8sv1resolver.StatefulSet{Name: "statefulset1", Clientset: "cs", Namespace: "ns"},
+		typeURLService:     &k8sv1resolver.Service{Name: "service1", Clientset: "cs", Namespace: "ns"},
+		typeURLCronJob:     &k8sv1resolver.CronJob{Name: "cronjob1", Clientset: "cs", Namespace: "ns"},
+		typeURLConfigMap:   &k8sv1resolver.ConfigMap{Name: "configmap1", Clientset: "cs", Namespace: "ns"},
+		typeURLJob:         &k8sv1resolver.Job{Name: "job1", Clientset: "cs", Namespace: "ns"},
+		typeURLNamespace:   &k8sv1resolver.Namespace{Name: "ns", Clientset: "cs"},
+	}
+	for typeURL, input := range inputs {
+		results, err := r.Resolve(context.Background(), typeURL, input, 1)
+		assert.NoError(t, err, typeURL)
+		assert.Len(t, results.Messages, 1, typeURL)
+		assert.Equal(t, typeURL, meta.TypeURL(results.Messages[0]))
+	}
+
+	_, err := r.Resolve(context.Background(), typeURLDeployment, &k8sv1resolver.PodID{}, 1)
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	_, err = r.Resolve(context.Background(), "type.googleapis.com/foo", &k8sv1resolver.PodID{}, 1)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+type fakePodIndex struct {
+	topology.Service
+
+	pods []*k8sv1api.Pod
+}
+
+func (f *fakePodIndex) Search(ctx context.Context, req *topologyv1.SearchRequest) ([]*topologyv1.Resource, string, error) {
+	var ret []*topologyv1.Resource
+	for _, pod := range f.pods {
+		if req.Filter.TypeUrl != typeURLPod || req.Filter.Metadata[k8s.PodIPMetadataKey] != pod.PodIp {
+			continue
+		}
+		pb, err := anypb.New(pod)
+		if err != nil {
+			return nil, "", err
+		}
+		ret = append(ret, &topologyv1.Resource{Id: pod.Cluster + "/" + pod.Namespace + "/" + pod.Name, Pb: pb})
+	}
+	return ret, "1", nil
+}
+
+func (f *fakePodIndex) Autocomplete(ctx context.Context, typeURL, search string, limit uint64, caseSensitive bool) ([]*topologyv1.Resource, error) {
+	resources, _, err := f.Search(ctx, &topologyv1.SearchRequest{
+		Filter: &topologyv1.SearchRequest_Filter{TypeUrl: typeURL, Metadata: map[string]string{k8s.PodIPMetadataKey: search}},
+	})
+	return resources, err
+}
+
+func TestResolvePodsByIP(t *testing.T) {
+	input := &k8sv1resolver.IPAddress{IpAddress: "10.0.0.1"}
+
+	r := newTestResolver(t, nil)
+	_, err := r.Resolve(context.Background(), typeURLPod, input, 1)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	r = newTestResolver(t, &fakePodIndex{pods: []*k8sv1api.Pod{
+		{Cluster: "c", Namespace: "ns", Name: "pod1", PodIp: "10.0.0.1"},
+		{Cluster: "c", Namespace: "ns", Name: "pod2", PodIp: "10.0.0.2"},
+	}})
+
+	results, err := r.Resolve(context.Background(), typeURLPod, input, 1)
+	assert.NoError(t, err)
+	assert.Len(t, results.Messages, 1)
+	assert.Equal(t, "pod1", results.Messages[0].(*k8sv1api.Pod).Name)
+
+	// IP addresses are searched in the index rather than by name.
+	results, err = r.Search(context.Background(), typeURLPod, "10.0.0.2", 1)
+	assert.NoError(t, err)
+	assert.Len(t, results.Messages, 1)
+	assert.Equal(t, "pod2", results.Messages[0].(*k8sv1api.Pod).Name)
+
+	_, err = r.Resolve(context.Background(), typeURLPod, &k8sv1resolver.IPAddress{IpAddress: "pod1"}, 1)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSearch(t *testing.T) {
+	r := newTestResolver(t, nil)
+
+	for typeURL := range typeSchemas {
+		results, err := r.Search(context.Background(), typeURL, "cluster/ns/abc", 1)
+		assert.NoError(t, err, typeURL)
+		assert.Len(t, results.Messages, 1, typeURL)
+		assert.Empty(t, results.PartialFailures, typeURL)
+	}
+
+	results, err := r.Search(context.Background(), typeURLPod, "fake-cluster-name/ns/abc", 1)
+	assert.NoError(t, err)
+	pod := results.Messages[0].(*k8sv1api.Pod)
+	assert.Equal(t, "ns", pod.Namespace)
+	assert.Equal(t, "abc", pod.Name)
+
+	_, err = r.Search(context.Background(), typeURLPod, "zzz", 1)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = r.Search(context.Background(), "type.googleapis.com/foo", "abc", 1)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestAutocompleteLabel(t *testing.T) {
+	testCases := []struct {
+		pb     proto.Message
+		expect string
+	}{
+		{pb: &k8sv1api.Pod{State: k8sv1api.Pod_RUNNING, PodIp: "10.0.0.1"}, expect: "running, 10.0.0.1"},
+		{
+			pb:     &k8sv1api.Deployment{DeploymentStatus: &k8sv1api.Deployment_DeploymentStatus{Replicas: 3, ReadyReplicas: 2}},
+			expect: "2/3 ready",
+		},
+		{
+			pb:     &k8sv1api.HPA{Sizing: &k8sv1api.HPA_Sizing{MinReplicas: 1, MaxReplicas: 5, CurrentReplicas: 2}},
+			expect: "2 replicas, min 1, max 5",
+		},
+		{pb: &k8sv1api.Node{Unschedulable: true}, expect: "unschedulable"},
+		{pb: &k8sv1api.CronJob{Schedule: "0 * * * *", Suspend: true}, expect: "0 * * * *, suspended"},
+		{pb: &k8sv1api.Namespace{Name: "ns"}, expect: ""},
+	}
+
+	for _, tt := range testCases {
+		pb, err := anypb.New(proto.MessageV2(tt.pb))
+		assert.NoError(t, err)
+		assert.Equal(t, tt.expect, autocompleteLabel(&topologyv1.Resource{Pb: pb}))
+	}
+	assert.Equal(t, "", autocompleteLabel(&topologyv1.Resource{}))
+
+	r := newTestResolver(t, &fakePodIndex{pods: []*k8sv1api.Pod{
+		{Cluster: "c", Namespace: "ns", Name: "pod1", PodIp: "10.0.0.1", State: k8sv1api.Pod_PENDING},
+	}})
+	results, err := r.Autocomplete(context.Background(), typeURLPod, "10.0.0.1", 0, false)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "c/ns/pod1", results[0].Id)
+	assert.Equal(t, "pending, 10.0.0.1", results[0].Label)
+}
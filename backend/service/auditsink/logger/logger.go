@@ -5,9 +5,12 @@ package logger
 // <!-- END clutchdoc -->
 
 import (
+	"sync"
+
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
 	configv1 "github.com/lyft/clutch/backend/api/config/service/audit/v1"
@@ -32,11 +35,36 @@ func New(cfg *any.Any, logger *zap.Logger, _ tally.Scope) (service.Service, erro
 
 type svc struct {
 	logger *zap.Logger
+
+	mu     sync.RWMutex
 	filter *configv1.Filter
 }
 
+// PrepareReload replaces the filter. A nil config removes the filter, and a config that can't be unmarshaled fails the
+// reload so that the current filter is kept.
+func (s *svc) PrepareReload(cfg *anypb.Any) (func(), error) {
+	var filter *configv1.Filter
+	if cfg != nil {
+		config := &configv1.SinkConfig{}
+		if err := cfg.UnmarshalTo(config); err != nil {
+			return nil, err
+		}
+		filter = config.Filter
+	}
+
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.filter = filter
+	}, nil
+}
+
 func (s *svc) Write(event *auditv1.Event) error {
-	if auditsink.Filter(s.filter, event) {
+	s.mu.RLock()
+	filter := s.filter
+	s.mu.RUnlock()
+
+	if auditsink.Filter(filter, event) {
 		s.logger.Info("new audit event", log.ProtoField("event", event))
 	}
 	return nil
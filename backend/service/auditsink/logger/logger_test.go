@@ -0,0 +1,33 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	configv1 "github.com/lyft/clutch/backend/api/config/service/audit/v1"
+)
+
+func TestPrepareReload(t *testing.T) {
+	s := &svc{logger: zaptest.NewLogger(t)}
+
+	cfg, _ := anypb.New(&configv1.SinkConfig{Filter: &configv1.Filter{Denylist: true}})
+	apply, err := s.PrepareReload(cfg)
+	assert.NoError(t, err)
+	apply()
+	assert.True(t, s.filter.Denylist)
+
+	// A config of the wrong type fails the reload, which keeps the current filter.
+	invalid, _ := anypb.New(durationpb.New(0))
+	_, err = s.PrepareReload(invalid)
+	assert.Error(t, err)
+	assert.True(t, s.filter.Denylist)
+
+	apply, err = s.PrepareReload(nil)
+	assert.NoError(t, err)
+	apply()
+	assert.Nil(t, s.filter)
+}
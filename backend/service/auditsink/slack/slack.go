@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 	"text/template"
 
 	"github.com/golang/protobuf/ptypes/any"
@@ -17,6 +18,7 @@ import (
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
 	auditconfigv1 "github.com/lyft/clutch/backend/api/config/service/audit/v1"
@@ -66,6 +68,7 @@ type svc struct {
 	logger *zap.Logger
 	scope  tally.Scope
 
+	mu        sync.RWMutex
 	filter    *auditconfigv1.Filter
 	overrides OverrideLookup
 
@@ -73,8 +76,31 @@ type svc struct {
 	channel string
 }
 
+// PrepareReload builds the filter, message overrides and client of a new config.
+func (s *svc) PrepareReload(cfg *anypb.Any) (func(), error) {
+	config := &configv1.SlackConfig{}
+	if err := cfg.UnmarshalTo(config); err != nil {
+		return nil, err
+	}
+
+	overrides := NewOverrideLookup(config.Overrides)
+	client := slack.New(config.Token)
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.filter = config.Filter
+		s.overrides = overrides
+		s.slack = client
+		s.channel = config.Channel
+	}, nil
+}
+
 func (s *svc) Write(event *auditv1.Event) error {
-	if !auditsink.Filter(s.filter, event) {
+	s.mu.RLock()
+	filter := s.filter
+	s.mu.RUnlock()
+
+	if !auditsink.Filter(filter, event) {
 		return nil
 	}
 
@@ -87,8 +113,12 @@ func (s *svc) Write(event *auditv1.Event) error {
 }
 
 func (s *svc) writeRequestEvent(event *auditv1.RequestEvent) error {
+	s.mu.RLock()
+	client, channel := s.slack, s.channel
+	s.mu.RUnlock()
+
 	// Get user ID for pretty message printing.
-	user, err := s.slack.GetUserByEmail(event.Username)
+	user, err := client.GetUserByEmail(event.Username)
 
 	var username string
 	if err != nil {
@@ -105,7 +135,7 @@ func (s *svc) writeRequestEvent(event *auditv1.RequestEvent) error {
 	messageText := s.auditEventToMessage(username, event)
 
 	// Post
-	if _, _, err := s.slack.PostMessage(s.channel, slack.MsgOptionText(messageText, false)); err != nil {
+	if _, _, err := client.PostMessage(channel, slack.MsgOptionText(messageText, false)); err != nil {
 		return err
 	}
 	return nil
@@ -158,7 +188,11 @@ func (s *svc) auditEventToMessage(username string, event *auditv1.RequestEvent)
 	message := formatText(username, event)
 
 	// check for a custom message override for the slack event
-	cm, ok := s.overrides.GetOverrideMessage(event.ServiceName, event.MethodName)
+	s.mu.RLock()
+	overrides := s.overrides
+	s.mu.RUnlock()
+
+	cm, ok := overrides.GetOverrideMessage(event.ServiceName, event.MethodName)
 	if ok {
 		if customText, err := FormatCustomText(cm, event); err != nil {
 			s.logger.Error("create custom message error", log.ErrorField(err))
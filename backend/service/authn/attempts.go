@@ -0,0 +1,112 @@
+package authn
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	defaultLoginMaxFailuresPerUsername = 5
+	defaultLoginFailureWindow          = 15 * time.Minute
+
+	// Bounds the memory used to count failures, e.g. when many usernames are guessed. Keys that aren't tracked because
+	// the limit was reached aren't limited, but the per IP limit, if any, still applies to the clients guessing them.
+	maxLoginFailureKeys = 100000
+)
+
+// loginAttemptLimiter counts failed logins per key, e.g. per username or per client IP address. Once a key reaches
+// the maximum number of failures, further attempts are rejected until the window that started with the first failure
+// ends.
+type loginAttemptLimiter struct {
+	maxFailures int
+	window      time.Duration
+
+	mu       sync.Mutex
+	failures map[string]*loginFailures
+}
+
+type loginFailures struct {
+	count int
+	start time.Time
+}
+
+func newLoginAttemptLimiter(maxFailures int, window time.Duration) *loginAttemptLimiter {
+	return &loginAttemptLimiter{
+		maxFailures: maxFailures,
+		window:      window,
+		failures:    make(map[string]*loginFailures),
+	}
+}
+
+func (l *loginAttemptLimiter) allowed(key string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, ok := l.failures[key]
+	if !ok {
+		return true
+	}
+	if now.Sub(f.start) >= l.window {
+		delete(l.failures, key)
+		return true
+	}
+	return f.count < l.maxFailures
+}
+
+func (l *loginAttemptLimiter) fail(key string, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, ok := l.failures[key]
+	if ok && now.Sub(f.start) < l.window {
+		f.count++
+		return
+	}
+
+	if !ok && len(l.failures) >= maxLoginFailureKeys {
+		for k, f := range l.failures {
+			if now.Sub(f.start) >= l.window {
+				delete(l.failures, k)
+			}
+		}
+		if len(l.failures) >= maxLoginFailureKeys {
+			return
+		}
+	}
+	l.failures[key] = &loginFailures{count: 1, start: now}
+}
+
+func (l *loginAttemptLimiter) reset(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.failures, key)
+}
+
+// usedCodes records the IDs of exchanged codes until they expire, so that each code can only be exchanged once. It's
+// used when the authn storage service isn't configured, in which case codes are recorded by each gateway replica
+// separately and only a single replica is supported.
+type usedCodes struct {
+	mu  sync.Mutex
+	ids map[string]time.Time
+}
+
+// use records the code and returns false if it was already used.
+func (u *usedCodes) use(id string, expiresAt, now time.Time) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if u.ids == nil {
+		u.ids = make(map[string]time.Time)
+	}
+	for k, exp := range u.ids {
+		if !now.Before(exp) {
+			delete(u.ids, k)
+		}
+	}
+
+	if _, ok := u.ids[id]; ok {
+		return false
+	}
+	u.ids[id] = expiresAt
+	return true
+}
@@ -1,7 +1,7 @@
 package authn
 
 // <!-- START clutchdoc -->
-// description: Produces tokens for the configured OIDC provider.
+// description: Produces tokens for the configured OIDC provider or for local users.
 // <!-- END clutchdoc -->
 
 import (
@@ -13,10 +13,13 @@ import (
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
 	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	authnmodulev1 "github.com/lyft/clutch/backend/api/authn/v1"
 	authnv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+	"github.com/lyft/clutch/backend/middleware"
 	"github.com/lyft/clutch/backend/service"
 )
 
@@ -27,7 +30,10 @@ const Name = "clutch.service.authn"
 var AlwaysAllowedMethods = []string{
 	"/clutch.authn.v1.AuthnAPI/Callback",
 	"/clutch.authn.v1.AuthnAPI/Login",
-	"/clutch.healthcheck.v1.HealthcheckAPI/*",
+	// Probes can't authenticate. The deep healthcheck isn't included since it returns the errors of dependencies.
+	"/clutch.healthcheck.v1.HealthcheckAPI/Healthcheck",
+	"/clutch.healthcheck.v1.HealthcheckAPI/Liveness",
+	"/clutch.healthcheck.v1.HealthcheckAPI/Readiness",
 }
 
 func New(cfg *anypb.Any, logger *zap.Logger, scope tally.Scope) (service.Service, error) {
@@ -41,6 +47,8 @@ func New(cfg *anypb.Any, logger *zap.Logger, scope tally.Scope) (service.Service
 	switch t := config.Type.(type) {
 	case *authnv1.Config_Oidc:
 		return NewOIDCProvider(context.Background(), config, tokenStorage)
+	case *authnv1.Config_Local:
+		return NewLocalProvider(context.Background(), config, tokenStorage)
 	default:
 		return nil, fmt.Errorf("authn provider type '%T' not implemented", t)
 	}
@@ -52,6 +60,22 @@ type Claims struct {
 
 	// Groups could be derived from the token or an external mapping.
 	Groups []string `json:"grp,omitempty"`
+
+	// Scopes restrict the methods a token may call. If empty, the token is unrestricted.
+	Scopes []string `json:"scp,omitempty"`
+}
+
+// CheckScopes returns an error unless the method matches one of the claims' scopes, or the claims have no scopes.
+func (c *Claims) CheckScopes(fullMethod string) error {
+	if len(c.Scopes) == 0 {
+		return nil
+	}
+	for _, scope := range c.Scopes {
+		if middleware.MatchMethodOrResource(scope, fullMethod) {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "method '%s' is not within the token's scopes", fullMethod)
 }
 
 type Provider interface {
@@ -65,11 +89,34 @@ type Provider interface {
 
 type Issuer interface {
 	// CreateToken creates a new OAuth2 for the provided subject with the provided expiration. If expiry is nil,
-	// the token will never expire.
-	CreateToken(ctx context.Context, subject string, tokenType authnmodulev1.CreateTokenRequest_TokenType, expiry *time.Duration) (token *oauth2.Token, err error)
+	// the token will never expire. If token metadata is stored, the token's ID is set as the "token_id" extra.
+	CreateToken(ctx context.Context, subject string, tokenType authnmodulev1.CreateTokenRequest_TokenType, expiry *time.Duration, opts ...TokenOption) (token *oauth2.Token, err error)
 	RefreshToken(ctx context.Context, token *oauth2.Token) (*oauth2.Token, error)
 }
 
+type tokenOptions struct {
+	description string
+	scopes      []string
+}
+
+type TokenOption func(*tokenOptions)
+
+// WithTokenDescription records what a created token is used for.
+func WithTokenDescription(description string) TokenOption {
+	return func(o *tokenOptions) { o.description = description }
+}
+
+// WithTokenScopes restricts a created token to the methods matching the patterns.
+func WithTokenScopes(scopes ...string) TokenOption {
+	return func(o *tokenOptions) { o.scopes = scopes }
+}
+
+// TokenManager lists and revokes issued service tokens.
+type TokenManager interface {
+	ListTokens(ctx context.Context, includeRevoked bool) ([]*authnmodulev1.ServiceToken, error)
+	RevokeToken(ctx context.Context, id string) error
+}
+
 type Service interface {
 	Issuer
 	Provider
@@ -88,3 +135,15 @@ type Storage interface {
 	TokenReader
 	TokenStorer
 }
+
+// ServiceTokenStorage stores metadata about issued service tokens so they can be listed and revoked.
+type ServiceTokenStorage interface {
+	CreateServiceToken(ctx context.Context, token *authnmodulev1.ServiceToken) error
+	ListServiceTokens(ctx context.Context, includeRevoked bool) ([]*authnmodulev1.ServiceToken, error)
+	RevokeServiceToken(ctx context.Context, id string) error
+	// CheckServiceToken returns an error if the token was revoked or doesn't exist. It is called for every request
+	// made with the token, so it only reads.
+	CheckServiceToken(ctx context.Context, id string) error
+	// RecordServiceTokenUse sets the time the token was last used.
+	RecordServiceTokenUse(ctx context.Context, id string) error
+}
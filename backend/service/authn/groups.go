@@ -0,0 +1,332 @@
+package authn
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	githubv3 "github.com/google/go-github/v37/github"
+	"gopkg.in/yaml.v3"
+
+	authnv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+	"github.com/lyft/clutch/backend/service"
+)
+
+const (
+	defaultGroupCacheTTL    = 5 * time.Minute
+	defaultGroupMaxStale    = time.Hour
+	defaultGroupMaxEntries  = 10000
+	defaultGroupHTTPTimeout = 5 * time.Second
+	defaultGitHubPrefix     = "github:"
+
+	// Looked up by name since the GitHub service depends on this package.
+	githubServiceName = "clutch.service.github"
+)
+
+// GroupResolver returns the groups a subject belongs to in an external directory.
+type GroupResolver interface {
+	ResolveGroups(ctx context.Context, subject string) ([]string, error)
+}
+
+// NewGroupResolver creates a resolver that combines the groups from each configured source and caches the result.
+func NewGroupResolver(cfg *authnv1.GroupResolver) (GroupResolver, error) {
+	var sources []GroupResolver
+	for _, s := range cfg.Sources {
+		var r GroupResolver
+		var err error
+		switch t := s.Type.(type) {
+		case *authnv1.GroupSource_Github:
+			r, err = newGitHubGroupResolver(t.Github)
+		case *authnv1.GroupSource_Static:
+			r, err = newStaticGroupResolver(t.Static)
+		case *authnv1.GroupSource_Http:
+			r = newHTTPGroupResolver(t.Http)
+		default:
+			err = fmt.Errorf("group source type '%T' not implemented", t)
+		}
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, r)
+	}
+
+	ttl := defaultGroupCacheTTL
+	if cfg.CacheTtl != nil {
+		ttl = cfg.CacheTtl.AsDuration()
+	}
+	maxStale := defaultGroupMaxStale
+	if cfg.MaxStaleness != nil {
+		maxStale = cfg.MaxStaleness.AsDuration()
+	}
+	maxEntries := defaultGroupMaxEntries
+	if cfg.MaxCacheEntries > 0 {
+		maxEntries = int(cfg.MaxCacheEntries)
+	}
+
+	return &cachingGroupResolver{
+		resolver:   multiGroupResolver(sources),
+		ttl:        ttl,
+		maxStale:   maxStale,
+		maxEntries: maxEntries,
+		entries:    make(map[string]*groupCacheEntry),
+	}, nil
+}
+
+// mergeGroups returns the union of the groups, sorted and without empty names.
+func mergeGroups(groups ...[]string) []string {
+	seen := make(map[string]bool)
+	var ret []string
+	for _, gs := range groups {
+		for _, g := range gs {
+			if g != "" && !seen[g] {
+				seen[g] = true
+				ret = append(ret, g)
+			}
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+type multiGroupResolver []GroupResolver
+
+func (m multiGroupResolver) ResolveGroups(ctx context.Context, subject string) ([]string, error) {
+	var groups [][]string
+	for _, r := range m {
+		g, err := r.ResolveGroups(ctx, subject)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+	}
+	return mergeGroups(groups...), nil
+}
+
+type groupCacheEntry struct {
+	groups  []string
+	expires time.Time
+}
+
+// cachingGroupResolver caches resolved groups per subject. Expired groups are used for up to maxStale if resolving
+// fails, so that a briefly unavailable directory doesn't prevent users from logging in.
+type cachingGroupResolver struct {
+	resolver   GroupResolver
+	ttl        time.Duration
+	maxStale   time.Duration
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]*groupCacheEntry
+}
+
+func (c *cachingGroupResolver) ResolveGroups(ctx context.Context, subject string) ([]string, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[subject]
+	c.mu.Unlock()
+	now := time.Now()
+	if ok && now.Before(entry.expires) {
+		return entry.groups, nil
+	}
+
+	groups, err := c.resolver.ResolveGroups(ctx, subject)
+	if err != nil {
+		if ok && now.Before(entry.expires.Add(c.maxStale)) {
+			return entry.groups, nil
+		}
+		return nil, fmt.Errorf("could not resolve groups for '%s': %w", subject, err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[subject]; !ok && len(c.entries) >= c.maxEntries {
+		c.evict(now)
+	}
+	c.entries[subject] = &groupCacheEntry{groups: groups, expires: now.Add(c.ttl)}
+	return groups, nil
+}
+
+// evict removes the entries that can no longer be used, or the entry expiring first if all of them can. Must be
+// called with the lock held.
+func (c *cachingGroupResolver) evict(now time.Time) {
+	var oldest string
+	for subject, entry := range c.entries {
+		if !now.Before(entry.expires.Add(c.maxStale)) {
+			delete(c.entries, subject)
+			continue
+		}
+		if oldest == "" || entry.expires.Before(c.entries[oldest].expires) {
+			oldest = subject
+		}
+	}
+	if len(c.entries) >= c.maxEntries && oldest != "" {
+		delete(c.entries, oldest)
+	}
+}
+
+// The subset of the GitHub service's client used to resolve groups.
+type githubMembershipClient interface {
+	GetOrgMembership(ctx context.Context, user, org string) (*githubv3.Membership, error)
+	GetTeamMembership(ctx context.Context, org, team, user string) (*githubv3.Membership, error)
+}
+
+type githubGroupResolver struct {
+	client githubMembershipClient
+	cfg    *authnv1.GitHubGroupSource
+	prefix string
+}
+
+func newGitHubGroupResolver(cfg *authnv1.GitHubGroupSource) (*githubGroupResolver, error) {
+	svc, ok := service.Registry[githubServiceName]
+	if !ok {
+		return nil, errors.New("the github group source requires the github service to be configured before the authn service")
+	}
+	client, ok := svc.(githubMembershipClient)
+	if !ok {
+		return nil, errors.New("github service was not the correct type")
+	}
+
+	// Without a suffix every subject would be looked up as a GitHub username.
+	if cfg.SubjectSuffix == "" {
+		return nil, errors.New("the github group source requires a subject suffix")
+	}
+
+	prefix := cfg.GroupPrefix
+	if prefix == "" {
+		prefix = defaultGitHubPrefix
+	}
+	return &githubGroupResolver{client: client, cfg: cfg, prefix: prefix}, nil
+}
+
+func (g *githubGroupResolver) ResolveGroups(ctx context.Context, subject string) ([]string, error) {
+	user := strings.TrimSuffix(subject, g.cfg.SubjectSuffix)
+	if user == subject || user == "" {
+		return nil, nil
+	}
+
+	var ret []string
+	for _, org := range g.cfg.Organizations {
+		m, err := g.client.GetOrgMembership(ctx, user, org)
+		if err != nil {
+			// Non-members are reported as not found.
+			var errResp *githubv3.ErrorResponse
+			if errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return nil, err
+		}
+		if m.GetState() == "active" {
+			ret = append(ret, g.prefix+org)
+		}
+	}
+
+	for _, team := range g.cfg.Teams {
+		m, err := g.client.GetTeamMembership(ctx, team.Organization, team.Slug, user)
+		if err != nil {
+			return nil, err
+		}
+		if m.GetState() == "active" {
+			ret = append(ret, g.prefix+team.Organization+"/"+team.Slug)
+		}
+	}
+	return ret, nil
+}
+
+// staticGroupResolver maps subjects to groups.
+type staticGroupResolver map[string][]string
+
+func newStaticGroupResolver(cfg *authnv1.StaticGroupSource) (staticGroupResolver, error) {
+	members := make(map[string][]string)
+	switch {
+	case cfg.Path != "" && len(cfg.Groups) > 0:
+		return nil, errors.New("only one of path or groups may be set on the static group source")
+	case cfg.Path != "":
+		b, err := os.ReadFile(cfg.Path)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.Unmarshal(b, &members); err != nil {
+			return nil, fmt.Errorf("could not parse group file '%s': %w", cfg.Path, err)
+		}
+	case len(cfg.Groups) > 0:
+		for group, m := range cfg.Groups {
+			members[group] = m.Subjects
+		}
+	default:
+		return nil, errors.New("path or groups must be set on the static group source")
+	}
+
+	ret := make(staticGroupResolver)
+	for group, subjects := range members {
+		for _, subject := range subjects {
+			ret[subject] = append(ret[subject], group)
+		}
+	}
+	return ret, nil
+}
+
+func (s staticGroupResolver) ResolveGroups(ctx context.Context, subject string) ([]string, error) {
+	return s[subject], nil
+}
+
+type httpGroupResolver struct {
+	client  *http.Client
+	url     string
+	headers map[string]string
+}
+
+func newHTTPGroupResolver(cfg *authnv1.HTTPGroupSource) *httpGroupResolver {
+	timeout := defaultGroupHTTPTimeout
+	if cfg.Timeout != nil {
+		timeout = cfg.Timeout.AsDuration()
+	}
+	return &httpGroupResolver{
+		client:  &http.Client{Timeout: timeout},
+		url:     cfg.Url,
+		headers: cfg.Headers,
+	}
+}
+
+func (h *httpGroupResolver) ResolveGroups(ctx context.Context, subject string) ([]string, error) {
+	u, err := url.Parse(h.url)
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Set("subject", subject)
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range h.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("group endpoint returned status %d", resp.StatusCode)
+	}
+
+	body := struct {
+		Groups []string `json:"groups"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("could not decode group endpoint response: %w", err)
+	}
+	return body.Groups, nil
+}
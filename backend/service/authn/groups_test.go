@@ -0,0 +1,221 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	githubv3 "github.com/google/go-github/v37/github"
+	"github.com/stretchr/testify/assert"
+
+	authnv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+	apimock "github.com/lyft/clutch/backend/mock/api"
+	"github.com/lyft/clutch/backend/service"
+)
+
+func TestStaticGroupResolver(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "groups.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(`
+oncall: [user@example.com, other@example.com]
+admins: [user@example.com]
+`), 0600))
+
+	cfg := &authnv1.GroupResolver{}
+	apimock.FromYAML(`
+sources:
+  - static:
+      path: `+path+`
+  - static:
+      groups:
+        readers:
+          subjects: [other@example.com]
+`, cfg)
+
+	r, err := NewGroupResolver(cfg)
+	assert.NoError(t, err)
+
+	groups, err := r.ResolveGroups(context.Background(), "user@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"admins", "oncall"}, groups)
+
+	groups, err = r.ResolveGroups(context.Background(), "other@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"oncall", "readers"}, groups)
+
+	groups, err = r.ResolveGroups(context.Background(), "nobody@example.com")
+	assert.NoError(t, err)
+	assert.Empty(t, groups)
+
+	_, err = newStaticGroupResolver(&authnv1.StaticGroupSource{})
+	assert.Error(t, err)
+}
+
+func TestHTTPGroupResolver(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Query().Get("subject") == "user@example.com" {
+			_, _ = w.Write([]byte(`{"groups": ["oncall"]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	r := newHTTPGroupResolver(&authnv1.HTTPGroupSource{
+		Url:     srv.URL,
+		Headers: map[string]string{"Authorization": "Bearer secret"},
+	})
+
+	groups, err := r.ResolveGroups(context.Background(), "user@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"oncall"}, groups)
+
+	groups, err = r.ResolveGroups(context.Background(), "other@example.com")
+	assert.NoError(t, err)
+	assert.Empty(t, groups)
+
+	r.headers = nil
+	_, err = r.ResolveGroups(context.Background(), "user@example.com")
+	assert.EqualError(t, err, "group endpoint returned status 401")
+}
+
+type fakeGitHubMembershipClient struct {
+	orgs  map[string]bool
+	teams map[string]bool
+}
+
+func (f *fakeGitHubMembershipClient) GetOrgMembership(ctx context.Context, user, org string) (*githubv3.Membership, error) {
+	if !f.orgs[org] {
+		return nil, &githubv3.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}}
+	}
+	return &githubv3.Membership{State: githubv3.String("active")}, nil
+}
+
+func (f *fakeGitHubMembershipClient) GetTeamMembership(ctx context.Context, org, team, user string) (*githubv3.Membership, error) {
+	if !f.teams[org+"/"+team] {
+		return &githubv3.Membership{}, nil
+	}
+	return &githubv3.Membership{State: githubv3.String("active")}, nil
+}
+
+func TestGitHubGroupResolver(t *testing.T) {
+	cfg := &authnv1.GitHubGroupSource{
+		Organizations: []string{"lyft", "other"},
+		Teams: []*authnv1.GitHubGroupSource_Team{
+			{Organization: "lyft", Slug: "clutch"},
+			{Organization: "lyft", Slug: "envoy"},
+		},
+		SubjectSuffix: "@example.com",
+	}
+
+	delete(service.Registry, githubServiceName)
+	_, err := newGitHubGroupResolver(cfg)
+	assert.Error(t, err)
+
+	service.Registry[githubServiceName] = &fakeGitHubMembershipClient{
+		orgs:  map[string]bool{"lyft": true},
+		teams: map[string]bool{"lyft/clutch": true},
+	}
+	defer delete(service.Registry, githubServiceName)
+
+	r, err := newGitHubGroupResolver(cfg)
+	assert.NoError(t, err)
+
+	groups, err := r.ResolveGroups(context.Background(), "octocat@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"github:lyft", "github:lyft/clutch"}, groups)
+
+	// Subjects without the suffix are not looked up.
+	groups, err = r.ResolveGroups(context.Background(), "octocat@elsewhere.com")
+	assert.NoError(t, err)
+	assert.Empty(t, groups)
+	groups, err = r.ResolveGroups(context.Background(), "@example.com")
+	assert.NoError(t, err)
+	assert.Empty(t, groups)
+
+	// The suffix is required.
+	cfg.SubjectSuffix = ""
+	_, err = newGitHubGroupResolver(cfg)
+	assert.Error(t, err)
+}
+
+type countingGroupResolver struct {
+	calls  int
+	groups []string
+	err    error
+}
+
+func (c *countingGroupResolver) ResolveGroups(ctx context.Context, subject string) ([]string, error) {
+	c.calls++
+	return c.groups, c.err
+}
+
+func TestCachingGroupResolver(t *testing.T) {
+	inner := &countingGroupResolver{groups: []string{"oncall"}}
+	r := &cachingGroupResolver{resolver: inner, ttl: time.Hour, maxStale: time.Hour, maxEntries: 2, entries: make(map[string]*groupCacheEntry)}
+
+	for i := 0; i < 2; i++ {
+		groups, err := r.ResolveGroups(context.Background(), "user@example.com")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"oncall"}, groups)
+	}
+	assert.Equal(t, 1, inner.calls)
+
+	// Expired groups are used if resolving fails.
+	r.entries["user@example.com"].expires = time.Now().Add(-time.Second)
+	inner.err = errors.New("unavailable")
+	groups, err := r.ResolveGroups(context.Background(), "user@example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"oncall"}, groups)
+	assert.Equal(t, 2, inner.calls)
+
+	_, err = r.ResolveGroups(context.Background(), "other@example.com")
+	assert.Error(t, err)
+
+	// Groups that expired more than the max staleness ago aren't used.
+	r.entries["user@example.com"].expires = time.Now().Add(-2 * time.Hour)
+	_, err = r.ResolveGroups(context.Background(), "user@example.com")
+	assert.Error(t, err)
+
+	// The cache is bounded, evicting unusable entries first and then the entry expiring first.
+	inner.err = nil
+	r.entries = make(map[string]*groupCacheEntry)
+	_, _ = r.ResolveGroups(context.Background(), "a@example.com")
+	_, _ = r.ResolveGroups(context.Background(), "b@example.com")
+	r.entries["a@example.com"].expires = time.Now().Add(time.Minute)
+	_, _ = r.ResolveGroups(context.Background(), "c@example.com")
+	assert.Len(t, r.entries, 2)
+	assert.Contains(t, r.entries, "b@example.com")
+	assert.Contains(t, r.entries, "c@example.com")
+
+	r.entries["b@example.com"].expires = time.Now().Add(-3 * time.Hour)
+	_, _ = r.ResolveGroups(context.Background(), "d@example.com")
+	assert.Len(t, r.entries, 2)
+	assert.NotContains(t, r.entries, "b@example.com")
+}
+
+func TestMergeGroups(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c"}, mergeGroups([]string{""}, []string{"c", "a"}, []string{"b", "a"}))
+	assert.Nil(t, mergeGroups([]string{""}))
+}
+
+func TestProviderResolveGroups(t *testing.T) {
+	claims := &Claims{StandardClaims: &jwt.StandardClaims{Subject: "user@example.com"}, Groups: []string{"from-token"}}
+
+	p := &OIDCProvider{}
+	assert.NoError(t, p.resolveGroups(context.Background(), claims))
+	assert.Equal(t, []string{"from-token"}, claims.Groups)
+
+	p.groupResolver = staticGroupResolver{"user@example.com": {"oncall"}}
+	assert.NoError(t, p.resolveGroups(context.Background(), claims))
+	assert.Equal(t, []string{"from-token", "oncall"}, claims.Groups)
+}
@@ -0,0 +1,363 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/google/uuid"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authnmodulev1 "github.com/lyft/clutch/backend/api/authn/v1"
+	authnv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+)
+
+const (
+	clutchProvider = "clutch"
+
+	serviceSubjectPrefix = "service:"
+
+	// The oauth2.Token extra holding the ID of a created service token.
+	tokenIDExtra = "token_id"
+
+	// How often the last use of a service token is recorded, so that busy tokens don't cause a write per request.
+	serviceTokenUseInterval = time.Minute
+	serviceTokenUseTimeout  = 5 * time.Second
+)
+
+// tokenIssuer issues and verifies Clutch's own tokens. It is shared by the providers, which differ only in how users
+// are authenticated.
+type tokenIssuer struct {
+	sessionSecret string
+
+	tokenStorage  Storage
+	groupResolver GroupResolver
+
+	enableServiceTokenCreation bool
+
+	// Nil unless the storage holds service token metadata.
+	serviceTokenUses *serviceTokenUseRecorder
+}
+
+func newTokenIssuer(config *authnv1.Config, tokenStorage Storage) (tokenIssuer, error) {
+	ret := tokenIssuer{
+		sessionSecret:              config.SessionSecret,
+		tokenStorage:               tokenStorage,
+		enableServiceTokenCreation: tokenStorage != nil && config.EnableServiceTokenCreation,
+	}
+	if sts, ok := tokenStorage.(ServiceTokenStorage); ok {
+		ret.serviceTokenUses = newServiceTokenUseRecorder(sts, serviceTokenUseInterval)
+	}
+
+	if config.GroupResolver != nil {
+		r, err := NewGroupResolver(config.GroupResolver)
+		if err != nil {
+			return tokenIssuer{}, err
+		}
+		ret.groupResolver = r
+	}
+	return ret, nil
+}
+
+// Clutch's state token claims used during the exchange.
+type stateClaims struct {
+	*jwt.StandardClaims
+	RedirectURL string `json:"redirect"`
+}
+
+func (p *tokenIssuer) ValidateStateNonce(state string) (string, error) {
+	claims := &stateClaims{}
+	_, err := jwt.ParseWithClaims(state, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(p.sessionSecret), nil
+	})
+	if err != nil {
+		return "", err
+	}
+	if err := claims.Valid(); err != nil {
+		return "", err
+	}
+	return claims.RedirectURL, nil
+}
+
+func (p *tokenIssuer) GetStateNonce(redirectURL string) (string, error) {
+	u, err := url.Parse(redirectURL)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "" || u.Host != "" {
+		return "", errors.New("only relative redirects are supported")
+	}
+	dest := u.RequestURI()
+	if !strings.HasPrefix(dest, "/") {
+		dest = fmt.Sprintf("/%s", dest)
+	}
+
+	claims := &stateClaims{
+		StandardClaims: &jwt.StandardClaims{
+			Subject:   uuid.New().String(), // UUID serves as CSRF token.
+			ExpiresAt: time.Now().Add(time.Minute * 5).Unix(),
+			IssuedAt:  time.Now().Unix(),
+		},
+		RedirectURL: dest,
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(p.sessionSecret))
+}
+
+func (p *tokenIssuer) CreateToken(ctx context.Context, subject string, tokenType authnmodulev1.CreateTokenRequest_TokenType, expiry *time.Duration, opts ...TokenOption) (*oauth2.Token, error) {
+	if !p.enableServiceTokenCreation {
+		return nil, errors.New("not configured to allow service token creation")
+	}
+
+	var prefixedSubject string
+	switch tokenType {
+	case authnmodulev1.CreateTokenRequest_SERVICE:
+		prefixedSubject = serviceSubjectPrefix + subject
+	default:
+		return nil, errors.New("invalid token type")
+	}
+
+	o := &tokenOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	issuedAt := time.Now()
+	var expiresAt int64
+	if expiry != nil {
+		expiresAt = issuedAt.Add(*expiry).Unix()
+	}
+
+	claims := &Claims{
+		StandardClaims: &jwt.StandardClaims{
+			Id:        uuid.New().String(),
+			ExpiresAt: expiresAt,
+			IssuedAt:  issuedAt.Unix(),
+			Issuer:    clutchProvider,
+			Subject:   prefixedSubject,
+		},
+		Scopes: o.scopes,
+	}
+
+	// Record the token's metadata before issuing it, so that every issued token can be revoked.
+	sts, ok := p.tokenStorage.(ServiceTokenStorage)
+	if ok {
+		creator := AnonymousSubject
+		if c, err := ClaimsFromContext(ctx); err == nil {
+			creator = c.Subject
+		}
+
+		st := &authnmodulev1.ServiceToken{
+			Id:          claims.Id,
+			Subject:     prefixedSubject,
+			Creator:     creator,
+			Description: o.description,
+			Scopes:      o.scopes,
+			CreatedAt:   timestamppb.New(issuedAt),
+		}
+		if expiresAt != 0 {
+			st.ExpiresAt = timestamppb.New(time.Unix(expiresAt, 0))
+		}
+		if err := sts.CreateServiceToken(ctx, st); err != nil {
+			return nil, err
+		}
+	}
+
+	t, err := p.issueAndStoreToken(ctx, claims, false)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		t = t.WithExtra(map[string]interface{}{tokenIDExtra: claims.Id})
+	}
+	return t, nil
+}
+
+func (p *tokenIssuer) serviceTokenStorage() (ServiceTokenStorage, error) {
+	sts, ok := p.tokenStorage.(ServiceTokenStorage)
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "service token storage is not configured")
+	}
+	return sts, nil
+}
+
+func (p *tokenIssuer) ListTokens(ctx context.Context, includeRevoked bool) ([]*authnmodulev1.ServiceToken, error) {
+	sts, err := p.serviceTokenStorage()
+	if err != nil {
+		return nil, err
+	}
+	return sts.ListServiceTokens(ctx, includeRevoked)
+}
+
+func (p *tokenIssuer) RevokeToken(ctx context.Context, id string) error {
+	sts, err := p.serviceTokenStorage()
+	if err != nil {
+		return err
+	}
+	return sts.RevokeServiceToken(ctx, id)
+}
+
+// Adds the groups resolved from external directories to the claims, if a group resolver is configured.
+func (p *tokenIssuer) resolveGroups(ctx context.Context, claims *Claims) error {
+	if p.groupResolver == nil {
+		return nil
+	}
+	groups, err := p.groupResolver.ResolveGroups(ctx, claims.Subject)
+	if err != nil {
+		return err
+	}
+	claims.Groups = mergeGroups(claims.Groups, groups)
+	return nil
+}
+
+// Issues and stores a token based on the provided claims. If refresh is true and storage is enabled, a refresh
+// token will be issued as well.
+func (p *tokenIssuer) issueAndStoreToken(ctx context.Context, claims *Claims, refresh bool) (*oauth2.Token, error) {
+	// Sign and issue token.
+	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(p.sessionSecret))
+	if err != nil {
+		return nil, err
+	}
+	t := &oauth2.Token{
+		AccessToken: accessToken,
+		Expiry:      time.Unix(claims.ExpiresAt, 0),
+		TokenType:   "Bearer",
+	}
+
+	if p.tokenStorage != nil {
+		if refresh {
+			refreshClaims := &jwt.StandardClaims{
+				ExpiresAt: time.Now().Add(time.Hour * 12).Unix(), // TODO: configurable refresh token lifetime
+				Issuer:    claims.Issuer,
+				Subject:   claims.Subject,
+			}
+
+			refreshToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, refreshClaims).SignedString([]byte(p.sessionSecret))
+			if err != nil {
+				return nil, err
+			}
+			t.RefreshToken = refreshToken
+		}
+
+		if err := p.tokenStorage.Store(ctx, claims.Subject, clutchProvider, t); err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
+
+func (p *tokenIssuer) Verify(ctx context.Context, rawToken string) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(rawToken, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(p.sessionSecret), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := claims.Valid(); err != nil {
+		return nil, err
+	}
+
+	// If the token doesn't exist in the token storage anymore, it must have been revoked.
+	// Fail verification in this case.
+	// TODO(perf): Cache the lookup result in-memory for min(60, timeToExpiry) to prevent
+	// hitting the DB on each request. This should also cache whether we didn't find a token.
+	if p.tokenStorage != nil {
+		_, err := p.tokenStorage.Read(ctx, claims.Subject, clutchProvider)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Service tokens with stored metadata can also be revoked individually.
+	if sts, ok := p.tokenStorage.(ServiceTokenStorage); ok && claims.Id != "" && strings.HasPrefix(claims.Subject, serviceSubjectPrefix) {
+		if err := sts.CheckServiceToken(ctx, claims.Id); err != nil {
+			return nil, err
+		}
+		if p.serviceTokenUses != nil {
+			p.serviceTokenUses.record(claims.Id)
+		}
+	}
+
+	return claims, nil
+}
+
+// Extract claims from the refresh token and verify it matches the one stored in the database. The claims are also
+// validated by the parser (i.e. to check for expiry).
+func (p *tokenIssuer) verifyRefreshToken(ctx context.Context, t *oauth2.Token) (*jwt.StandardClaims, error) {
+	if p.tokenStorage == nil {
+		return nil, status.Error(codes.FailedPrecondition, "refresh attempted but storage is not configured")
+	}
+
+	claims := &jwt.StandardClaims{}
+	_, err := jwt.ParseWithClaims(t.RefreshToken, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(p.sessionSecret), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rt, err := p.tokenStorage.Read(ctx, claims.Subject, clutchProvider)
+	if err != nil {
+		return nil, err
+	}
+	if rt.RefreshToken != t.RefreshToken {
+		return nil, errors.New("refresh token did not match")
+	}
+	return claims, nil
+}
+
+// serviceTokenUseRecorder records the last use of service tokens in the background, at most once per interval for
+// each token. Recording is best effort, a failure only leaves the last use outdated.
+type serviceTokenUseRecorder struct {
+	storage  ServiceTokenStorage
+	interval time.Duration
+
+	mu       sync.Mutex
+	recorded map[string]time.Time
+	// Tracks the writes in progress, for tests.
+	wg sync.WaitGroup
+}
+
+func newServiceTokenUseRecorder(storage ServiceTokenStorage, interval time.Duration) *serviceTokenUseRecorder {
+	return &serviceTokenUseRecorder{
+		storage:  storage,
+		interval: interval,
+		recorded: make(map[string]time.Time),
+	}
+}
+
+func (r *serviceTokenUseRecorder) record(id string) {
+	now := time.Now()
+
+	r.mu.Lock()
+	if last, ok := r.recorded[id]; ok && now.Sub(last) < r.interval {
+		r.mu.Unlock()
+		return
+	}
+	// Forget the tokens that weren't used within the interval, so the map only holds recently used tokens.
+	for k, last := range r.recorded {
+		if now.Sub(last) >= r.interval {
+			delete(r.recorded, k)
+		}
+	}
+	r.recorded[id] = now
+	r.mu.Unlock()
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), serviceTokenUseTimeout)
+		defer cancel()
+		_ = r.storage.RecordServiceTokenUse(ctx, id)
+	}()
+}
@@ -0,0 +1,335 @@
+package authn
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+
+	authnv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+)
+
+// LocalLoginPath serves the login form of the local provider.
+const LocalLoginPath = "/v1/authn/local/login"
+
+const (
+	// Lifetime of the access tokens issued by the local provider. They can be refreshed like tokens from other providers.
+	localTokenLifetime = time.Hour
+
+	// Lifetime of the code passed from the login form to the callback.
+	localCodeLifetime = time.Minute
+)
+
+// PasswordAuthenticator is implemented by providers that verify credentials entered in Clutch's own login form rather
+// than redirecting to an external identity provider.
+type PasswordAuthenticator interface {
+	// Authenticate verifies the credentials and returns a short-lived code to pass to the callback, which exchanges it
+	// for a token. Failed attempts are limited per username and optionally per client IP address, after which
+	// ResourceExhausted is returned.
+	Authenticate(ctx context.Context, username, password, clientIP string) (code string, err error)
+
+	// ClientIP returns the client IP address of a login request from the address of the connection and the values of
+	// the X-Forwarded-For header.
+	ClientIP(remoteAddr string, forwardedFor []string) string
+}
+
+// Implemented by the authn storage service.
+type localUserStorage interface {
+	readLocalUser(ctx context.Context, username string) (*authnv1.Local_User, error)
+}
+
+// Implemented by the authn storage service.
+type localCodeStorage interface {
+	// useLocalCode records the code and returns false if it was already used.
+	useLocalCode(ctx context.Context, id string, expiresAt time.Time) (bool, error)
+}
+
+// LocalProvider authenticates users from the configuration, a file, or the database with a username and password.
+type LocalProvider struct {
+	tokenIssuer
+
+	users        map[string]*authnv1.Local_User
+	userStorage  localUserStorage
+	staticTokens []*authnv1.Local_StaticToken
+
+	// Compared against when a user does not exist, so that the response time doesn't reveal which users exist.
+	dummyHash []byte
+
+	usernameAttempts *loginAttemptLimiter
+	// nil if failures aren't limited per client IP address.
+	ipAttempts       *loginAttemptLimiter
+	trustedProxyHops int
+
+	// Exchanged codes are recorded in the database if it's available, and otherwise in memory.
+	codeStorage localCodeStorage
+	usedCodes   usedCodes
+}
+
+func NewLocalProvider(ctx context.Context, config *authnv1.Config, tokenStorage Storage) (Provider, error) {
+	c := config.GetLocal()
+
+	issuer, err := newTokenIssuer(config, tokenStorage)
+	if err != nil {
+		return nil, err
+	}
+
+	dummyHash, err := bcrypt.GenerateFromPassword([]byte("clutch"), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	maxUsernameFailures, window := defaultLoginMaxFailuresPerUsername, defaultLoginFailureWindow
+	if l := c.LoginLimits; l != nil {
+		if l.MaxFailuresPerUsername > 0 {
+			maxUsernameFailures = int(l.MaxFailuresPerUsername)
+		}
+		if l.Window.AsDuration() > 0 {
+			window = l.Window.AsDuration()
+		}
+	}
+
+	p := &LocalProvider{
+		tokenIssuer:      issuer,
+		users:            make(map[string]*authnv1.Local_User),
+		staticTokens:     c.StaticTokens,
+		dummyHash:        dummyHash,
+		usernameAttempts: newLoginAttemptLimiter(maxUsernameFailures, window),
+		trustedProxyHops: int(c.LoginLimits.GetTrustedProxyHops()),
+	}
+	if max := c.LoginLimits.GetMaxFailuresPerIp(); max > 0 {
+		p.ipAttempts = newLoginAttemptLimiter(int(max), window)
+	}
+	if s, ok := tokenStorage.(localCodeStorage); ok {
+		p.codeStorage = s
+	}
+
+	users := c.Users
+	if c.UsersFile != "" {
+		fileUsers, err := readLocalUsersFile(c.UsersFile)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, fileUsers...)
+	}
+	for _, u := range users {
+		if _, ok := p.users[u.Username]; ok {
+			return nil, fmt.Errorf("local user '%s' is defined more than once", u.Username)
+		}
+		if _, err := bcrypt.Cost([]byte(u.PasswordHash)); err != nil {
+			return nil, fmt.Errorf("local user '%s' does not have a valid bcrypt password hash: %w", u.Username, err)
+		}
+		p.users[u.Username] = u
+	}
+
+	if c.DatabaseUsers {
+		s, ok := tokenStorage.(localUserStorage)
+		if !ok {
+			return nil, errors.New("database users require the authn storage service")
+		}
+		p.userStorage = s
+	}
+
+	return p, nil
+}
+
+func readLocalUsersFile(path string) ([]*authnv1.Local_User, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []struct {
+		Username     string   `yaml:"username"`
+		PasswordHash string   `yaml:"password_hash"`
+		Groups       []string `yaml:"groups"`
+	}
+	if err := yaml.Unmarshal(b, &users); err != nil {
+		return nil, fmt.Errorf("could not parse users file '%s': %w", path, err)
+	}
+
+	ret := make([]*authnv1.Local_User, 0, len(users))
+	for _, u := range users {
+		user := &authnv1.Local_User{Username: u.Username, PasswordHash: u.PasswordHash, Groups: u.Groups}
+		if err := user.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid user in users file '%s': %w", path, err)
+		}
+		ret = append(ret, user)
+	}
+	return ret, nil
+}
+
+// lookupUser returns nil if the user does not exist.
+func (p *LocalProvider) lookupUser(ctx context.Context, username string) (*authnv1.Local_User, error) {
+	if u, ok := p.users[username]; ok {
+		return u, nil
+	}
+	if p.userStorage != nil {
+		return p.userStorage.readLocalUser(ctx, username)
+	}
+	return nil, nil
+}
+
+// Codes are signed with a different key than tokens, so that a code can't be used as a token.
+func (p *LocalProvider) codeSecret() []byte {
+	return []byte(p.sessionSecret + "/local-code")
+}
+
+func (p *LocalProvider) GetAuthCodeURL(ctx context.Context, state string) (string, error) {
+	return LocalLoginPath + "?" + url.Values{"state": []string{state}}.Encode(), nil
+}
+
+// ClientIP returns the address trustedProxyHops from the end of the X-Forwarded-For header, or the address of the
+// connection if there are no trusted proxies. Addresses before the trusted ones could be set by the client to avoid
+// the per IP limit on failed logins.
+func (p *LocalProvider) ClientIP(remoteAddr string, forwardedFor []string) string {
+	if p.trustedProxyHops > 0 {
+		var addrs []string
+		for _, value := range forwardedFor {
+			for _, addr := range strings.Split(value, ",") {
+				addrs = append(addrs, strings.TrimSpace(addr))
+			}
+		}
+		if len(addrs) >= p.trustedProxyHops {
+			return addrs[len(addrs)-p.trustedProxyHops]
+		}
+	}
+
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	return host
+}
+
+func (p *LocalProvider) Authenticate(ctx context.Context, username, password, clientIP string) (string, error) {
+	now := time.Now()
+	if !p.usernameAttempts.allowed(username, now) || (p.ipAttempts != nil && !p.ipAttempts.allowed(clientIP, now)) {
+		return "", status.Error(codes.ResourceExhausted, "too many failed login attempts")
+	}
+
+	user, err := p.lookupUser(ctx, username)
+	if err != nil {
+		return "", err
+	}
+
+	hash := p.dummyHash
+	if user != nil {
+		hash = []byte(user.PasswordHash)
+	}
+	if err := bcrypt.CompareHashAndPassword(hash, []byte(password)); err != nil || user == nil {
+		p.usernameAttempts.fail(username, now)
+		if p.ipAttempts != nil {
+			p.ipAttempts.fail(clientIP, now)
+		}
+		return "", status.Error(codes.Unauthenticated, "invalid username or password")
+	}
+	p.usernameAttempts.reset(username)
+
+	claims := &jwt.StandardClaims{
+		Id:        uuid.New().String(),
+		Subject:   user.Username,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(localCodeLifetime).Unix(),
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(p.codeSecret())
+}
+
+func (p *LocalProvider) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
+	codeClaims := &jwt.StandardClaims{}
+	_, err := jwt.ParseWithClaims(code, codeClaims, func(token *jwt.Token) (interface{}, error) {
+		return p.codeSecret(), nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid code: %v", err)
+	}
+	if codeClaims.Id == "" {
+		return nil, status.Error(codes.Unauthenticated, "invalid code: missing ID")
+	}
+
+	expiresAt := time.Unix(codeClaims.ExpiresAt, 0)
+	unused := true
+	if p.codeStorage != nil {
+		unused, err = p.codeStorage.useLocalCode(ctx, codeClaims.Id, expiresAt)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		unused = p.usedCodes.use(codeClaims.Id, expiresAt, time.Now())
+	}
+	if !unused {
+		return nil, status.Error(codes.Unauthenticated, "code was already used")
+	}
+
+	return p.issueUserToken(ctx, codeClaims.Subject)
+}
+
+func (p *LocalProvider) RefreshToken(ctx context.Context, t *oauth2.Token) (*oauth2.Token, error) {
+	claims, err := p.verifyRefreshToken(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+	return p.issueUserToken(ctx, claims.Subject)
+}
+
+// Issues a token with the user's current groups, failing if the user no longer exists.
+func (p *LocalProvider) issueUserToken(ctx context.Context, username string) (*oauth2.Token, error) {
+	user, err := p.lookupUser(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user '%s' does not exist", username)
+	}
+
+	now := time.Now()
+	claims := &Claims{
+		StandardClaims: &jwt.StandardClaims{
+			ExpiresAt: now.Add(localTokenLifetime).Unix(),
+			IssuedAt:  now.Unix(),
+			Issuer:    clutchProvider,
+			Subject:   user.Username,
+		},
+		Groups: mergeGroups(user.Groups),
+	}
+	if err := p.resolveGroups(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	return p.issueAndStoreToken(ctx, claims, true)
+}
+
+func (p *LocalProvider) Verify(ctx context.Context, rawToken string) (*Claims, error) {
+	for _, t := range p.staticTokens {
+		if subtle.ConstantTimeCompare([]byte(t.Token), []byte(rawToken)) == 1 {
+			return &Claims{
+				StandardClaims: &jwt.StandardClaims{Issuer: clutchProvider, Subject: t.Subject},
+				Groups:         t.Groups,
+			}, nil
+		}
+	}
+	return p.tokenIssuer.Verify(ctx, rawToken)
+}
+
+// Read returns the stored Clutch token, since there is no upstream provider whose tokens could be read.
+func (p *LocalProvider) Read(ctx context.Context, userID, provider string) (*oauth2.Token, error) {
+	if p.tokenStorage == nil {
+		return nil, status.Error(codes.Internal, "token read attempted but storage is not configured")
+	}
+	if provider != clutchProvider {
+		return nil, status.Errorf(codes.InvalidArgument, "provider '%s' cannot read '%s' tokens", clutchProvider, provider)
+	}
+	return p.tokenStorage.Read(ctx, userID, provider)
+}
@@ -0,0 +1,244 @@
+package authn
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authnv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+	"github.com/lyft/clutch/backend/internal/test/authntest"
+)
+
+func newTestLocalProvider(t *testing.T, tokenStorage Storage) *LocalProvider {
+	hash, err := bcrypt.GenerateFromPassword([]byte("hunter2"), bcrypt.MinCost)
+	assert.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "users.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(`
+- username: bar
+  password_hash: `+string(hash)+`
+`), 0600))
+
+	cfg := &authnv1.Config{
+		SessionSecret: "this_is_my_secret",
+		Type: &authnv1.Config_Local{Local: &authnv1.Local{
+			Users:     []*authnv1.Local_User{{Username: "foo", PasswordHash: string(hash), Groups: []string{"admins"}}},
+			UsersFile: path,
+			StaticTokens: []*authnv1.Local_StaticToken{
+				{Token: "0123456789abcdef0123456789abcdef", Subject: "service:ci", Groups: []string{"ci"}},
+			},
+		}},
+	}
+
+	p, err := NewLocalProvider(context.Background(), cfg, tokenStorage)
+	assert.NoError(t, err)
+	return p.(*LocalProvider)
+}
+
+func TestLocalProviderLogin(t *testing.T) {
+	ctx := context.Background()
+	mockStorage := authntest.NewMockStorage()
+	p := newTestLocalProvider(t, mockStorage)
+
+	state, err := p.GetStateNonce("/foo")
+	assert.NoError(t, err)
+	authURL, err := p.GetAuthCodeURL(ctx, state)
+	assert.NoError(t, err)
+	assert.Equal(t, LocalLoginPath+"?state="+state, authURL)
+
+	for _, username := range []string{"foo", "nobody"} {
+		_, err = p.Authenticate(ctx, username, "wrong", "192.0.2.1")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	code, err := p.Authenticate(ctx, "foo", "hunter2", "192.0.2.1")
+	assert.NoError(t, err)
+
+	// Codes can't be used as tokens.
+	_, err = p.Verify(ctx, code)
+	assert.Error(t, err)
+
+	token, err := p.Exchange(ctx, code)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token.RefreshToken)
+
+	// Codes can only be exchanged once.
+	_, err = p.Exchange(ctx, code)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	claims, err := p.Verify(ctx, token.AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", claims.Subject)
+	assert.Equal(t, []string{"admins"}, claims.Groups)
+
+	refreshed, err := p.RefreshToken(ctx, &oauth2.Token{RefreshToken: token.RefreshToken})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, refreshed.AccessToken)
+
+	stored, err := p.Read(ctx, "foo", clutchProvider)
+	assert.NoError(t, err)
+	assert.Equal(t, refreshed.AccessToken, stored.AccessToken)
+
+	// Users from the file.
+	_, err = p.Authenticate(ctx, "bar", "hunter2", "192.0.2.1")
+	assert.NoError(t, err)
+}
+
+func TestLocalProviderLoginLimits(t *testing.T) {
+	ctx := context.Background()
+	p := newTestLocalProvider(t, nil)
+	p.usernameAttempts = newLoginAttemptLimiter(2, time.Minute)
+	p.ipAttempts = newLoginAttemptLimiter(3, time.Minute)
+
+	// A successful login resets the failures of the username.
+	_, err := p.Authenticate(ctx, "foo", "wrong", "192.0.2.1")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = p.Authenticate(ctx, "foo", "hunter2", "192.0.2.1")
+	assert.NoError(t, err)
+
+	// The username is locked out, even with the right password and from another address.
+	for i := 0; i < 2; i++ {
+		_, err = p.Authenticate(ctx, "foo", "wrong", "192.0.2.2")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+	_, err = p.Authenticate(ctx, "foo", "hunter2", "192.0.2.3")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// The address is locked out for every username.
+	_, err = p.Authenticate(ctx, "nobody", "wrong", "192.0.2.2")
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = p.Authenticate(ctx, "bar", "hunter2", "192.0.2.2")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	_, err = p.Authenticate(ctx, "bar", "hunter2", "192.0.2.3")
+	assert.NoError(t, err)
+}
+
+func TestLocalProviderClientIP(t *testing.T) {
+	p := newTestLocalProvider(t, nil)
+	// Failures aren't limited per IP address unless configured.
+	assert.Nil(t, p.ipAttempts)
+
+	assert.Equal(t, "192.0.2.1", p.ClientIP("192.0.2.1:1234", []string{"198.51.100.1"}))
+
+	p.trustedProxyHops = 1
+	assert.Equal(t, "198.51.100.2", p.ClientIP("192.0.2.1:1234", []string{"203.0.113.1, 198.51.100.1", "198.51.100.2"}))
+	p.trustedProxyHops = 2
+	assert.Equal(t, "198.51.100.1", p.ClientIP("192.0.2.1:1234", []string{"203.0.113.1, 198.51.100.1", "198.51.100.2"}))
+	// Requests that didn't pass through the proxies use the address of the connection.
+	assert.Equal(t, "192.0.2.1", p.ClientIP("192.0.2.1:1234", nil))
+}
+
+func TestLoginAttemptLimiter(t *testing.T) {
+	l := newLoginAttemptLimiter(2, time.Minute)
+	now := time.Now()
+
+	l.fail("foo", now)
+	assert.True(t, l.allowed("foo", now))
+	l.fail("foo", now.Add(time.Second))
+	assert.False(t, l.allowed("foo", now.Add(time.Second)))
+	assert.True(t, l.allowed("bar", now))
+
+	// The window starts with the first failure.
+	assert.True(t, l.allowed("foo", now.Add(time.Minute)))
+	assert.Empty(t, l.failures)
+}
+
+func TestUsedCodes(t *testing.T) {
+	var u usedCodes
+	now := time.Now()
+
+	assert.True(t, u.use("a", now.Add(time.Minute), now))
+	assert.False(t, u.use("a", now.Add(time.Minute), now))
+	assert.True(t, u.use("b", now.Add(time.Minute), now))
+
+	// Expired codes are pruned.
+	assert.True(t, u.use("c", now.Add(2*time.Minute), now.Add(time.Minute)))
+	assert.Len(t, u.ids, 1)
+}
+
+func TestLocalProviderStaticToken(t *testing.T) {
+	p := newTestLocalProvider(t, nil)
+
+	claims, err := p.Verify(context.Background(), "0123456789abcdef0123456789abcdef")
+	assert.NoError(t, err)
+	assert.Equal(t, "service:ci", claims.Subject)
+	assert.Equal(t, []string{"ci"}, claims.Groups)
+
+	_, err = p.Verify(context.Background(), "0123456789abcdef0123456789abcdee")
+	assert.Error(t, err)
+}
+
+type fakeLocalCodeStorage map[string]bool
+
+func (f fakeLocalCodeStorage) useLocalCode(ctx context.Context, id string, expiresAt time.Time) (bool, error) {
+	if f[id] {
+		return false, nil
+	}
+	f[id] = true
+	return true, nil
+}
+
+func TestLocalProviderCodeStorage(t *testing.T) {
+	p := newTestLocalProvider(t, nil)
+	used := fakeLocalCodeStorage{}
+	p.codeStorage = used
+
+	code, err := p.Authenticate(context.Background(), "foo", "hunter2", "192.0.2.1")
+	assert.NoError(t, err)
+	_, err = p.Exchange(context.Background(), code)
+	assert.NoError(t, err)
+	assert.Len(t, used, 1)
+	assert.Empty(t, p.usedCodes.ids)
+
+	_, err = p.Exchange(context.Background(), code)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+type fakeLocalUserStorage map[string]*authnv1.Local_User
+
+func (f fakeLocalUserStorage) readLocalUser(ctx context.Context, username string) (*authnv1.Local_User, error) {
+	return f[username], nil
+}
+
+func TestLocalProviderDatabaseUsers(t *testing.T) {
+	p := newTestLocalProvider(t, nil)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("swordfish"), bcrypt.MinCost)
+	assert.NoError(t, err)
+	p.userStorage = fakeLocalUserStorage{"baz": {Username: "baz", PasswordHash: string(hash)}}
+
+	code, err := p.Authenticate(context.Background(), "baz", "swordfish", "192.0.2.1")
+	assert.NoError(t, err)
+	_, err = p.Exchange(context.Background(), code)
+	assert.NoError(t, err)
+
+	// Database users require the storage service.
+	_, err = NewLocalProvider(context.Background(), &authnv1.Config{
+		SessionSecret: "this_is_my_secret",
+		Type:          &authnv1.Config_Local{Local: &authnv1.Local{DatabaseUsers: true}},
+	}, nil)
+	assert.Error(t, err)
+}
+
+func TestNewLocalProviderInvalidUsers(t *testing.T) {
+	testCases := []*authnv1.Local{
+		{Users: []*authnv1.Local_User{{Username: "foo", PasswordHash: "plaintext"}}},
+		{UsersFile: "/does/not/exist"},
+	}
+
+	for _, tt := range testCases {
+		_, err := NewLocalProvider(context.Background(), &authnv1.Config{
+			SessionSecret: "this_is_my_secret",
+			Type:          &authnv1.Config_Local{Local: tt},
+		}, nil)
+		assert.Error(t, err)
+	}
+}
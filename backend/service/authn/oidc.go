@@ -6,17 +6,13 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
-	"strings"
-	"time"
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/dgrijalva/jwt-go"
-	"github.com/google/uuid"
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
-	authnmodulev1 "github.com/lyft/clutch/backend/api/authn/v1"
 	authnv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
 )
 
@@ -24,8 +20,6 @@ import (
 // Compatible with Okta offline access, a holdover from previous defaults.
 var defaultScopes = []string{oidc.ScopeOpenID, oidc.ScopeOfflineAccess, "email"}
 
-const clutchProvider = "clutch"
-
 type OIDCProvider struct {
 	provider *oidc.Provider
 	verifier *oidc.IDTokenVerifier
@@ -33,20 +27,11 @@ type OIDCProvider struct {
 
 	httpClient *http.Client
 
-	sessionSecret string
+	tokenIssuer
 
-	tokenStorage  Storage
 	providerAlias string
 
 	claimsFromOIDCToken ClaimsFromOIDCTokenFunc
-
-	enableServiceTokenCreation bool
-}
-
-// Clutch's state token claims used during the exchange.
-type stateClaims struct {
-	*jwt.StandardClaims
-	RedirectURL string `json:"redirect"`
 }
 
 // Intermediate claims object for the ID token. Based on what scopes were requested.
@@ -65,44 +50,6 @@ func (p *OIDCProvider) GetAuthCodeURL(ctx context.Context, state string) (string
 	return p.oauth2.AuthCodeURL(state, opts...), nil
 }
 
-func (p *OIDCProvider) ValidateStateNonce(state string) (string, error) {
-	claims := &stateClaims{}
-	_, err := jwt.ParseWithClaims(state, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(p.sessionSecret), nil
-	})
-	if err != nil {
-		return "", err
-	}
-	if err := claims.Valid(); err != nil {
-		return "", err
-	}
-	return claims.RedirectURL, nil
-}
-
-func (p *OIDCProvider) GetStateNonce(redirectURL string) (string, error) {
-	u, err := url.Parse(redirectURL)
-	if err != nil {
-		return "", err
-	}
-	if u.Scheme != "" || u.Host != "" {
-		return "", errors.New("only relative redirects are supported")
-	}
-	dest := u.RequestURI()
-	if !strings.HasPrefix(dest, "/") {
-		dest = fmt.Sprintf("/%s", dest)
-	}
-
-	claims := &stateClaims{
-		StandardClaims: &jwt.StandardClaims{
-			Subject:   uuid.New().String(), // UUID serves as CSRF token.
-			ExpiresAt: time.Now().Add(time.Minute * 5).Unix(),
-			IssuedAt:  time.Now().Unix(),
-		},
-		RedirectURL: dest,
-	}
-	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(p.sessionSecret))
-}
-
 func (p *OIDCProvider) Exchange(ctx context.Context, code string) (*oauth2.Token, error) {
 	// Exchange.
 	ctx = oidc.ClientContext(ctx, p.httpClient)
@@ -130,6 +77,9 @@ func (p *OIDCProvider) Exchange(ctx context.Context, code string) (*oauth2.Token
 	if err != nil {
 		return nil, err
 	}
+	if err := p.resolveGroups(ctx, claims); err != nil {
+		return nil, err
+	}
 
 	if p.tokenStorage != nil {
 		err := p.tokenStorage.Store(ctx, claims.Subject, p.providerAlias, token)
@@ -141,57 +91,13 @@ func (p *OIDCProvider) Exchange(ctx context.Context, code string) (*oauth2.Token
 	return p.issueAndStoreToken(ctx, claims, true)
 }
 
-func (p *OIDCProvider) CreateToken(ctx context.Context, subject string, tokenType authnmodulev1.CreateTokenRequest_TokenType, expiry *time.Duration) (*oauth2.Token, error) {
-	if !p.enableServiceTokenCreation {
-		return nil, errors.New("not configured to allow service token creation")
-	}
-
-	var prefixedSubject string
-	switch tokenType {
-	case authnmodulev1.CreateTokenRequest_SERVICE:
-		prefixedSubject = "service:" + subject
-	default:
-		return nil, errors.New("invalid token type")
-	}
-
-	issuedAt := time.Now()
-	var expiresAt int64
-	if expiry != nil {
-		expiresAt = issuedAt.Add(*expiry).Unix()
-	}
-
-	claims := &Claims{
-		StandardClaims: &jwt.StandardClaims{
-			ExpiresAt: expiresAt,
-			IssuedAt:  issuedAt.Unix(),
-			Issuer:    clutchProvider,
-			Subject:   prefixedSubject,
-		},
-	}
-
-	return p.issueAndStoreToken(ctx, claims, false)
-}
-
 // Refresh the issuer token. If the provider token is not valid, refresh it. If any error occurs continue auth code flow.
 func (p *OIDCProvider) RefreshToken(ctx context.Context, t *oauth2.Token) (*oauth2.Token, error) {
-	// Extract claims from refresh token. They are also validated by the parser (i.e. to check for expiry).
-	claims := &jwt.StandardClaims{}
-	_, err := jwt.ParseWithClaims(t.RefreshToken, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(p.sessionSecret), nil
-	})
+	claims, err := p.verifyRefreshToken(ctx, t)
 	if err != nil {
 		return nil, err
 	}
 
-	// Look up refresh token and verify it matches the one stored in the database.
-	rt, err := p.tokenStorage.Read(ctx, claims.Subject, clutchProvider)
-	if err != nil {
-		return nil, err
-	}
-	if rt.RefreshToken != t.RefreshToken {
-		return nil, errors.New("refresh token did not match")
-	}
-
 	// Verify provider token is still valid.
 	pt, err := p.tokenStorage.Read(ctx, claims.Subject, p.providerAlias)
 	if err != nil {
@@ -228,6 +134,9 @@ func (p *OIDCProvider) RefreshToken(ctx context.Context, t *oauth2.Token) (*oaut
 	if err != nil {
 		return nil, err
 	}
+	if err := p.resolveGroups(ctx, newClaims); err != nil {
+		return nil, err
+	}
 
 	newToken, err := p.issueAndStoreToken(ctx, newClaims, true)
 	if err != nil {
@@ -238,43 +147,6 @@ func (p *OIDCProvider) RefreshToken(ctx context.Context, t *oauth2.Token) (*oaut
 	return newToken, nil
 }
 
-// Issues and stores a token based on the provided claims. If refresh is true and storage is enabled, a refresh
-// token will be issued as well.
-func (p *OIDCProvider) issueAndStoreToken(ctx context.Context, claims *Claims, refresh bool) (*oauth2.Token, error) {
-	// Sign and issue token.
-	accessToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(p.sessionSecret))
-	if err != nil {
-		return nil, err
-	}
-	t := &oauth2.Token{
-		AccessToken: accessToken,
-		Expiry:      time.Unix(claims.ExpiresAt, 0),
-		TokenType:   "Bearer",
-	}
-
-	if p.tokenStorage != nil {
-		if refresh {
-			refreshClaims := &jwt.StandardClaims{
-				ExpiresAt: time.Now().Add(time.Hour * 12).Unix(), // TODO: configurable refresh token lifetime
-				Issuer:    claims.Issuer,
-				Subject:   claims.Subject,
-			}
-
-			refreshToken, err := jwt.NewWithClaims(jwt.SigningMethodHS256, refreshClaims).SignedString([]byte(p.sessionSecret))
-			if err != nil {
-				return nil, err
-			}
-			t.RefreshToken = refreshToken
-		}
-
-		if err := p.tokenStorage.Store(ctx, claims.Subject, clutchProvider, t); err != nil {
-			return nil, err
-		}
-	}
-
-	return t, nil
-}
-
 type ClaimsFromOIDCTokenFunc func(ctx context.Context, t *oidc.IDToken) (*Claims, error)
 
 // Extract claims from an OIDC token and return Clutch's standard claims object. This could be configurable at a later
@@ -296,33 +168,6 @@ func DefaultClaimsFromOIDCToken(ctx context.Context, t *oidc.IDToken) (*Claims,
 	}, nil
 }
 
-func (p *OIDCProvider) Verify(ctx context.Context, rawToken string) (*Claims, error) {
-	claims := &Claims{}
-	_, err := jwt.ParseWithClaims(rawToken, claims, func(token *jwt.Token) (interface{}, error) {
-		return []byte(p.sessionSecret), nil
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	if err := claims.Valid(); err != nil {
-		return nil, err
-	}
-
-	// If the token doesn't exist in the token storage anymore, it must have been revoked.
-	// Fail verification in this case.
-	// TODO(perf): Cache the lookup result in-memory for min(60, timeToExpiry) to prevent
-	// hitting the DB on each request. This should also cache whether we didn't find a token.
-	if p.tokenStorage != nil {
-		_, err := p.tokenStorage.Read(ctx, claims.Subject, clutchProvider)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return claims, nil
-}
-
 func (p *OIDCProvider) Read(ctx context.Context, userID, provider string) (*oauth2.Token, error) {
 	if p.tokenStorage == nil {
 		return nil, status.Error(codes.Internal, "token read attempted but storage is not configured")
@@ -410,15 +255,17 @@ func NewOIDCProvider(ctx context.Context, config *authnv1.Config, tokenStorage S
 		claimsFromOIDCTokenFunc = NewClaimsConfig(c.SubjectClaimNameOverride).ClaimsFromOIDCToken
 	}
 	p := &OIDCProvider{
-		providerAlias:              alias,
-		provider:                   provider,
-		verifier:                   verifier,
-		oauth2:                     oc,
-		httpClient:                 ctx.Value(oauth2.HTTPClient).(*http.Client),
-		sessionSecret:              config.SessionSecret,
-		claimsFromOIDCToken:        claimsFromOIDCTokenFunc,
-		tokenStorage:               tokenStorage,
-		enableServiceTokenCreation: tokenStorage != nil && config.EnableServiceTokenCreation,
+		providerAlias:       alias,
+		provider:            provider,
+		verifier:            verifier,
+		oauth2:              oc,
+		httpClient:          ctx.Value(oauth2.HTTPClient).(*http.Client),
+		claimsFromOIDCToken: claimsFromOIDCTokenFunc,
+	}
+
+	p.tokenIssuer, err = newTokenIssuer(config, tokenStorage)
+	if err != nil {
+		return nil, err
 	}
 
 	return p, nil
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -13,17 +14,19 @@ import (
 	"github.com/dgrijalva/jwt-go"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/oauth2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gopkg.in/square/go-jose.v2"
 
 	authnmodulev1 "github.com/lyft/clutch/backend/api/authn/v1"
 	authnv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
+	"github.com/lyft/clutch/backend/internal/test/authntest"
 	apimock "github.com/lyft/clutch/backend/mock/api"
-	"github.com/lyft/clutch/backend/mock/service/authnmock"
 )
 
 func TestStateNonceRoundTrip(t *testing.T) {
 	p := &OIDCProvider{
-		sessionSecret: "this-is-my-secret",
+		tokenIssuer: tokenIssuer{sessionSecret: "this-is-my-secret"},
 	}
 
 	url := "/foo"
@@ -38,7 +41,7 @@ func TestStateNonceRoundTrip(t *testing.T) {
 
 	// Check that the same fails if not signed correctly.
 	p2 := &OIDCProvider{
-		sessionSecret: "this-is-a-different-secret",
+		tokenIssuer: tokenIssuer{sessionSecret: "this-is-a-different-secret"},
 	}
 	_, err = p2.ValidateStateNonce(state)
 	assert.Error(t, err)
@@ -46,7 +49,7 @@ func TestStateNonceRoundTrip(t *testing.T) {
 
 func TestStateNoncePrependsLeadingSlash(t *testing.T) {
 	p := &OIDCProvider{
-		sessionSecret: "this-is-my-secret",
+		tokenIssuer: tokenIssuer{sessionSecret: "this-is-my-secret"},
 	}
 	s, err := p.GetStateNonce("dest/foo")
 	assert.NoError(t, err)
@@ -56,7 +59,7 @@ func TestStateNoncePrependsLeadingSlash(t *testing.T) {
 
 func TestStateNonceRejections(t *testing.T) {
 	p := &OIDCProvider{
-		sessionSecret: "this-is-my-secret",
+		tokenIssuer: tokenIssuer{sessionSecret: "this-is-my-secret"},
 	}
 
 	tests := []string{
@@ -91,7 +94,7 @@ oidc:
 
 	email := "user@example.com"
 
-	mockprovider := authnmock.NewMockOIDCProviderServer(email)
+	mockprovider := authntest.NewMockOIDCProviderServer(email)
 	defer mockprovider.Close()
 
 	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, mockprovider.Client())
@@ -132,10 +135,10 @@ oidc:
 
 	email := "user@example.com"
 
-	mockprovider := authnmock.NewMockOIDCProviderServer(email)
+	mockprovider := authntest.NewMockOIDCProviderServer(email)
 	defer mockprovider.Close()
 
-	mockStorage := authnmock.NewMockStorage()
+	mockStorage := authntest.NewMockStorage()
 
 	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, mockprovider.Client())
 
@@ -209,6 +212,105 @@ oidc:
 	assert.Error(t, err)
 }
 
+func TestServiceTokenManagement(t *testing.T) {
+	cfg := &authnv1.Config{}
+	apimock.FromYAML(`
+session_secret: this_is_my_secret
+enable_service_token_creation: true
+oidc:
+  issuer: http://foo.example.com
+  client_id: my_client_id
+  client_secret: my_client_secret
+  redirect_url: "http://localhost:12000/v1/authn/callback"
+  scopes:
+  - openid
+  - email
+`, cfg)
+
+	mockprovider := authntest.NewMockOIDCProviderServer("user@example.com")
+	defer mockprovider.Close()
+
+	mockStorage := authntest.NewMockServiceTokenStorage()
+
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, mockprovider.Client())
+
+	p, err := NewOIDCProvider(ctx, cfg, mockStorage)
+	assert.NoError(t, err)
+
+	creatorCtx := ContextWithClaims(ctx, &Claims{StandardClaims: &jwt.StandardClaims{Subject: "user@example.com"}})
+	expiry := time.Hour
+	createdToken, err := p.(Issuer).CreateToken(creatorCtx, "deployer", authnmodulev1.CreateTokenRequest_SERVICE, &expiry,
+		WithTokenDescription("deploys things"), WithTokenScopes("/clutch.k8s.v1.K8sAPI/*"))
+	assert.NoError(t, err)
+
+	id, ok := createdToken.Extra(tokenIDExtra).(string)
+	assert.True(t, ok)
+
+	claims, err := p.Verify(ctx, createdToken.AccessToken)
+	assert.NoError(t, err)
+	assert.Equal(t, id, claims.Id)
+	assert.Equal(t, []string{"/clutch.k8s.v1.K8sAPI/*"}, claims.Scopes)
+	p.(*OIDCProvider).serviceTokenUses.wg.Wait()
+
+	tokens, err := p.(TokenManager).ListTokens(ctx, false)
+	assert.NoError(t, err)
+	assert.Len(t, tokens, 1)
+	assert.Equal(t, "service:deployer", tokens[0].Subject)
+	assert.Equal(t, "user@example.com", tokens[0].Creator)
+	assert.Equal(t, "deploys things", tokens[0].Description)
+	assert.NotNil(t, tokens[0].ExpiresAt)
+	assert.NotNil(t, tokens[0].LastUsedAt)
+
+	assert.NoError(t, p.(TokenManager).RevokeToken(ctx, id))
+	_, err = p.Verify(ctx, createdToken.AccessToken)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	tokens, err = p.(TokenManager).ListTokens(ctx, false)
+	assert.NoError(t, err)
+	assert.Empty(t, tokens)
+
+	err = p.(TokenManager).RevokeToken(ctx, id)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Token management requires storage for the metadata.
+	p, err = NewOIDCProvider(ctx, cfg, authntest.NewMockStorage())
+	assert.NoError(t, err)
+	_, err = p.(TokenManager).ListTokens(ctx, false)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+type countingServiceTokenStorage struct {
+	*authntest.MockServiceTokenStorage
+	mu   sync.Mutex
+	uses map[string]int
+}
+
+func (c *countingServiceTokenStorage) RecordServiceTokenUse(ctx context.Context, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.uses[id]++
+	return nil
+}
+
+func TestServiceTokenUseRecorder(t *testing.T) {
+	storage := &countingServiceTokenStorage{MockServiceTokenStorage: authntest.NewMockServiceTokenStorage(), uses: map[string]int{}}
+	r := newServiceTokenUseRecorder(storage, time.Hour)
+
+	// Uses within the interval are only recorded once.
+	r.record("a")
+	r.record("a")
+	r.record("b")
+	r.wg.Wait()
+	assert.Equal(t, map[string]int{"a": 1, "b": 1}, storage.uses)
+
+	// Tokens that weren't used within the interval are forgotten.
+	r.interval = 0
+	r.record("a")
+	r.wg.Wait()
+	assert.Equal(t, 2, storage.uses["a"])
+	assert.Len(t, r.recorded, 1)
+}
+
 func TestReadThrough(t *testing.T) {
 	cfg := &authnv1.Config{}
 	apimock.FromYAML(`
@@ -224,9 +326,9 @@ oidc:
   - email
 `, cfg)
 
-	mockStorage := authnmock.NewMockStorage()
+	mockStorage := authntest.NewMockStorage()
 
-	mockprovider := authnmock.NewMockOIDCProviderServer("foo@example.com")
+	mockprovider := authntest.NewMockOIDCProviderServer("foo@example.com")
 	defer mockprovider.Close()
 	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, mockprovider.Client())
 
@@ -271,10 +373,10 @@ oidc:
 
 	email := "user@example.com"
 
-	mockprovider := authnmock.NewMockOIDCProviderServer(email)
+	mockprovider := authntest.NewMockOIDCProviderServer(email)
 	defer mockprovider.Close()
 
-	mockStorage := authnmock.NewMockStorage()
+	mockStorage := authntest.NewMockStorage()
 
 	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, mockprovider.Client())
 
@@ -387,11 +489,11 @@ oidc:
 	for idx, tc := range testcases {
 		tc := tc
 		t.Run(fmt.Sprintf("%d", idx), func(t *testing.T) {
-			mockprovider := authnmock.NewMockOIDCProviderServer(email)
+			mockprovider := authntest.NewMockOIDCProviderServer(email)
 			defer mockprovider.Close()
 			ctx := context.WithValue(context.Background(), oauth2.HTTPClient, mockprovider.Client())
 
-			mockStorage := authnmock.NewMockStorage()
+			mockStorage := authntest.NewMockStorage()
 			p, err := NewOIDCProvider(ctx, cfg, mockStorage)
 			assert.NoError(t, err)
 
@@ -450,7 +552,7 @@ oidc:
 	c := cfg.GetOidc()
 	email := "user@example.com"
 
-	mockprovider := authnmock.NewMockOIDCProviderServer(email)
+	mockprovider := authntest.NewMockOIDCProviderServer(email)
 	defer mockprovider.Close()
 
 	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, mockprovider.Client())
@@ -483,7 +585,7 @@ oidc:
 
 	email := "user@example.com"
 
-	mockprovider := authnmock.NewMockOIDCProviderServer(email)
+	mockprovider := authntest.NewMockOIDCProviderServer(email)
 	defer mockprovider.Close()
 
 	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, mockprovider.Client())
@@ -518,7 +620,7 @@ oidc:
 
 	email := "user@example.com"
 
-	mockprovider := authnmock.NewMockOIDCProviderServer(email)
+	mockprovider := authntest.NewMockOIDCProviderServer(email)
 	defer mockprovider.Close()
 
 	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, mockprovider.Client())
@@ -548,7 +650,7 @@ oidc:
 
 	email := "user@example.com"
 
-	mockprovider := authnmock.NewMockOIDCProviderServer(email)
+	mockprovider := authntest.NewMockOIDCProviderServer(email)
 	mockprovider.SetGroupClaim([]string{"group1", "group2"})
 	defer mockprovider.Close()
 
@@ -579,7 +681,7 @@ oidc:
 
 	email := "user@example.com"
 
-	mockprovider := authnmock.NewMockOIDCProviderServer(email)
+	mockprovider := authntest.NewMockOIDCProviderServer(email)
 	mockprovider.SetCustomClaim("")
 	defer mockprovider.Close()
 
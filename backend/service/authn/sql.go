@@ -6,6 +6,13 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authnmodulev1 "github.com/lyft/clutch/backend/api/authn/v1"
+	authnv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
 	"github.com/lyft/clutch/backend/service"
 	"github.com/lyft/clutch/backend/service/db/postgres"
 )
@@ -74,3 +81,137 @@ func (r *repository) readProviderToken(ctx context.Context, userID, provider str
 
 	return t, nil
 }
+
+const createServiceToken = `
+INSERT INTO authn_service_tokens (id, subject, creator, description, scopes, expires_at) VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+func (r *repository) createServiceToken(ctx context.Context, token *authnmodulev1.ServiceToken) error {
+	var expiresAt sql.NullTime
+	if token.ExpiresAt != nil {
+		expiresAt = sql.NullTime{Time: token.ExpiresAt.AsTime(), Valid: true}
+	}
+
+	_, err := r.db.ExecContext(ctx, createServiceToken,
+		token.Id, token.Subject, token.Creator, token.Description, pq.Array(token.Scopes), expiresAt)
+	return err
+}
+
+const listServiceTokens = `
+SELECT id, subject, creator, description, scopes, created_at, expires_at, last_used_at, revoked_at FROM authn_service_tokens
+`
+
+func (r *repository) listServiceTokens(ctx context.Context, includeRevoked bool) ([]*authnmodulev1.ServiceToken, error) {
+	query := listServiceTokens
+	if !includeRevoked {
+		query += " WHERE revoked_at IS NULL"
+	}
+	query += " ORDER BY created_at DESC"
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ret []*authnmodulev1.ServiceToken
+	for rows.Next() {
+		t := &authnmodulev1.ServiceToken{}
+		var createdAt time.Time
+		var expiresAt, lastUsedAt, revokedAt sql.NullTime
+		if err := rows.Scan(&t.Id, &t.Subject, &t.Creator, &t.Description, pq.Array(&t.Scopes), &createdAt, &expiresAt,
+			&lastUsedAt, &revokedAt); err != nil {
+			return nil, err
+		}
+		t.CreatedAt = timestamppb.New(createdAt)
+		t.ExpiresAt = nullTimestamp(expiresAt)
+		t.LastUsedAt = nullTimestamp(lastUsedAt)
+		t.RevokedAt = nullTimestamp(revokedAt)
+		ret = append(ret, t)
+	}
+	return ret, rows.Err()
+}
+
+func nullTimestamp(t sql.NullTime) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
+
+const revokeServiceToken = `
+UPDATE authn_service_tokens SET revoked_at = now() WHERE id = $1 AND revoked_at IS NULL
+`
+
+func (r *repository) revokeServiceToken(ctx context.Context, id string) error {
+	res, err := r.db.ExecContext(ctx, revokeServiceToken, id)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return status.Errorf(codes.NotFound, "token '%s' does not exist or was already revoked", id)
+	}
+	return nil
+}
+
+const checkServiceToken = `
+SELECT revoked_at IS NULL FROM authn_service_tokens WHERE id = $1
+`
+
+func (r *repository) checkServiceToken(ctx context.Context, id string) error {
+	var valid bool
+	err := r.db.QueryRowContext(ctx, checkServiceToken, id).Scan(&valid)
+	if err == sql.ErrNoRows || (err == nil && !valid) {
+		return status.Error(codes.Unauthenticated, "token was revoked")
+	}
+	return err
+}
+
+const recordServiceTokenUse = `
+UPDATE authn_service_tokens SET last_used_at = now() WHERE id = $1
+`
+
+func (r *repository) recordServiceTokenUse(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, recordServiceTokenUse, id)
+	return err
+}
+
+const readLocalUser = `
+SELECT username, password_hash, groups FROM authn_local_users WHERE username = $1
+`
+
+// readLocalUser returns nil if the user does not exist.
+func (r *repository) readLocalUser(ctx context.Context, username string) (*authnv1.Local_User, error) {
+	u := &authnv1.Local_User{}
+	err := r.db.QueryRowContext(ctx, readLocalUser, username).Scan(&u.Username, &u.PasswordHash, pq.Array(&u.Groups))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// Expired codes are removed as new ones are used, since they can't be exchanged anyway.
+const useLocalCode = `
+WITH expired AS (DELETE FROM authn_local_codes WHERE expires_at < now())
+INSERT INTO authn_local_codes (id, expires_at) VALUES ($1, $2) ON CONFLICT (id) DO NOTHING
+`
+
+// useLocalCode records the code and returns false if it was already used.
+func (r *repository) useLocalCode(ctx context.Context, id string, expiresAt time.Time) (bool, error) {
+	res, err := r.db.ExecContext(ctx, useLocalCode, id, expiresAt)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n == 1, nil
+}
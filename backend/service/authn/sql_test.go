@@ -7,6 +7,8 @@ import (
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestCreateOrUpdateUser(t *testing.T) {
@@ -32,3 +34,86 @@ func TestCreateOrUpdateUser(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestListServiceTokens(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "subject", "creator", "description", "scopes", "created_at", "expires_at", "last_used_at", "revoked_at"}).
+		AddRow("abc", "service:foo", "user@example.com", "", "{/clutch.k8s.v1.K8sAPI/*}", now, nil, now, nil)
+	mock.ExpectQuery(listServiceTokens + " WHERE revoked_at IS NULL ORDER BY created_at DESC").WillReturnRows(rows)
+
+	r := &repository{db: db}
+	tokens, err := r.listServiceTokens(context.Background(), false)
+	assert.NoError(t, err)
+	assert.Len(t, tokens, 1)
+	assert.Equal(t, []string{"/clutch.k8s.v1.K8sAPI/*"}, tokens[0].Scopes)
+	assert.Nil(t, tokens[0].ExpiresAt)
+	assert.NotNil(t, tokens[0].LastUsedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRevokeAndUseServiceToken(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(revokeServiceToken).WithArgs("abc").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(revokeServiceToken).WithArgs("abc").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(checkServiceToken).WithArgs("abc").WillReturnRows(sqlmock.NewRows([]string{"valid"}).AddRow(false))
+	mock.ExpectQuery(checkServiceToken).WithArgs("def").WillReturnRows(sqlmock.NewRows([]string{"valid"}).AddRow(true))
+	mock.ExpectQuery(checkServiceToken).WithArgs("missing").WillReturnRows(sqlmock.NewRows([]string{"valid"}))
+	mock.ExpectExec(recordServiceTokenUse).WithArgs("def").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	r := &repository{db: db}
+	assert.NoError(t, r.revokeServiceToken(context.Background(), "abc"))
+	assert.Equal(t, codes.NotFound, status.Code(r.revokeServiceToken(context.Background(), "abc")))
+	assert.Equal(t, codes.Unauthenticated, status.Code(r.checkServiceToken(context.Background(), "abc")))
+	assert.NoError(t, r.checkServiceToken(context.Background(), "def"))
+	assert.Equal(t, codes.Unauthenticated, status.Code(r.checkServiceToken(context.Background(), "missing")))
+	assert.NoError(t, r.recordServiceTokenUse(context.Background(), "def"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReadLocalUser(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(readLocalUser).WithArgs("foo").
+		WillReturnRows(sqlmock.NewRows([]string{"username", "password_hash", "groups"}).AddRow("foo", "hash", "{admins}"))
+	mock.ExpectQuery(readLocalUser).WithArgs("bar").
+		WillReturnRows(sqlmock.NewRows([]string{"username", "password_hash", "groups"}))
+
+	r := &repository{db: db}
+	u, err := r.readLocalUser(context.Background(), "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "hash", u.PasswordHash)
+	assert.Equal(t, []string{"admins"}, u.Groups)
+
+	u, err = r.readLocalUser(context.Background(), "bar")
+	assert.NoError(t, err)
+	assert.Nil(t, u)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUseLocalCode(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	expiresAt := time.Now().Add(time.Minute)
+	mock.ExpectExec(useLocalCode).WithArgs("abc", expiresAt).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(useLocalCode).WithArgs("abc", expiresAt).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	r := &repository{db: db}
+	unused, err := r.useLocalCode(context.Background(), "abc", expiresAt)
+	assert.NoError(t, err)
+	assert.True(t, unused)
+	unused, err = r.useLocalCode(context.Background(), "abc", expiresAt)
+	assert.NoError(t, err)
+	assert.False(t, unused)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -6,6 +6,7 @@ package authn
 
 import (
 	"context"
+	"time"
 
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
@@ -14,6 +15,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	authnmodulev1 "github.com/lyft/clutch/backend/api/authn/v1"
 	authnv1 "github.com/lyft/clutch/backend/api/config/service/authn/v1"
 	"github.com/lyft/clutch/backend/service"
 )
@@ -132,3 +134,31 @@ func (s *storage) Read(ctx context.Context, userID string, provider string) (*oa
 
 	return ret, nil
 }
+
+func (s *storage) CreateServiceToken(ctx context.Context, token *authnmodulev1.ServiceToken) error {
+	return s.repo.createServiceToken(ctx, token)
+}
+
+func (s *storage) ListServiceTokens(ctx context.Context, includeRevoked bool) ([]*authnmodulev1.ServiceToken, error) {
+	return s.repo.listServiceTokens(ctx, includeRevoked)
+}
+
+func (s *storage) RevokeServiceToken(ctx context.Context, id string) error {
+	return s.repo.revokeServiceToken(ctx, id)
+}
+
+func (s *storage) CheckServiceToken(ctx context.Context, id string) error {
+	return s.repo.checkServiceToken(ctx, id)
+}
+
+func (s *storage) RecordServiceTokenUse(ctx context.Context, id string) error {
+	return s.repo.recordServiceTokenUse(ctx, id)
+}
+
+func (s *storage) readLocalUser(ctx context.Context, username string) (*authnv1.Local_User, error) {
+	return s.repo.readLocalUser(ctx, username)
+}
+
+func (s *storage) useLocalCode(ctx context.Context, id string, expiresAt time.Time) (bool, error) {
+	return s.repo.useLocalCode(ctx, id, expiresAt)
+}
@@ -7,10 +7,12 @@ package authz
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	authzv1 "github.com/lyft/clutch/backend/api/authz/v1"
 	authzcfgv1 "github.com/lyft/clutch/backend/api/config/service/authz/v1"
@@ -51,6 +53,8 @@ type principalToRoleMap map[principalKey][]string
 type roleToPolicyMap map[string]*authzcfgv1.Role
 
 type staticImpl struct {
+	mu sync.RWMutex
+
 	// Map of policy role names to the policy object.
 	roleToPolicy roleToPolicyMap
 
@@ -118,6 +122,16 @@ func configToPrincipalRoleMap(config *authzcfgv1.Config) principalToRoleMap {
 }
 
 func newStaticImpl(logger *zap.Logger, config *authzcfgv1.Config) (Client, error) {
+	s := &staticImpl{}
+	apply, err := s.prepare(config)
+	if err != nil {
+		return nil, err
+	}
+	apply()
+	return s, nil
+}
+
+func (s *staticImpl) prepare(config *authzcfgv1.Config) (func(), error) {
 	// Compute map of role to policy.
 	roleToPolicy, err := configToRolePolicyMap(config)
 	if err != nil {
@@ -128,12 +142,23 @@ func newStaticImpl(logger *zap.Logger, config *authzcfgv1.Config) (Client, error
 	principalToRole := configToPrincipalRoleMap(config)
 
 	// Save on the struct for lookup at runtime.
-	return &staticImpl{
-		principalToRole: principalToRole,
-		roleToPolicy:    roleToPolicy,
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.principalToRole = principalToRole
+		s.roleToPolicy = roleToPolicy
 	}, nil
 }
 
+// PrepareReload computes the roles and bindings of a new config. Checks in progress complete with the previous policies.
+func (s *staticImpl) PrepareReload(cfg *anypb.Any) (func(), error) {
+	config := &authzcfgv1.Config{}
+	if err := cfg.UnmarshalTo(config); err != nil {
+		return nil, err
+	}
+	return s.prepare(config)
+}
+
 func assertPolicy(pol *authzcfgv1.Policy, req *authzv1.CheckRequest) bool {
 	// ActionTypes: if none specified or request action is in the policy's list, OK.
 	if len(pol.ActionTypes) != 0 {
@@ -196,6 +221,9 @@ func (s *staticImpl) evaluate(roles []string, req *authzv1.CheckRequest) *authzv
 }
 
 func (s *staticImpl) Check(ctx context.Context, req *authzv1.CheckRequest) (*authzv1.CheckResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
 	// Gather all of the roles for the user and/or groups.
 	var roles []string
 	if req.Subject.User != "" {
@@ -1,10 +1,12 @@
 package authz
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
 	authzv1 "github.com/lyft/clutch/backend/api/authz/v1"
@@ -117,3 +119,46 @@ func TestConfigToRolePolicyMap(t *testing.T) {
 	assert.NoError(t, err)
 	assert.EqualValues(t, roleToPolicyMap{"role-a": &authzcfgv1.Role{RoleName: "role-a", Policies: pol}}, result)
 }
+
+func TestPrepareReload(t *testing.T) {
+	newConfig := func(user string) *authzcfgv1.Config {
+		return &authzcfgv1.Config{
+			Roles: []*authzcfgv1.Role{{RoleName: "admin", Policies: []*authzcfgv1.Policy{{PolicyName: "all"}}}},
+			RoleBindings: []*authzcfgv1.RoleBinding{
+				{
+					To:         []string{"admin"},
+					Principals: []*authzcfgv1.Principal{{Type: &authzcfgv1.Principal_User{User: user}}},
+				},
+			},
+		}
+	}
+
+	c, err := newStaticImpl(nil, newConfig("alice"))
+	assert.NoError(t, err)
+	s := c.(*staticImpl)
+
+	check := func(user string) authzv1.Decision {
+		resp, err := s.Check(context.Background(), &authzv1.CheckRequest{Subject: &authzv1.Subject{User: user}})
+		assert.NoError(t, err)
+		return resp.Decision
+	}
+	assert.Equal(t, authzv1.Decision_ALLOW, check("alice"))
+
+	// An invalid config is rejected.
+	bad := newConfig("bob")
+	bad.RoleBindings[0].To = []string{"nonexistent"}
+	cfg, err := anypb.New(bad)
+	assert.NoError(t, err)
+	_, err = s.PrepareReload(cfg)
+	assert.Error(t, err)
+
+	cfg, err = anypb.New(newConfig("bob"))
+	assert.NoError(t, err)
+	apply, err := s.PrepareReload(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, authzv1.Decision_ALLOW, check("alice"))
+
+	apply()
+	assert.Equal(t, authzv1.Decision_DENY, check("alice"))
+	assert.Equal(t, authzv1.Decision_ALLOW, check("bob"))
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/iancoleman/strcase"
 	"github.com/uber-go/tally/v4"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
 	"golang.org/x/sync/semaphore"
 	"google.golang.org/grpc/codes"
@@ -78,7 +79,7 @@ func New(cfg *anypb.Any, logger *zap.Logger, scope tally.Scope) (service.Service
 	}
 
 	ds := getScalingLimits(ac)
-	awsHTTPClient := &http.Client{}
+	awsHTTPClient := &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
 	awsClientCommonOptions := []func(*config.LoadOptions) error{
 		config.WithHTTPClient(awsHTTPClient),
 		config.WithRetryer(func() aws.Retryer {
@@ -2,6 +2,9 @@ package aws
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 )
@@ -15,3 +18,29 @@ func (c *client) GetCallerIdentity(ctx context.Context, account, region string)
 	in := &sts.GetCallerIdentityInput{}
 	return cl.sts.GetCallerIdentity(ctx, in)
 }
+
+// Check verifies the credentials for each configured account by calling GetCallerIdentity in the account's first
+// region.
+func (c *client) Check(ctx context.Context) error {
+	aliases := make([]string, 0, len(c.accounts))
+	for alias := range c.accounts {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	var failures []string
+	for _, alias := range aliases {
+		account := c.accounts[alias]
+		if len(account.regions) == 0 {
+			continue
+		}
+		if _, err := c.GetCallerIdentity(ctx, alias, account.regions[0]); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %s", alias, err))
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf("failed to get caller identity for %d account(s): %s", len(failures), strings.Join(failures, "; "))
+	}
+	return nil
+}
@@ -63,6 +63,32 @@ func TestSTSGetCallerIdentityErrorHandling(t *testing.T) {
 	assert.Error(t, err2)
 }
 
+func TestCheck(t *testing.T) {
+	c := &client{
+		accounts: map[string]*accountClients{
+			"default": {
+				regions: []string{"us-east-1"},
+				clients: map[string]*regionalClient{
+					"us-east-1": {region: "us-east-1", sts: &mockSTS{getIdentityOutput: &sts.GetCallerIdentityOutput{}}},
+				},
+			},
+			"staging": {
+				regions: []string{"us-west-2", "us-east-1"},
+				clients: map[string]*regionalClient{
+					"us-west-2": {region: "us-west-2", sts: &mockSTS{getIdentityErr: fmt.Errorf("expired token")}},
+					"us-east-1": {region: "us-east-1", sts: &mockSTS{getIdentityOutput: &sts.GetCallerIdentityOutput{}}},
+				},
+			},
+		},
+	}
+
+	err := c.Check(context.Background())
+	assert.EqualError(t, err, "failed to get caller identity for 1 account(s): staging: expired token")
+
+	delete(c.accounts, "staging")
+	assert.NoError(t, c.Check(context.Background()))
+}
+
 type mockSTS struct {
 	stsClient
 
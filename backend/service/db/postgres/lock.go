@@ -0,0 +1,14 @@
+package postgres
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+)
+
+// AdvisoryLockID converts the name of a lock to an ID for the pg_*advisory_lock functions, e.g. "shortlink:cleanup".
+// The ID is derived from a hash of the name, so different names are unlikely to share a lock. Existing locks must keep
+// the IDs they were derived with, since replicas running different versions would otherwise lock different IDs.
+func AdvisoryLockID(name string) uint32 {
+	sum := sha256.Sum256([]byte(name))
+	return binary.BigEndian.Uint32(sum[:])
+}
@@ -0,0 +1,16 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdvisoryLockID(t *testing.T) {
+	assert.Equal(t, uint32(0xd0141b75), AdvisoryLockID("topology:cache"))
+	assert.Equal(t, AdvisoryLockID("shortlink:cleanup"), AdvisoryLockID("shortlink:cleanup"))
+
+	// Names that share a prefix get different IDs.
+	assert.NotEqual(t, AdvisoryLockID("topology:cache"), AdvisoryLockID("topology:cache2"))
+	assert.NotEqual(t, AdvisoryLockID("shortlink:cleanup"), AdvisoryLockID("shortlink:cleanupx"))
+}
@@ -5,13 +5,14 @@ package postgres
 // <!-- END clutchdoc -->
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/golang/protobuf/ptypes/any"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
 
@@ -33,6 +34,8 @@ type Client interface {
 
 func (c *client) DB() *sql.DB { return c.sqlDB }
 
+func (c *client) Check(ctx context.Context) error { return c.sqlDB.PingContext(ctx) }
+
 func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service, error) {
 	pgcfg := &postgresv1.Config{}
 	err := cfg.UnmarshalTo(pgcfg)
@@ -45,10 +48,11 @@ func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service,
 		return nil, err
 	}
 
-	sqlDB, err := sql.Open("postgres", connection)
+	connector, err := pq.NewConnector(connection)
 	if err != nil {
 		return nil, err
 	}
+	sqlDB := sql.OpenDB(&tracingConnector{Connector: connector})
 
 	// Zero is used as the default, this will utilize the default database/sql value
 	// Specifying -1 will disable Idle connections
@@ -1,8 +1,11 @@
 package postgres
 
 import (
+	"context"
+	"errors"
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/stretchr/testify/assert"
 	"github.com/uber-go/tally/v4"
@@ -85,3 +88,21 @@ func TestConnString(t *testing.T) {
 		})
 	}
 }
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	c := &client{sqlDB: db}
+
+	mock.ExpectPing()
+	assert.NoError(t, c.Check(context.Background()))
+
+	mock.ExpectPing().WillReturnError(errors.New("connection refused"))
+	assert.EqualError(t, c.Check(context.Background()), "connection refused")
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/lyft/clutch/backend/service/db/postgres"
+
+// tracingConnector records a span for each query made as part of a traced request.
+type tracingConnector struct {
+	driver.Connector
+}
+
+func (c *tracingConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	conn, err := c.Connector.Connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if pc, ok := conn.(pqConn); ok {
+		return &tracingConn{pqConn: pc}, nil
+	}
+	return conn, nil
+}
+
+// pqConn is the set of interfaces implemented by connections from lib/pq.
+type pqConn interface {
+	driver.Conn
+	driver.ConnBeginTx
+	driver.ConnPrepareContext
+	driver.ExecerContext
+	driver.QueryerContext
+	driver.Pinger
+}
+
+type tracingConn struct {
+	pqConn
+}
+
+func (c *tracingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	ctx, span := startSpan(ctx, "postgres.exec", query)
+	if span == nil {
+		return c.pqConn.ExecContext(ctx, query, args)
+	}
+	defer span.End()
+
+	res, err := c.pqConn.ExecContext(ctx, query, args)
+	recordError(span, err)
+	return res, err
+}
+
+func (c *tracingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	ctx, span := startSpan(ctx, "postgres.query", query)
+	if span == nil {
+		return c.pqConn.QueryContext(ctx, query, args)
+	}
+	defer span.End()
+
+	rows, err := c.pqConn.QueryContext(ctx, query, args)
+	recordError(span, err)
+	return rows, err
+}
+
+// startSpan returns a nil span if the context is not part of a trace, so that background work doesn't start new traces.
+func startSpan(ctx context.Context, name, query string) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx, nil
+	}
+	return otel.Tracer(instrumentationName).Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL, semconv.DBStatement(query)),
+	)
+}
+
+func recordError(span trace.Span, err error) {
+	if err != nil && err != driver.ErrSkip {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+}
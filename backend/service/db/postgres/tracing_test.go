@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+
+	"github.com/lyft/clutch/backend/mock/tracingmock"
+)
+
+type fakeConn struct {
+	pqConn
+
+	err error
+}
+
+func (c *fakeConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	return driver.RowsAffected(1), c.err
+}
+
+func (c *fakeConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	return nil, c.err
+}
+
+func TestTracingConn(t *testing.T) {
+	exporter := tracingmock.New()
+	conn := &tracingConn{pqConn: &fakeConn{}}
+
+	// Queries outside of a trace are not recorded.
+	_, err := conn.ExecContext(context.Background(), "DELETE FROM shortlink", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, exporter.GetSpans())
+
+	ctx, span := otel.Tracer("test").Start(context.Background(), "request")
+	_, err = conn.ExecContext(ctx, "DELETE FROM shortlink", nil)
+	assert.NoError(t, err)
+
+	conn = &tracingConn{pqConn: &fakeConn{err: errors.New("connection reset")}}
+	_, err = conn.QueryContext(ctx, "SELECT 1", nil)
+	assert.Error(t, err)
+	span.End()
+
+	spans := exporter.GetSpans()
+	assert.Len(t, spans, 3)
+	assert.Equal(t, "postgres.exec", spans[0].Name)
+	assert.Equal(t, span.SpanContext().SpanID(), spans[0].Parent.SpanID())
+	assert.Contains(t, spans[0].Attributes, semconv.DBStatement("DELETE FROM shortlink"))
+	assert.Equal(t, "postgres.query", spans[1].Name)
+	assert.Equal(t, otelcodes.Error, spans[1].Status.Code)
+}
@@ -9,18 +9,29 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	envoyadminv1 "github.com/lyft/clutch/backend/api/config/service/envoyadmin/v1"
 	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
 	"github.com/lyft/clutch/backend/service"
 )
 
-const Name = "clutch.service.envoyadmin"
+const (
+	Name = "clutch.service.envoyadmin"
+
+	defaultRevertTTL    = 15 * time.Minute
+	defaultMaxRevertTTL = time.Hour
+)
 
 func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service, error) {
 	return NewWithHTTPClient(cfg, logger, scope, &http.Client{})
@@ -32,26 +43,46 @@ func NewWithHTTPClient(cfg *any.Any, logger *zap.Logger, scope tally.Scope, http
 		return nil, err
 	}
 
-	return &client{
-		defaultPort: config.DefaultRemotePort,
-		httpClient:  httpClient,
-	}, nil
+	c := &client{
+		defaultPort:      config.DefaultRemotePort,
+		defaultRevertTTL: defaultRevertTTL,
+		maxRevertTTL:     defaultMaxRevertTTL,
+		httpClient:       httpClient,
+		reverter:         newReverter(logger, scope.SubScope("revert")),
+	}
+	if config.DefaultRevertTtl != nil {
+		c.defaultRevertTTL = config.DefaultRevertTtl.AsDuration()
+	}
+	if config.MaxRevertTtl != nil {
+		c.maxRevertTTL = config.MaxRevertTtl.AsDuration()
+	}
+	if c.defaultRevertTTL > c.maxRevertTTL {
+		return nil, fmt.Errorf("default revert TTL '%s' exceeds max revert TTL '%s'", c.defaultRevertTTL, c.maxRevertTTL)
+	}
+
+	return c, nil
 }
 
 type Client interface {
 	// Get performs read-only operations concurrently and returns the results. If any of the operations fail,
 	// an error is returned.
 	Get(ctx context.Context, operation *envoytriagev1.ReadOperation) (*envoytriagev1.Result, error)
+
+	// Update performs a write operation and schedules its reversion if the operation is reversible.
+	Update(ctx context.Context, operation *envoytriagev1.UpdateOperation) (*envoytriagev1.UpdateResult, error)
 }
 
 type client struct {
-	defaultPort uint32
-	httpClient  *http.Client
+	defaultPort      uint32
+	defaultRevertTTL time.Duration
+	maxRevertTTL     time.Duration
+	httpClient       *http.Client
+	reverter         *reverter
 }
 
-func makeRequest(ctx context.Context, cl *http.Client, baseURL, path string) ([]byte, error) {
+func makeRequest(ctx context.Context, cl *http.Client, method, baseURL, path string) ([]byte, error) {
 	url := fmt.Sprintf("%s%s", baseURL, path)
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -68,20 +99,59 @@ func makeRequest(ctx context.Context, cl *http.Client, baseURL, path string) ([]
 	return io.ReadAll(resp.Body)
 }
 
-func (c *client) Get(ctx context.Context, operation *envoytriagev1.ReadOperation) (*envoytriagev1.Result, error) {
-	defer c.httpClient.CloseIdleConnections()
-
-	// Use default port if one was not provided and construct address.
-	port := operation.Address.Port
+// resolveAddress uses the default port if one was not provided and constructs the base URL for the address.
+func (c *client) resolveAddress(address *envoytriagev1.Address) (*envoytriagev1.Address, string) {
+	port := address.Port
 	if port == 0 {
 		port = c.defaultPort
 	}
-	addr := fmt.Sprintf("%s:%d", operation.Address.Host, port)
-	baseURL := fmt.Sprintf("http://%s", addr)
+	return &envoytriagev1.Address{Host: address.Host, Port: port}, fmt.Sprintf("http://%s:%d", address.Host, port)
+}
+
+// statsPath returns the admin path for the stats options along with the format that will be returned.
+func statsPath(opts *envoytriagev1.StatsOptions) (string, envoytriagev1.StatsOptions_Format, error) {
+	format := opts.GetFormat()
+	if format == envoytriagev1.StatsOptions_UNSPECIFIED {
+		format = envoytriagev1.StatsOptions_TEXT
+		if opts.GetHistograms() {
+			format = envoytriagev1.StatsOptions_JSON
+		}
+	}
+
+	path := "/stats"
+	var params []string
+	switch format {
+	case envoytriagev1.StatsOptions_JSON:
+		params = append(params, "format=json")
+	case envoytriagev1.StatsOptions_PROMETHEUS:
+		path = "/stats/prometheus"
+	}
+
+	// Envoy uses RE2, so validate the expression before sending it to avoid an opaque error from the host.
+	if filter := opts.GetFilter(); filter != "" {
+		if _, err := regexp.Compile(filter); err != nil {
+			return "", format, status.Errorf(codes.InvalidArgument, "invalid stats filter '%s': %s", filter, err)
+		}
+		params = append(params, "filter="+url.QueryEscape(filter))
+	}
+	if opts.GetUsedOnly() {
+		params = append(params, "usedonly")
+	}
+
+	if len(params) > 0 {
+		path += "?" + strings.Join(params, "&")
+	}
+	return path, format, nil
+}
+
+func (c *client) Get(ctx context.Context, operation *envoytriagev1.ReadOperation) (*envoytriagev1.Result, error) {
+	defer c.httpClient.CloseIdleConnections()
+
+	address, baseURL := c.resolveAddress(operation.Address)
 
 	// Make an empty result.
 	result := &envoytriagev1.Result{
-		Address: &envoytriagev1.Address{Host: operation.Address.Host, Port: port},
+		Address: address,
 		Output:  &envoytriagev1.Result_Output{},
 	}
 
@@ -89,7 +159,7 @@ func (c *client) Get(ctx context.Context, operation *envoytriagev1.ReadOperation
 	g, ctx := errgroup.WithContext(ctx)
 	if operation.Include.Clusters {
 		g.Go(func() error {
-			resp, err := makeRequest(ctx, c.httpClient, baseURL, "/clusters?format=json")
+			resp, err := makeRequest(ctx, c.httpClient, http.MethodGet, baseURL, "/clusters?format=json")
 			if err != nil {
 				return err
 			}
@@ -102,7 +172,7 @@ func (c *client) Get(ctx context.Context, operation *envoytriagev1.ReadOperation
 
 	if operation.Include.ConfigDump {
 		g.Go(func() error {
-			resp, err := makeRequest(ctx, c.httpClient, baseURL, "/config_dump")
+			resp, err := makeRequest(ctx, c.httpClient, http.MethodGet, baseURL, "/config_dump")
 			if err != nil {
 				return err
 			}
@@ -115,7 +185,7 @@ func (c *client) Get(ctx context.Context, operation *envoytriagev1.ReadOperation
 
 	if operation.Include.Listeners {
 		g.Go(func() error {
-			resp, err := makeRequest(ctx, c.httpClient, baseURL, "/listeners?format=json")
+			resp, err := makeRequest(ctx, c.httpClient, http.MethodGet, baseURL, "/listeners?format=json")
 			if err != nil {
 				return err
 			}
@@ -128,7 +198,7 @@ func (c *client) Get(ctx context.Context, operation *envoytriagev1.ReadOperation
 
 	if operation.Include.Runtime {
 		g.Go(func() error {
-			resp, err := makeRequest(ctx, c.httpClient, baseURL, "/runtime")
+			resp, err := makeRequest(ctx, c.httpClient, http.MethodGet, baseURL, "/runtime")
 			if err != nil {
 				return err
 			}
@@ -140,13 +210,27 @@ func (c *client) Get(ctx context.Context, operation *envoytriagev1.ReadOperation
 	}
 
 	if operation.Include.Stats {
+		opts := operation.Include.StatsOptions
+		path, format, err := statsPath(opts)
+		if err != nil {
+			return nil, err
+		}
+
 		g.Go(func() error {
-			resp, err := makeRequest(ctx, c.httpClient, baseURL, "/stats")
+			resp, err := makeRequest(ctx, c.httpClient, http.MethodGet, baseURL, path)
 			if err != nil {
 				return err
 			}
 
-			v, err := statsFromResponse(resp)
+			var v *envoytriagev1.Stats
+			switch format {
+			case envoytriagev1.StatsOptions_JSON:
+				v, err = statsFromJSONResponse(resp, opts.GetHistograms())
+			case envoytriagev1.StatsOptions_PROMETHEUS:
+				v, err = statsFromPrometheusResponse(resp, opts.GetHistograms())
+			default:
+				v, err = statsFromResponse(resp)
+			}
 			result.Output.Stats = v
 			return err
 		})
@@ -154,7 +238,7 @@ func (c *client) Get(ctx context.Context, operation *envoytriagev1.ReadOperation
 
 	// Always fetch server info so we can populate node metadata.
 	g.Go(func() error {
-		resp, err := makeRequest(ctx, c.httpClient, baseURL, "/server_info")
+		resp, err := makeRequest(ctx, c.httpClient, http.MethodGet, baseURL, "/server_info")
 		if err != nil {
 			return err
 		}
@@ -13,8 +13,11 @@ import (
 	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	structpb "github.com/golang/protobuf/ptypes/struct"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
 )
@@ -61,6 +64,7 @@ type RuntimeEntry struct {
 }
 
 type Runtime struct {
+	Layers  []string                 `json:"layers"`
 	Entries map[string]*RuntimeEntry `json:"entries"`
 }
 
@@ -78,13 +82,142 @@ func statsFromResponse(resp []byte) (*envoytriagev1.Stats, error) {
 		}
 	}
 
-	sort.Slice(stats, func(i, j int) bool {
-		return stats[i].Key < stats[j].Key
-	})
+	ret := &envoytriagev1.Stats{Stats: stats}
+	sortStats(ret)
+	return ret, nil
+}
 
-	return &envoytriagev1.Stats{
-		Stats: stats,
-	}, nil
+type jsonStats struct {
+	Stats []struct {
+		Name       string          `json:"name"`
+		Value      json.RawMessage `json:"value"`
+		Histograms *struct {
+			SupportedQuantiles []float64 `json:"supported_quantiles"`
+			ComputedQuantiles  []struct {
+				Name   string `json:"name"`
+				Values []struct {
+					Interval   *float64 `json:"interval"`
+					Cumulative *float64 `json:"cumulative"`
+				} `json:"values"`
+			} `json:"computed_quantiles"`
+		} `json:"histograms"`
+	} `json:"stats"`
+}
+
+func optionalDouble(v *float64) *wrapperspb.DoubleValue {
+	if v == nil {
+		return nil
+	}
+	return wrapperspb.Double(*v)
+}
+
+func statsFromJSONResponse(resp []byte, includeHistograms bool) (*envoytriagev1.Stats, error) {
+	js := &jsonStats{}
+	if err := json.Unmarshal(resp, js); err != nil {
+		return nil, err
+	}
+
+	ret := &envoytriagev1.Stats{}
+	for _, stat := range js.Stats {
+		if stat.Histograms != nil {
+			if !includeHistograms {
+				continue
+			}
+
+			supported := stat.Histograms.SupportedQuantiles
+			for _, computed := range stat.Histograms.ComputedQuantiles {
+				h := &envoytriagev1.Histogram{Name: computed.Name}
+				for idx, v := range computed.Values {
+					if idx >= len(supported) {
+						break
+					}
+					h.Quantiles = append(h.Quantiles, &envoytriagev1.Histogram_Quantile{
+						Quantile:   supported[idx],
+						Interval:   optionalDouble(v.Interval),
+						Cumulative: optionalDouble(v.Cumulative),
+					})
+				}
+				ret.Histograms = append(ret.Histograms, h)
+			}
+			continue
+		}
+
+		// Text readouts have string values and are skipped.
+		v, err := strconv.ParseUint(string(stat.Value), 10, 64)
+		if err != nil {
+			continue
+		}
+		ret.Stats = append(ret.Stats, &envoytriagev1.Stats_Stat{Key: stat.Name, Value: v})
+	}
+
+	sortStats(ret)
+	return ret, nil
+}
+
+// prometheusKey renders the metric name and its labels in the exposition format.
+func prometheusKey(name string, labels []*dto.LabelPair) string {
+	if len(labels) == 0 {
+		return name
+	}
+
+	pairs := make([]string, 0, len(labels))
+	for _, l := range labels {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", l.GetName(), l.GetValue()))
+	}
+	sort.Strings(pairs)
+	return fmt.Sprintf("%s{%s}", name, strings.Join(pairs, ","))
+}
+
+func statsFromPrometheusResponse(resp []byte, includeHistograms bool) (*envoytriagev1.Stats, error) {
+	parser := &expfmt.TextParser{}
+	families, err := parser.TextToMetricFamilies(bytes.NewReader(resp))
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &envoytriagev1.Stats{}
+	for name, family := range families {
+		for _, m := range family.Metric {
+			key := prometheusKey(name, m.Label)
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				ret.Stats = append(ret.Stats, &envoytriagev1.Stats_Stat{Key: key, Value: uint64(m.GetCounter().GetValue())})
+			case dto.MetricType_GAUGE:
+				ret.Stats = append(ret.Stats, &envoytriagev1.Stats_Stat{Key: key, Value: uint64(m.GetGauge().GetValue())})
+			case dto.MetricType_UNTYPED:
+				ret.Stats = append(ret.Stats, &envoytriagev1.Stats_Stat{Key: key, Value: uint64(m.GetUntyped().GetValue())})
+			case dto.MetricType_HISTOGRAM:
+				if !includeHistograms {
+					continue
+				}
+				ph := m.GetHistogram()
+				h := &envoytriagev1.Histogram{
+					Name:        key,
+					SampleSum:   ph.GetSampleSum(),
+					SampleCount: ph.GetSampleCount(),
+				}
+				for _, b := range ph.Bucket {
+					h.Buckets = append(h.Buckets, &envoytriagev1.Histogram_Bucket{
+						UpperBound:      b.GetUpperBound(),
+						CumulativeCount: b.GetCumulativeCount(),
+					})
+				}
+				ret.Histograms = append(ret.Histograms, h)
+			}
+		}
+	}
+
+	sortStats(ret)
+	return ret, nil
+}
+
+func sortStats(s *envoytriagev1.Stats) {
+	sort.Slice(s.Stats, func(i, j int) bool {
+		return s.Stats[i].Key < s.Stats[j].Key
+	})
+	sort.Slice(s.Histograms, func(i, j int) bool {
+		return s.Histograms[i].Name < s.Histograms[j].Name
+	})
 }
 
 func runtimeFromResponse(resp []byte) (*envoytriagev1.Runtime, error) {
@@ -34,3 +34,129 @@ func TestNodeMetadataFromResponse(t *testing.T) {
 		}
 	}
 }
+
+func TestStatsFromResponse(t *testing.T) {
+	resp := []byte("cluster.foo.upstream_rq_200: 5\ncluster.foo.upstream_rq_time: P0(nan,1) P25(nan,2)\nserver.live: 1\n")
+
+	stats, err := statsFromResponse(resp)
+	assert.NoError(t, err)
+	assert.Equal(t, []*envoytriagev1.Stats_Stat{
+		{Key: "cluster.foo.upstream_rq_200", Value: 5},
+		{Key: "server.live", Value: 1},
+	}, stats.Stats)
+	assert.Empty(t, stats.Histograms)
+}
+
+const jsonStatsResponse = `{
+  "stats": [
+    {"name": "server.live", "value": 1},
+    {"name": "cluster.foo.upstream_rq_200", "value": 5},
+    {"name": "server.version_text", "value": "1.24.0"},
+    {
+      "histograms": {
+        "supported_quantiles": [50, 99.9],
+        "computed_quantiles": [
+          {"name": "cluster.foo.upstream_rq_time", "values": [{"interval": null, "cumulative": 10}, {"interval": 4, "cumulative": 20}]}
+        ]
+      }
+    }
+  ]
+}`
+
+func TestStatsFromJSONResponse(t *testing.T) {
+	stats, err := statsFromJSONResponse([]byte(jsonStatsResponse), true)
+	assert.NoError(t, err)
+	assert.Equal(t, []*envoytriagev1.Stats_Stat{
+		{Key: "cluster.foo.upstream_rq_200", Value: 5},
+		{Key: "server.live", Value: 1},
+	}, stats.Stats)
+
+	assert.Len(t, stats.Histograms, 1)
+	h := stats.Histograms[0]
+	assert.Equal(t, "cluster.foo.upstream_rq_time", h.Name)
+	assert.Len(t, h.Quantiles, 2)
+	assert.Equal(t, 50.0, h.Quantiles[0].Quantile)
+	assert.Nil(t, h.Quantiles[0].Interval)
+	assert.Equal(t, 10.0, h.Quantiles[0].Cumulative.Value)
+	assert.Equal(t, 99.9, h.Quantiles[1].Quantile)
+	assert.Equal(t, 4.0, h.Quantiles[1].Interval.Value)
+
+	stats, err = statsFromJSONResponse([]byte(jsonStatsResponse), false)
+	assert.NoError(t, err)
+	assert.Len(t, stats.Stats, 2)
+	assert.Empty(t, stats.Histograms)
+
+	_, err = statsFromJSONResponse([]byte("not json"), false)
+	assert.Error(t, err)
+}
+
+const prometheusStatsResponse = `# TYPE envoy_cluster_upstream_rq counter
+envoy_cluster_upstream_rq{envoy_response_code="200",envoy_cluster_name="foo"} 5
+# TYPE envoy_server_live gauge
+envoy_server_live{} 1
+# TYPE envoy_cluster_upstream_rq_time histogram
+envoy_cluster_upstream_rq_time_bucket{envoy_cluster_name="foo",le="0.5"} 1
+envoy_cluster_upstream_rq_time_bucket{envoy_cluster_name="foo",le="+Inf"} 3
+envoy_cluster_upstream_rq_time_sum{envoy_cluster_name="foo"} 12.5
+envoy_cluster_upstream_rq_time_count{envoy_cluster_name="foo"} 3
+`
+
+func TestStatsFromPrometheusResponse(t *testing.T) {
+	stats, err := statsFromPrometheusResponse([]byte(prometheusStatsResponse), true)
+	assert.NoError(t, err)
+	assert.Equal(t, []*envoytriagev1.Stats_Stat{
+		{Key: `envoy_cluster_upstream_rq{envoy_cluster_name="foo",envoy_response_code="200"}`, Value: 5},
+		{Key: "envoy_server_live", Value: 1},
+	}, stats.Stats)
+
+	assert.Len(t, stats.Histograms, 1)
+	h := stats.Histograms[0]
+	assert.Equal(t, `envoy_cluster_upstream_rq_time{envoy_cluster_name="foo"}`, h.Name)
+	assert.Equal(t, 12.5, h.SampleSum)
+	assert.Equal(t, uint64(3), h.SampleCount)
+	assert.Len(t, h.Buckets, 2)
+	assert.Equal(t, 0.5, h.Buckets[0].UpperBound)
+	assert.Equal(t, uint64(1), h.Buckets[0].CumulativeCount)
+
+	stats, err = statsFromPrometheusResponse([]byte(prometheusStatsResponse), false)
+	assert.NoError(t, err)
+	assert.Empty(t, stats.Histograms)
+}
+
+func TestStatsPath(t *testing.T) {
+	testCases := []struct {
+		opts   *envoytriagev1.StatsOptions
+		path   string
+		format envoytriagev1.StatsOptions_Format
+		hasErr bool
+	}{
+		{opts: nil, path: "/stats", format: envoytriagev1.StatsOptions_TEXT},
+		{
+			opts:   &envoytriagev1.StatsOptions{Histograms: true},
+			path:   "/stats?format=json",
+			format: envoytriagev1.StatsOptions_JSON,
+		},
+		{
+			opts:   &envoytriagev1.StatsOptions{Filter: `^cluster\.foo\.upstream_rq`, UsedOnly: true},
+			path:   "/stats?filter=%5Ecluster%5C.foo%5C.upstream_rq&usedonly",
+			format: envoytriagev1.StatsOptions_TEXT,
+		},
+		{
+			opts:   &envoytriagev1.StatsOptions{Format: envoytriagev1.StatsOptions_PROMETHEUS, UsedOnly: true},
+			path:   "/stats/prometheus?usedonly",
+			format: envoytriagev1.StatsOptions_PROMETHEUS,
+		},
+		{opts: &envoytriagev1.StatsOptions{Filter: "(unclosed"}, hasErr: true},
+	}
+
+	for _, tt := range testCases {
+		path, format, err := statsPath(tt.opts)
+		if tt.hasErr {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, tt.path, path)
+		assert.Equal(t, tt.format, format)
+	}
+}
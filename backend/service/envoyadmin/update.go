@@ -0,0 +1,224 @@
+package envoyadmin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+)
+
+const (
+	// Name of the runtime layer that runtime_modify writes to.
+	adminRuntimeLayer = "admin"
+
+	revertTimeout = 30 * time.Second
+)
+
+func (c *client) Update(ctx context.Context, operation *envoytriagev1.UpdateOperation) (*envoytriagev1.UpdateResult, error) {
+	defer c.httpClient.CloseIdleConnections()
+
+	address, baseURL := c.resolveAddress(operation.Address)
+	result := &envoytriagev1.UpdateResult{Address: address}
+
+	switch action := operation.Action.(type) {
+	case *envoytriagev1.UpdateOperation_RuntimeModify_:
+		revertTime, err := c.runtimeModify(ctx, baseURL, action.RuntimeModify)
+		if err != nil {
+			return nil, err
+		}
+		result.RevertTime = timestamppb.New(revertTime)
+	case *envoytriagev1.UpdateOperation_HealthcheckFail_:
+		if _, err := makeRequest(ctx, c.httpClient, http.MethodPost, baseURL, "/healthcheck/fail"); err != nil {
+			return nil, err
+		}
+		revertTime := c.reverter.schedule(baseURL+"/healthcheck", c.revertTTL(action.HealthcheckFail.Ttl), func(ctx context.Context) error {
+			_, err := makeRequest(ctx, c.httpClient, http.MethodPost, baseURL, "/healthcheck/ok")
+			return err
+		})
+		result.RevertTime = timestamppb.New(revertTime)
+	case *envoytriagev1.UpdateOperation_HealthcheckOk_:
+		if _, err := makeRequest(ctx, c.httpClient, http.MethodPost, baseURL, "/healthcheck/ok"); err != nil {
+			return nil, err
+		}
+		c.reverter.cancel(baseURL + "/healthcheck")
+	case *envoytriagev1.UpdateOperation_DrainListeners_:
+		// Envoy expects bare flags rather than key/value pairs.
+		var flags []string
+		if action.DrainListeners.InboundOnly {
+			flags = append(flags, "inboundonly")
+		}
+		if action.DrainListeners.Graceful {
+			flags = append(flags, "graceful")
+		}
+		path := "/drain_listeners"
+		if len(flags) > 0 {
+			path += "?" + strings.Join(flags, "&")
+		}
+		if _, err := makeRequest(ctx, c.httpClient, http.MethodPost, baseURL, path); err != nil {
+			return nil, err
+		}
+	case *envoytriagev1.UpdateOperation_ResetCounters_:
+		if _, err := makeRequest(ctx, c.httpClient, http.MethodPost, baseURL, "/reset_counters"); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported update action '%T'", action)
+	}
+
+	return result, nil
+}
+
+// revertTTL returns the requested TTL, falling back to the default and capping it at the maximum.
+func (c *client) revertTTL(ttl *durationpb.Duration) time.Duration {
+	if ttl == nil || ttl.AsDuration() == 0 {
+		return c.defaultRevertTTL
+	}
+	if d := ttl.AsDuration(); d < c.maxRevertTTL {
+		return d
+	}
+	return c.maxRevertTTL
+}
+
+// adminLayerValues returns the current admin layer value of each key, or an empty string if the key is not overridden
+// in the admin layer. Setting an empty value with runtime_modify removes the key from the admin layer.
+func adminLayerValues(resp []byte, keys []string) (map[string]string, error) {
+	r := &Runtime{}
+	if err := json.Unmarshal(resp, r); err != nil {
+		return nil, err
+	}
+
+	layer := -1
+	for idx, name := range r.Layers {
+		if name == adminRuntimeLayer {
+			layer = idx
+		}
+	}
+
+	ret := make(map[string]string, len(keys))
+	for _, key := range keys {
+		ret[key] = ""
+		if entry, ok := r.Entries[key]; ok && layer >= 0 && layer < len(entry.LayerValues) {
+			ret[key] = entry.LayerValues[layer]
+		}
+	}
+	return ret, nil
+}
+
+func (c *client) runtimeModify(ctx context.Context, baseURL string, op *envoytriagev1.UpdateOperation_RuntimeModify) (time.Time, error) {
+	keys := make([]string, 0, len(op.Values))
+	q := url.Values{}
+	for k, v := range op.Values {
+		keys = append(keys, k)
+		q.Set(k, v)
+	}
+
+	// Capture the current values so they can be restored later.
+	resp, err := makeRequest(ctx, c.httpClient, http.MethodGet, baseURL, "/runtime")
+	if err != nil {
+		return time.Time{}, err
+	}
+	previous, err := adminLayerValues(resp, keys)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if _, err := makeRequest(ctx, c.httpClient, http.MethodPost, baseURL, "/runtime_modify?"+q.Encode()); err != nil {
+		return time.Time{}, err
+	}
+
+	ttl := c.revertTTL(op.Ttl)
+	var revertTime time.Time
+	for key, value := range previous {
+		revertQuery := url.Values{key: []string{value}}.Encode()
+		revertTime = c.reverter.schedule(baseURL+"/runtime/"+key, ttl, func(ctx context.Context) error {
+			_, err := makeRequest(ctx, c.httpClient, http.MethodPost, baseURL, "/runtime_modify?"+revertQuery)
+			return err
+		})
+	}
+	return revertTime, nil
+}
+
+// reverter restores state changed by write operations once their TTL expires. Pending reversions are only held in
+// memory and are lost if the process exits before they run.
+type reverter struct {
+	logger *zap.Logger
+	scope  tally.Scope
+
+	mu      sync.Mutex
+	pending map[string]*pendingRevert
+}
+
+type pendingRevert struct {
+	timer  *time.Timer
+	revert func(context.Context) error
+}
+
+func newReverter(logger *zap.Logger, scope tally.Scope) *reverter {
+	return &reverter{
+		logger:  logger,
+		scope:   scope,
+		pending: make(map[string]*pendingRevert),
+	}
+}
+
+// schedule runs revert for key after ttl and returns the time it will run. If a reversion for the key is already
+// pending, the original revert function is kept so that the state from before the first change is restored, and only
+// the deadline moves.
+func (r *reverter) schedule(key string, ttl time.Duration, revert func(context.Context) error) time.Time {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.pending[key]; ok {
+		existing.timer.Stop()
+		revert = existing.revert
+	}
+
+	p := &pendingRevert{revert: revert}
+	p.timer = time.AfterFunc(ttl, func() { r.run(key, p) })
+	r.pending[key] = p
+	return time.Now().Add(ttl)
+}
+
+// cancel drops the pending reversion for key, if any.
+func (r *reverter) cancel(key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.pending[key]; ok {
+		existing.timer.Stop()
+		delete(r.pending, key)
+	}
+}
+
+func (r *reverter) run(key string, p *pendingRevert) {
+	r.mu.Lock()
+	if r.pending[key] != p {
+		// Superseded or cancelled after the timer fired.
+		r.mu.Unlock()
+		return
+	}
+	delete(r.pending, key)
+	r.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), revertTimeout)
+	defer cancel()
+
+	if err := p.revert(ctx); err != nil {
+		r.scope.Counter("failure").Inc(1)
+		r.logger.Error("failed to revert envoy admin change", zap.String("key", key), zap.Error(err))
+		return
+	}
+	r.scope.Counter("success").Inc(1)
+	r.logger.Info("reverted envoy admin change", zap.String("key", key))
+}
@@ -0,0 +1,187 @@
+package envoyadmin
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	envoyadminv1 "github.com/lyft/clutch/backend/api/config/service/envoyadmin/v1"
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+)
+
+// stubAdmin is a minimal Envoy admin server that records write requests and keeps an admin runtime layer.
+type stubAdmin struct {
+	mu       sync.Mutex
+	requests []string
+	runtime  map[string]string
+}
+
+func (s *stubAdmin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/runtime":
+		_, _ = w.Write([]byte(`{"layers": ["static", "admin"], "entries": {"existing": {"final_value": "5", "layer_values": ["1", "5"]}}}`))
+		return
+	case "/runtime_modify":
+		for k, v := range r.URL.Query() {
+			s.runtime[k] = v[0]
+		}
+	}
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	s.requests = append(s.requests, r.URL.RequestURI())
+	_, _ = w.Write([]byte("OK\n"))
+}
+
+func (s *stubAdmin) recorded() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+func newTestClient(t *testing.T, config *envoyadminv1.Config) (*client, *stubAdmin, *envoytriagev1.Address) {
+	stub := &stubAdmin{runtime: map[string]string{}}
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	assert.NoError(t, err)
+	p, err := strconv.Atoi(port)
+	assert.NoError(t, err)
+
+	cfg, err := anypb.New(config)
+	assert.NoError(t, err)
+	svc, err := New(cfg, zaptest.NewLogger(t), tally.NewTestScope("", nil))
+	assert.NoError(t, err)
+
+	return svc.(*client), stub, &envoytriagev1.Address{Host: host, Port: uint32(p)}
+}
+
+func TestUpdateRuntimeModifyReverts(t *testing.T) {
+	c, stub, addr := newTestClient(t, &envoyadminv1.Config{})
+
+	result, err := c.Update(context.Background(), &envoytriagev1.UpdateOperation{
+		Address: addr,
+		Action: &envoytriagev1.UpdateOperation_RuntimeModify_{RuntimeModify: &envoytriagev1.UpdateOperation_RuntimeModify{
+			Values: map[string]string{"existing": "10"},
+			Ttl:    durationpb.New(20 * time.Millisecond),
+		}},
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, result.RevertTime)
+	assert.Equal(t, []string{"/runtime_modify?existing=10"}, stub.recorded())
+
+	assert.Eventually(t, func() bool {
+		return len(stub.recorded()) == 2
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, "/runtime_modify?existing=5", stub.recorded()[1])
+}
+
+func TestUpdateRuntimeModifyRemovesNewKeys(t *testing.T) {
+	c, stub, addr := newTestClient(t, &envoyadminv1.Config{})
+
+	for _, v := range []string{"a", "b"} {
+		_, err := c.Update(context.Background(), &envoytriagev1.UpdateOperation{
+			Address: addr,
+			Action: &envoytriagev1.UpdateOperation_RuntimeModify_{RuntimeModify: &envoytriagev1.UpdateOperation_RuntimeModify{
+				Values: map[string]string{"new": v},
+				Ttl:    durationpb.New(20 * time.Millisecond),
+			}},
+		})
+		assert.NoError(t, err)
+	}
+
+	// Only a single reversion runs and it removes the key from the admin layer.
+	assert.Eventually(t, func() bool {
+		return len(stub.recorded()) == 3
+	}, time.Second, 5*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, []string{"/runtime_modify?new=a", "/runtime_modify?new=b", "/runtime_modify?new="}, stub.recorded())
+}
+
+func TestUpdateHealthcheck(t *testing.T) {
+	c, stub, addr := newTestClient(t, &envoyadminv1.Config{})
+
+	fail := &envoytriagev1.UpdateOperation{
+		Address: addr,
+		Action: &envoytriagev1.UpdateOperation_HealthcheckFail_{HealthcheckFail: &envoytriagev1.UpdateOperation_HealthcheckFail{
+			Ttl: durationpb.New(20 * time.Millisecond),
+		}},
+	}
+
+	// Failure is reverted after the TTL.
+	_, err := c.Update(context.Background(), fail)
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return len(stub.recorded()) == 2
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, []string{"/healthcheck/fail", "/healthcheck/ok"}, stub.recorded())
+
+	// A manual ok cancels the pending reversion.
+	fail.GetHealthcheckFail().Ttl = durationpb.New(50 * time.Millisecond)
+	_, err = c.Update(context.Background(), fail)
+	assert.NoError(t, err)
+	result, err := c.Update(context.Background(), &envoytriagev1.UpdateOperation{
+		Address: addr,
+		Action:  &envoytriagev1.UpdateOperation_HealthcheckOk_{HealthcheckOk: &envoytriagev1.UpdateOperation_HealthcheckOk{}},
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, result.RevertTime)
+	time.Sleep(100 * time.Millisecond)
+	assert.Len(t, stub.recorded(), 4)
+}
+
+func TestUpdateDrainAndReset(t *testing.T) {
+	c, stub, addr := newTestClient(t, &envoyadminv1.Config{})
+
+	_, err := c.Update(context.Background(), &envoytriagev1.UpdateOperation{
+		Address: addr,
+		Action: &envoytriagev1.UpdateOperation_DrainListeners_{DrainListeners: &envoytriagev1.UpdateOperation_DrainListeners{
+			InboundOnly: true,
+			Graceful:    true,
+		}},
+	})
+	assert.NoError(t, err)
+
+	_, err = c.Update(context.Background(), &envoytriagev1.UpdateOperation{
+		Address: addr,
+		Action:  &envoytriagev1.UpdateOperation_ResetCounters_{ResetCounters: &envoytriagev1.UpdateOperation_ResetCounters{}},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"/drain_listeners?inboundonly&graceful", "/reset_counters"}, stub.recorded())
+}
+
+func TestRevertTTL(t *testing.T) {
+	c, _, _ := newTestClient(t, &envoyadminv1.Config{
+		DefaultRevertTtl: durationpb.New(time.Minute),
+		MaxRevertTtl:     durationpb.New(time.Hour),
+	})
+
+	assert.Equal(t, time.Minute, c.revertTTL(nil))
+	assert.Equal(t, time.Minute, c.revertTTL(durationpb.New(0)))
+	assert.Equal(t, 5*time.Minute, c.revertTTL(durationpb.New(5*time.Minute)))
+	assert.Equal(t, time.Hour, c.revertTTL(durationpb.New(48*time.Hour)))
+}
+
+func TestNewInvalidRevertTTL(t *testing.T) {
+	cfg, _ := anypb.New(&envoyadminv1.Config{DefaultRevertTtl: durationpb.New(2 * time.Hour)})
+	_, err := New(cfg, zaptest.NewLogger(t), tally.NewTestScope("", nil))
+	assert.Error(t, err)
+}
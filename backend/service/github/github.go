@@ -26,6 +26,7 @@ import (
 	githubv3 "github.com/google/go-github/v37/github"
 	"github.com/shurcooL/githubv4"
 	"github.com/uber-go/tally/v4"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
 	"golang.org/x/oauth2"
 	"google.golang.org/grpc/codes"
@@ -110,6 +111,7 @@ type Client interface {
 	ListOrganizations(ctx context.Context, user string) ([]*githubv3.Organization, error)
 	ListPullRequestsWithCommit(ctx context.Context, ref *RemoteRef, sha string, opts *githubv3.PullRequestListOptions) ([]*PullRequestInfo, error)
 	GetOrgMembership(ctx context.Context, user, org string) (*githubv3.Membership, error)
+	GetTeamMembership(ctx context.Context, org, team, user string) (*githubv3.Membership, error)
 	GetUser(ctx context.Context, username string) (*githubv3.User, error)
 }
 
@@ -192,6 +194,33 @@ func (s *svc) GetOrgMembership(ctx context.Context, user, org string) (*githubv3
 	return membership, nil
 }
 
+// GetTeamMembership returns a specified user's membership within a team, identified by its slug. If the user is not
+// a member of the team a default Membership is returned.
+func (s *svc) GetTeamMembership(ctx context.Context, org, team, user string) (*githubv3.Membership, error) {
+	membership, response, err := s.rest.Teams.GetTeamMembershipBySlug(ctx, org, team, user)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return &githubv3.Membership{}, nil
+		}
+		return nil, err
+	}
+
+	return membership, nil
+}
+
+// Check verifies that the API is reachable with the configured credentials and that the core rate limit is not
+// exhausted. Rate limit requests do not count against the limit.
+func (s *svc) Check(ctx context.Context) error {
+	limits, _, err := s.rest.RateLimits.RateLimits(ctx)
+	if err != nil {
+		return err
+	}
+	if core := limits.GetCore(); core != nil && core.Remaining == 0 {
+		return fmt.Errorf("core rate limit of %d requests exhausted until %s", core.Limit, core.Reset.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
 // GetUser returns information about the specified user.
 // To list organizations for the currently authenticated user set user to "".
 func (s *svc) GetUser(ctx context.Context, username string) (*githubv3.User, error) {
@@ -414,7 +443,7 @@ func newService(config *githubv1.Config, scope tally.Scope, logger *zap.Logger)
 	default:
 		return nil, fmt.Errorf("did not recognize auth config type '%T'", auth)
 	}
-	httpClient.Transport = &StatsRoundTripper{Wrapped: httpClient.Transport, scope: scope}
+	httpClient.Transport = &StatsRoundTripper{Wrapped: otelhttp.NewTransport(httpClient.Transport), scope: scope}
 
 	restClient := githubv3.NewClient(httpClient)
 	ret.rest = v3client{
@@ -423,6 +452,8 @@ func newService(config *githubv1.Config, scope tally.Scope, logger *zap.Logger)
 		Issues:        restClient.Issues,
 		Users:         restClient.Users,
 		Organizations: restClient.Organizations,
+		Teams:         restClient.Teams,
+		RateLimits:    restClient,
 	}
 
 	ret.graphQL = githubv4.NewClient(httpClient)
@@ -712,6 +712,38 @@ func TestGetOrgMembership(t *testing.T) {
 	}
 }
 
+type mockTeams struct {
+	generalError bool
+	notFound     bool
+}
+
+func (m *mockTeams) GetTeamMembershipBySlug(ctx context.Context, org, slug, user string) (*githubv3.Membership, *githubv3.Response, error) {
+	if m.generalError {
+		return nil, &githubv3.Response{Response: &http.Response{StatusCode: 500}}, errors.New(problem)
+	}
+	if m.notFound {
+		return nil, &githubv3.Response{Response: &http.Response{StatusCode: 404}}, errors.New("not found")
+	}
+	state := "active"
+	return &githubv3.Membership{State: &state}, nil, nil
+}
+
+func TestGetTeamMembership(t *testing.T) {
+	s := &svc{rest: v3client{Teams: &mockTeams{generalError: true}}}
+	_, err := s.GetTeamMembership(context.Background(), "org", "team", "foobar")
+	assert.Error(t, err)
+
+	s.rest.Teams = &mockTeams{notFound: true}
+	m, err := s.GetTeamMembership(context.Background(), "org", "team", "foobar")
+	assert.NoError(t, err)
+	assert.Empty(t, m.GetState())
+
+	s.rest.Teams = &mockTeams{}
+	m, err = s.GetTeamMembership(context.Background(), "org", "team", "foobar")
+	assert.NoError(t, err)
+	assert.Equal(t, "active", m.GetState())
+}
+
 type getRepositoryMock struct {
 	v4client
 	branchName string
@@ -956,3 +988,52 @@ func TestCommitAuthorFromContext(t *testing.T) {
 	assert.Equal(t, commitTime, result.Author.When)
 	assert.Equal(t, true, result.All)
 }
+
+type mockRateLimits struct {
+	limits *githubv3.RateLimits
+	err    error
+}
+
+func (m *mockRateLimits) RateLimits(ctx context.Context) (*githubv3.RateLimits, *githubv3.Response, error) {
+	return m.limits, nil, m.err
+}
+
+func TestCheck(t *testing.T) {
+	reset := githubv3.Timestamp{Time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		name   string
+		mock   *mockRateLimits
+		errMsg string
+	}{
+		{
+			name: "healthy",
+			mock: &mockRateLimits{limits: &githubv3.RateLimits{Core: &githubv3.Rate{Limit: 5000, Remaining: 10, Reset: reset}}},
+		},
+		{
+			name:   "exhausted",
+			mock:   &mockRateLimits{limits: &githubv3.RateLimits{Core: &githubv3.Rate{Limit: 5000, Remaining: 0, Reset: reset}}},
+			errMsg: "core rate limit of 5000 requests exhausted until 2021-01-01T00:00:00Z",
+		},
+		{
+			name:   "unreachable",
+			mock:   &mockRateLimits{err: errors.New("bad credentials")},
+			errMsg: "bad credentials",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := &svc{rest: v3client{RateLimits: tt.mock}}
+			err := s.Check(context.Background())
+			if tt.errMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.errMsg)
+			}
+		})
+	}
+}
@@ -16,6 +16,8 @@ type v3client struct {
 	Issues        v3issues
 	Users         v3users
 	Organizations v3organizations
+	Teams         v3teams
+	RateLimits    v3ratelimits
 }
 
 // Interface for struct defined in https://github.com/google/go-github/blob/master/github/repos.go.
@@ -61,3 +63,14 @@ type v3organizations interface {
 	List(ctx context.Context, user string, opts *githubv3.ListOptions) ([]*githubv3.Organization, *githubv3.Response, error)
 	GetOrgMembership(ctx context.Context, user, org string) (*githubv3.Membership, *githubv3.Response, error)
 }
+
+// Interface for struct defined in https://github.com/google/go-github/blob/master/github/teams.go.
+type v3teams interface {
+	// GetTeamMembershipBySlug returns the membership status for a user in a team, given a specified organization ID, by team slug.
+	GetTeamMembershipBySlug(ctx context.Context, org, slug, user string) (*githubv3.Membership, *githubv3.Response, error)
+}
+
+// Interface for the RateLimits method defined in https://github.com/google/go-github/blob/master/github/github.go.
+type v3ratelimits interface {
+	RateLimits(ctx context.Context) (*githubv3.RateLimits, *githubv3.Response, error)
+}
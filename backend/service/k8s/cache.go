@@ -8,6 +8,7 @@ import (
 
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -25,6 +26,9 @@ import (
 const topologyObjectChanBufferSize = 5000
 const topologyInformerLockId = 1
 
+// PodIPMetadataKey is the topology metadata key that holds the IP address of cached pods.
+const PodIPMetadataKey = "pod_ip"
+
 func (s *svc) CacheEnabled() bool {
 	return true
 }
@@ -217,10 +221,19 @@ func (s *svc) processInformerEvent(obj interface{}, action topologyv1.UpdateCach
 			return
 		}
 
+		// The pod IP is indexed so that the k8s resolver can locate pods by IP address.
+		var metadata map[string]*structpb.Value
+		if pod.PodIp != "" {
+			metadata = map[string]*structpb.Value{
+				PodIPMetadataKey: structpb.NewStringValue(pod.PodIp),
+			}
+		}
+
 		s.topologyObjectChan <- &topologyv1.UpdateCacheRequest{
 			Resource: &topologyv1.Resource{
-				Id: patternId,
-				Pb: protoPod,
+				Id:       patternId,
+				Pb:       protoPod,
+				Metadata: metadata,
 			},
 			Action: action,
 		}
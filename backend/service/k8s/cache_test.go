@@ -49,3 +49,25 @@ func TestProcessInformerEvent(t *testing.T) {
 
 	assert.Equal(t, expectedUpdateCacheRequest, topologyUpdateRequest)
 }
+
+func TestProcessInformerEventPodIP(t *testing.T) {
+	svc := svc{
+		topologyObjectChan: make(chan *topologyv1.UpdateCacheRequest, 1),
+		log:                zaptest.NewLogger(t),
+		scope:              tally.NewTestScope("", nil),
+	}
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			ClusterName: "cluster",
+			Name:        "test-pod-1",
+			Namespace:   "testing-namespace",
+		},
+		Status: corev1.PodStatus{PodIP: "10.0.0.1"},
+	}
+
+	svc.processInformerEvent(pod, topologyv1.UpdateCacheRequest_CREATE_OR_UPDATE)
+	topologyUpdateRequest := <-svc.topologyObjectChan
+
+	assert.Equal(t, "10.0.0.1", topologyUpdateRequest.Resource.Metadata[PodIPMetadataKey].GetStringValue())
+}
@@ -3,11 +3,14 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"net/http"
 
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
 	k8s "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -30,6 +33,17 @@ type ContextClientset interface {
 	Cluster() string
 }
 
+// DynamicClientset is implemented by clientsets that can also access arbitrary resources, such as custom resources.
+type DynamicClientset interface {
+	Dynamic() dynamic.Interface
+}
+
+// RESTConfigClientset is implemented by clientsets that expose their config, which is needed to stream commands
+// executed in containers.
+type RESTConfigClientset interface {
+	RESTConfig() *rest.Config
+}
+
 func NewContextClientset(namespace string, cluster string, clientset k8s.Interface) ContextClientset {
 	return &ctxClientsetImpl{
 		Interface: clientset,
@@ -40,12 +54,16 @@ func NewContextClientset(namespace string, cluster string, clientset k8s.Interfa
 
 type ctxClientsetImpl struct {
 	k8s.Interface
-	namespace string
-	cluster   string
+	dynamic    dynamic.Interface
+	restConfig *rest.Config
+	namespace  string
+	cluster    string
 }
 
-func (c *ctxClientsetImpl) Namespace() string { return c.namespace }
-func (c *ctxClientsetImpl) Cluster() string   { return c.cluster }
+func (c *ctxClientsetImpl) Namespace() string          { return c.namespace }
+func (c *ctxClientsetImpl) Cluster() string            { return c.cluster }
+func (c *ctxClientsetImpl) Dynamic() dynamic.Interface { return c.dynamic }
+func (c *ctxClientsetImpl) RESTConfig() *rest.Config   { return c.restConfig }
 
 func newClientsetManager(rules *clientcmd.ClientConfigLoadingRules, restClientConfig *k8sconfigv1.RestClientConfig, logger *zap.Logger) (ClientsetManager, error) {
 	kubeConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, &clientcmd.ConfigOverrides{})
@@ -69,17 +87,23 @@ func newClientsetManager(rules *clientcmd.ClientConfigLoadingRules, restClientCo
 		if err := ApplyRestClientConfig(restConfig, restClientConfig); err != nil {
 			return nil, err
 		}
+		restConfig.Wrap(tracedTransport)
 
 		clientset, err := k8s.NewForConfig(restConfig)
 		if err != nil {
 			return nil, fmt.Errorf("could not create k8s clientset from config: %w", err)
 		}
 
+		dynamicClient, err := dynamic.NewForConfig(restConfig)
+		if err != nil {
+			return nil, fmt.Errorf("could not create k8s dynamic client from config: %w", err)
+		}
+
 		ns, _, err := contextConfig.Namespace()
 		if err != nil {
 			return nil, err
 		}
-		lookup[name] = &ctxClientsetImpl{Interface: clientset, namespace: ns, cluster: ctxInfo.Cluster}
+		lookup[name] = &ctxClientsetImpl{Interface: clientset, dynamic: dynamicClient, restConfig: restConfig, namespace: ns, cluster: ctxInfo.Cluster}
 	}
 
 	// If there is no configured cluster produced fallback to InClusterConfig
@@ -93,13 +117,19 @@ func newClientsetManager(rules *clientcmd.ClientConfigLoadingRules, restClientCo
 			if err := ApplyRestClientConfig(restConfig, restClientConfig); err != nil {
 				return nil, err
 			}
+			restConfig.Wrap(tracedTransport)
 
 			clientset, err := k8s.NewForConfig(restConfig)
 			if err != nil {
 				return nil, fmt.Errorf("could not create k8s InClusterConfig: %w", err)
 			}
 
-			lookup[inCluster] = &ctxClientsetImpl{Interface: clientset, namespace: "default", cluster: inCluster}
+			dynamicClient, err := dynamic.NewForConfig(restConfig)
+			if err != nil {
+				return nil, fmt.Errorf("could not create k8s dynamic client from InClusterConfig: %w", err)
+			}
+
+			lookup[inCluster] = &ctxClientsetImpl{Interface: clientset, dynamic: dynamicClient, restConfig: restConfig, namespace: "default", cluster: inCluster}
 		case rest.ErrNotInCluster:
 			// Warn but allow to continue.
 			logger.Warn("unable to load configuration for kube clientset")
@@ -111,6 +141,11 @@ func newClientsetManager(rules *clientcmd.ClientConfigLoadingRules, restClientCo
 	return &managerImpl{clientsets: lookup}, nil
 }
 
+// tracedTransport propagates the trace context of requests to the API server.
+func tracedTransport(rt http.RoundTripper) http.RoundTripper {
+	return otelhttp.NewTransport(rt)
+}
+
 func ApplyRestClientConfig(restConfig *rest.Config, restClientConfig *k8sconfigv1.RestClientConfig) error {
 	if restClientConfig == nil {
 		return nil
@@ -5,8 +5,10 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 
 	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
 )
@@ -69,6 +71,120 @@ func (s *svc) ListConfigMaps(ctx context.Context, clientset, cluster, namespace
 	return configMaps, nil
 }
 
+func (s *svc) CreateConfigMap(ctx context.Context, clientset, cluster, namespace, name string, labels, annotations, data map[string]string) (*k8sapiv1.ConfigMap, error) {
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	configMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   cs.Namespace(),
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Data: data,
+	}
+	configMap, err = cs.CoreV1().ConfigMaps(cs.Namespace()).Create(ctx, configMap, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return protoForConfigMap(cs.Cluster(), configMap), nil
+}
+
+// UpdateConfigMap sets and removes keys of the config map, leaving the other keys unchanged. If requested, the
+// deployments using the config map are restarted afterwards and their names returned. If authorizeRestart is not nil,
+// it's called with the names of the deployments before the config map is updated, and an error leaves everything
+// unchanged. If restarting fails, the updated config map and the deployments that were restarted are returned with the
+// error.
+func (s *svc) UpdateConfigMap(ctx context.Context, clientset, cluster, namespace, name string, data map[string]string, removeKeys []string, restartDependentDeployments bool, authorizeRestart func(deployments []string) error) (*k8sapiv1.ConfigMap, []string, error) {
+	for _, key := range removeKeys {
+		if _, ok := data[key]; ok {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "key '%s' can't be both updated and removed", key)
+		}
+	}
+
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var dependents []appsv1.Deployment
+	if restartDependentDeployments {
+		dependents, err = dependentDeployments(ctx, cs, func(spec *v1.PodSpec) bool { return podSpecUsesConfigMap(spec, name) })
+		if err != nil {
+			return nil, nil, err
+		}
+		if authorizeRestart != nil {
+			if err := authorizeRestart(deploymentNames(dependents)); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	var configMap *v1.ConfigMap
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := cs.CoreV1().ConfigMaps(cs.Namespace()).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if current.Data == nil && len(data) > 0 {
+			current.Data = make(map[string]string, len(data))
+		}
+		for k, v := range data {
+			current.Data[k] = v
+		}
+		for _, k := range removeKeys {
+			delete(current.Data, k)
+			delete(current.BinaryData, k)
+		}
+
+		configMap, err = cs.CoreV1().ConfigMaps(cs.Namespace()).Update(ctx, current, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var restarted []string
+	if restartDependentDeployments {
+		restarted, err = restartDeployments(ctx, cs, dependents)
+	}
+	return protoForConfigMap(cs.Cluster(), configMap), restarted, err
+}
+
+// podSpecUsesConfigMap returns whether the pods mount the config map or read it into their environment.
+func podSpecUsesConfigMap(spec *v1.PodSpec, name string) bool {
+	for _, volume := range spec.Volumes {
+		if volume.ConfigMap != nil && volume.ConfigMap.Name == name {
+			return true
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.ConfigMap != nil && source.ConfigMap.Name == name {
+					return true
+				}
+			}
+		}
+	}
+
+	return anyContainer(spec, func(container *v1.Container) bool {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.ConfigMapRef != nil && envFrom.ConfigMapRef.Name == name {
+				return true
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.ConfigMapKeyRef != nil && env.ValueFrom.ConfigMapKeyRef.Name == name {
+				return true
+			}
+		}
+		return false
+	})
+}
+
 func protoForConfigMap(cluster string, k8sconfigMap *v1.ConfigMap) *k8sapiv1.ConfigMap {
 	clusterName := k8sconfigMap.ClusterName
 	if clusterName == "" {
@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -155,6 +158,127 @@ func TestDeleteConfigMap(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestCreateConfigMap(t *testing.T) {
+	t.Parallel()
+
+	cs := testConfigMapClientset()
+	s := &svc{
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{"testing-clientset": {
+				Interface: cs,
+				namespace: "testing-namespace",
+				cluster:   "testing-cluster",
+			}},
+		},
+	}
+
+	configMap, err := s.CreateConfigMap(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace", "new-configmap",
+		map[string]string{"foo": "bar"}, nil, map[string]string{"key": "value"})
+	assert.NoError(t, err)
+	assert.Equal(t, "testing-cluster", configMap.Cluster)
+	assert.Equal(t, "new-configmap", configMap.Name)
+	assert.Equal(t, map[string]string{"key": "value"}, configMap.Data)
+
+	// Already exists
+	_, err = s.CreateConfigMap(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace", "testing-configmap-name", nil, nil, nil)
+	assert.Error(t, err)
+}
+
+func testDependentDeployment(name string, spec v1.PodSpec) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "testing-namespace"},
+		Spec: appsv1.DeploymentSpec{
+			Template: v1.PodTemplateSpec{Spec: spec},
+		},
+	}
+}
+
+func TestUpdateConfigMap(t *testing.T) {
+	t.Parallel()
+
+	cs := fake.NewSimpleClientset(
+		&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "testing-configmap-name", Namespace: "testing-namespace"},
+			Data:       map[string]string{"a": "1", "b": "2"},
+			BinaryData: map[string][]byte{"c": []byte("3")},
+		},
+		testDependentDeployment("mounts", v1.PodSpec{
+			Volumes: []v1.Volume{{
+				Name: "config",
+				VolumeSource: v1.VolumeSource{
+					ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "testing-configmap-name"}},
+				},
+			}},
+		}),
+		testDependentDeployment("env", v1.PodSpec{
+			InitContainers: []v1.Container{{
+				Name: "init",
+				Env: []v1.EnvVar{{
+					Name: "A",
+					ValueFrom: &v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+						LocalObjectReference: v1.LocalObjectReference{Name: "testing-configmap-name"},
+						Key:                  "a",
+					}},
+				}},
+			}},
+		}),
+		testDependentDeployment("other", v1.PodSpec{
+			Containers: []v1.Container{{
+				Name:    "app",
+				EnvFrom: []v1.EnvFromSource{{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "other"}}}},
+			}},
+		}),
+	)
+	s := &svc{
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{"testing-clientset": {
+				Interface: cs,
+				namespace: "testing-namespace",
+				cluster:   "testing-cluster",
+			}},
+		},
+	}
+
+	configMap, restarted, err := s.UpdateConfigMap(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace", "testing-configmap-name",
+		map[string]string{"a": "10", "d": "4"}, []string{"b", "c"}, false, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"a": "10", "d": "4"}, configMap.Data)
+	assert.Empty(t, configMap.BinaryData)
+	assert.Empty(t, restarted)
+
+	_, restarted, err = s.UpdateConfigMap(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace", "testing-configmap-name",
+		map[string]string{"a": "11"}, nil, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"env", "mounts"}, restarted)
+
+	for _, name := range []string{"env", "mounts", "other"} {
+		deployment, err := cs.AppsV1().Deployments("testing-namespace").Get(context.Background(), name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		_, ok := deployment.Spec.Template.Annotations["kubectl.kubernetes.io/restartedAt"]
+		assert.Equal(t, name != "other", ok, name)
+	}
+
+	// The restart is authorized before anything is updated.
+	var authorized []string
+	_, _, err = s.UpdateConfigMap(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace", "testing-configmap-name",
+		map[string]string{"a": "12"}, nil, true, func(deployments []string) error {
+			authorized = deployments
+			return status.Error(codes.PermissionDenied, "denied")
+		})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, []string{"env", "mounts"}, authorized)
+	stored, err := cs.CoreV1().ConfigMaps("testing-namespace").Get(context.Background(), "testing-configmap-name", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "11", stored.Data["a"])
+
+	_, _, err = s.UpdateConfigMap(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace", "testing-configmap-name",
+		map[string]string{"a": "12"}, []string{"a"}, false, nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, _, err = s.UpdateConfigMap(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace", "missing", nil, []string{"a"}, false, nil)
+	assert.Error(t, err)
+}
+
 func TestProtoForConfigMap(t *testing.T) {
 	t.Parallel()
 
@@ -2,33 +2,41 @@ package k8s
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	batchv1 "k8s.io/api/batch/v1"
 	v1beta1 "k8s.io/api/batch/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
 
 	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
 )
 
+// Jobs are labeled with their name, so the name of triggered jobs can't be longer than a label value.
+const maxJobNameLength = 63
+
 func (s *svc) DescribeCronJob(ctx context.Context, clientset, cluster, namespace, name string) (*k8sapiv1.CronJob, error) {
 	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
 	if err != nil {
 		return nil, err
 	}
 
-	cronJobs, err := cs.BatchV1beta1().CronJobs(cs.Namespace()).List(ctx, metav1.ListOptions{
+	cronJobs, err := s.listCronJobs(ctx, clientset, cs, metav1.ListOptions{
 		FieldSelector: "metadata.name=" + name,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	if len(cronJobs.Items) == 1 {
-		return ProtoForCronJob(cs.Cluster(), &cronJobs.Items[0]), nil
-	} else if len(cronJobs.Items) > 1 {
+	if len(cronJobs) == 1 {
+		return ProtoForCronJob(cs.Cluster(), &cronJobs[0]), nil
+	} else if len(cronJobs) > 1 {
 		return nil, status.Error(codes.FailedPrecondition, "located multiple cron jobs")
 	}
 	return nil, status.Error(codes.NotFound, "unable to locate specified cron job")
@@ -45,13 +53,13 @@ func (s *svc) ListCronJobs(ctx context.Context, clientset, cluster, namespace st
 		return nil, err
 	}
 
-	cronJobList, err := cs.BatchV1beta1().CronJobs(cs.Namespace()).List(ctx, opts)
+	cronJobList, err := s.listCronJobs(ctx, clientset, cs, opts)
 	if err != nil {
 		return nil, err
 	}
 
 	var cronJobs []*k8sapiv1.CronJob
-	for _, d := range cronJobList.Items {
+	for _, d := range cronJobList {
 		cronJob := d
 		cronJobs = append(cronJobs, ProtoForCronJob(cs.Cluster(), &cronJob))
 	}
@@ -66,10 +74,138 @@ func (s *svc) DeleteCronJob(ctx context.Context, clientset, cluster, namespace,
 	}
 
 	opts := metav1.DeleteOptions{}
-	return cs.BatchV1beta1().CronJobs(cs.Namespace()).Delete(ctx, name, opts)
+	if s.cronJobsV1Beta1(clientset, cs) {
+		return cs.BatchV1beta1().CronJobs(cs.Namespace()).Delete(ctx, name, opts)
+	}
+	return cs.BatchV1().CronJobs(cs.Namespace()).Delete(ctx, name, opts)
+}
+
+// SetCronJobSuspended suspends or resumes the scheduling of the cron job. Jobs that are already running aren't affected.
+func (s *svc) SetCronJobSuspended(ctx context.Context, clientset, cluster, namespace, name string, suspended bool) (*k8sapiv1.CronJob, error) {
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	patch := []byte(fmt.Sprintf(`{"spec":{"suspend":%t}}`, suspended))
+	var cronJob *batchv1.CronJob
+	if s.cronJobsV1Beta1(clientset, cs) {
+		var betaCronJob *v1beta1.CronJob
+		betaCronJob, err = cs.BatchV1beta1().CronJobs(cs.Namespace()).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if err == nil {
+			cronJob = cronJobFromV1Beta1(betaCronJob)
+		}
+	} else {
+		cronJob, err = cs.BatchV1().CronJobs(cs.Namespace()).Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ProtoForCronJob(cs.Cluster(), cronJob), nil
+}
+
+// TriggerCronJob creates a job from the template of the cron job, the same way as `kubectl create job --from=cronjob`.
+func (s *svc) TriggerCronJob(ctx context.Context, clientset, cluster, namespace, name string) (*k8sapiv1.Job, error) {
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var cronJob *batchv1.CronJob
+	gvk := batchv1.SchemeGroupVersion.WithKind("CronJob")
+	if s.cronJobsV1Beta1(clientset, cs) {
+		var betaCronJob *v1beta1.CronJob
+		betaCronJob, err = cs.BatchV1beta1().CronJobs(cs.Namespace()).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			cronJob = cronJobFromV1Beta1(betaCronJob)
+		}
+		gvk = v1beta1.SchemeGroupVersion.WithKind("CronJob")
+	} else {
+		cronJob, err = cs.BatchV1().CronJobs(cs.Namespace()).Get(ctx, name, metav1.GetOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := cs.BatchV1().Jobs(cs.Namespace()).Create(ctx, jobFromCronJob(cronJob, gvk), metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return protoForJob(cs.Cluster(), job), nil
+}
+
+func jobFromCronJob(cronJob *batchv1.CronJob, gvk schema.GroupVersionKind) *batchv1.Job {
+	annotations := map[string]string{"cronjob.kubernetes.io/instantiate": "manual"}
+	for k, v := range cronJob.Spec.JobTemplate.Annotations {
+		annotations[k] = v
+	}
+
+	prefix := cronJob.Name
+	if suffixLength := len("-manual-") + 5; len(prefix)+suffixLength > maxJobNameLength {
+		prefix = prefix[:maxJobNameLength-suffixLength]
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("%s-manual-%s", prefix, utilrand.String(5)),
+			Namespace:       cronJob.Namespace,
+			Labels:          cronJob.Spec.JobTemplate.Labels,
+			Annotations:     annotations,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cronJob, gvk)},
+		},
+		Spec: cronJob.Spec.JobTemplate.Spec,
+	}
+}
+
+// cronJobsV1Beta1 returns whether the cluster only serves cron jobs from batch/v1beta1, which is the case before
+// Kubernetes 1.21. If the served versions can't be discovered, batch/v1 is assumed.
+func (s *svc) cronJobsV1Beta1(clientset string, cs ContextClientset) bool {
+	mapping, err := s.restMapper(clientset, cs).RESTMapping(schema.GroupKind{Group: batchv1.GroupName, Kind: "CronJob"})
+	return err == nil && mapping.GroupVersionKind.Version == v1beta1.SchemeGroupVersion.Version
+}
+
+func (s *svc) listCronJobs(ctx context.Context, clientset string, cs ContextClientset, opts metav1.ListOptions) ([]batchv1.CronJob, error) {
+	if !s.cronJobsV1Beta1(clientset, cs) {
+		cronJobList, err := cs.BatchV1().CronJobs(cs.Namespace()).List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return cronJobList.Items, nil
+	}
+
+	cronJobList, err := cs.BatchV1beta1().CronJobs(cs.Namespace()).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	cronJobs := make([]batchv1.CronJob, 0, len(cronJobList.Items))
+	for i := range cronJobList.Items {
+		cronJobs = append(cronJobs, *cronJobFromV1Beta1(&cronJobList.Items[i]))
+	}
+	return cronJobs, nil
+}
+
+// cronJobFromV1Beta1 converts the cron job to batch/v1, whose fields are the same.
+func cronJobFromV1Beta1(cronJob *v1beta1.CronJob) *batchv1.CronJob {
+	return &batchv1.CronJob{
+		ObjectMeta: cronJob.ObjectMeta,
+		Spec: batchv1.CronJobSpec{
+			Schedule:                   cronJob.Spec.Schedule,
+			StartingDeadlineSeconds:    cronJob.Spec.StartingDeadlineSeconds,
+			ConcurrencyPolicy:          batchv1.ConcurrencyPolicy(cronJob.Spec.ConcurrencyPolicy),
+			Suspend:                    cronJob.Spec.Suspend,
+			JobTemplate:                batchv1.JobTemplateSpec(cronJob.Spec.JobTemplate),
+			SuccessfulJobsHistoryLimit: cronJob.Spec.SuccessfulJobsHistoryLimit,
+			FailedJobsHistoryLimit:     cronJob.Spec.FailedJobsHistoryLimit,
+		},
+		Status: batchv1.CronJobStatus{
+			Active:             cronJob.Status.Active,
+			LastScheduleTime:   cronJob.Status.LastScheduleTime,
+			LastSuccessfulTime: cronJob.Status.LastSuccessfulTime,
+		},
+	}
 }
 
-func ProtoForCronJob(cluster string, k8scronJob *v1beta1.CronJob) *k8sapiv1.CronJob {
+func ProtoForCronJob(cluster string, k8scronJob *batchv1.CronJob) *k8sapiv1.CronJob {
 	clusterName := k8scronJob.ClusterName
 	if clusterName == "" {
 		clusterName = cluster
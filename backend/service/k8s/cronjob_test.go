@@ -6,25 +6,54 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
 	v1beta1 "k8s.io/api/batch/v1beta1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakediscovery "k8s.io/client-go/discovery/fake"
 	"k8s.io/client-go/kubernetes/fake"
 
 	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
 )
 
 func testCronService() *svc {
-	cron := &v1beta1.CronJob{
+	cron := &batchv1.CronJob{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        "testing-cron-name",
 			Namespace:   "testing-namespace",
 			Labels:      map[string]string{"test": "foo"},
 			Annotations: map[string]string{"test": "bar"},
+			UID:         "1234",
+		},
+		Spec: batchv1.CronJobSpec{
+			Schedule: "5 4 * * *",
+			JobTemplate: batchv1.JobTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      map[string]string{"app": "cron"},
+					Annotations: map[string]string{"owner": "team"},
+				},
+				Spec: batchv1.JobSpec{
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{Containers: []v1.Container{{Name: "main", Image: "cron:1"}}},
+					},
+				},
+			},
 		},
 	}
 
+	return testCronServiceWithVersion(cron, batchv1.SchemeGroupVersion.String())
+}
+
+// testCronServiceWithVersion returns a service for a cluster that serves cron jobs from the group version.
+func testCronServiceWithVersion(cron runtime.Object, groupVersion string) *svc {
 	cs := fake.NewSimpleClientset(cron)
+	cs.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: groupVersion,
+			APIResources: []metav1.APIResource{{Name: "cronjobs", Kind: "CronJob", Namespaced: true}},
+		},
+	}
 	return &svc{
 		manager: &managerImpl{
 			clientsets: map[string]*ctxClientsetImpl{"foo": {
@@ -70,6 +99,105 @@ func TestDeleteCron(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestCronV1Beta1Fallback(t *testing.T) {
+	cron := &v1beta1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testing-cron-name",
+			Namespace: "testing-namespace",
+			Labels:    map[string]string{"test": "foo"},
+			UID:       "1234",
+		},
+		Spec: v1beta1.CronJobSpec{
+			Schedule:          "5 4 * * *",
+			ConcurrencyPolicy: v1beta1.ForbidConcurrent,
+			JobTemplate: v1beta1.JobTemplateSpec{
+				Spec: batchv1.JobSpec{
+					Template: v1.PodTemplateSpec{
+						Spec: v1.PodSpec{Containers: []v1.Container{{Name: "main", Image: "cron:1"}}},
+					},
+				},
+			},
+		},
+	}
+	s := testCronServiceWithVersion(cron, v1beta1.SchemeGroupVersion.String())
+
+	described, err := s.DescribeCronJob(context.Background(), "foo", "core-testing", "testing-namespace", "testing-cron-name")
+	assert.NoError(t, err)
+	assert.Equal(t, "5 4 * * *", described.Schedule)
+	assert.Equal(t, k8sapiv1.CronJob_FORBID, described.ConcurrencyPolicy)
+
+	list, err := s.ListCronJobs(context.Background(), "foo", "core-testing", "testing-namespace", &k8sapiv1.ListOptions{Labels: map[string]string{"test": "foo"}})
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	suspended, err := s.SetCronJobSuspended(context.Background(), "foo", "core-testing", "testing-namespace", "testing-cron-name", true)
+	assert.NoError(t, err)
+	assert.True(t, suspended.Suspend)
+
+	job, err := s.TriggerCronJob(context.Background(), "foo", "core-testing", "testing-namespace", "testing-cron-name")
+	assert.NoError(t, err)
+	assert.Equal(t, "testing-cron-name", job.CronJobName)
+
+	cs := s.manager.(*managerImpl).clientsets["foo"].Interface
+	k8sJob, err := cs.BatchV1().Jobs("testing-namespace").Get(context.Background(), job.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "batch/v1beta1", k8sJob.OwnerReferences[0].APIVersion)
+
+	err = s.DeleteCronJob(context.Background(), "foo", "core-testing", "testing-namespace", "testing-cron-name")
+	assert.NoError(t, err)
+	_, err = cs.BatchV1beta1().CronJobs("testing-namespace").Get(context.Background(), "testing-cron-name", metav1.GetOptions{})
+	assert.Error(t, err)
+}
+
+func TestSetCronJobSuspended(t *testing.T) {
+	s := testCronService()
+
+	cron, err := s.SetCronJobSuspended(context.Background(), "foo", "core-testing", "testing-namespace", "testing-cron-name", true)
+	assert.NoError(t, err)
+	assert.True(t, cron.Suspend)
+
+	cron, err = s.SetCronJobSuspended(context.Background(), "foo", "core-testing", "testing-namespace", "testing-cron-name", false)
+	assert.NoError(t, err)
+	assert.False(t, cron.Suspend)
+	// Other fields are left unchanged.
+	assert.Equal(t, "5 4 * * *", cron.Schedule)
+
+	// Not found.
+	_, err = s.SetCronJobSuspended(context.Background(), "foo", "core-testing", "testing-namespace", "abc", true)
+	assert.Error(t, err)
+}
+
+func TestTriggerCronJob(t *testing.T) {
+	s := testCronService()
+
+	job, err := s.TriggerCronJob(context.Background(), "foo", "core-testing", "testing-namespace", "testing-cron-name")
+	assert.NoError(t, err)
+	assert.Regexp(t, "^testing-cron-name-manual-[a-z0-9]{5}$", job.Name)
+	assert.Equal(t, "testing-cron-name", job.CronJobName)
+	assert.Equal(t, map[string]string{"app": "cron"}, job.Labels)
+	assert.Equal(t, map[string]string{"owner": "team", "cronjob.kubernetes.io/instantiate": "manual"}, job.Annotations)
+
+	cs := s.manager.(*managerImpl).clientsets["foo"].Interface
+	k8sJob, err := cs.BatchV1().Jobs("testing-namespace").Get(context.Background(), job.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "main", k8sJob.Spec.Template.Spec.Containers[0].Name)
+	owner := metav1.GetControllerOf(k8sJob)
+	assert.Equal(t, "batch/v1", owner.APIVersion)
+	assert.Equal(t, "CronJob", owner.Kind)
+	assert.Equal(t, "1234", string(owner.UID))
+
+	// Not found.
+	_, err = s.TriggerCronJob(context.Background(), "foo", "core-testing", "testing-namespace", "abc")
+	assert.Error(t, err)
+}
+
+func TestJobFromCronJobNameLength(t *testing.T) {
+	cron := &batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: strings.Repeat("a", 52)}}
+	job := jobFromCronJob(cron, batchv1.SchemeGroupVersion.WithKind("CronJob"))
+	assert.Len(t, job.Name, maxJobNameLength)
+	assert.True(t, strings.HasPrefix(job.Name, strings.Repeat("a", 50)+"-manual-"))
+}
+
 func TestProtoForCron(t *testing.T) {
 	t.Parallel()
 
@@ -78,14 +206,14 @@ func TestProtoForCron(t *testing.T) {
 		inputClusterName    string
 		expectedClusterName string
 		expectedName        string
-		cron                *v1beta1.CronJob
+		cron                *batchv1.CronJob
 	}{
 		{
 			id:                  "clustername already set",
 			inputClusterName:    "abc",
 			expectedClusterName: "production",
 			expectedName:        "test1",
-			cron: &v1beta1.CronJob{
+			cron: &batchv1.CronJob{
 				ObjectMeta: metav1.ObjectMeta{
 					ClusterName: "production",
 					Name:        "test1",
@@ -97,18 +225,18 @@ func TestProtoForCron(t *testing.T) {
 			inputClusterName:    "staging",
 			expectedClusterName: "staging",
 			expectedName:        "test2",
-			cron: &v1beta1.CronJob{
+			cron: &batchv1.CronJob{
 				ObjectMeta: metav1.ObjectMeta{
 					ClusterName: "",
 					Name:        "test2",
 				},
-				Spec: v1beta1.CronJobSpec{
-					ConcurrencyPolicy:       v1beta1.AllowConcurrent,
+				Spec: batchv1.CronJobSpec{
+					ConcurrencyPolicy:       batchv1.AllowConcurrent,
 					Schedule:                "5 4 * * *",
 					Suspend:                 &[]bool{true}[0],
 					StartingDeadlineSeconds: &[]int64{69}[0],
 				},
-				Status: v1beta1.CronJobStatus{
+				Status: batchv1.CronJobStatus{
 					Active: []v1.ObjectReference{{}, {}},
 				},
 			},
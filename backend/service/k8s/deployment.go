@@ -2,7 +2,9 @@ package k8s
 
 import (
 	"context"
+	"sort"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -154,6 +156,10 @@ func (s *svc) UpdateDeployment(ctx context.Context, clientset, cluster, namespac
 	return retryErr
 }
 
+func (s *svc) PatchDeployment(ctx context.Context, clientset, cluster, namespace, name string, patch *k8sapiv1.ResourcePatch) (string, error) {
+	return s.patchTypedObject(ctx, clientset, cluster, namespace, appsv1.SchemeGroupVersion.WithKind("Deployment"), "deployments", name, patch)
+}
+
 func (s *svc) DeleteDeployment(ctx context.Context, clientset, cluster, namespace, name string) error {
 	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
 	if err != nil {
@@ -164,6 +170,72 @@ func (s *svc) DeleteDeployment(ctx context.Context, clientset, cluster, namespac
 	return cs.AppsV1().Deployments(cs.Namespace()).Delete(ctx, name, opts)
 }
 
+// dependentDeployments returns the deployments in the namespace whose pods match, sorted by name.
+func dependentDeployments(ctx context.Context, cs ContextClientset, match func(*v1.PodSpec) bool) ([]appsv1.Deployment, error) {
+	deployments, err := cs.AppsV1().Deployments(cs.Namespace()).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var dependents []appsv1.Deployment
+	for _, deployment := range deployments.Items {
+		if match(&deployment.Spec.Template.Spec) {
+			dependents = append(dependents, deployment)
+		}
+	}
+	sort.Slice(dependents, func(i, j int) bool { return dependents[i].Name < dependents[j].Name })
+	return dependents, nil
+}
+
+func deploymentNames(deployments []appsv1.Deployment) []string {
+	names := make([]string, 0, len(deployments))
+	for _, deployment := range deployments {
+		names = append(names, deployment.Name)
+	}
+	return names
+}
+
+// restartDeployments restarts the rollout of the deployments, the same way as `kubectl rollout restart`, and returns
+// their names.
+func restartDeployments(ctx context.Context, cs ContextClientset, deployments []appsv1.Deployment) ([]string, error) {
+	restartedAt := time.Now().Format(time.RFC3339)
+	var restarted []string
+	for i := range deployments {
+		oldDeployment := &deployments[i]
+		newDeployment := oldDeployment.DeepCopy()
+		if newDeployment.Spec.Template.Annotations == nil {
+			newDeployment.Spec.Template.Annotations = map[string]string{}
+		}
+		newDeployment.Spec.Template.Annotations["kubectl.kubernetes.io/restartedAt"] = restartedAt
+
+		patchBytes, err := GenerateStrategicPatch(oldDeployment, newDeployment, appsv1.Deployment{})
+		if err != nil {
+			return restarted, err
+		}
+		_, err = cs.AppsV1().Deployments(cs.Namespace()).Patch(ctx, oldDeployment.Name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{})
+		if err != nil {
+			return restarted, err
+		}
+		restarted = append(restarted, oldDeployment.Name)
+	}
+	return restarted, nil
+}
+
+// anyContainer returns whether match is true for any of the containers or init containers of the pods.
+func anyContainer(spec *v1.PodSpec, match func(*v1.Container) bool) bool {
+	for i := range spec.InitContainers {
+		if match(&spec.InitContainers[i]) {
+			return true
+		}
+	}
+	for i := range spec.Containers {
+		if match(&spec.Containers[i]) {
+			return true
+		}
+	}
+	return false
+}
+
 func mergeDeploymentLabelsAndAnnotations(deployment *appsv1.Deployment, fields *k8sapiv1.UpdateDeploymentRequest_Fields) {
 	if len(fields.Labels) > 0 {
 		deployment.Labels = labels.Merge(labels.Set(deployment.Labels), labels.Set(fields.Labels))
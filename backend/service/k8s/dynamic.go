@@ -0,0 +1,331 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/util/retry"
+
+	k8sconfigv1 "github.com/lyft/clutch/backend/api/config/service/k8s/v1"
+	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+func matchDynamicResourceField(pattern, value string) bool {
+	return pattern == "*" || pattern == value
+}
+
+// Secrets are only available through the secret APIs, which never return their values.
+var secretsGroupResource = schema.GroupResource{Resource: "secrets"}
+
+func matchesSecrets(r *k8sconfigv1.DynamicResource) bool {
+	return matchDynamicResourceField(r.Group, secretsGroupResource.Group) &&
+		matchDynamicResourceField(r.Resource, secretsGroupResource.Resource)
+}
+
+// validateDynamicResources rejects configured resources that would expose secrets.
+func validateDynamicResources(resources []*k8sconfigv1.DynamicResource) error {
+	for _, r := range resources {
+		if matchesSecrets(r) {
+			return fmt.Errorf("dynamic resource '%s/%s/%s' would expose secrets, which can't be accessed with the dynamic resource APIs", r.Group, r.Version, r.Resource)
+		}
+	}
+	return nil
+}
+
+// isDynamicResourceExposed checks the resource against the configured dynamic resources. Secrets are never exposed.
+func (s *svc) isDynamicResourceExposed(gvr schema.GroupVersionResource) bool {
+	if gvr.GroupResource() == secretsGroupResource {
+		return false
+	}
+	for _, r := range s.dynamicResources {
+		if matchDynamicResourceField(r.Group, gvr.Group) &&
+			matchDynamicResourceField(r.Version, gvr.Version) &&
+			matchDynamicResourceField(r.Resource, gvr.Resource) {
+			return true
+		}
+	}
+	return false
+}
+
+// restMapper returns the mapper of kinds to resources for the clientset. Discovery results are cached, and refreshed
+// when a kind isn't found.
+func (s *svc) restMapper(clientset string, cs ContextClientset) meta.RESTMapper {
+	key := clientset + "/" + cs.Cluster()
+	if m, ok := s.restMappers.Load(key); ok {
+		return m.(meta.RESTMapper)
+	}
+	m, _ := s.restMappers.LoadOrStore(key, restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(cs.Discovery())))
+	return m.(meta.RESTMapper)
+}
+
+// dynamicResource returns a client for the kind, if it's exposed. Namespaced resources are scoped to the namespace of
+// the clientset, unless requireNamespace is false and no namespace was specified.
+func (s *svc) dynamicResource(ctx context.Context, clientset, cluster, namespace string, gvk schema.GroupVersionKind, requireNamespace bool) (dynamic.ResourceInterface, ContextClientset, error) {
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dcs, ok := cs.(DynamicClientset)
+	if !ok || dcs.Dynamic() == nil {
+		return nil, nil, status.Errorf(codes.Unimplemented, "clientset '%s' does not support dynamic resources", clientset)
+	}
+
+	mapping, err := s.restMapper(clientset, cs).RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil, status.Errorf(codes.NotFound, "kind '%s' was not found in cluster '%s'", gvk, cs.Cluster())
+		}
+		return nil, nil, err
+	}
+
+	if !s.isDynamicResourceExposed(mapping.Resource) {
+		return nil, nil, status.Errorf(codes.PermissionDenied, "resource '%s' is not exposed", mapping.Resource)
+	}
+
+	ri := dcs.Dynamic().Resource(mapping.Resource)
+	if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+		return ri, cs, nil
+	}
+	if requireNamespace && namespace == "" {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "a namespace is required for kind '%s'", gvk.Kind)
+	}
+	return ri.Namespace(cs.Namespace()), cs, nil
+}
+
+func ProtoForResource(cluster string, obj *unstructured.Unstructured) (*k8sapiv1.Resource, error) {
+	object, err := structpb.NewStruct(obj.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterName := obj.GetClusterName()
+	if clusterName == "" {
+		clusterName = cluster
+	}
+	gvk := obj.GroupVersionKind()
+	return &k8sapiv1.Resource{
+		Cluster:   clusterName,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Object:    object,
+	}, nil
+}
+
+func (s *svc) ListAPIResources(ctx context.Context, clientset, cluster string) ([]*k8sapiv1.APIResource, error) {
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, metav1.NamespaceAll)
+	if err != nil {
+		return nil, err
+	}
+
+	lists, err := discovery.ServerPreferredResources(cs.Discovery())
+	if err != nil {
+		// The resources of the groups that were discovered are still returned.
+		if !discovery.IsGroupDiscoveryFailedError(err) {
+			return nil, err
+		}
+		s.log.Warn("unable to discover some API groups", zap.String("cluster", cs.Cluster()), zap.Error(err))
+	}
+
+	var resources []*k8sapiv1.APIResource
+	for _, list := range lists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, r := range list.APIResources {
+			// Subresources, e.g. deployments/scale, aren't accessed directly.
+			if strings.Contains(r.Name, "/") || !s.isDynamicResourceExposed(gv.WithResource(r.Name)) {
+				continue
+			}
+			resources = append(resources, &k8sapiv1.APIResource{
+				Group:      gv.Group,
+				Version:    gv.Version,
+				Kind:       r.Kind,
+				Resource:   r.Name,
+				Namespaced: r.Namespaced,
+				Verbs:      r.Verbs,
+			})
+		}
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].Group != resources[j].Group {
+			return resources[i].Group < resources[j].Group
+		}
+		return resources[i].Kind < resources[j].Kind
+	})
+	return resources, nil
+}
+
+func (s *svc) DescribeResource(ctx context.Context, clientset, cluster, namespace string, gvk schema.GroupVersionKind, name string) (*k8sapiv1.Resource, error) {
+	ri, cs, err := s.dynamicResource(ctx, clientset, cluster, namespace, gvk, true)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := ri.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return ProtoForResource(cs.Cluster(), obj)
+}
+
+func (s *svc) ListResources(ctx context.Context, clientset, cluster, namespace string, gvk schema.GroupVersionKind, listOptions *k8sapiv1.ListOptions) ([]*k8sapiv1.Resource, error) {
+	opts, err := ApplyListOptions(listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	ri, cs, err := s.dynamicResource(ctx, clientset, cluster, namespace, gvk, false)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := ri.List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]*k8sapiv1.Resource, 0, len(list.Items))
+	for i := range list.Items {
+		resource, err := ProtoForResource(cs.Cluster(), &list.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+// PatchResource patches the resource and returns the result with a diff of the change. Patches that change any of the
+// denied fields are rejected, which is checked with a dry run of the patch.
+func (s *svc) PatchResource(ctx context.Context, clientset, cluster, namespace string, gvk schema.GroupVersionKind, name string, patchType k8sapiv1.PatchResourceRequest_PatchType, patch *structpb.Value, dryRun, force bool) (*k8sapiv1.PatchResourceResponse, error) {
+	ri, cs, err := s.dynamicResource(ctx, clientset, cluster, namespace, gvk, true)
+	if err != nil {
+		return nil, err
+	}
+
+	original, patched, err := s.patchObject(ctx, ri, gvk, name, patchType, patch, dryRun, force)
+	if err != nil {
+		return nil, err
+	}
+	return patchResourceResponse(cs.Cluster(), original, patched)
+}
+
+// patchObject patches the object and returns it from before and after the patch. The patch is pinned to the resource
+// version of the object it was checked against, so that the object can't change between the dry run that checks the
+// denied fields and the patch. If it does, the object is read and checked again.
+func (s *svc) patchObject(ctx context.Context, ri dynamic.ResourceInterface, gvk schema.GroupVersionKind, name string, patchType k8sapiv1.PatchResourceRequest_PatchType, patch *structpb.Value, dryRun, force bool) (original, patched *unstructured.Unstructured, err error) {
+	pt, ok := patchTypes[patchType]
+	if !ok {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "unknown patch type '%s'", patchType)
+	}
+	if pt == types.JSONPatchType {
+		if patch.GetListValue() == nil {
+			return nil, nil, status.Error(codes.InvalidArgument, "a JSON patch must be a list of operations")
+		}
+	} else if patch.GetStructValue() == nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "a %s patch must be an object", patchType)
+	}
+
+	opts := metav1.PatchOptions{FieldManager: fieldManager}
+	if pt == types.ApplyPatchType {
+		// Force is only allowed for server-side applies.
+		opts.Force = &force
+	}
+
+	err = retry.OnError(retry.DefaultRetry, isResourceVersionConflict, func() error {
+		var err error
+		original, err = ri.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		data, err := patchData(pt, patch, gvk, name, original.GetResourceVersion())
+		if err != nil {
+			return err
+		}
+
+		if dryRun || len(s.patchDeniedFields) > 0 {
+			dryRunOpts := opts
+			dryRunOpts.DryRun = []string{metav1.DryRunAll}
+			patched, err = ri.Patch(ctx, name, pt, data, dryRunOpts)
+			if err != nil {
+				return err
+			}
+			if denied := deniedChanges(original, patched, s.patchDeniedFields); len(denied) > 0 {
+				return status.Errorf(codes.PermissionDenied, "patching these fields is not allowed: %s", strings.Join(denied, ", "))
+			}
+			if dryRun {
+				return nil
+			}
+		}
+
+		patched, err = ri.Patch(ctx, name, pt, data, opts)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return original, patched, nil
+}
+
+// patchTypedObject patches an object of a built-in kind, which doesn't need to be exposed as a dynamic resource, and
+// returns a diff of the change.
+func (s *svc) patchTypedObject(ctx context.Context, clientset, cluster, namespace string, gvk schema.GroupVersionKind, resource, name string, patch *k8sapiv1.ResourcePatch) (string, error) {
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	dcs, ok := cs.(DynamicClientset)
+	if !ok || dcs.Dynamic() == nil {
+		return "", status.Errorf(codes.Unimplemented, "clientset '%s' does not support patches", clientset)
+	}
+	ri := dcs.Dynamic().Resource(gvk.GroupVersion().WithResource(resource)).Namespace(cs.Namespace())
+
+	original, patched, err := s.patchObject(ctx, ri, gvk, name, patch.PatchType, patch.Patch, patch.DryRun, patch.Force)
+	if err != nil {
+		return "", err
+	}
+	return objectDiff(original, patched)
+}
+
+func patchResourceResponse(cluster string, original, patched *unstructured.Unstructured) (*k8sapiv1.PatchResourceResponse, error) {
+	resource, err := ProtoForResource(cluster, patched)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := objectDiff(original, patched)
+	if err != nil {
+		return nil, err
+	}
+	return &k8sapiv1.PatchResourceResponse{Resource: resource, Diff: diff}, nil
+}
+
+func (s *svc) DeleteResource(ctx context.Context, clientset, cluster, namespace string, gvk schema.GroupVersionKind, name string) error {
+	ri, _, err := s.dynamicResource(ctx, clientset, cluster, namespace, gvk, true)
+	if err != nil {
+		return err
+	}
+	return ri.Delete(ctx, name, metav1.DeleteOptions{})
+}
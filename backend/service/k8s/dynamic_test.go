@@ -0,0 +1,424 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	k8sconfigv1 "github.com/lyft/clutch/backend/api/config/service/k8s/v1"
+	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+var (
+	widgetGVK        = schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+	clusterWidgetGVK = schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "ClusterWidget"}
+)
+
+func testWidget(name, namespace string, size int64) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"size": size},
+	}}
+	u.SetGroupVersionKind(widgetGVK)
+	u.SetName(name)
+	u.SetNamespace(namespace)
+	return u
+}
+
+func testDynamicService(dynamicResources []*k8sconfigv1.DynamicResource, objects ...runtime.Object) *svc {
+	cs := fake.NewSimpleClientset()
+	cs.Discovery().(*fakediscovery.FakeDiscovery).Resources = []*metav1.APIResourceList{
+		{
+			GroupVersion: "example.com/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "widgets", Kind: "Widget", Namespaced: true, Verbs: []string{"get", "list", "patch", "delete"}},
+				{Name: "widgets/status", Kind: "Widget", Namespaced: true, Verbs: []string{"get", "patch"}},
+				{Name: "clusterwidgets", Kind: "ClusterWidget", Verbs: []string{"get", "list"}},
+			},
+		},
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "secrets", Kind: "Secret", Namespaced: true, Verbs: []string{"get", "list"}},
+			},
+		},
+	}
+
+	dcs := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			{Group: "example.com", Version: "v1", Resource: "widgets"}:        "WidgetList",
+			{Group: "example.com", Version: "v1", Resource: "clusterwidgets"}: "ClusterWidgetList",
+		},
+		objects...,
+	)
+
+	return &svc{
+		dynamicResources: dynamicResources,
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{"foo": {
+				Interface: cs,
+				dynamic:   dcs,
+				namespace: "default",
+				cluster:   "core-testing",
+			}},
+		},
+	}
+}
+
+func TestIsDynamicResourceExposed(t *testing.T) {
+	s := &svc{dynamicResources: []*k8sconfigv1.DynamicResource{
+		{Group: "example.com", Version: "*", Resource: "widgets"},
+		{Group: "*.example.org", Version: "v1", Resource: "*"},
+		{Group: "argoproj.io", Version: "*", Resource: "*"},
+	}}
+
+	tests := []struct {
+		gvr      schema.GroupVersionResource
+		expected bool
+	}{
+		{gvr: schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}, expected: true},
+		{gvr: schema.GroupVersionResource{Group: "example.com", Version: "v2beta1", Resource: "widgets"}, expected: true},
+		{gvr: schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "gadgets"}, expected: false},
+		{gvr: schema.GroupVersionResource{Group: "sub.example.org", Version: "v1", Resource: "gadgets"}, expected: false},
+		{gvr: schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"}, expected: true},
+		{gvr: schema.GroupVersionResource{Version: "v1", Resource: "secrets"}, expected: false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, s.isDynamicResourceExposed(tt.gvr), tt.gvr.String())
+	}
+
+	assert.False(t, (&svc{}).isDynamicResourceExposed(schema.GroupVersionResource{Version: "v1", Resource: "pods"}))
+
+	// Secrets are never exposed, even if the configuration wasn't validated.
+	s = &svc{dynamicResources: []*k8sconfigv1.DynamicResource{{Group: "", Version: "v1", Resource: "*"}}}
+	assert.True(t, s.isDynamicResourceExposed(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}))
+	assert.False(t, s.isDynamicResourceExposed(schema.GroupVersionResource{Version: "v1", Resource: "secrets"}))
+}
+
+func TestValidateDynamicResources(t *testing.T) {
+	assert.NoError(t, validateDynamicResources([]*k8sconfigv1.DynamicResource{
+		{Group: "example.com", Version: "*", Resource: "*"},
+		{Group: "", Version: "v1", Resource: "configmaps"},
+		{Group: "*", Version: "v1", Resource: "widgets"},
+	}))
+
+	for _, r := range []*k8sconfigv1.DynamicResource{
+		{Group: "", Version: "v1", Resource: "*"},
+		{Group: "", Version: "v1", Resource: "secrets"},
+		{Group: "*", Version: "*", Resource: "*"},
+		{Group: "*", Version: "v1", Resource: "secrets"},
+	} {
+		assert.Error(t, validateDynamicResources([]*k8sconfigv1.DynamicResource{r}), r.String())
+	}
+
+	_, err := newService(nil, &k8sconfigv1.Config{
+		DynamicResources: []*k8sconfigv1.DynamicResource{{Version: "v1", Resource: "*"}},
+	}, zaptest.NewLogger(t), tally.NoopScope)
+	assert.Error(t, err)
+}
+
+func TestListAPIResources(t *testing.T) {
+	s := testDynamicService([]*k8sconfigv1.DynamicResource{{Group: "example.com", Version: "*", Resource: "*"}})
+
+	resources, err := s.ListAPIResources(context.Background(), "foo", "core-testing")
+	assert.NoError(t, err)
+	assert.Len(t, resources, 2)
+	assert.Equal(t, "ClusterWidget", resources[0].Kind)
+	assert.False(t, resources[0].Namespaced)
+	assert.Equal(t, "widgets", resources[1].Resource)
+	assert.Equal(t, "example.com", resources[1].Group)
+	assert.Equal(t, "v1", resources[1].Version)
+	assert.True(t, resources[1].Namespaced)
+	assert.Equal(t, []string{"get", "list", "patch", "delete"}, resources[1].Verbs)
+
+	// Nothing is exposed by default.
+	s = testDynamicService(nil)
+	resources, err = s.ListAPIResources(context.Background(), "foo", "core-testing")
+	assert.NoError(t, err)
+	assert.Empty(t, resources)
+}
+
+func TestDescribeResource(t *testing.T) {
+	s := testDynamicService(
+		[]*k8sconfigv1.DynamicResource{{Group: "example.com", Version: "v1", Resource: "widgets"}},
+		testWidget("w1", "default", 3),
+	)
+
+	resource, err := s.DescribeResource(context.Background(), "foo", "core-testing", "default", widgetGVK, "w1")
+	assert.NoError(t, err)
+	assert.Equal(t, "core-testing", resource.Cluster)
+	assert.Equal(t, "default", resource.Namespace)
+	assert.Equal(t, "w1", resource.Name)
+	assert.Equal(t, "example.com", resource.Group)
+	assert.Equal(t, "v1", resource.Version)
+	assert.Equal(t, "Widget", resource.Kind)
+	assert.Equal(t, float64(3), resource.Object.Fields["spec"].GetStructValue().Fields["size"].GetNumberValue())
+
+	_, err = s.DescribeResource(context.Background(), "foo", "core-testing", "default", widgetGVK, "w2")
+	assert.Error(t, err)
+
+	_, err = s.DescribeResource(context.Background(), "foo", "core-testing", "", widgetGVK, "w1")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.DescribeResource(context.Background(), "foo", "core-testing", "default", clusterWidgetGVK, "cw1")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.DescribeResource(context.Background(), "foo", "core-testing", "default", schema.GroupVersionKind{Version: "v1", Kind: "Secret"}, "s1")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.DescribeResource(context.Background(), "foo", "core-testing", "default", schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Gadget"}, "g1")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListResources(t *testing.T) {
+	s := testDynamicService(
+		[]*k8sconfigv1.DynamicResource{{Group: "example.com", Version: "v1", Resource: "widgets"}},
+		testWidget("w1", "default", 3),
+		testWidget("w2", "default", 1),
+		testWidget("w3", "other", 1),
+	)
+
+	resources, err := s.ListResources(context.Background(), "foo", "core-testing", "default", widgetGVK, nil)
+	assert.NoError(t, err)
+	assert.Len(t, resources, 2)
+
+	// All namespaces are listed if none is specified.
+	resources, err = s.ListResources(context.Background(), "foo", "core-testing", "", widgetGVK, &k8sapiv1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, resources, 3)
+}
+
+func widgetSize(r *k8sapiv1.Resource) float64 {
+	return r.Object.Fields["spec"].GetStructValue().Fields["size"].GetNumberValue()
+}
+
+func TestPatchResource(t *testing.T) {
+	s := testDynamicService(
+		[]*k8sconfigv1.DynamicResource{{Group: "example.com", Version: "v1", Resource: "widgets"}},
+		testWidget("w1", "default", 3),
+	)
+
+	var patches []k8stesting.PatchActionImpl
+	s.manager.(*managerImpl).clientsets["foo"].dynamic.(*dynamicfake.FakeDynamicClient).PrependReactor("patch", "widgets",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			patches = append(patches, action.(k8stesting.PatchActionImpl))
+			return false, nil, nil
+		})
+
+	// Merge patch.
+	patch, _ := structpb.NewValue(map[string]interface{}{"spec": map[string]interface{}{"size": 5}})
+	resp, err := s.PatchResource(context.Background(), "foo", "core-testing", "default", widgetGVK, "w1", k8sapiv1.PatchResourceRequest_UNSPECIFIED, patch, false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(5), widgetSize(resp.Resource))
+	assert.Contains(t, resp.Diff, "-  size: 3\n+  size: 5\n")
+	// Without denied fields the patch isn't dry run first.
+	assert.Len(t, patches, 1)
+	assert.Equal(t, types.MergePatchType, patches[0].PatchType)
+
+	resource, err := s.DescribeResource(context.Background(), "foo", "core-testing", "default", widgetGVK, "w1")
+	assert.NoError(t, err)
+	assert.Equal(t, float64(5), widgetSize(resource))
+
+	// JSON patch.
+	patch, _ = structpb.NewValue([]interface{}{
+		map[string]interface{}{"op": "replace", "path": "/spec/size", "value": 7},
+	})
+	resp, err = s.PatchResource(context.Background(), "foo", "core-testing", "default", widgetGVK, "w1", k8sapiv1.PatchResourceRequest_JSON, patch, false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(7), widgetSize(resp.Resource))
+	assert.Equal(t, types.JSONPatchType, patches[1].PatchType)
+
+	// Dry run.
+	patches = nil
+	patch, _ = structpb.NewValue(map[string]interface{}{"spec": map[string]interface{}{"size": 1}})
+	resp, err = s.PatchResource(context.Background(), "foo", "core-testing", "default", widgetGVK, "w1", k8sapiv1.PatchResourceRequest_MERGE, patch, true, false)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(1), widgetSize(resp.Resource))
+	assert.Contains(t, resp.Diff, "-  size: 7\n+  size: 1\n")
+	assert.Len(t, patches, 1)
+
+	// The patch must match the patch type.
+	_, err = s.PatchResource(context.Background(), "foo", "core-testing", "default", widgetGVK, "w1", k8sapiv1.PatchResourceRequest_JSON, patch, false, false)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.PatchResource(context.Background(), "foo", "core-testing", "default", widgetGVK, "w1", k8sapiv1.PatchResourceRequest_MERGE, structpb.NewListValue(&structpb.ListValue{}), false, false)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPatchResourceApply(t *testing.T) {
+	s := testDynamicService(
+		[]*k8sconfigv1.DynamicResource{{Group: "example.com", Version: "v1", Resource: "widgets"}},
+		testWidget("w1", "default", 3),
+	)
+
+	// The fake client doesn't support server-side apply.
+	var applied map[string]interface{}
+	s.manager.(*managerImpl).clientsets["foo"].dynamic.(*dynamicfake.FakeDynamicClient).PrependReactor("patch", "widgets",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			patch := action.(k8stesting.PatchActionImpl)
+			assert.Equal(t, types.ApplyPatchType, patch.PatchType)
+			assert.NoError(t, json.Unmarshal(patch.Patch, &applied))
+			return true, testWidget("w1", "default", 4), nil
+		})
+
+	patch, _ := structpb.NewValue(map[string]interface{}{"spec": map[string]interface{}{"size": 4}})
+	resp, err := s.PatchResource(context.Background(), "foo", "core-testing", "default", widgetGVK, "w1", k8sapiv1.PatchResourceRequest_APPLY, patch, false, true)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(4), widgetSize(resp.Resource))
+	assert.Equal(t, map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]interface{}{"name": "w1"},
+		"spec":       map[string]interface{}{"size": float64(4)},
+	}, applied)
+}
+
+func TestPatchResourceDeniedFields(t *testing.T) {
+	s := testDynamicService(
+		[]*k8sconfigv1.DynamicResource{{Group: "example.com", Version: "v1", Resource: "widgets"}},
+		testWidget("w1", "default", 3),
+	)
+	s.patchDeniedFields = []string{"securityContext", "spec.hostNetwork"}
+
+	var patches int
+	s.manager.(*managerImpl).clientsets["foo"].dynamic.(*dynamicfake.FakeDynamicClient).PrependReactor("patch", "widgets",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			patches++
+			return false, nil, nil
+		})
+
+	patch, _ := structpb.NewValue(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"hostNetwork":     true,
+			"securityContext": map[string]interface{}{"runAsUser": 0},
+		},
+	})
+	_, err := s.PatchResource(context.Background(), "foo", "core-testing", "default", widgetGVK, "w1", k8sapiv1.PatchResourceRequest_MERGE, patch, false, false)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Contains(t, err.Error(), "spec.hostNetwork, spec.securityContext")
+	// Only the dry run was made.
+	assert.Equal(t, 1, patches)
+
+	patch, _ = structpb.NewValue(map[string]interface{}{"spec": map[string]interface{}{"size": 5}})
+	resp, err := s.PatchResource(context.Background(), "foo", "core-testing", "default", widgetGVK, "w1", k8sapiv1.PatchResourceRequest_MERGE, patch, false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(5), widgetSize(resp.Resource))
+	assert.Equal(t, 3, patches)
+}
+
+func TestPatchResourcePinsResourceVersion(t *testing.T) {
+	w1 := testWidget("w1", "default", 3)
+	w1.SetResourceVersion("1")
+	s := testDynamicService(
+		[]*k8sconfigv1.DynamicResource{{Group: "example.com", Version: "v1", Resource: "widgets"}},
+		w1,
+	)
+	s.patchDeniedFields = []string{"spec.hostNetwork"}
+
+	// The object changes after the first dry run, so the patch is rejected with a conflict and checked again.
+	dcs := s.manager.(*managerImpl).clientsets["foo"].dynamic.(*dynamicfake.FakeDynamicClient)
+	var patches []map[string]interface{}
+	dcs.PrependReactor("patch", "widgets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		var patch map[string]interface{}
+		assert.NoError(t, json.Unmarshal(action.(k8stesting.PatchActionImpl).Patch, &patch))
+		patches = append(patches, patch)
+		if len(patches) == 2 {
+			changed := testWidget("w1", "default", 3)
+			changed.SetResourceVersion("2")
+			assert.NoError(t, dcs.Tracker().Update(schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}, changed, "default"))
+			return true, nil, apierrors.NewConflict(schema.GroupResource{Group: "example.com", Resource: "widgets"}, "w1", errors.New("the object has been modified"))
+		}
+		return false, nil, nil
+	})
+
+	patch, _ := structpb.NewValue(map[string]interface{}{
+		"metadata": map[string]interface{}{"resourceVersion": "0"},
+		"spec":     map[string]interface{}{"size": 5},
+	})
+	resp, err := s.PatchResource(context.Background(), "foo", "core-testing", "default", widgetGVK, "w1", k8sapiv1.PatchResourceRequest_MERGE, patch, false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(5), widgetSize(resp.Resource))
+
+	var versions []interface{}
+	for _, p := range patches {
+		versions = append(versions, p["metadata"].(map[string]interface{})["resourceVersion"])
+	}
+	assert.Equal(t, []interface{}{"1", "1", "2", "2"}, versions)
+
+	// JSON patches are pinned with an operation after the patch's own operations.
+	data, err := patchData(types.JSONPatchType, structpb.NewListValue(&structpb.ListValue{}), widgetGVK, "w1", "3")
+	assert.NoError(t, err)
+	assert.JSONEq(t, `[{"op": "replace", "path": "/metadata/resourceVersion", "value": "3"}]`, string(data))
+}
+
+func TestIsResourceVersionConflict(t *testing.T) {
+	gr := schema.GroupResource{Group: "apps", Resource: "deployments"}
+	assert.True(t, isResourceVersionConflict(apierrors.NewConflict(gr, "d1", errors.New("the object has been modified"))))
+	assert.False(t, isResourceVersionConflict(apierrors.NewNotFound(gr, "d1")))
+
+	// Conflicts with other field managers in a server-side apply aren't resolved by retrying.
+	applyConflict := apierrors.NewConflict(gr, "d1", errors.New("conflict"))
+	applyConflict.ErrStatus.Details.Causes = []metav1.StatusCause{{Type: metav1.CauseTypeFieldManagerConflict, Field: ".spec.replicas"}}
+	assert.False(t, isResourceVersionConflict(applyConflict))
+}
+
+func TestPatchTypedObject(t *testing.T) {
+	deployment := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"replicas": int64(1), "template": map[string]interface{}{}},
+	}}
+	deployment.SetGroupVersionKind(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+	deployment.SetName("d1")
+	deployment.SetNamespace("default")
+
+	// Deployments don't need to be exposed as dynamic resources.
+	s := testDynamicService(nil, deployment)
+	s.patchDeniedFields = []string{"hostNetwork"}
+
+	patch, _ := structpb.NewValue(map[string]interface{}{"spec": map[string]interface{}{"replicas": 2}})
+	diff, err := s.PatchDeployment(context.Background(), "foo", "core-testing", "default", "d1", &k8sapiv1.ResourcePatch{Patch: patch})
+	assert.NoError(t, err)
+	assert.Contains(t, diff, "-  replicas: 1\n+  replicas: 2\n")
+
+	patch, _ = structpb.NewValue(map[string]interface{}{
+		"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{"hostNetwork": true}}},
+	})
+	_, err = s.PatchDeployment(context.Background(), "foo", "core-testing", "default", "d1", &k8sapiv1.ResourcePatch{Patch: patch})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestDeleteResource(t *testing.T) {
+	s := testDynamicService(
+		[]*k8sconfigv1.DynamicResource{{Group: "example.com", Version: "v1", Resource: "widgets"}},
+		testWidget("w1", "default", 3),
+	)
+
+	err := s.DeleteResource(context.Background(), "foo", "core-testing", "default", widgetGVK, "w1")
+	assert.NoError(t, err)
+
+	_, err = s.DescribeResource(context.Background(), "foo", "core-testing", "default", widgetGVK, "w1")
+	assert.Error(t, err)
+}
+
+func TestDynamicResourceUnsupportedClientset(t *testing.T) {
+	s := testDynamicService([]*k8sconfigv1.DynamicResource{{Group: "*", Version: "*", Resource: "*"}})
+	s.manager.(*managerImpl).clientsets["foo"].dynamic = nil
+
+	_, err := s.DescribeResource(context.Background(), "foo", "core-testing", "default", widgetGVK, "w1")
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -0,0 +1,275 @@
+package k8s
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/transport/spdy"
+	utilexec "k8s.io/client-go/util/exec"
+
+	k8sconfigv1 "github.com/lyft/clutch/backend/api/config/service/k8s/v1"
+	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+const (
+	defaultExecTimeout        = 30 * time.Second
+	defaultExecMaxOutputBytes = 1 << 20
+	defaultExecMaxConcurrent  = 10
+)
+
+// executorFactory returns an executor whose stream ends when the context is done.
+type executorFactory func(ctx context.Context, config *rest.Config, method string, url *url.URL) (remotecommand.Executor, error)
+
+// newSPDYExecutor is remotecommand.NewSPDYExecutor, except that the connection is closed when the context is done.
+// Streams can't be canceled otherwise, so they would be left running until the command finished.
+func newSPDYExecutor(ctx context.Context, config *rest.Config, method string, url *url.URL) (remotecommand.Executor, error) {
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return nil, err
+	}
+	return remotecommand.NewSPDYExecutorForTransports(
+		&contextRoundTripper{ctx: ctx, RoundTripper: transport},
+		&closingUpgrader{ctx: ctx, Upgrader: upgrader},
+		method, url,
+	)
+}
+
+// contextRoundTripper sends requests with its context, so that connecting is canceled when the context is done.
+type contextRoundTripper struct {
+	ctx context.Context
+	http.RoundTripper
+}
+
+func (rt *contextRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return rt.RoundTripper.RoundTrip(req.WithContext(rt.ctx))
+}
+
+// closingUpgrader closes the connections it upgrades when its context is done.
+type closingUpgrader struct {
+	ctx context.Context
+	spdy.Upgrader
+}
+
+func (u *closingUpgrader) NewConnection(resp *http.Response) (httpstream.Connection, error) {
+	conn, err := u.Upgrader.NewConnection(resp)
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		select {
+		case <-u.ctx.Done():
+			_ = conn.Close()
+		case <-conn.CloseChan():
+		}
+	}()
+	return conn, nil
+}
+
+type allowedCommand struct {
+	command string
+	args    []*regexp.Regexp
+}
+
+// commandAllowlist is the compiled form of the allowed commands in the configuration.
+type commandAllowlist []allowedCommand
+
+func newCommandAllowlist(commands []*k8sconfigv1.AllowedCommand) (commandAllowlist, error) {
+	ret := make(commandAllowlist, 0, len(commands))
+	for _, c := range commands {
+		ac := allowedCommand{command: c.Command}
+		for _, arg := range c.Args {
+			re, err := regexp.Compile("^(?:" + arg + ")$")
+			if err != nil {
+				return nil, fmt.Errorf("invalid argument pattern for command '%s': %w", c.Command, err)
+			}
+			ac.args = append(ac.args, re)
+		}
+		ret = append(ret, ac)
+	}
+	return ret, nil
+}
+
+func (c allowedCommand) allows(command []string) bool {
+	if command[0] != c.command {
+		return false
+	}
+	for _, arg := range command[1:] {
+		matched := false
+		for _, re := range c.args {
+			if re.MatchString(arg) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func (l commandAllowlist) check(command []string) error {
+	if len(command) == 0 {
+		return status.Error(codes.InvalidArgument, "a command is required")
+	}
+	for _, c := range l {
+		if c.allows(command) {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "command '%s' is not allowed", strings.Join(command, " "))
+}
+
+// limitedBuffer keeps the first limit bytes written to it, discarding the rest.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.limit - b.Len(); len(p) > remaining {
+		b.truncated = true
+		b.Buffer.Write(p[:remaining])
+	} else {
+		b.Buffer.Write(p)
+	}
+	return len(p), nil
+}
+
+func (s *svc) ExecPod(ctx context.Context, clientset, cluster, namespace, name, container string, command []string) (*k8sapiv1.ExecPodResponse, error) {
+	if err := s.execCommands.check(command); err != nil {
+		return nil, err
+	}
+
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
+	if err != nil {
+		return nil, err
+	}
+	rcs, ok := cs.(RESTConfigClientset)
+	if !ok || rcs.RESTConfig() == nil {
+		return nil, status.Errorf(codes.Unimplemented, "clientset '%s' does not support executing commands", clientset)
+	}
+
+	req := cs.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(cs.Namespace()).
+		Name(name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	ctx, cancel := context.WithTimeout(ctx, s.execTimeout)
+	defer cancel()
+
+	executor, err := s.newExecutor(ctx, rcs.RESTConfig(), "POST", req.URL())
+	if err != nil {
+		return nil, err
+	}
+
+	stdout := &limitedBuffer{limit: s.execMaxOutputBytes}
+	stderr := &limitedBuffer{limit: s.execMaxOutputBytes}
+	// The slot is released once the stream ends, which is shortly after the timeout at the latest.
+	if !s.execSlots.TryAcquire(1) {
+		return nil, status.Error(codes.ResourceExhausted, "too many commands are running, try again later")
+	}
+	done := make(chan error, 1)
+	go func() {
+		err := executor.Stream(remotecommand.StreamOptions{Stdout: stdout, Stderr: stderr})
+		s.execSlots.Release(1)
+		done <- err
+	}()
+
+	select {
+	case <-ctx.Done():
+		// The connection is closed by the executor, which ends the stream.
+		return nil, status.Errorf(codes.DeadlineExceeded, "command '%s' did not finish in %s", strings.Join(command, " "), s.execTimeout)
+	case err = <-done:
+	}
+
+	resp := &k8sapiv1.ExecPodResponse{
+		Stdout:    stdout.String(),
+		Stderr:    stderr.String(),
+		Truncated: stdout.truncated || stderr.truncated,
+	}
+	var exitErr utilexec.ExitError
+	if errors.As(err, &exitErr) && exitErr.Exited() {
+		resp.ExitCode = int32(exitErr.ExitStatus())
+	} else if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *svc) CreateDebugContainer(ctx context.Context, clientset, cluster, namespace, name, image, targetContainer string, command []string) (string, error) {
+	imageAllowed := false
+	for _, allowed := range s.debugImages {
+		if image == allowed {
+			imageAllowed = true
+			break
+		}
+	}
+	if !imageAllowed {
+		return "", status.Errorf(codes.PermissionDenied, "image '%s' is not allowed for debug containers", image)
+	}
+	if len(command) > 0 {
+		if err := s.debugCommands.check(command); err != nil {
+			return "", err
+		}
+	}
+
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
+	if err != nil {
+		return "", err
+	}
+
+	oldPod, err := cs.CoreV1().Pods(cs.Namespace()).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	// Same as the names generated by `kubectl debug`.
+	containerName := fmt.Sprintf("debugger-%s", utilrand.String(5))
+	newPod := oldPod.DeepCopy()
+	newPod.Spec.EphemeralContainers = append(newPod.Spec.EphemeralContainers, corev1.EphemeralContainer{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+			Name:                     containerName,
+			Image:                    image,
+			Command:                  command,
+			ImagePullPolicy:          corev1.PullIfNotPresent,
+			TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+		},
+		TargetContainerName: targetContainer,
+	})
+
+	patchBytes, err := GenerateStrategicPatch(oldPod, newPod, corev1.Pod{})
+	if err != nil {
+		return "", err
+	}
+	_, err = cs.CoreV1().Pods(cs.Namespace()).Patch(ctx, name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{}, "ephemeralcontainers")
+	if err != nil {
+		return "", err
+	}
+	return containerName, nil
+}
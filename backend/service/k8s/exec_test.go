@@ -0,0 +1,263 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sync/semaphore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+
+	k8sconfigv1 "github.com/lyft/clutch/backend/api/config/service/k8s/v1"
+)
+
+func TestCommandAllowlist(t *testing.T) {
+	l, err := newCommandAllowlist([]*k8sconfigv1.AllowedCommand{
+		{Command: "ps"},
+		{Command: "cat", Args: []string{"/etc/[a-z.]+", "-n"}},
+		{Command: "env"},
+	})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		command []string
+		code    codes.Code
+	}{
+		{command: []string{"ps"}, code: codes.OK},
+		{command: []string{"ps", "aux"}, code: codes.PermissionDenied},
+		{command: []string{"cat", "/etc/resolv.conf"}, code: codes.OK},
+		{command: []string{"cat", "-n", "/etc/hosts"}, code: codes.OK},
+		// Patterns must match the whole argument.
+		{command: []string{"cat", "/etc/../root/.ssh/id_rsa"}, code: codes.PermissionDenied},
+		{command: []string{"cat", "/etc/hosts", "/var/run/secrets/token"}, code: codes.PermissionDenied},
+		{command: []string{"/bin/ps"}, code: codes.PermissionDenied},
+		{command: []string{"sh", "-c", "ps"}, code: codes.PermissionDenied},
+		{command: nil, code: codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.code, status.Code(l.check(tt.command)), "%v", tt.command)
+	}
+
+	// Nothing is allowed by default.
+	assert.Equal(t, codes.PermissionDenied, status.Code(commandAllowlist(nil).check([]string{"ps"})))
+
+	_, err = newCommandAllowlist([]*k8sconfigv1.AllowedCommand{{Command: "cat", Args: []string{"("}}})
+	assert.Error(t, err)
+}
+
+func TestLimitedBuffer(t *testing.T) {
+	b := &limitedBuffer{limit: 5}
+	n, err := b.Write([]byte("abc"))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.False(t, b.truncated)
+
+	n, err = b.Write([]byte("defg"))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.True(t, b.truncated)
+	assert.Equal(t, "abcde", b.String())
+}
+
+type testExecutor struct {
+	stdout, stderr string
+	err            error
+	block          chan struct{}
+}
+
+func (e *testExecutor) Stream(options remotecommand.StreamOptions) error {
+	if e.block != nil {
+		<-e.block
+	}
+	_, _ = options.Stdout.Write([]byte(e.stdout))
+	_, _ = options.Stderr.Write([]byte(e.stderr))
+	return e.err
+}
+
+func testExecService(t *testing.T, executor *testExecutor, urls *[]*url.URL) *svc {
+	config := &rest.Config{Host: "https://core-testing.example.com"}
+	cs, err := k8s.NewForConfig(config)
+	assert.NoError(t, err)
+
+	execCommands, err := newCommandAllowlist([]*k8sconfigv1.AllowedCommand{{Command: "cat", Args: []string{".*"}}})
+	assert.NoError(t, err)
+
+	return &svc{
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{"foo": {
+				Interface:  cs,
+				restConfig: config,
+				namespace:  "default",
+				cluster:    "core-testing",
+			}},
+		},
+		execCommands:       execCommands,
+		execTimeout:        time.Second,
+		execMaxOutputBytes: 10,
+		execSlots:          semaphore.NewWeighted(1),
+		newExecutor: func(_ context.Context, _ *rest.Config, method string, u *url.URL) (remotecommand.Executor, error) {
+			assert.Equal(t, "POST", method)
+			*urls = append(*urls, u)
+			return executor, nil
+		},
+	}
+}
+
+func TestExecPod(t *testing.T) {
+	executor := &testExecutor{stdout: "nameserver 10.0.0.1", stderr: "warning"}
+	var urls []*url.URL
+	s := testExecService(t, executor, &urls)
+
+	resp, err := s.ExecPod(context.Background(), "foo", "core-testing", "default", "pod1", "app", []string{"cat", "/etc/resolv.conf"})
+	assert.NoError(t, err)
+	assert.Equal(t, "nameserver", resp.Stdout)
+	assert.Equal(t, "warning", resp.Stderr)
+	assert.True(t, resp.Truncated)
+	assert.Equal(t, int32(0), resp.ExitCode)
+
+	assert.Len(t, urls, 1)
+	assert.Equal(t, "/api/v1/namespaces/default/pods/pod1/exec", urls[0].Path)
+	q := urls[0].Query()
+	assert.Equal(t, []string{"cat", "/etc/resolv.conf"}, q["command"])
+	assert.Equal(t, "app", q.Get("container"))
+	assert.Equal(t, "true", q.Get("stdout"))
+	assert.Empty(t, q.Get("stdin"))
+
+	// A command that fails returns its exit code.
+	executor.stdout = ""
+	executor.stderr = "not found"
+	executor.err = utilexec.CodeExitError{Err: errors.New("command terminated with exit code 1"), Code: 1}
+	resp, err = s.ExecPod(context.Background(), "foo", "core-testing", "default", "pod1", "app", []string{"cat", "/missing"})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), resp.ExitCode)
+	assert.Equal(t, "not found", resp.Stderr)
+	assert.False(t, resp.Truncated)
+
+	// Other errors are returned.
+	executor.err = errors.New("upgrade failed")
+	_, err = s.ExecPod(context.Background(), "foo", "core-testing", "default", "pod1", "app", []string{"cat", "/etc/hosts"})
+	assert.EqualError(t, err, "upgrade failed")
+
+	// Commands that aren't allowed aren't run.
+	_, err = s.ExecPod(context.Background(), "foo", "core-testing", "default", "pod1", "app", []string{"rm", "-rf", "/"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Len(t, urls, 3)
+}
+
+func TestExecPodTimeout(t *testing.T) {
+	executor := &testExecutor{block: make(chan struct{})}
+	defer close(executor.block)
+	var urls []*url.URL
+	s := testExecService(t, executor, &urls)
+	s.execTimeout = 10 * time.Millisecond
+
+	_, err := s.ExecPod(context.Background(), "foo", "core-testing", "default", "pod1", "app", []string{"cat", "/dev/zero"})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+
+	// The command that timed out still holds its slot until its stream ends.
+	_, err = s.ExecPod(context.Background(), "foo", "core-testing", "default", "pod1", "app", []string{"cat", "/etc/hosts"})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+type testConnection struct {
+	httpstream.Connection
+	closed chan bool
+}
+
+func (c *testConnection) Close() error {
+	close(c.closed)
+	return nil
+}
+
+func (c *testConnection) CloseChan() <-chan bool { return c.closed }
+
+type testUpgrader struct{ conn *testConnection }
+
+func (u *testUpgrader) NewConnection(*http.Response) (httpstream.Connection, error) {
+	return u.conn, nil
+}
+
+func TestClosingUpgrader(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	conn := &testConnection{closed: make(chan bool)}
+	u := &closingUpgrader{ctx: ctx, Upgrader: &testUpgrader{conn: conn}}
+
+	upgraded, err := u.NewConnection(&http.Response{})
+	assert.NoError(t, err)
+	assert.Equal(t, conn, upgraded)
+
+	// The connection is closed once the context is done, which ends the stream.
+	cancel()
+	select {
+	case <-conn.CloseChan():
+	case <-time.After(time.Second):
+		t.Error("connection wasn't closed")
+	}
+}
+
+func TestExecPodUnsupportedClientset(t *testing.T) {
+	var urls []*url.URL
+	s := testExecService(t, &testExecutor{}, &urls)
+	s.manager.(*managerImpl).clientsets["foo"].restConfig = nil
+
+	_, err := s.ExecPod(context.Background(), "foo", "core-testing", "default", "pod1", "app", []string{"cat", "/etc/hosts"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	assert.Empty(t, urls)
+}
+
+func TestCreateDebugContainer(t *testing.T) {
+	cs := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod1", Namespace: "default"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "app", Image: "app:1"}}},
+	})
+	debugCommands, err := newCommandAllowlist([]*k8sconfigv1.AllowedCommand{{Command: "sleep", Args: []string{"[0-9]+"}}})
+	assert.NoError(t, err)
+	s := &svc{
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{"foo": {
+				Interface: cs,
+				namespace: "default",
+				cluster:   "core-testing",
+			}},
+		},
+		debugImages:   []string{"busybox:1.36"},
+		debugCommands: debugCommands,
+	}
+
+	name, err := s.CreateDebugContainer(context.Background(), "foo", "core-testing", "default", "pod1", "busybox:1.36", "app", []string{"sleep", "3600"})
+	assert.NoError(t, err)
+	assert.Regexp(t, "^debugger-[a-z0-9]{5}$", name)
+
+	// The entrypoint of the image can always be run.
+	_, err = s.CreateDebugContainer(context.Background(), "foo", "core-testing", "default", "pod1", "busybox:1.36", "", nil)
+	assert.NoError(t, err)
+
+	pod, err := cs.CoreV1().Pods("default").Get(context.Background(), "pod1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, pod.Spec.EphemeralContainers, 2)
+	c := pod.Spec.EphemeralContainers[0]
+	assert.Equal(t, name, c.Name)
+	assert.Equal(t, "busybox:1.36", c.Image)
+	assert.Equal(t, []string{"sleep", "3600"}, c.Command)
+	assert.Equal(t, "app", c.TargetContainerName)
+	assert.Empty(t, pod.Spec.EphemeralContainers[1].Command)
+
+	_, err = s.CreateDebugContainer(context.Background(), "foo", "core-testing", "default", "pod1", "alpine:3", "app", nil)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = s.CreateDebugContainer(context.Background(), "foo", "core-testing", "default", "pod1", "busybox:1.36", "app", []string{"sh"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
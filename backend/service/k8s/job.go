@@ -90,11 +90,15 @@ func protoForJob(cluster string, k8sJob *v1.Job) *k8sapiv1.Job {
 		clusterName = cluster
 	}
 
-	return &k8sapiv1.Job{
+	ret := &k8sapiv1.Job{
 		Cluster:     clusterName,
 		Namespace:   k8sJob.Namespace,
 		Name:        k8sJob.Name,
 		Labels:      k8sJob.Labels,
 		Annotations: k8sJob.Annotations,
 	}
+	if owner := metav1.GetControllerOf(k8sJob); owner != nil && owner.Kind == "CronJob" {
+		ret.CronJobName = owner.Name
+	}
+	return ret
 }
@@ -176,6 +176,7 @@ func TestProtoForJob(t *testing.T) {
 		id                  string
 		inputClusterName    string
 		expectedClusterName string
+		expectedCronJobName string
 		job                 *v1.Job
 	}{
 		{
@@ -198,6 +199,29 @@ func TestProtoForJob(t *testing.T) {
 				},
 			},
 		},
+		{
+			id:                  "created by a cron job",
+			inputClusterName:    "staging",
+			expectedClusterName: "staging",
+			expectedCronJobName: "nightly",
+			job: &v1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					OwnerReferences: []metav1.OwnerReference{
+						{APIVersion: "batch/v1", Kind: "CronJob", Name: "nightly", Controller: &[]bool{true}[0]},
+					},
+				},
+			},
+		},
+		{
+			id:                  "owned but not controlled by a cron job",
+			inputClusterName:    "staging",
+			expectedClusterName: "staging",
+			job: &v1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					OwnerReferences: []metav1.OwnerReference{{APIVersion: "batch/v1", Kind: "CronJob", Name: "nightly"}},
+				},
+			},
+		},
 	}
 
 	for _, tt := range jobTestCases {
@@ -207,6 +231,7 @@ func TestProtoForJob(t *testing.T) {
 
 			job := protoForJob(tt.inputClusterName, tt.job)
 			assert.Equal(t, tt.expectedClusterName, job.Cluster)
+			assert.Equal(t, tt.expectedCronJobName, job.CronJobName)
 		})
 	}
 }
@@ -6,12 +6,21 @@ package k8s
 
 import (
 	"context"
+	"crypto/rand"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
 	"golang.org/x/sync/semaphore"
+	"google.golang.org/protobuf/types/known/structpb"
 	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc"
 	"k8s.io/client-go/tools/clientcmd"
 
@@ -46,7 +55,7 @@ func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service,
 		return nil, err
 	}
 
-	return NewWithClientsetManager(c, logger, scope)
+	return newService(c, k8sConfig, logger, scope)
 }
 
 type Service interface {
@@ -60,6 +69,8 @@ type Service interface {
 	ListPods(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sapiv1.ListOptions) ([]*k8sapiv1.Pod, error)
 	UpdatePod(ctx context.Context, clientset, cluster, namespace, name string, expected_object_meta_fields *k8sapiv1.ExpectedObjectMetaFields, object_meta_fields *k8sapiv1.ObjectMetaFields, remove_object_meta_fields *k8sapiv1.RemoveObjectMetaFields) error
 	GetPodLogs(ctx context.Context, clientset, cluster, namespace, name string, opts *k8sapiv1.PodLogsOptions) (*k8sapiv1.GetPodLogsResponse, error)
+	ExecPod(ctx context.Context, clientset, cluster, namespace, name, container string, command []string) (*k8sapiv1.ExecPodResponse, error)
+	CreateDebugContainer(ctx context.Context, clientset, cluster, namespace, name, image, targetContainer string, command []string) (string, error)
 
 	// HPA management functions.
 	DescribeHPA(ctx context.Context, clientset, cluster, namespace, name string) (*k8sapiv1.HPA, error)
@@ -70,6 +81,7 @@ type Service interface {
 	DescribeDeployment(ctx context.Context, clientset, cluster, namespace, name string) (*k8sapiv1.Deployment, error)
 	ListDeployments(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sapiv1.ListOptions) ([]*k8sapiv1.Deployment, error)
 	UpdateDeployment(ctx context.Context, clientset, cluster, namespace, name string, fields *k8sapiv1.UpdateDeploymentRequest_Fields) error
+	PatchDeployment(ctx context.Context, clientset, cluster, namespace, name string, patch *k8sapiv1.ResourcePatch) (string, error)
 	DeleteDeployment(ctx context.Context, clientset, cluster, namespace, name string) error
 
 	// Service management functions.
@@ -81,17 +93,27 @@ type Service interface {
 	DescribeStatefulSet(ctx context.Context, clientset, cluster, namespace, name string) (*k8sapiv1.StatefulSet, error)
 	ListStatefulSets(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sapiv1.ListOptions) ([]*k8sapiv1.StatefulSet, error)
 	UpdateStatefulSet(ctx context.Context, clientset, cluster, namespace, name string, fields *k8sapiv1.UpdateStatefulSetRequest_Fields) error
+	PatchStatefulSet(ctx context.Context, clientset, cluster, namespace, name string, patch *k8sapiv1.ResourcePatch) (string, error)
 	DeleteStatefulSet(ctx context.Context, clientset, cluster, namespace, name string) error
 
 	// CronJob management functions.
 	DescribeCronJob(ctx context.Context, clientset, cluster, namespace, name string) (*k8sapiv1.CronJob, error)
 	ListCronJobs(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sapiv1.ListOptions) ([]*k8sapiv1.CronJob, error)
 	DeleteCronJob(ctx context.Context, clientset, cluster, namespace, name string) error
+	SetCronJobSuspended(ctx context.Context, clientset, cluster, namespace, name string, suspended bool) (*k8sapiv1.CronJob, error)
+	TriggerCronJob(ctx context.Context, clientset, cluster, namespace, name string) (*k8sapiv1.Job, error)
 
 	// ConfigMap management functions.
 	DescribeConfigMap(ctx context.Context, clientset, cluster, namespace, name string) (*k8sapiv1.ConfigMap, error)
 	DeleteConfigMap(ctx context.Context, clientset, cluster, namespace, name string) error
 	ListConfigMaps(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sapiv1.ListOptions) ([]*k8sapiv1.ConfigMap, error)
+	CreateConfigMap(ctx context.Context, clientset, cluster, namespace, name string, labels, annotations, data map[string]string) (*k8sapiv1.ConfigMap, error)
+	UpdateConfigMap(ctx context.Context, clientset, cluster, namespace, name string, data map[string]string, removeKeys []string, restartDependentDeployments bool, authorizeRestart func(deployments []string) error) (*k8sapiv1.ConfigMap, []string, error)
+
+	// Secret management functions. Secret values are never returned.
+	ListSecrets(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sapiv1.ListOptions) ([]*k8sapiv1.Secret, error)
+	DescribeSecret(ctx context.Context, clientset, cluster, namespace, name string) (*k8sapiv1.Secret, error)
+	UpdateSecret(ctx context.Context, clientset, cluster, namespace, name string, data map[string][]byte, removeKeys []string, restartDependentDeployments bool, authorizeRestart func(deployments []string) error) (*k8sapiv1.Secret, []string, error)
 
 	// Job management functions.
 	DescribeJob(ctx context.Context, clientset, cluster, namespace, name string) (*k8sapiv1.Job, error)
@@ -108,11 +130,51 @@ type Service interface {
 	// Node management functions.
 	DescribeNode(ctx context.Context, clientset, cluster, name string) (*k8sapiv1.Node, error)
 	UpdateNode(ctx context.Context, clientset, cluster, name string, unschedulable bool) error
+
+	// Watch functions call the handler with each event, fanning in the events of every clientset if neither the
+	// clientset nor the cluster is specified. They return when the context is done or the handler returns an error.
+	WatchPods(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sapiv1.ListOptions, resourceVersions map[string]string, handler func(*k8sapiv1.WatchEvent, *k8sapiv1.Pod) error) error
+	WatchDeployments(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sapiv1.ListOptions, resourceVersions map[string]string, handler func(*k8sapiv1.WatchEvent, *k8sapiv1.Deployment) error) error
+	WatchHPAs(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sapiv1.ListOptions, resourceVersions map[string]string, handler func(*k8sapiv1.WatchEvent, *k8sapiv1.HPA) error) error
+	WatchNodes(ctx context.Context, clientset, cluster string, listOptions *k8sapiv1.ListOptions, resourceVersions map[string]string, handler func(*k8sapiv1.WatchEvent, *k8sapiv1.Node) error) error
+	WatchEvents(ctx context.Context, clientset, cluster, namespace, object string, kind k8sapiv1.ObjectKind, listOptions *k8sapiv1.ListOptions, resourceVersions map[string]string, handler func(*k8sapiv1.WatchEvent, *k8sapiv1.Event) error) error
+
+	// Workload functions.
+	ScaleWorkload(ctx context.Context, clientset, cluster, namespace, name string, kind k8sapiv1.WorkloadKind, replicas uint32) (*k8sapiv1.Workload, error)
+	SetContainerImage(ctx context.Context, clientset, cluster, namespace, name string, kind k8sapiv1.WorkloadKind, containerName, image string) (*k8sapiv1.Workload, error)
+	WaitForRollout(ctx context.Context, clientset, cluster, namespace, name string, kind k8sapiv1.WorkloadKind, handler func(*k8sapiv1.Workload) error) error
+
+	// Dynamic resource functions, which access any resource exposed by the configuration, e.g. custom resources.
+	ListAPIResources(ctx context.Context, clientset, cluster string) ([]*k8sapiv1.APIResource, error)
+	DescribeResource(ctx context.Context, clientset, cluster, namespace string, gvk schema.GroupVersionKind, name string) (*k8sapiv1.Resource, error)
+	ListResources(ctx context.Context, clientset, cluster, namespace string, gvk schema.GroupVersionKind, listOptions *k8sapiv1.ListOptions) ([]*k8sapiv1.Resource, error)
+	PatchResource(ctx context.Context, clientset, cluster, namespace string, gvk schema.GroupVersionKind, name string, patchType k8sapiv1.PatchResourceRequest_PatchType, patch *structpb.Value, dryRun, force bool) (*k8sapiv1.PatchResourceResponse, error)
+	DeleteResource(ctx context.Context, clientset, cluster, namespace string, gvk schema.GroupVersionKind, name string) error
 }
 
 type svc struct {
 	manager ClientsetManager
 
+	// The resources exposed by the dynamic resource APIs, and the mappers that resolve their kinds for each clientset.
+	dynamicResources []*k8sconfigv1.DynamicResource
+	restMappers      sync.Map
+	// Fields that can't be changed with PatchResource or the patches of the Update* APIs.
+	patchDeniedFields []string
+	// Namespaces in which workloads can't be scaled to zero.
+	scaleToZeroProtectedNamespaces []string
+
+	// The commands that can be run in containers, and the images and commands of debug containers.
+	execCommands       commandAllowlist
+	execTimeout        time.Duration
+	execMaxOutputBytes int
+	execSlots          *semaphore.Weighted
+	newExecutor        executorFactory
+	debugImages        []string
+	debugCommands      commandAllowlist
+
+	// The key of the HMAC digests of secret values.
+	secretDigestKey []byte
+
 	topologyObjectChan   chan *topologyv1.UpdateCacheRequest
 	topologyInformerLock *semaphore.Weighted
 	log                  *zap.Logger
@@ -120,12 +182,61 @@ type svc struct {
 }
 
 func NewWithClientsetManager(manager ClientsetManager, logger *zap.Logger, scope tally.Scope) (Service, error) {
+	return newService(manager, &k8sconfigv1.Config{}, logger, scope)
+}
+
+func newService(manager ClientsetManager, cfg *k8sconfigv1.Config, logger *zap.Logger, scope tally.Scope) (*svc, error) {
+	if err := validateDynamicResources(cfg.DynamicResources); err != nil {
+		return nil, err
+	}
+	execCommands, err := newCommandAllowlist(cfg.GetExec().GetAllowedCommands())
+	if err != nil {
+		return nil, err
+	}
+	debugCommands, err := newCommandAllowlist(cfg.GetDebugContainers().GetAllowedCommands())
+	if err != nil {
+		return nil, err
+	}
+
+	execTimeout := defaultExecTimeout
+	if cfg.GetExec().GetTimeout().AsDuration() > 0 {
+		execTimeout = cfg.GetExec().GetTimeout().AsDuration()
+	}
+	execMaxOutputBytes := defaultExecMaxOutputBytes
+	if cfg.GetExec().GetMaxOutputBytes() > 0 {
+		execMaxOutputBytes = int(cfg.GetExec().GetMaxOutputBytes())
+	}
+
+	secretDigestKey := []byte(cfg.SecretDigestKey)
+	if len(secretDigestKey) == 0 {
+		secretDigestKey = make([]byte, 32)
+		if _, err := rand.Read(secretDigestKey); err != nil {
+			return nil, fmt.Errorf("could not generate secret digest key: %w", err)
+		}
+	}
+
+	execMaxConcurrent := int64(defaultExecMaxConcurrent)
+	if cfg.GetExec().GetMaxConcurrent() > 0 {
+		execMaxConcurrent = int64(cfg.GetExec().GetMaxConcurrent())
+	}
+
 	return &svc{
-		manager:              manager,
-		topologyObjectChan:   make(chan *topologyv1.UpdateCacheRequest, topologyObjectChanBufferSize),
-		topologyInformerLock: semaphore.NewWeighted(1),
-		log:                  logger,
-		scope:                scope,
+		manager:                        manager,
+		dynamicResources:               cfg.DynamicResources,
+		patchDeniedFields:              cfg.PatchDeniedFields,
+		scaleToZeroProtectedNamespaces: cfg.ScaleToZeroProtectedNamespaces,
+		execCommands:                   execCommands,
+		execTimeout:                    execTimeout,
+		execMaxOutputBytes:             execMaxOutputBytes,
+		execSlots:                      semaphore.NewWeighted(execMaxConcurrent),
+		newExecutor:                    newSPDYExecutor,
+		debugImages:                    cfg.GetDebugContainers().GetAllowedImages(),
+		debugCommands:                  debugCommands,
+		secretDigestKey:                secretDigestKey,
+		topologyObjectChan:             make(chan *topologyv1.UpdateCacheRequest, topologyObjectChanBufferSize),
+		topologyInformerLock:           semaphore.NewWeighted(1),
+		log:                            logger,
+		scope:                          scope,
 	}, nil
 }
 
@@ -141,6 +252,57 @@ func (s *svc) Clientsets(ctx context.Context) ([]string, error) {
 	return ret, nil
 }
 
+// Check requests the server version from every clientset concurrently, returning once every request finished or
+// the context is done.
+func (s *svc) Check(ctx context.Context) error {
+	cs, err := s.manager.Clientsets(ctx)
+	if err != nil {
+		return err
+	}
+
+	var mu sync.Mutex
+	var failures []string
+	var wg sync.WaitGroup
+	for name, clientset := range cs {
+		wg.Add(1)
+		go func(name string, clientset ContextClientset) {
+			defer wg.Done()
+			if err := serverVersion(ctx, clientset.Discovery()); err != nil {
+				mu.Lock()
+				failures = append(failures, fmt.Sprintf("%s: %s", name, err))
+				mu.Unlock()
+			}
+		}(name, clientset)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if len(failures) > 0 {
+		sort.Strings(failures)
+		return fmt.Errorf("%d of %d clientset(s) unreachable: %s", len(failures), len(cs), strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// serverVersion requests the version with the context, which the discovery client's ServerVersion doesn't take.
+// Clients without a REST client, e.g. fakes, fall back to ServerVersion.
+func serverVersion(ctx context.Context, d discovery.DiscoveryInterface) error {
+	if rc := d.RESTClient(); rc != nil {
+		return rc.Get().AbsPath("/version").Do(ctx).Error()
+	}
+	_, err := d.ServerVersion()
+	return err
+}
+
 func (s *svc) GetK8sClientset(ctx context.Context, clientset string) (ContextClientset, error) {
 	// Dont specify cluster or namespace, were simply looking for the clientset.
 	return s.manager.GetK8sClientset(ctx, clientset, "", "")
@@ -2,6 +2,9 @@ package k8s
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
@@ -15,6 +18,11 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
 	restclient "k8s.io/client-go/rest"
 
 	k8sv1 "github.com/lyft/clutch/backend/api/config/service/k8s/v1"
@@ -164,3 +172,69 @@ func TestGetK8sClientset(t *testing.T) {
 	assert.Nil(t, cs)
 	assert.Contains(t, err.Error(), "clientset 'unknown' not found")
 }
+
+// The fake discovery client ignores reactor errors for version requests.
+type unreachableClientset struct {
+	*fake.Clientset
+}
+
+func (c unreachableClientset) Discovery() discovery.DiscoveryInterface {
+	return unreachableDiscovery{c.Clientset.Discovery().(*fakediscovery.FakeDiscovery)}
+}
+
+type unreachableDiscovery struct {
+	*fakediscovery.FakeDiscovery
+}
+
+func (unreachableDiscovery) ServerVersion() (*version.Info, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestCheck(t *testing.T) {
+	healthy := fake.NewSimpleClientset()
+	unhealthy := unreachableClientset{fake.NewSimpleClientset()}
+
+	s := &svc{
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{
+				"healthy":   {Interface: healthy, cluster: "healthy"},
+				"unhealthy": {Interface: unhealthy, cluster: "unhealthy"},
+			},
+		},
+	}
+	assert.EqualError(t, s.Check(context.Background()), "1 of 2 clientset(s) unreachable: unhealthy: connection refused")
+
+	delete(s.manager.(*managerImpl).clientsets, "unhealthy")
+	assert.NoError(t, s.Check(context.Background()))
+}
+
+func TestCheckHonorsContext(t *testing.T) {
+	block := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/version" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		select {
+		case <-block:
+		case <-r.Context().Done():
+		}
+		_, _ = w.Write([]byte(`{"major":"1","minor":"24"}`))
+	}))
+	defer srv.Close()
+	defer close(block)
+
+	cs, err := k8s.NewForConfig(&restclient.Config{Host: srv.URL})
+	assert.NoError(t, err)
+	s := &svc{
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{"slow": {Interface: cs, cluster: "slow"}},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	assert.ErrorIs(t, s.Check(ctx), context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
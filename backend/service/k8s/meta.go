@@ -13,18 +13,18 @@ import (
 // for all k8s resources and support field selectors
 func ApplyListOptions(listOpts *k8sapiv1.ListOptions) (metav1.ListOptions, error) {
 	opts := metav1.ListOptions{}
-	if len(listOpts.Labels) > 0 {
-		opts.LabelSelector = labels.FormatLabels(listOpts.Labels)
+	if len(listOpts.GetLabels()) > 0 {
+		opts.LabelSelector = labels.FormatLabels(listOpts.GetLabels())
 	}
 	// use the selector string as an addition
 	// Example: "!abc"
 	// Another example: "a=b,!c,d!=e"
-	if len(listOpts.SupplementalSelectorString) > 0 {
+	if len(listOpts.GetSupplementalSelectorString()) > 0 {
 		// If we already got a string from the labels, we need to add a comma
 		if len(opts.LabelSelector) > 0 {
 			opts.LabelSelector += ","
 		}
-		opts.LabelSelector += listOpts.SupplementalSelectorString
+		opts.LabelSelector += listOpts.GetSupplementalSelectorString()
 	}
 	// Parse() validates the selector string, and will give an err if it fails
 	_, err := labels.Parse(opts.LabelSelector)
@@ -0,0 +1,241 @@
+package k8s
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+// The field manager of server-side applies and other patches made by clutch.
+const fieldManager = "clutch"
+
+var patchTypes = map[k8sapiv1.PatchResourceRequest_PatchType]types.PatchType{
+	k8sapiv1.PatchResourceRequest_UNSPECIFIED:     types.MergePatchType,
+	k8sapiv1.PatchResourceRequest_MERGE:           types.MergePatchType,
+	k8sapiv1.PatchResourceRequest_JSON:            types.JSONPatchType,
+	k8sapiv1.PatchResourceRequest_STRATEGIC_MERGE: types.StrategicMergePatchType,
+	k8sapiv1.PatchResourceRequest_APPLY:           types.ApplyPatchType,
+}
+
+// patchData returns the encoded patch, pinned to the resource version so that the API server rejects it with a conflict
+// if the object has changed since it was read. The patch is pinned last so that it can't be overridden by the patch.
+func patchData(pt types.PatchType, patch *structpb.Value, gvk schema.GroupVersionKind, name, resourceVersion string) ([]byte, error) {
+	var body interface{}
+	if pt == types.JSONPatchType {
+		ops := patch.GetListValue().AsSlice()
+		if resourceVersion != "" {
+			ops = append(ops, map[string]interface{}{"op": "replace", "path": "/metadata/resourceVersion", "value": resourceVersion})
+		}
+		body = ops
+	} else {
+		obj := &unstructured.Unstructured{Object: patch.GetStructValue().AsMap()}
+		if pt == types.ApplyPatchType {
+			// The API server requires the type and name of the object in the applied configuration.
+			if obj.GetAPIVersion() == "" {
+				obj.SetAPIVersion(gvk.GroupVersion().String())
+			}
+			if obj.GetKind() == "" {
+				obj.SetKind(gvk.Kind)
+			}
+			if obj.GetName() == "" {
+				obj.SetName(name)
+			}
+		}
+		if resourceVersion != "" {
+			if err := unstructured.SetNestedField(obj.Object, resourceVersion, "metadata", "resourceVersion"); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid patch: %s", err)
+			}
+		}
+		body = obj.Object
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid patch: %s", err)
+	}
+	return data, nil
+}
+
+// isResourceVersionConflict returns whether the patch was rejected because the object changed since it was read, as
+// opposed to a conflict with the fields of another manager in a server-side apply, which retrying doesn't resolve.
+func isResourceVersionConflict(err error) bool {
+	if !apierrors.IsConflict(err) {
+		return false
+	}
+	if apiStatus, ok := err.(apierrors.APIStatus); ok && apiStatus.Status().Details != nil {
+		for _, c := range apiStatus.Status().Details.Causes {
+			if c.Type == metav1.CauseTypeFieldManagerConflict {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// withoutManagedFields returns a copy of the object without its managed fields, which change with every patch and
+// are only noise in a diff.
+func withoutManagedFields(obj *unstructured.Unstructured) map[string]interface{} {
+	obj = obj.DeepCopy()
+	obj.SetManagedFields(nil)
+	return obj.Object
+}
+
+// objectDiff returns a unified diff of the objects in YAML.
+func objectDiff(original, patched *unstructured.Unstructured) (string, error) {
+	a, err := yaml.Marshal(withoutManagedFields(original))
+	if err != nil {
+		return "", err
+	}
+	b, err := yaml.Marshal(withoutManagedFields(patched))
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(strings.TrimSuffix(string(a), "\n")),
+		B:        difflib.SplitLines(strings.TrimSuffix(string(b), "\n")),
+		FromFile: "original",
+		ToFile:   "patched",
+		Context:  3,
+	})
+}
+
+// changedFields calls fn with the path of every field that differs between a and b, including the nested fields of
+// values that were added, removed or replaced. Elements of lists are compared by index, and appear in the path as
+// "[i]".
+func changedFields(path []string, a, b interface{}, fn func(path []string)) {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		if bv, ok := b.(map[string]interface{}); ok {
+			keys := make(map[string]struct{}, len(av)+len(bv))
+			for k := range av {
+				keys[k] = struct{}{}
+			}
+			for k := range bv {
+				keys[k] = struct{}{}
+			}
+			for k := range keys {
+				changedFields(append(path[:len(path):len(path)], k), av[k], bv[k], fn)
+			}
+			return
+		}
+	case []interface{}:
+		if bv, ok := b.([]interface{}); ok {
+			for i := 0; i < len(av) || i < len(bv); i++ {
+				var ae, be interface{}
+				if i < len(av) {
+					ae = av[i]
+				}
+				if i < len(bv) {
+					be = bv[i]
+				}
+				changedFields(append(path[:len(path):len(path)], "["+strconv.Itoa(i)+"]"), ae, be, fn)
+			}
+			return
+		}
+	}
+
+	if reflect.DeepEqual(a, b) {
+		return
+	}
+	walkFields(path, a, fn)
+	walkFields(path, b, fn)
+}
+
+func walkFields(path []string, v interface{}, fn func(path []string)) {
+	if v == nil {
+		return
+	}
+	fn(path)
+	switch vv := v.(type) {
+	case map[string]interface{}:
+		for k, e := range vv {
+			walkFields(append(path[:len(path):len(path)], k), e, fn)
+		}
+	case []interface{}:
+		for i, e := range vv {
+			walkFields(append(path[:len(path):len(path)], "["+strconv.Itoa(i)+"]"), e, fn)
+		}
+	}
+}
+
+func fieldPathString(path []string) string {
+	var b strings.Builder
+	for i, p := range path {
+		if i > 0 && !strings.HasPrefix(p, "[") {
+			b.WriteByte('.')
+		}
+		b.WriteString(p)
+	}
+	return b.String()
+}
+
+// isFieldDenied checks whether the denied field, e.g. "spec.hostNetwork", appears anywhere in the path.
+func isFieldDenied(path []string, denied string) bool {
+	var names []string
+	for _, p := range path {
+		if !strings.HasPrefix(p, "[") {
+			names = append(names, p)
+		}
+	}
+
+	want := strings.Split(denied, ".")
+	for i := 0; i+len(want) <= len(names); i++ {
+		if reflect.DeepEqual(names[i:i+len(want)], want) {
+			return true
+		}
+	}
+	return false
+}
+
+// deniedChanges returns the sorted paths of the fields changed between the objects that match any of the denied
+// fields.
+func deniedChanges(original, patched *unstructured.Unstructured, deniedFields []string) []string {
+	if len(deniedFields) == 0 {
+		return nil
+	}
+
+	found := map[string]struct{}{}
+	changedFields(nil, withoutManagedFields(original), withoutManagedFields(patched), func(path []string) {
+		for _, denied := range deniedFields {
+			if isFieldDenied(path, denied) {
+				found[fieldPathString(path)] = struct{}{}
+				return
+			}
+		}
+	})
+
+	paths := make([]string, 0, len(found))
+	for p := range found {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	// Only the outermost of nested fields is returned, e.g. "securityContext" and not also "securityContext.runAsUser".
+	var ret []string
+outer:
+	for _, p := range paths {
+		for _, r := range ret {
+			if strings.HasPrefix(p, r+".") || strings.HasPrefix(p, r+"[") {
+				continue outer
+			}
+		}
+		ret = append(ret, p)
+	}
+	return ret
+}
@@ -0,0 +1,99 @@
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func testPatchedPod(containers ...interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]interface{}{"name": "p1", "namespace": "default"},
+		"spec":       map[string]interface{}{"containers": containers},
+	}}
+}
+
+func TestIsFieldDenied(t *testing.T) {
+	tests := []struct {
+		path     []string
+		denied   string
+		expected bool
+	}{
+		{path: []string{"spec", "containers", "[0]", "securityContext"}, denied: "securityContext", expected: true},
+		{path: []string{"spec", "containers", "[0]", "securityContext", "runAsUser"}, denied: "securityContext", expected: true},
+		{path: []string{"spec", "securityContextConstraints"}, denied: "securityContext", expected: false},
+		{path: []string{"spec", "template", "spec", "hostNetwork"}, denied: "spec.hostNetwork", expected: true},
+		{path: []string{"spec", "hostNetwork"}, denied: "spec.template.spec.hostNetwork", expected: false},
+		{path: []string{"spec", "containers", "[0]", "image"}, denied: "containers.image", expected: true},
+		{path: []string{"metadata", "labels"}, denied: "spec", expected: false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, isFieldDenied(tt.path, tt.denied), "%v %s", tt.path, tt.denied)
+	}
+}
+
+func TestDeniedChanges(t *testing.T) {
+	original := testPatchedPod(
+		map[string]interface{}{"name": "app", "image": "app:1"},
+	)
+
+	// Changing the image isn't denied.
+	patched := testPatchedPod(
+		map[string]interface{}{"name": "app", "image": "app:2"},
+	)
+	assert.Empty(t, deniedChanges(original, patched, []string{"securityContext"}))
+	assert.Equal(t, []string{"spec.containers[0].image"}, deniedChanges(original, patched, []string{"image"}))
+
+	// Nested fields of an added container are checked, and only the outermost denied field is returned.
+	patched = testPatchedPod(
+		map[string]interface{}{"name": "app", "image": "app:1"},
+		map[string]interface{}{
+			"name":            "sidecar",
+			"image":           "sidecar:1",
+			"securityContext": map[string]interface{}{"privileged": true},
+		},
+	)
+	assert.Equal(t, []string{"spec.containers[1].securityContext"}, deniedChanges(original, patched, []string{"securityContext"}))
+
+	// Removed fields are checked too.
+	original = patched
+	patched = testPatchedPod(
+		map[string]interface{}{"name": "app", "image": "app:1"},
+		map[string]interface{}{"name": "sidecar", "image": "sidecar:1"},
+	)
+	assert.Equal(t, []string{"spec.containers[1].securityContext"}, deniedChanges(original, patched, []string{"securityContext"}))
+
+	// Changes to managed fields are ignored.
+	patched = original.DeepCopy()
+	patched.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: fieldManager}})
+	assert.Empty(t, deniedChanges(original, patched, []string{"managedFields"}))
+
+	assert.Empty(t, deniedChanges(original, patched, nil))
+}
+
+func TestObjectDiff(t *testing.T) {
+	original := testPatchedPod(map[string]interface{}{"name": "app", "image": "app:1"})
+	patched := testPatchedPod(map[string]interface{}{"name": "app", "image": "app:2"})
+	patched.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: fieldManager}})
+
+	diff, err := objectDiff(original, patched)
+	assert.NoError(t, err)
+	assert.Equal(t, `--- original
++++ patched
+@@ -5,5 +5,5 @@
+   namespace: default
+ spec:
+   containers:
+-  - image: app:1
++  - image: app:2
+     name: app
+`, diff)
+
+	diff, err = objectDiff(original, original)
+	assert.NoError(t, err)
+	assert.Empty(t, diff)
+}
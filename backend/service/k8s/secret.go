@@ -0,0 +1,186 @@
+package k8s
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+func (s *svc) ListSecrets(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sapiv1.ListOptions) ([]*k8sapiv1.Secret, error) {
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := ApplyListOptions(listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	secretList, err := cs.CoreV1().Secrets(cs.Namespace()).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var secrets []*k8sapiv1.Secret
+	for i := range secretList.Items {
+		secrets = append(secrets, s.protoForSecret(cs.Cluster(), &secretList.Items[i]))
+	}
+	return secrets, nil
+}
+
+func (s *svc) DescribeSecret(ctx context.Context, clientset, cluster, namespace, name string) (*k8sapiv1.Secret, error) {
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := cs.CoreV1().Secrets(cs.Namespace()).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return s.protoForSecret(cs.Cluster(), secret), nil
+}
+
+// UpdateSecret sets and removes keys of the secret, leaving the other keys unchanged. If requested, the deployments
+// using the secret are restarted afterwards and their names returned. If authorizeRestart is not nil, it's called with
+// the names of the deployments before the secret is updated, and an error leaves everything unchanged. If restarting
+// fails, the updated secret and the deployments that were restarted are returned with the error.
+func (s *svc) UpdateSecret(ctx context.Context, clientset, cluster, namespace, name string, data map[string][]byte, removeKeys []string, restartDependentDeployments bool, authorizeRestart func(deployments []string) error) (*k8sapiv1.Secret, []string, error) {
+	for _, key := range removeKeys {
+		if _, ok := data[key]; ok {
+			return nil, nil, status.Errorf(codes.InvalidArgument, "key '%s' can't be both updated and removed", key)
+		}
+	}
+
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var dependents []appsv1.Deployment
+	if restartDependentDeployments {
+		dependents, err = dependentDeployments(ctx, cs, func(spec *v1.PodSpec) bool { return podSpecUsesSecret(spec, name) })
+		if err != nil {
+			return nil, nil, err
+		}
+		if authorizeRestart != nil {
+			if err := authorizeRestart(deploymentNames(dependents)); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	var secret *v1.Secret
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := cs.CoreV1().Secrets(cs.Namespace()).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if current.Data == nil && len(data) > 0 {
+			current.Data = make(map[string][]byte, len(data))
+		}
+		for k, v := range data {
+			current.Data[k] = v
+		}
+		for _, k := range removeKeys {
+			delete(current.Data, k)
+		}
+
+		secret, err = cs.CoreV1().Secrets(cs.Namespace()).Update(ctx, current, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var restarted []string
+	if restartDependentDeployments {
+		restarted, err = restartDeployments(ctx, cs, dependents)
+	}
+	return s.protoForSecret(cs.Cluster(), secret), restarted, err
+}
+
+// podSpecUsesSecret returns whether the pods mount the secret or read it into their environment.
+func podSpecUsesSecret(spec *v1.PodSpec, name string) bool {
+	for _, volume := range spec.Volumes {
+		if volume.Secret != nil && volume.Secret.SecretName == name {
+			return true
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.Secret != nil && source.Secret.Name == name {
+					return true
+				}
+			}
+		}
+	}
+
+	return anyContainer(spec, func(container *v1.Container) bool {
+		for _, envFrom := range container.EnvFrom {
+			if envFrom.SecretRef != nil && envFrom.SecretRef.Name == name {
+				return true
+			}
+		}
+		for _, env := range container.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil && env.ValueFrom.SecretKeyRef.Name == name {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// protoForSecret only describes the keys of the secret, the values and anything holding them are left out.
+func (s *svc) protoForSecret(cluster string, secret *v1.Secret) *k8sapiv1.Secret {
+	clusterName := secret.ClusterName
+	if clusterName == "" {
+		clusterName = cluster
+	}
+
+	keys := make([]*k8sapiv1.Secret_Key, 0, len(secret.Data))
+	for name, value := range secret.Data {
+		mac := hmac.New(sha256.New, s.secretDigestKey)
+		mac.Write(value)
+		keys = append(keys, &k8sapiv1.Secret_Key{
+			Name:       name,
+			SizeBytes:  uint64(len(value)),
+			HmacSha256: hex.EncodeToString(mac.Sum(nil)),
+		})
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Name < keys[j].Name })
+
+	// Secrets created with `kubectl apply` hold their values in the last applied configuration.
+	var annotations map[string]string
+	for k, v := range secret.Annotations {
+		if k == v1.LastAppliedConfigAnnotation {
+			continue
+		}
+		if annotations == nil {
+			annotations = make(map[string]string, len(secret.Annotations))
+		}
+		annotations[k] = v
+	}
+
+	return &k8sapiv1.Secret{
+		Cluster:     clusterName,
+		Namespace:   secret.Namespace,
+		Name:        secret.Name,
+		Labels:      secret.Labels,
+		Annotations: annotations,
+		Type:        string(secret.Type),
+		Keys:        keys,
+	}
+}
@@ -0,0 +1,174 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	k8sv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+func testSecretService(cs *fake.Clientset) *svc {
+	return &svc{
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{"testing-clientset": {
+				Interface: cs,
+				namespace: "testing-namespace",
+				cluster:   "testing-cluster",
+			}},
+		},
+		secretDigestKey: []byte("testing-key"),
+	}
+}
+
+func testSecret() *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testing-secret-name",
+			Namespace: "testing-namespace",
+			Labels:    map[string]string{"foo": "bar"},
+			Annotations: map[string]string{
+				"owner":                        "team",
+				v1.LastAppliedConfigAnnotation: `{"data":{"password":"cGFzc3dvcmQ="}}`,
+			},
+		},
+		Type: v1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": []byte("password"),
+		},
+	}
+}
+
+func TestDescribeSecret(t *testing.T) {
+	t.Parallel()
+
+	s := testSecretService(fake.NewSimpleClientset(testSecret()))
+
+	secret, err := s.DescribeSecret(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace", "testing-secret-name")
+	assert.NoError(t, err)
+	assert.Equal(t, &k8sv1.Secret{
+		Cluster:     "testing-cluster",
+		Namespace:   "testing-namespace",
+		Name:        "testing-secret-name",
+		Labels:      map[string]string{"foo": "bar"},
+		Annotations: map[string]string{"owner": "team"},
+		Type:        "Opaque",
+		Keys: []*k8sv1.Secret_Key{
+			{Name: "password", SizeBytes: 8, HmacSha256: "56feb7851d51c82a0904b7100d044d09b28710f627dc97ed9418c6bb833960e2"},
+			{Name: "username", SizeBytes: 5, HmacSha256: "66d3ca027ce84072bc74733d4def954ad887a466b2f5acc5c97872808c3c21a3"},
+		},
+	}, secret)
+
+	_, err = s.DescribeSecret(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace", "missing")
+	assert.Error(t, err)
+
+	// The digests can't be compared with the digests of guessed values without the key.
+	s.secretDigestKey = []byte("other-key")
+	other, err := s.DescribeSecret(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace", "testing-secret-name")
+	assert.NoError(t, err)
+	assert.NotEqual(t, secret.Keys[0].HmacSha256, other.Keys[0].HmacSha256)
+}
+
+func TestListSecrets(t *testing.T) {
+	t.Parallel()
+
+	other := testSecret()
+	other.Name = "other"
+	other.Labels = nil
+	s := testSecretService(fake.NewSimpleClientset(testSecret(), other))
+
+	secrets, err := s.ListSecrets(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace",
+		&k8sv1.ListOptions{Labels: map[string]string{"foo": "bar"}})
+	assert.NoError(t, err)
+	assert.Len(t, secrets, 1)
+	assert.Equal(t, "testing-secret-name", secrets[0].Name)
+	assert.Len(t, secrets[0].Keys, 2)
+}
+
+func TestUpdateSecret(t *testing.T) {
+	t.Parallel()
+
+	cs := fake.NewSimpleClientset(
+		testSecret(),
+		testDependentDeployment("mounts", v1.PodSpec{
+			Volumes: []v1.Volume{{
+				Name: "credentials",
+				VolumeSource: v1.VolumeSource{
+					Projected: &v1.ProjectedVolumeSource{Sources: []v1.VolumeProjection{{
+						Secret: &v1.SecretProjection{LocalObjectReference: v1.LocalObjectReference{Name: "testing-secret-name"}},
+					}}},
+				},
+			}},
+		}),
+		testDependentDeployment("env", v1.PodSpec{
+			Containers: []v1.Container{{
+				Name:    "app",
+				EnvFrom: []v1.EnvFromSource{{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "other"}}}},
+			}},
+		}),
+	)
+	s := testSecretService(cs)
+
+	secret, restarted, err := s.UpdateSecret(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace", "testing-secret-name",
+		map[string][]byte{"token": []byte("abc")}, []string{"username"}, true, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"mounts"}, restarted)
+	assert.Len(t, secret.Keys, 2)
+	assert.Equal(t, "password", secret.Keys[0].Name)
+	assert.Equal(t, "token", secret.Keys[1].Name)
+	assert.Equal(t, uint64(3), secret.Keys[1].SizeBytes)
+
+	stored, err := cs.CoreV1().Secrets("testing-namespace").Get(context.Background(), "testing-secret-name", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"password": []byte("password"), "token": []byte("abc")}, stored.Data)
+
+	_, _, err = s.UpdateSecret(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace", "testing-secret-name",
+		map[string][]byte{"token": []byte("def")}, []string{"token"}, false, nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The updated secret and the deployments restarted before a failure are returned with the error.
+	cs.PrependReactor("patch", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("deployment restart failed")
+	})
+	secret, restarted, err = s.UpdateSecret(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace", "testing-secret-name",
+		map[string][]byte{"token": []byte("def")}, nil, true, nil)
+	assert.EqualError(t, err, "deployment restart failed")
+	assert.Empty(t, restarted)
+	assert.Equal(t, uint64(3), secret.Keys[1].SizeBytes)
+}
+
+func TestPodSpecUsesSecret(t *testing.T) {
+	t.Parallel()
+
+	spec := &v1.PodSpec{
+		Volumes: []v1.Volume{{
+			Name:         "tls",
+			VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "tls"}},
+		}},
+		Containers: []v1.Container{{
+			Name: "app",
+			Env: []v1.EnvVar{{
+				Name: "PASSWORD",
+				ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "db"},
+					Key:                  "password",
+				}},
+			}},
+		}},
+	}
+	assert.True(t, podSpecUsesSecret(spec, "tls"))
+	assert.True(t, podSpecUsesSecret(spec, "db"))
+	assert.False(t, podSpecUsesSecret(spec, "other"))
+	// Config maps with the same name aren't secrets.
+	assert.False(t, podSpecUsesConfigMap(spec, "tls"))
+}
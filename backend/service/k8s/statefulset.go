@@ -131,6 +131,10 @@ func mergeStatefulSetLabelsAndAnnotations(statefulSet *appsv1.StatefulSet, field
 	}
 }
 
+func (s *svc) PatchStatefulSet(ctx context.Context, clientset, cluster, namespace, name string, patch *k8sapiv1.ResourcePatch) (string, error) {
+	return s.patchTypedObject(ctx, clientset, cluster, namespace, appsv1.SchemeGroupVersion.WithKind("StatefulSet"), "statefulsets", name, patch)
+}
+
 func (s *svc) DeleteStatefulSet(ctx context.Context, clientset, cluster, namespace, name string) error {
 	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
 	if err != nil {
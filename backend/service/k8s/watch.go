@@ -0,0 +1,339 @@
+package k8s
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iancoleman/strcase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+
+	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+// How long to wait before watching again when the API server closes a watch without sending any events.
+var rewatchInterval = time.Second
+
+// A clientset to watch, with the resource version to start from.
+type watchTarget struct {
+	name            string
+	cs              ContextClientset
+	resourceVersion string
+}
+
+type watchResult[T any] struct {
+	event  *k8sapiv1.WatchEvent
+	object T
+}
+
+type watchFunc func(ctx context.Context, cs ContextClientset, opts metav1.ListOptions) (watch.Interface, error)
+
+// watchTargets returns the clientsets to watch. If neither a clientset nor a cluster is specified every clientset is
+// watched, and if only the cluster is specified every clientset of the cluster is watched.
+func (s *svc) watchTargets(ctx context.Context, clientset, cluster, namespace string, resourceVersions map[string]string) ([]watchTarget, error) {
+	var names []string
+	if clientset != "" {
+		names = []string{clientset}
+	} else {
+		all, err := s.manager.Clientsets(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for name, cs := range all {
+			if cluster == "" || cs.Cluster() == cluster {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			return nil, status.Errorf(codes.NotFound, "no clientsets found for cluster '%s'", cluster)
+		}
+		sort.Strings(names)
+	}
+
+	targets := make([]watchTarget, 0, len(names))
+	for _, name := range names {
+		cs, err := s.manager.GetK8sClientset(ctx, name, cluster, namespace)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, watchTarget{name: name, cs: cs, resourceVersion: resourceVersions[name]})
+	}
+	return targets, nil
+}
+
+// watchResources watches every target concurrently and calls the handler with their events one at a time. It returns
+// when the context is done, the handler returns an error or the watch of every target has failed.
+func watchResources[T any](ctx context.Context, targets []watchTarget, opts metav1.ListOptions, watchFn watchFunc, convert func(cluster string, obj runtime.Object) (T, bool), handler func(*k8sapiv1.WatchEvent, T) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ch := make(chan watchResult[T])
+	var wg sync.WaitGroup
+	for _, t := range targets {
+		wg.Add(1)
+		go func(t watchTarget) {
+			defer wg.Done()
+			watchTargetResources(ctx, t, opts, watchFn, convert, ch)
+		}(t)
+	}
+	go func() {
+		wg.Wait()
+		close(ch)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case r, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := handler(r.event, r.object); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// watchTargetResources watches a single target, watching again from the last resource version whenever the API
+// server closes the watch. Failures are sent as an error event and end the watch of the target.
+func watchTargetResources[T any](ctx context.Context, t watchTarget, opts metav1.ListOptions, watchFn watchFunc, convert func(cluster string, obj runtime.Object) (T, bool), ch chan<- watchResult[T]) {
+	send := func(r watchResult[T]) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case ch <- r:
+			return true
+		}
+	}
+	sendError := func(err error) {
+		send(watchResult[T]{event: &k8sapiv1.WatchEvent{
+			Type:            k8sapiv1.WatchEvent_ERROR,
+			Clientset:       t.name,
+			Cluster:         t.cs.Cluster(),
+			ResourceVersion: t.resourceVersion,
+			Status:          status.Convert(ConvertError(err)).Proto(),
+		}})
+	}
+
+	opts.AllowWatchBookmarks = true
+	for {
+		opts.ResourceVersion = t.resourceVersion
+		w, err := watchFn(ctx, t.cs, opts)
+		if err != nil {
+			if ctx.Err() == nil {
+				sendError(err)
+			}
+			return
+		}
+
+		received := false
+		for ev := range w.ResultChan() {
+			received = true
+			if ev.Type == watch.Error {
+				w.Stop()
+				sendError(k8serrors.FromObject(ev.Object))
+				return
+			}
+
+			accessor, err := meta.Accessor(ev.Object)
+			if err != nil {
+				w.Stop()
+				sendError(err)
+				return
+			}
+			t.resourceVersion = accessor.GetResourceVersion()
+
+			r := watchResult[T]{event: &k8sapiv1.WatchEvent{
+				Clientset:       t.name,
+				Cluster:         t.cs.Cluster(),
+				ResourceVersion: t.resourceVersion,
+			}}
+			switch ev.Type {
+			case watch.Added:
+				r.event.Type = k8sapiv1.WatchEvent_ADDED
+			case watch.Modified:
+				r.event.Type = k8sapiv1.WatchEvent_MODIFIED
+			case watch.Deleted:
+				r.event.Type = k8sapiv1.WatchEvent_DELETED
+			case watch.Bookmark:
+				r.event.Type = k8sapiv1.WatchEvent_BOOKMARK
+			}
+			if r.event.Type != k8sapiv1.WatchEvent_BOOKMARK {
+				obj, ok := convert(t.cs.Cluster(), ev.Object)
+				if !ok {
+					continue
+				}
+				r.object = obj
+			}
+
+			if !send(r) {
+				w.Stop()
+				return
+			}
+		}
+		w.Stop()
+
+		// The API server closes watches after a timeout, in which case the watch resumes where it left off.
+		if !received {
+			select {
+			case <-ctx.Done():
+			case <-time.After(rewatchInterval):
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (s *svc) WatchPods(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sapiv1.ListOptions, resourceVersions map[string]string, handler func(*k8sapiv1.WatchEvent, *k8sapiv1.Pod) error) error {
+	opts, err := ApplyListOptions(listOptions)
+	if err != nil {
+		return err
+	}
+	targets, err := s.watchTargets(ctx, clientset, cluster, namespace, resourceVersions)
+	if err != nil {
+		return err
+	}
+
+	return watchResources(ctx, targets, opts,
+		func(ctx context.Context, cs ContextClientset, opts metav1.ListOptions) (watch.Interface, error) {
+			return cs.CoreV1().Pods(cs.Namespace()).Watch(ctx, opts)
+		},
+		func(cluster string, obj runtime.Object) (*k8sapiv1.Pod, bool) {
+			pod, ok := obj.(*corev1.Pod)
+			if !ok {
+				return nil, false
+			}
+			return podDescription(pod, cluster), true
+		},
+		handler,
+	)
+}
+
+func (s *svc) WatchDeployments(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sapiv1.ListOptions, resourceVersions map[string]string, handler func(*k8sapiv1.WatchEvent, *k8sapiv1.Deployment) error) error {
+	opts, err := ApplyListOptions(listOptions)
+	if err != nil {
+		return err
+	}
+	targets, err := s.watchTargets(ctx, clientset, cluster, namespace, resourceVersions)
+	if err != nil {
+		return err
+	}
+
+	return watchResources(ctx, targets, opts,
+		func(ctx context.Context, cs ContextClientset, opts metav1.ListOptions) (watch.Interface, error) {
+			return cs.AppsV1().Deployments(cs.Namespace()).Watch(ctx, opts)
+		},
+		func(cluster string, obj runtime.Object) (*k8sapiv1.Deployment, bool) {
+			deployment, ok := obj.(*appsv1.Deployment)
+			if !ok {
+				return nil, false
+			}
+			return ProtoForDeployment(cluster, deployment), true
+		},
+		handler,
+	)
+}
+
+func (s *svc) WatchHPAs(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sapiv1.ListOptions, resourceVersions map[string]string, handler func(*k8sapiv1.WatchEvent, *k8sapiv1.HPA) error) error {
+	opts, err := ApplyListOptions(listOptions)
+	if err != nil {
+		return err
+	}
+	targets, err := s.watchTargets(ctx, clientset, cluster, namespace, resourceVersions)
+	if err != nil {
+		return err
+	}
+
+	return watchResources(ctx, targets, opts,
+		func(ctx context.Context, cs ContextClientset, opts metav1.ListOptions) (watch.Interface, error) {
+			return cs.AutoscalingV1().HorizontalPodAutoscalers(cs.Namespace()).Watch(ctx, opts)
+		},
+		func(cluster string, obj runtime.Object) (*k8sapiv1.HPA, bool) {
+			hpa, ok := obj.(*autoscalingv1.HorizontalPodAutoscaler)
+			if !ok || hpa.Spec.MinReplicas == nil {
+				return nil, false
+			}
+			return ProtoForHPA(cluster, hpa), true
+		},
+		handler,
+	)
+}
+
+func (s *svc) WatchNodes(ctx context.Context, clientset, cluster string, listOptions *k8sapiv1.ListOptions, resourceVersions map[string]string, handler func(*k8sapiv1.WatchEvent, *k8sapiv1.Node) error) error {
+	opts, err := ApplyListOptions(listOptions)
+	if err != nil {
+		return err
+	}
+	// Node is a cluster level object, so namespace should be ""
+	targets, err := s.watchTargets(ctx, clientset, cluster, metav1.NamespaceAll, resourceVersions)
+	if err != nil {
+		return err
+	}
+
+	return watchResources(ctx, targets, opts,
+		func(ctx context.Context, cs ContextClientset, opts metav1.ListOptions) (watch.Interface, error) {
+			return cs.CoreV1().Nodes().Watch(ctx, opts)
+		},
+		func(cluster string, obj runtime.Object) (*k8sapiv1.Node, bool) {
+			node, ok := obj.(*corev1.Node)
+			if !ok {
+				return nil, false
+			}
+			return ProtoForNode(cluster, node), true
+		},
+		handler,
+	)
+}
+
+func (s *svc) WatchEvents(ctx context.Context, clientset, cluster, namespace, object string, kind k8sapiv1.ObjectKind, listOptions *k8sapiv1.ListOptions, resourceVersions map[string]string, handler func(*k8sapiv1.WatchEvent, *k8sapiv1.Event) error) error {
+	opts, err := ApplyListOptions(listOptions)
+	if err != nil {
+		return err
+	}
+	targets, err := s.watchTargets(ctx, clientset, cluster, namespace, resourceVersions)
+	if err != nil {
+		return err
+	}
+
+	return watchResources(ctx, targets, opts,
+		func(ctx context.Context, cs ContextClientset, opts metav1.ListOptions) (watch.Interface, error) {
+			events := cs.CoreV1().Events(cs.Namespace())
+			if object != "" {
+				// Only watch the events about the object, as ListEvents does.
+				var objNamespace, objKind *string
+				if namespace != "" {
+					objNamespace = &namespace
+				}
+				if kind != k8sapiv1.ObjectKind_UNSPECIFIED {
+					k := strcase.ToCamel(strings.ToLower(kind.String()))
+					objKind = &k
+				}
+				opts.FieldSelector = events.GetFieldSelector(&object, objNamespace, objKind, nil).String()
+			}
+			return events.Watch(ctx, opts)
+		},
+		func(cluster string, obj runtime.Object) (*k8sapiv1.Event, bool) {
+			event, ok := obj.(*corev1.Event)
+			if !ok {
+				return nil, false
+			}
+			return ProtoForEvent(cluster, event), true
+		},
+		handler,
+	)
+}
@@ -0,0 +1,265 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+// testWatchClientset returns the watchers in order for each watch request, recording the options of the requests.
+type testWatchClientset struct {
+	*fake.Clientset
+
+	mu       sync.Mutex
+	watchers []*watch.FakeWatcher
+	opts     []metav1.ListOptions
+}
+
+func newTestWatchClientset(watchers ...*watch.FakeWatcher) *testWatchClientset {
+	cs := &testWatchClientset{Clientset: fake.NewSimpleClientset(), watchers: watchers}
+	cs.PrependWatchReactor("*", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		cs.mu.Lock()
+		defer cs.mu.Unlock()
+
+		r := action.(k8stesting.WatchActionImpl).WatchRestrictions
+		cs.opts = append(cs.opts, metav1.ListOptions{LabelSelector: r.Labels.String(), ResourceVersion: r.ResourceVersion})
+		if len(cs.watchers) == 0 {
+			return true, nil, errors.New("no more watchers")
+		}
+		w := cs.watchers[0]
+		cs.watchers = cs.watchers[1:]
+		return true, w, nil
+	})
+	return cs
+}
+
+func (c *testWatchClientset) watchOpts() []metav1.ListOptions {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]metav1.ListOptions(nil), c.opts...)
+}
+
+func testPod(name, resourceVersion string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns", ResourceVersion: resourceVersion}}
+}
+
+// Returns a watcher that sends the events and then closes, like the API server does when a watch times out.
+func closedWatcher(events ...watch.Event) *watch.FakeWatcher {
+	w := watch.NewFakeWithChanSize(len(events), false)
+	for _, ev := range events {
+		w.Action(ev.Type, ev.Object)
+	}
+	w.Stop()
+	return w
+}
+
+type watchedPod struct {
+	event *k8sapiv1.WatchEvent
+	pod   *k8sapiv1.Pod
+}
+
+func TestWatchPods(t *testing.T) {
+	rewatchInterval = time.Millisecond
+
+	csA := newTestWatchClientset(
+		closedWatcher(
+			watch.Event{Type: watch.Added, Object: testPod("pod1", "10")},
+			watch.Event{Type: watch.Bookmark, Object: testPod("", "11")},
+		),
+		closedWatcher(watch.Event{Type: watch.Modified, Object: testPod("pod1", "12")}),
+		closedWatcher(watch.Event{Type: watch.Error, Object: &metav1.Status{
+			Status: metav1.StatusFailure, Code: 410, Reason: metav1.StatusReasonExpired, Message: "too old resource version",
+		}}),
+	)
+	csB := newTestWatchClientset(closedWatcher(watch.Event{Type: watch.Deleted, Object: testPod("pod2", "20")}))
+
+	s := &svc{
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{
+				"a": {Interface: csA, namespace: "default", cluster: "cluster-a"},
+				"b": {Interface: csB, namespace: "default", cluster: "cluster-b"},
+			},
+		},
+	}
+
+	var got []watchedPod
+
+	done := make(chan error)
+	go func() {
+		done <- s.WatchPods(context.Background(), "", "", "ns", &k8sapiv1.ListOptions{Labels: map[string]string{"app": "foo"}}, map[string]string{"a": "5"},
+			func(event *k8sapiv1.WatchEvent, pod *k8sapiv1.Pod) error {
+				got = append(got, watchedPod{event, pod})
+				return nil
+			})
+	}()
+
+	// The watch ends once the watch of every clientset has failed.
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("watch did not end")
+	}
+
+	byClientset := map[string][]watchedPod{}
+	for _, w := range got {
+		byClientset[w.event.Clientset] = append(byClientset[w.event.Clientset], w)
+	}
+
+	a := byClientset["a"]
+	assert.Len(t, a, 4)
+	assert.Equal(t, k8sapiv1.WatchEvent_ADDED, a[0].event.Type)
+	assert.Equal(t, "cluster-a", a[0].event.Cluster)
+	assert.Equal(t, "10", a[0].event.ResourceVersion)
+	assert.Equal(t, "pod1", a[0].pod.Name)
+	assert.Equal(t, "cluster-a", a[0].pod.Cluster)
+	assert.Equal(t, k8sapiv1.WatchEvent_BOOKMARK, a[1].event.Type)
+	assert.Equal(t, "11", a[1].event.ResourceVersion)
+	assert.Nil(t, a[1].pod)
+	assert.Equal(t, k8sapiv1.WatchEvent_MODIFIED, a[2].event.Type)
+	assert.Equal(t, k8sapiv1.WatchEvent_ERROR, a[3].event.Type)
+	assert.Equal(t, "12", a[3].event.ResourceVersion)
+	assert.Equal(t, "too old resource version", a[3].event.Status.Message)
+
+	b := byClientset["b"]
+	assert.Len(t, b, 2)
+	assert.Equal(t, k8sapiv1.WatchEvent_DELETED, b[0].event.Type)
+	assert.Equal(t, "pod2", b[0].pod.Name)
+	assert.Equal(t, k8sapiv1.WatchEvent_ERROR, b[1].event.Type)
+	assert.Equal(t, "no more watchers", b[1].event.Status.Message)
+
+	// Watches resume from the requested resource version, then from the last event received.
+	opts := csA.watchOpts()
+	assert.Len(t, opts, 3)
+	assert.Equal(t, "app=foo", opts[0].LabelSelector)
+	assert.Equal(t, "5", opts[0].ResourceVersion)
+	assert.Equal(t, "11", opts[1].ResourceVersion)
+	assert.Equal(t, "12", opts[2].ResourceVersion)
+	assert.Equal(t, "", csB.watchOpts()[0].ResourceVersion)
+	assert.Equal(t, "20", csB.watchOpts()[1].ResourceVersion)
+}
+
+func TestWatchPodsAllFailed(t *testing.T) {
+	cs := newTestWatchClientset()
+	cs.PrependWatchReactor("*", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		return true, nil, k8serrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "", errors.New("denied"))
+	})
+	s := &svc{
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{"a": {Interface: cs, namespace: "default", cluster: "cluster-a"}},
+		},
+	}
+
+	var events []*k8sapiv1.WatchEvent
+	err := s.WatchPods(context.Background(), "a", "", "ns", nil, nil, func(event *k8sapiv1.WatchEvent, pod *k8sapiv1.Pod) error {
+		events = append(events, event)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, k8sapiv1.WatchEvent_ERROR, events[0].Type)
+	assert.Equal(t, int32(codes.PermissionDenied), events[0].Status.Code)
+}
+
+func TestWatchHandlerError(t *testing.T) {
+	w := watch.NewFake()
+	cs := newTestWatchClientset(w)
+	s := &svc{
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{"a": {Interface: cs, namespace: "default", cluster: "cluster-a"}},
+		},
+	}
+	go w.Add(&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1", ResourceVersion: "1"}})
+
+	handlerErr := errors.New("client went away")
+	err := s.WatchNodes(context.Background(), "", "cluster-a", nil, nil, func(event *k8sapiv1.WatchEvent, node *k8sapiv1.Node) error {
+		assert.Equal(t, "node1", node.Name)
+		return handlerErr
+	})
+	assert.Equal(t, handlerErr, err)
+}
+
+func TestWatchEvents(t *testing.T) {
+	cs := newTestWatchClientset(closedWatcher(watch.Event{Type: watch.Added, Object: &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "event1", Namespace: "ns", ResourceVersion: "3"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "pod1", Namespace: "ns"},
+	}}))
+	s := &svc{
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{"a": {Interface: cs, namespace: "default", cluster: "cluster-a"}},
+		},
+	}
+
+	var events []*k8sapiv1.Event
+	err := s.WatchEvents(context.Background(), "a", "", "ns", "pod1", k8sapiv1.ObjectKind_POD, &k8sapiv1.ListOptions{Labels: map[string]string{"app": "foo"}}, nil,
+		func(event *k8sapiv1.WatchEvent, k8sEvent *k8sapiv1.Event) error {
+			if k8sEvent != nil {
+				events = append(events, k8sEvent)
+			}
+			return nil
+		})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "event1", events[0].Name)
+
+	assert.Equal(t, "app=foo", cs.watchOpts()[0].LabelSelector)
+
+	err = s.WatchEvents(context.Background(), "a", "", "ns", "", k8sapiv1.ObjectKind_UNSPECIFIED, &k8sapiv1.ListOptions{SupplementalSelectorString: "a=(b"}, nil,
+		func(*k8sapiv1.WatchEvent, *k8sapiv1.Event) error { return nil })
+	assert.Error(t, err)
+}
+
+func TestWatchTargets(t *testing.T) {
+	s := &svc{
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{
+				"a":  {namespace: "default", cluster: "cluster-a"},
+				"a2": {namespace: "default", cluster: "cluster-a"},
+				"b":  {namespace: "default", cluster: "cluster-b"},
+			},
+		},
+	}
+
+	names := func(targets []watchTarget) []string {
+		var ret []string
+		for _, t := range targets {
+			ret = append(ret, t.name)
+		}
+		return ret
+	}
+
+	targets, err := s.watchTargets(context.Background(), "", "", "ns", map[string]string{"b": "7"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "a2", "b"}, names(targets))
+	assert.Equal(t, "ns", targets[0].cs.Namespace())
+	assert.Equal(t, "7", targets[2].resourceVersion)
+
+	targets, err = s.watchTargets(context.Background(), "", "cluster-a", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "a2"}, names(targets))
+
+	targets, err = s.watchTargets(context.Background(), "b", "", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b"}, names(targets))
+
+	_, err = s.watchTargets(context.Background(), "b", "cluster-a", "", nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.watchTargets(context.Background(), "", "cluster-c", "", nil)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,376 @@
+package k8s
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+// How often the status of a workload is checked while waiting for its rollout.
+var rolloutPollInterval = 2 * time.Second
+
+// The scale subresource of deployments, stateful sets and replica sets.
+type scaleClient interface {
+	GetScale(ctx context.Context, name string, opts metav1.GetOptions) (*autoscalingv1.Scale, error)
+	UpdateScale(ctx context.Context, name string, scale *autoscalingv1.Scale, opts metav1.UpdateOptions) (*autoscalingv1.Scale, error)
+}
+
+func workloadScaleClient(cs ContextClientset, kind k8sapiv1.WorkloadKind) (scaleClient, error) {
+	switch kind {
+	case k8sapiv1.WorkloadKind_DEPLOYMENT:
+		return cs.AppsV1().Deployments(cs.Namespace()), nil
+	case k8sapiv1.WorkloadKind_STATEFUL_SET:
+		return cs.AppsV1().StatefulSets(cs.Namespace()), nil
+	case k8sapiv1.WorkloadKind_REPLICA_SET:
+		return cs.AppsV1().ReplicaSets(cs.Namespace()), nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported workload kind '%s'", kind)
+	}
+}
+
+// The kinds of the workloads in the scale target references of horizontal pod autoscalers.
+var workloadKindNames = map[k8sapiv1.WorkloadKind]string{
+	k8sapiv1.WorkloadKind_DEPLOYMENT:   "Deployment",
+	k8sapiv1.WorkloadKind_STATEFUL_SET: "StatefulSet",
+	k8sapiv1.WorkloadKind_REPLICA_SET:  "ReplicaSet",
+}
+
+// checkScalable returns an error if the replicas of the workload are managed by something else, which would undo the
+// change. Replica sets are scaled by their deployment, and workloads with an autoscaler by the autoscaler.
+func checkScalable(ctx context.Context, cs ContextClientset, kind k8sapiv1.WorkloadKind, name string) error {
+	if kind == k8sapiv1.WorkloadKind_REPLICA_SET {
+		replicaSet, err := cs.AppsV1().ReplicaSets(cs.Namespace()).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if owner := metav1.GetControllerOf(replicaSet); owner != nil && owner.Kind == "Deployment" {
+			return status.Errorf(codes.FailedPrecondition, "replica set '%s' is managed by deployment '%s', scale the deployment instead", name, owner.Name)
+		}
+	}
+
+	// Autoscalers can't be selected by their target, so every autoscaler of the namespace is checked.
+	targets, err := hpaScaleTargets(ctx, cs)
+	if err != nil {
+		return err
+	}
+	for hpa, target := range targets {
+		gv, err := schema.ParseGroupVersion(target.APIVersion)
+		if err != nil {
+			continue
+		}
+		if gv.Group == appsv1.GroupName && target.Kind == workloadKindNames[kind] && target.Name == name {
+			return status.Errorf(codes.FailedPrecondition, "%s '%s' is scaled by horizontal pod autoscaler '%s', resize the autoscaler instead", kind, name, hpa)
+		}
+	}
+	return nil
+}
+
+// hpaScaleTargets returns the scale target references of the horizontal pod autoscalers in the namespace by name. The
+// autoscalers are listed with autoscaling/v2 if the cluster serves it, and with autoscaling/v1 otherwise.
+func hpaScaleTargets(ctx context.Context, cs ContextClientset) (map[string]autoscalingv1.CrossVersionObjectReference, error) {
+	targets := make(map[string]autoscalingv1.CrossVersionObjectReference)
+
+	hpas, err := cs.AutoscalingV2().HorizontalPodAutoscalers(cs.Namespace()).List(ctx, metav1.ListOptions{})
+	if err == nil {
+		for _, hpa := range hpas.Items {
+			ref := hpa.Spec.ScaleTargetRef
+			targets[hpa.Name] = autoscalingv1.CrossVersionObjectReference{APIVersion: ref.APIVersion, Kind: ref.Kind, Name: ref.Name}
+		}
+		return targets, nil
+	}
+	if !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	v1hpas, err := cs.AutoscalingV1().HorizontalPodAutoscalers(cs.Namespace()).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, hpa := range v1hpas.Items {
+		targets[hpa.Name] = hpa.Spec.ScaleTargetRef
+	}
+	return targets, nil
+}
+
+func desiredReplicas(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+func (s *svc) isScaleToZeroProtected(namespace string) bool {
+	for _, ns := range s.scaleToZeroProtectedNamespaces {
+		if ns == "*" || ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *svc) ScaleWorkload(ctx context.Context, clientset, cluster, namespace, name string, kind k8sapiv1.WorkloadKind, replicas uint32) (*k8sapiv1.Workload, error) {
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if replicas == 0 && s.isScaleToZeroProtected(cs.Namespace()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "workloads in namespace '%s' can't be scaled to zero", cs.Namespace())
+	}
+
+	scales, err := workloadScaleClient(cs, kind)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkScalable(ctx, cs, kind, name); err != nil {
+		return nil, err
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		scale, err := scales.GetScale(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		scale.Spec.Replicas = int32(replicas)
+		_, err = scales.UpdateScale(ctx, name, scale, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return describeWorkload(ctx, cs, kind, name)
+}
+
+// setContainerImage sets the image of the container or init container of the pod spec, returning false if there's no
+// such container.
+func setContainerImage(spec *corev1.PodSpec, containerName, image string) bool {
+	for _, containers := range [][]corev1.Container{spec.Containers, spec.InitContainers} {
+		for i := range containers {
+			if containers[i].Name == containerName {
+				containers[i].Image = image
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *svc) SetContainerImage(ctx context.Context, clientset, cluster, namespace, name string, kind k8sapiv1.WorkloadKind, containerName, image string) (*k8sapiv1.Workload, error) {
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	containerNotFound := status.Errorf(codes.NotFound, "container '%s' not found in %s '%s'", containerName, kind, name)
+	switch kind {
+	case k8sapiv1.WorkloadKind_DEPLOYMENT:
+		oldDeployment, err := cs.AppsV1().Deployments(cs.Namespace()).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		newDeployment := oldDeployment.DeepCopy()
+		if !setContainerImage(&newDeployment.Spec.Template.Spec, containerName, image) {
+			return nil, containerNotFound
+		}
+
+		patchBytes, err := GenerateStrategicPatch(oldDeployment, newDeployment, appsv1.Deployment{})
+		if err != nil {
+			return nil, err
+		}
+		deployment, err := cs.AppsV1().Deployments(cs.Namespace()).Patch(ctx, name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return protoForDeploymentWorkload(cs.Cluster(), deployment), nil
+	case k8sapiv1.WorkloadKind_STATEFUL_SET:
+		oldStatefulSet, err := cs.AppsV1().StatefulSets(cs.Namespace()).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		newStatefulSet := oldStatefulSet.DeepCopy()
+		if !setContainerImage(&newStatefulSet.Spec.Template.Spec, containerName, image) {
+			return nil, containerNotFound
+		}
+
+		patchBytes, err := GenerateStrategicPatch(oldStatefulSet, newStatefulSet, appsv1.StatefulSet{})
+		if err != nil {
+			return nil, err
+		}
+		statefulSet, err := cs.AppsV1().StatefulSets(cs.Namespace()).Patch(ctx, name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return protoForStatefulSetWorkload(cs.Cluster(), statefulSet), nil
+	default:
+		// Replica sets don't replace their pods when the template changes.
+		return nil, status.Errorf(codes.InvalidArgument, "the image of a %s can't be set", kind)
+	}
+}
+
+// WaitForRollout calls the handler with the workload whenever the progress of its rollout changes, until the rollout
+// is complete or the context is done.
+func (s *svc) WaitForRollout(ctx context.Context, clientset, cluster, namespace, name string, kind k8sapiv1.WorkloadKind, handler func(*k8sapiv1.Workload) error) error {
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(rolloutPollInterval)
+	defer ticker.Stop()
+
+	timedOut := status.Errorf(codes.DeadlineExceeded, "timed out waiting for the rollout of %s '%s'", kind, name)
+	var last *k8sapiv1.Workload
+	for {
+		workload, err := describeWorkload(ctx, cs, kind, name)
+		if err != nil {
+			if ctx.Err() != nil {
+				return timedOut
+			}
+			return err
+		}
+		if !proto.Equal(workload, last) {
+			if err := handler(workload); err != nil {
+				return err
+			}
+			last = workload
+		}
+		if workload.RolloutComplete {
+			return nil
+		}
+		if deploymentProgressDeadlineExceeded(workload) {
+			return status.Errorf(codes.DeadlineExceeded, "deployment '%s' exceeded its progress deadline", name)
+		}
+
+		select {
+		case <-ctx.Done():
+			return timedOut
+		case <-ticker.C:
+		}
+	}
+}
+
+func describeWorkload(ctx context.Context, cs ContextClientset, kind k8sapiv1.WorkloadKind, name string) (*k8sapiv1.Workload, error) {
+	switch kind {
+	case k8sapiv1.WorkloadKind_DEPLOYMENT:
+		deployment, err := cs.AppsV1().Deployments(cs.Namespace()).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return protoForDeploymentWorkload(cs.Cluster(), deployment), nil
+	case k8sapiv1.WorkloadKind_STATEFUL_SET:
+		statefulSet, err := cs.AppsV1().StatefulSets(cs.Namespace()).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return protoForStatefulSetWorkload(cs.Cluster(), statefulSet), nil
+	case k8sapiv1.WorkloadKind_REPLICA_SET:
+		replicaSet, err := cs.AppsV1().ReplicaSets(cs.Namespace()).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return protoForReplicaSetWorkload(cs.Cluster(), replicaSet), nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported workload kind '%s'", kind)
+	}
+}
+
+func deploymentProgressDeadlineExceeded(workload *k8sapiv1.Workload) bool {
+	for _, cond := range workload.GetDeploymentStatus().GetDeploymentConditions() {
+		if cond.Type == k8sapiv1.Deployment_DeploymentStatus_Condition_PROGRESSING && cond.Reason == "ProgressDeadlineExceeded" {
+			return true
+		}
+	}
+	return false
+}
+
+// The rollout checks follow those of `kubectl rollout status`.
+func protoForDeploymentWorkload(cluster string, deployment *appsv1.Deployment) *k8sapiv1.Workload {
+	clusterName := deployment.ClusterName
+	if clusterName == "" {
+		clusterName = cluster
+	}
+	desired := desiredReplicas(deployment.Spec.Replicas)
+	st := deployment.Status
+	return &k8sapiv1.Workload{
+		Kind:              k8sapiv1.WorkloadKind_DEPLOYMENT,
+		Cluster:           clusterName,
+		Namespace:         deployment.Namespace,
+		Name:              deployment.Name,
+		Replicas:          uint32(desired),
+		UpdatedReplicas:   uint32(st.UpdatedReplicas),
+		ReadyReplicas:     uint32(st.ReadyReplicas),
+		AvailableReplicas: uint32(st.AvailableReplicas),
+		RolloutComplete: deployment.Generation <= st.ObservedGeneration &&
+			st.UpdatedReplicas == desired &&
+			st.Replicas == st.UpdatedReplicas &&
+			st.AvailableReplicas == st.UpdatedReplicas,
+		DeploymentStatus: ProtoForDeploymentStatus(st),
+	}
+}
+
+func protoForStatefulSetWorkload(cluster string, statefulSet *appsv1.StatefulSet) *k8sapiv1.Workload {
+	clusterName := statefulSet.ClusterName
+	if clusterName == "" {
+		clusterName = cluster
+	}
+	desired := desiredReplicas(statefulSet.Spec.Replicas)
+	st := statefulSet.Status
+
+	complete := st.ObservedGeneration != 0 && statefulSet.Generation <= st.ObservedGeneration &&
+		st.Replicas == desired && st.ReadyReplicas == desired
+	if ru := statefulSet.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil {
+		// Only the replicas at or above the partition are updated.
+		complete = complete && st.UpdatedReplicas >= desired-*ru.Partition
+	} else if statefulSet.Spec.UpdateStrategy.Type != appsv1.OnDeleteStatefulSetStrategyType {
+		complete = complete && st.UpdateRevision == st.CurrentRevision
+	}
+
+	return &k8sapiv1.Workload{
+		Kind:              k8sapiv1.WorkloadKind_STATEFUL_SET,
+		Cluster:           clusterName,
+		Namespace:         statefulSet.Namespace,
+		Name:              statefulSet.Name,
+		Replicas:          uint32(desired),
+		UpdatedReplicas:   uint32(st.UpdatedReplicas),
+		ReadyReplicas:     uint32(st.ReadyReplicas),
+		AvailableReplicas: uint32(st.AvailableReplicas),
+		RolloutComplete:   complete,
+	}
+}
+
+func protoForReplicaSetWorkload(cluster string, replicaSet *appsv1.ReplicaSet) *k8sapiv1.Workload {
+	clusterName := replicaSet.ClusterName
+	if clusterName == "" {
+		clusterName = cluster
+	}
+	desired := desiredReplicas(replicaSet.Spec.Replicas)
+	st := replicaSet.Status
+	return &k8sapiv1.Workload{
+		Kind:              k8sapiv1.WorkloadKind_REPLICA_SET,
+		Cluster:           clusterName,
+		Namespace:         replicaSet.Namespace,
+		Name:              replicaSet.Name,
+		Replicas:          uint32(desired),
+		UpdatedReplicas:   uint32(st.Replicas),
+		ReadyReplicas:     uint32(st.ReadyReplicas),
+		AvailableReplicas: uint32(st.AvailableReplicas),
+		RolloutComplete: replicaSet.Generation <= st.ObservedGeneration &&
+			st.Replicas == desired &&
+			st.ReadyReplicas == desired &&
+			st.AvailableReplicas == desired,
+	}
+}
@@ -0,0 +1,320 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+)
+
+func int32Ptr(i int32) *int32 { return &i }
+
+func boolPtr(b bool) *bool { return &b }
+
+func testWorkloadService(cs *fake.Clientset) *svc {
+	return &svc{
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{"foo": {
+				Interface: cs,
+				namespace: "default",
+				cluster:   "core-testing",
+			}},
+		},
+	}
+}
+
+func testWorkloadDeployment(replicas, updated, available int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", Generation: 2},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(replicas),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{{Name: "init", Image: "init:1"}},
+					Containers:     []corev1.Container{{Name: "app", Image: "app:1"}, {Name: "sidecar", Image: "sidecar:1"}},
+				},
+			},
+		},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 2,
+			Replicas:           replicas,
+			UpdatedReplicas:    updated,
+			ReadyReplicas:      available,
+			AvailableReplicas:  available,
+		},
+	}
+}
+
+func TestScaleWorkload(t *testing.T) {
+	cs := fake.NewSimpleClientset(testWorkloadDeployment(3, 3, 3))
+
+	var updated []*autoscalingv1.Scale
+	cs.PrependReactor("get", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "scale" {
+			return false, nil, nil
+		}
+		return true, &autoscalingv1.Scale{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+			Spec:       autoscalingv1.ScaleSpec{Replicas: 3},
+		}, nil
+	})
+	cs.PrependReactor("update", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "scale" {
+			return false, nil, nil
+		}
+		scale := action.(k8stesting.UpdateActionImpl).GetObject().(*autoscalingv1.Scale)
+		updated = append(updated, scale)
+		return true, scale, nil
+	})
+
+	s := testWorkloadService(cs)
+	workload, err := s.ScaleWorkload(context.Background(), "foo", "core-testing", "default", "app", k8sapiv1.WorkloadKind_DEPLOYMENT, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, "app", workload.Name)
+	assert.Equal(t, k8sapiv1.WorkloadKind_DEPLOYMENT, workload.Kind)
+	assert.Len(t, updated, 1)
+	assert.Equal(t, int32(5), updated[0].Spec.Replicas)
+
+	// Scaling to zero is allowed unless the namespace is protected.
+	_, err = s.ScaleWorkload(context.Background(), "foo", "core-testing", "default", "app", k8sapiv1.WorkloadKind_DEPLOYMENT, 0)
+	assert.NoError(t, err)
+	assert.Len(t, updated, 2)
+
+	for _, protected := range [][]string{{"default"}, {"*"}} {
+		s.scaleToZeroProtectedNamespaces = protected
+		_, err = s.ScaleWorkload(context.Background(), "foo", "core-testing", "default", "app", k8sapiv1.WorkloadKind_DEPLOYMENT, 0)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Len(t, updated, 2)
+	}
+
+	s.scaleToZeroProtectedNamespaces = []string{"other"}
+	_, err = s.ScaleWorkload(context.Background(), "foo", "core-testing", "default", "app", k8sapiv1.WorkloadKind_DEPLOYMENT, 0)
+	assert.NoError(t, err)
+
+	_, err = s.ScaleWorkload(context.Background(), "foo", "core-testing", "default", "app", k8sapiv1.WorkloadKind_WORKLOAD_KIND_UNSPECIFIED, 1)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestScaleWorkloadManagedReplicas(t *testing.T) {
+	cs := fake.NewSimpleClientset(
+		testWorkloadDeployment(3, 3, 3),
+		&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+			Name:            "app-5d4f8",
+			Namespace:       "default",
+			OwnerReferences: []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "app", Controller: boolPtr(true)}},
+		}},
+		&autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-hpa", Namespace: "default"},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "app"},
+			},
+		},
+		// A custom resource of the same kind.
+		&autoscalingv2.HorizontalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "rollout-hpa", Namespace: "default"},
+			Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+				ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{APIVersion: "example.com/v1", Kind: "StatefulSet", Name: "app"},
+			},
+		},
+	)
+	s := testWorkloadService(cs)
+
+	// The deployment would scale the replica set back.
+	_, err := s.ScaleWorkload(context.Background(), "foo", "core-testing", "default", "app-5d4f8", k8sapiv1.WorkloadKind_REPLICA_SET, 5)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "deployment 'app'")
+
+	// The autoscaler would scale the deployment back.
+	_, err = s.ScaleWorkload(context.Background(), "foo", "core-testing", "default", "app", k8sapiv1.WorkloadKind_DEPLOYMENT, 5)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "horizontal pod autoscaler 'app-hpa'")
+
+	// Autoscalers of other workloads don't matter.
+	_, err = s.ScaleWorkload(context.Background(), "foo", "core-testing", "default", "app", k8sapiv1.WorkloadKind_STATEFUL_SET, 5)
+	assert.NotEqual(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestHPAScaleTargetsV1Fallback(t *testing.T) {
+	cs := fake.NewSimpleClientset(&autoscalingv1.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "app-hpa", Namespace: "default"},
+		Spec: autoscalingv1.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "app"},
+		},
+	})
+	// Clusters older than 1.23 don't serve autoscaling/v2.
+	cs.PrependReactor("list", "horizontalpodautoscalers", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetResource().Version == "v2" {
+			return true, nil, k8serrors.NewNotFound(schema.GroupResource{Group: "autoscaling", Resource: "horizontalpodautoscalers"}, "")
+		}
+		return false, nil, nil
+	})
+
+	targets, err := hpaScaleTargets(context.Background(), &ctxClientsetImpl{Interface: cs, namespace: "default"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]autoscalingv1.CrossVersionObjectReference{
+		"app-hpa": {APIVersion: "apps/v1", Kind: "Deployment", Name: "app"},
+	}, targets)
+}
+
+func TestSetContainerImage(t *testing.T) {
+	cs := fake.NewSimpleClientset(
+		testWorkloadDeployment(3, 3, 3),
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+			Spec: appsv1.StatefulSetSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "db", Image: "db:1"}}},
+				},
+			},
+		},
+	)
+	s := testWorkloadService(cs)
+
+	workload, err := s.SetContainerImage(context.Background(), "foo", "core-testing", "default", "app", k8sapiv1.WorkloadKind_DEPLOYMENT, "sidecar", "sidecar:2")
+	assert.NoError(t, err)
+	assert.Equal(t, "app", workload.Name)
+	assert.NotNil(t, workload.DeploymentStatus)
+
+	_, err = s.SetContainerImage(context.Background(), "foo", "core-testing", "default", "app", k8sapiv1.WorkloadKind_DEPLOYMENT, "init", "init:2")
+	assert.NoError(t, err)
+
+	deployment, err := cs.AppsV1().Deployments("default").Get(context.Background(), "app", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "app:1", deployment.Spec.Template.Spec.Containers[0].Image)
+	assert.Equal(t, "sidecar:2", deployment.Spec.Template.Spec.Containers[1].Image)
+	assert.Equal(t, "init:2", deployment.Spec.Template.Spec.InitContainers[0].Image)
+
+	_, err = s.SetContainerImage(context.Background(), "foo", "core-testing", "default", "db", k8sapiv1.WorkloadKind_STATEFUL_SET, "db", "db:2")
+	assert.NoError(t, err)
+	statefulSet, err := cs.AppsV1().StatefulSets("default").Get(context.Background(), "db", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "db:2", statefulSet.Spec.Template.Spec.Containers[0].Image)
+
+	_, err = s.SetContainerImage(context.Background(), "foo", "core-testing", "default", "app", k8sapiv1.WorkloadKind_DEPLOYMENT, "missing", "missing:2")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.SetContainerImage(context.Background(), "foo", "core-testing", "default", "app", k8sapiv1.WorkloadKind_REPLICA_SET, "app", "app:2")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestWaitForRollout(t *testing.T) {
+	rolloutPollInterval = time.Millisecond
+
+	// Each get returns the next step of the rollout, repeating the last one.
+	steps := []*appsv1.Deployment{
+		testWorkloadDeployment(3, 1, 0),
+		testWorkloadDeployment(3, 1, 0),
+		testWorkloadDeployment(3, 3, 1),
+		testWorkloadDeployment(3, 3, 3),
+	}
+	cs := fake.NewSimpleClientset()
+	cs.PrependReactor("get", "deployments", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		d := steps[0]
+		if len(steps) > 1 {
+			steps = steps[1:]
+		}
+		return true, d, nil
+	})
+
+	s := testWorkloadService(cs)
+	var progress []*k8sapiv1.Workload
+	err := s.WaitForRollout(context.Background(), "foo", "core-testing", "default", "app", k8sapiv1.WorkloadKind_DEPLOYMENT,
+		func(w *k8sapiv1.Workload) error {
+			progress = append(progress, w)
+			return nil
+		})
+	assert.NoError(t, err)
+	// Unchanged progress isn't sent again.
+	assert.Len(t, progress, 3)
+	assert.Equal(t, uint32(1), progress[0].UpdatedReplicas)
+	assert.False(t, progress[0].RolloutComplete)
+	assert.Equal(t, uint32(1), progress[1].AvailableReplicas)
+	assert.Equal(t, uint32(1), progress[1].DeploymentStatus.AvailableReplicas)
+	assert.True(t, progress[2].RolloutComplete)
+}
+
+func TestWaitForRolloutTimeout(t *testing.T) {
+	rolloutPollInterval = time.Millisecond
+
+	cs := fake.NewSimpleClientset(testWorkloadDeployment(3, 1, 0))
+	s := testWorkloadService(cs)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	var progress []*k8sapiv1.Workload
+	err := s.WaitForRollout(ctx, "foo", "core-testing", "default", "app", k8sapiv1.WorkloadKind_DEPLOYMENT,
+		func(w *k8sapiv1.Workload) error {
+			progress = append(progress, w)
+			return nil
+		})
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Len(t, progress, 1)
+
+	// A deployment that exceeded its progress deadline won't complete.
+	d := testWorkloadDeployment(3, 1, 0)
+	d.Status.Conditions = []appsv1.DeploymentCondition{
+		{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: "ProgressDeadlineExceeded"},
+	}
+	s = testWorkloadService(fake.NewSimpleClientset(d))
+	err = s.WaitForRollout(context.Background(), "foo", "core-testing", "default", "app", k8sapiv1.WorkloadKind_DEPLOYMENT,
+		func(w *k8sapiv1.Workload) error { return nil })
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+	assert.Contains(t, err.Error(), "progress deadline")
+}
+
+func TestProtoForWorkloadRolloutComplete(t *testing.T) {
+	// Old replicas are still terminating.
+	d := testWorkloadDeployment(3, 3, 3)
+	d.Status.Replicas = 4
+	assert.False(t, protoForDeploymentWorkload("c", d).RolloutComplete)
+
+	// The controller hasn't observed the latest spec.
+	d = testWorkloadDeployment(3, 3, 3)
+	d.Generation = 3
+	assert.False(t, protoForDeploymentWorkload("c", d).RolloutComplete)
+
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Generation: 1},
+		Spec:       appsv1.StatefulSetSpec{Replicas: int32Ptr(3)},
+		Status: appsv1.StatefulSetStatus{
+			ObservedGeneration: 1,
+			Replicas:           3,
+			ReadyReplicas:      3,
+			UpdatedReplicas:    1,
+			CurrentRevision:    "a",
+			UpdateRevision:     "b",
+		},
+	}
+	assert.False(t, protoForStatefulSetWorkload("c", sts).RolloutComplete)
+
+	// Only the replicas at or above the partition are updated.
+	sts.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{Partition: int32Ptr(2)}
+	assert.True(t, protoForStatefulSetWorkload("c", sts).RolloutComplete)
+
+	sts.Spec.UpdateStrategy.RollingUpdate = nil
+	sts.Status.CurrentRevision = "b"
+	assert.True(t, protoForStatefulSetWorkload("c", sts).RolloutComplete)
+
+	rs := &appsv1.ReplicaSet{
+		Spec:   appsv1.ReplicaSetSpec{Replicas: int32Ptr(2)},
+		Status: appsv1.ReplicaSetStatus{Replicas: 2, ReadyReplicas: 2, AvailableReplicas: 1},
+	}
+	assert.False(t, protoForReplicaSetWorkload("c", rs).RolloutComplete)
+	rs.Status.AvailableReplicas = 2
+	assert.True(t, protoForReplicaSetWorkload("c", rs).RolloutComplete)
+}
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"context"
+
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
@@ -12,4 +14,11 @@ type Factory map[string]func(*any.Any, *zap.Logger, tally.Scope) (Service, error
 
 var Registry = map[string]Service{}
 
+// Checker is an optional interface implemented by services that depend on an external system. Check should verify
+// that the system is reachable and return promptly when the context is done. The results are reported by the
+// healthcheck module.
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
 // TODO: create a one-way registry that errors on duplicates and can be locked after instantiation for additional safety.
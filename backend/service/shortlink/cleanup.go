@@ -0,0 +1,79 @@
+package shortlink
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+
+	pgservice "github.com/lyft/clutch/backend/service/db/postgres"
+)
+
+const (
+	shortlinkCleanupLockId = "shortlink:cleanup"
+
+	cleanupTimeout = time.Minute
+)
+
+// cleanup periodically deletes expired shortlinks. Every gateway instance runs the loop, but a postgres advisory lock
+// ensures that only one of them deletes rows at a time.
+// This should be called via `go` in order to avoid blocking main execution.
+func (c *client) cleanup() {
+	lockID := pgservice.AdvisoryLockID(shortlinkCleanupLockId)
+
+	ticker := time.NewTicker(c.cleanupInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		ctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+		c.cleanupWithLock(ctx, lockID)
+		cancel()
+	}
+}
+
+func (c *client) cleanupWithLock(ctx context.Context, lockID uint32) {
+	// The advisory lock must be released from the same session, so a dedicated connection is used.
+	// If the connection is severed for any reason the advisory lock will automatically unlock.
+	conn, err := c.db.Conn(ctx)
+	if err != nil {
+		c.log.Error("unable to get a database connection for shortlink cleanup", zap.Error(err))
+		return
+	}
+	defer conn.Close()
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1);", lockID).Scan(&locked); err != nil {
+		c.log.Error("unable to query for an advisory lock", zap.Error(err))
+		return
+	}
+	if !locked {
+		c.scope.Counter("cleanup.lock_not_acquired").Inc(1)
+		return
+	}
+	defer func() {
+		if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1);", lockID); err != nil {
+			c.log.Error("unable to perform an advisory unlock", zap.Error(err))
+		}
+	}()
+
+	deleted, err := c.deleteExpired(ctx)
+	if err != nil {
+		c.scope.Counter("cleanup.failure").Inc(1)
+		c.log.Error("failed to delete expired shortlinks", zap.Error(err))
+		return
+	}
+	c.scope.Counter("cleanup.deleted").Inc(deleted)
+}
+
+func (c *client) deleteExpired(ctx context.Context) (int64, error) {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete("shortlink").
+		Where(sq.Expr("expires_at <= now()"))
+
+	result, err := query.RunWith(c.db).ExecContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
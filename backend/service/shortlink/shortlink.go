@@ -5,17 +5,24 @@ import (
 	"crypto/rand"
 	"database/sql"
 	"errors"
+	"strconv"
+	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/lib/pq"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	shortlinkv1cfg "github.com/lyft/clutch/backend/api/config/service/shortlink/v1"
 	shortlinkv1 "github.com/lyft/clutch/backend/api/shortlink/v1"
 	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/authn"
 	pgservice "github.com/lyft/clutch/backend/service/db/postgres"
 )
 
@@ -24,6 +31,8 @@ const (
 	defaultShortlinkChars  = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
 	defaultShortlinkLength = 10
 	maxCollisionRetry      = 5
+	defaultListLimit       = 100
+	defaultCleanupInterval = time.Hour
 
 	// If we hit a key collision inserting a duplicate random string this error is thrown.
 	// We catch this error and retry up to the maxCollisionRetry limit.
@@ -32,13 +41,19 @@ const (
 )
 
 type Service interface {
-	Create(context.Context, string, []*shortlinkv1.ShareableState) (string, error)
-	Get(context.Context, string) (string, []*shortlinkv1.ShareableState, error)
+	Create(ctx context.Context, path string, state []*shortlinkv1.ShareableState, ttl time.Duration) (*shortlinkv1.Shortlink, error)
+	Get(ctx context.Context, hash string) (*shortlinkv1.Shortlink, []*shortlinkv1.ShareableState, error)
+	List(ctx context.Context, owner string, pageToken string, limit uint64) ([]*shortlinkv1.Shortlink, string, error)
+	Delete(ctx context.Context, hash string) error
+	Extend(ctx context.Context, hash string, ttl time.Duration) (*shortlinkv1.Shortlink, error)
 }
 
 type client struct {
 	shortlinkChars  string
 	shortlinkLength int
+	defaultTTL      time.Duration
+	cleanupInterval time.Duration
+	adminGroups     map[string]bool
 
 	db    *sql.DB
 	log   *zap.Logger
@@ -72,24 +87,101 @@ func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service,
 		length = int(slConfig.ShortlinkLength)
 	}
 
+	cleanupInterval := defaultCleanupInterval
+	if slConfig.CleanupInterval != nil {
+		cleanupInterval = slConfig.CleanupInterval.AsDuration()
+	}
+
+	adminGroups := make(map[string]bool, len(slConfig.AdminGroups))
+	for _, g := range slConfig.AdminGroups {
+		adminGroups[g] = true
+	}
+
 	c := &client{
 		shortlinkChars:  chars,
 		shortlinkLength: length,
+		defaultTTL:      slConfig.DefaultTtl.AsDuration(),
+		cleanupInterval: cleanupInterval,
+		adminGroups:     adminGroups,
 		db:              dbClient.DB(),
 		log:             logger,
 		scope:           scope,
 	}
 
+	go c.cleanup()
+
 	return c, nil
 }
 
-func (c *client) Create(ctx context.Context, path string, state []*shortlinkv1.ShareableState) (string, error) {
+// Columns holding the shortlink metadata, in the order expected by scanShortlink.
+var shortlinkColumns = []string{"slhash", "page_path", "created_by", "created_at", "expires_at", "access_count", "last_accessed_at"}
+
+// notExpired matches shortlinks that have no expiration or have not expired yet.
+// Expired shortlinks are treated as deleted until they are cleaned up.
+var notExpired = sq.Or{sq.Eq{"expires_at": nil}, sq.Expr("expires_at > now()")}
+
+// scanShortlink scans the shortlinkColumns followed by any additional destinations.
+func scanShortlink(row sq.RowScanner, dest ...interface{}) (*shortlinkv1.Shortlink, error) {
+	var hash, path string
+	var createdBy sql.NullString
+	var createdAt time.Time
+	var expiresAt, lastAccessedAt sql.NullTime
+	var accessCount int64
+
+	cols := append([]interface{}{&hash, &path, &createdBy, &createdAt, &expiresAt, &accessCount, &lastAccessedAt}, dest...)
+	if err := row.Scan(cols...); err != nil {
+		return nil, err
+	}
+
+	ret := &shortlinkv1.Shortlink{
+		Hash:        hash,
+		Path:        path,
+		CreatedBy:   createdBy.String,
+		CreatedAt:   timestamppb.New(createdAt),
+		AccessCount: uint64(accessCount),
+	}
+	if expiresAt.Valid {
+		ret.ExpiresAt = timestamppb.New(expiresAt.Time)
+	}
+	if lastAccessedAt.Valid {
+		ret.LastAccessedAt = timestamppb.New(lastAccessedAt.Time)
+	}
+	return ret, nil
+}
+
+// subjectFromContext returns the subject of the authenticated user, or an empty string if it is not known.
+func subjectFromContext(ctx context.Context) string {
+	if claims, err := authn.ClaimsFromContext(ctx); err == nil && claims.Subject != authn.AnonymousSubject {
+		return claims.Subject
+	}
+	return ""
+}
+
+// isAdmin returns whether the current user is a member of one of the admin groups.
+func (c *client) isAdmin(ctx context.Context) bool {
+	claims, err := authn.ClaimsFromContext(ctx)
+	if err != nil {
+		return false
+	}
+	for _, g := range claims.Groups {
+		if c.adminGroups[g] {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *client) Create(ctx context.Context, path string, state []*shortlinkv1.ShareableState, ttl time.Duration) (*shortlinkv1.Shortlink, error) {
 	stateJson, err := marshalShareableState(state)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return c.createShortlinkWithRetries(ctx, path, stateJson)
+	if ttl == 0 {
+		ttl = c.defaultTTL
+	}
+
+	return c.createShortlinkWithRetries(ctx, path, stateJson, subjectFromContext(ctx), ttl)
 }
 
 // createShortlinkWithRetries retries the insert of a new shortlink
@@ -97,56 +189,207 @@ func (c *client) Create(ctx context.Context, path string, state []*shortlinkv1.S
 // There could be a possibility of a collision depending on the configuration
 // With the default settings in place [a-zA-Z0-9] and a default subset length of 10,
 // this leaves us with 62^10.
-func (c *client) createShortlinkWithRetries(ctx context.Context, path string, state []byte) (string, error) {
+func (c *client) createShortlinkWithRetries(ctx context.Context, path string, state []byte, createdBy string, ttl time.Duration) (*shortlinkv1.Shortlink, error) {
+	var expiresAt sql.NullTime
+	if ttl > 0 {
+		expiresAt = sql.NullTime{Time: time.Now().Add(ttl), Valid: true}
+	}
+
 	for i := 0; i < maxCollisionRetry; i++ {
 		hash, err := generateShortlink(c.shortlinkChars, c.shortlinkLength)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		insertBuilder := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
 			Insert("shortlink").
-			Columns("slhash", "page_path", "state").
-			Values(hash, path, state)
+			Columns("slhash", "page_path", "state", "created_by", "expires_at").
+			Values(hash, path, state, sql.NullString{String: createdBy, Valid: createdBy != ""}, expiresAt).
+			Suffix("RETURNING " + strings.Join(shortlinkColumns, ", "))
 
-		_, err = insertBuilder.RunWith(c.db).ExecContext(ctx)
+		shortlink, err := scanShortlink(insertBuilder.RunWith(c.db).QueryRowContext(ctx))
 		if err, ok := err.(*pq.Error); ok {
 			if err.Code == pgUniqueErrorCode {
 				// If we hit a key collision lets retry
 				continue
 			} else {
-				return "", err
+				return nil, err
 			}
 		}
 
-		return hash, err
+		return shortlink, err
 	}
 
-	return "", errors.New("retries exhausted, unable to create unique shortlink hash.")
+	return nil, errors.New("retries exhausted, unable to create unique shortlink hash.")
 }
 
-func (c *client) Get(ctx context.Context, hash string) (string, []*shortlinkv1.ShareableState, error) {
+// Get returns the shortlink and its state, recording the access.
+func (c *client) Get(ctx context.Context, hash string) (*shortlinkv1.Shortlink, []*shortlinkv1.ShareableState, error) {
 	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
-		Select("page_path", "state").
-		From("shortlink").
-		Where(sq.Eq{"slhash": hash})
+		Update("shortlink").
+		Set("access_count", sq.Expr("access_count + 1")).
+		Set("last_accessed_at", sq.Expr("now()")).
+		Where(sq.Eq{"slhash": hash}).
+		Where(notExpired).
+		Suffix("RETURNING " + strings.Join(shortlinkColumns, ", ") + ", state")
 
 	row := query.RunWith(c.db).QueryRowContext(ctx)
 
-	var path string
 	var byteState []byte
-	if err := row.Scan(&path, &byteState); err != nil {
+	shortlink, err := scanShortlink(row, &byteState)
+	if err == sql.ErrNoRows {
+		return nil, nil, status.Errorf(codes.NotFound, "shortlink '%s' not found", hash)
+	} else if err != nil {
 		c.log.Error("Error scanning row", zap.Error(err))
-		return "", nil, err
+		return nil, nil, err
 	}
 
 	var state shortlinkv1.CreateRequest
 	if err := protojson.Unmarshal(byteState, &state); err != nil {
 		c.log.Error("Error unmarshaling data field", zap.Error(err))
-		return "", nil, err
+		return nil, nil, err
+	}
+
+	return shortlink, state.State, nil
+}
+
+// List returns the shortlinks created by owner, most recent first. If owner is empty the current user is used. Only
+// admins can list the shortlinks of other users.
+func (c *client) List(ctx context.Context, owner string, pageToken string, limit uint64) ([]*shortlinkv1.Shortlink, string, error) {
+	subject := subjectFromContext(ctx)
+	if owner == "" {
+		owner = subject
+		if owner == "" {
+			return nil, "", status.Error(codes.InvalidArgument, "owner is required when the current user is not known")
+		}
+	}
+	if owner != subject && !c.isAdmin(ctx) {
+		return nil, "", status.Errorf(codes.PermissionDenied, "only admins can list the shortlinks of '%s'", owner)
+	}
+
+	if limit == 0 {
+		limit = defaultListLimit
 	}
 
-	return path, state.State, nil
+	var offset uint64
+	if pageToken != "" {
+		var err error
+		if offset, err = strconv.ParseUint(pageToken, 10, 64); err != nil {
+			return nil, "", status.Error(codes.InvalidArgument, "unable to parse page_token")
+		}
+	}
+
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(shortlinkColumns...).
+		From("shortlink").
+		Where(sq.Eq{"created_by": owner}).
+		Where(notExpired).
+		OrderBy("created_at DESC", "slhash").
+		Limit(limit).
+		Offset(offset)
+
+	rows, err := query.RunWith(c.db).QueryContext(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var ret []*shortlinkv1.Shortlink
+	for rows.Next() {
+		shortlink, err := scanShortlink(rows)
+		if err != nil {
+			c.log.Error("Error scanning row", zap.Error(err))
+			return nil, "", err
+		}
+		ret = append(ret, shortlink)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var nextPageToken string
+	if uint64(len(ret)) == limit {
+		nextPageToken = strconv.FormatUint(offset+limit, 10)
+	}
+	return ret, nextPageToken, nil
+}
+
+// modifiable matches the shortlinks that the current user may modify. Admins may modify any shortlink, including
+// those created without a known user, and other users only their own.
+func (c *client) modifiable(ctx context.Context) (sq.Sqlizer, error) {
+	if c.isAdmin(ctx) {
+		return sq.And{}, nil
+	}
+	subject := subjectFromContext(ctx)
+	if subject == "" {
+		return nil, status.Error(codes.PermissionDenied, "only known users can modify shortlinks")
+	}
+	return sq.Eq{"created_by": subject}, nil
+}
+
+func (c *client) Delete(ctx context.Context, hash string) error {
+	modifiable, err := c.modifiable(ctx)
+	if err != nil {
+		return err
+	}
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete("shortlink").
+		Where(sq.Eq{"slhash": hash}).
+		Where(modifiable)
+
+	result, err := query.RunWith(c.db).ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+	if n, err := result.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return c.modifyError(ctx, hash)
+	}
+	return nil
+}
+
+// Extend sets the expiration of the shortlink to ttl from now.
+func (c *client) Extend(ctx context.Context, hash string, ttl time.Duration) (*shortlinkv1.Shortlink, error) {
+	modifiable, err := c.modifiable(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Update("shortlink").
+		Set("expires_at", time.Now().Add(ttl)).
+		Where(sq.Eq{"slhash": hash}).
+		Where(notExpired).
+		Where(modifiable).
+		Suffix("RETURNING " + strings.Join(shortlinkColumns, ", "))
+
+	shortlink, err := scanShortlink(query.RunWith(c.db).QueryRowContext(ctx))
+	if err == sql.ErrNoRows {
+		return nil, c.modifyError(ctx, hash)
+	}
+	return shortlink, err
+}
+
+// modifyError determines why a modification matched no rows.
+func (c *client) modifyError(ctx context.Context, hash string) error {
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("created_by").
+		From("shortlink").
+		Where(sq.Eq{"slhash": hash}).
+		Where(notExpired)
+
+	var createdBy sql.NullString
+	err := query.RunWith(c.db).QueryRowContext(ctx).Scan(&createdBy)
+	switch {
+	case err == sql.ErrNoRows:
+		return status.Errorf(codes.NotFound, "shortlink '%s' not found", hash)
+	case err != nil:
+		return err
+	case !createdBy.Valid:
+		return status.Errorf(codes.PermissionDenied, "shortlink '%s' was created without a known user and can only be modified by admins", hash)
+	default:
+		return status.Errorf(codes.PermissionDenied, "shortlink '%s' is owned by '%s'", hash, createdBy.String)
+	}
 }
 
 // generateShortlink generates a random string from a set of characters to the length specified
@@ -3,11 +3,16 @@ package shortlink
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
 
@@ -15,6 +20,7 @@ import (
 	shortlinkv1 "github.com/lyft/clutch/backend/api/shortlink/v1"
 	"github.com/lyft/clutch/backend/mock/service/dbmock"
 	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/authn"
 )
 
 func TestNewDefaults(t *testing.T) {
@@ -50,6 +56,8 @@ func TestNewWithOverrides(t *testing.T) {
 	assert.Equal(t, 3, slClient.shortlinkLength)
 }
 
+var testShortlinkColumns = []string{"slhash", "page_path", "created_by", "created_at", "expires_at", "access_count", "last_accessed_at"}
+
 func TestGetShortlink(t *testing.T) {
 	m := dbmock.NewMockDB()
 	m.Register()
@@ -73,16 +81,27 @@ func TestGetShortlink(t *testing.T) {
 	stateJson, err := marshalShareableState(expectedState)
 	assert.NoError(t, err)
 
-	rows := sqlmock.NewRows([]string{"page_path", "state"})
-	rows.AddRow("/test", stateJson)
+	now := time.Now()
+	rows := sqlmock.NewRows(append(testShortlinkColumns, "state"))
+	rows.AddRow("test", "/test", "user@example.com", now, nil, 3, now, stateJson)
 
-	m.Mock.ExpectQuery("SELECT page_path, state FROM shortlink WHERE slhash = .*").
+	m.Mock.ExpectQuery(`UPDATE shortlink SET access_count = access_count \+ 1, last_accessed_at = now\(\) WHERE slhash = \$1 AND \(expires_at IS NULL OR expires_at > now\(\)\) RETURNING .*, state`).
+		WithArgs("test").
 		WillReturnRows(rows)
 
-	path, actualState, err := slClient.Get(context.TODO(), "test")
+	shortlink, actualState, err := slClient.Get(context.TODO(), "test")
 	assert.NoError(t, err)
-	assert.Equal(t, "/test", path)
+	assert.Equal(t, "/test", shortlink.Path)
+	assert.Equal(t, "user@example.com", shortlink.CreatedBy)
+	assert.Equal(t, uint64(3), shortlink.AccessCount)
+	assert.Nil(t, shortlink.ExpiresAt)
+	assert.NotNil(t, shortlink.LastAccessedAt)
 	assert.Equal(t, expectedState, actualState)
+
+	m.Mock.ExpectQuery("UPDATE shortlink").WillReturnRows(sqlmock.NewRows(append(testShortlinkColumns, "state")))
+	_, _, err = slClient.Get(context.TODO(), "expired")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
 	m.MustMeetExpectations()
 }
 
@@ -96,13 +115,147 @@ func TestCreateShortlinkWithRetries(t *testing.T) {
 		db:              m.DB(),
 	}
 
-	m.Mock.ExpectExec("INSERT INTO shortlink").WithArgs(
-		"a", "/test", []byte("state"),
-	).WillReturnResult(sqlmock.NewResult(1, 1))
+	now := time.Now()
+	m.Mock.ExpectQuery("INSERT INTO shortlink").WithArgs(
+		"a", "/test", []byte("state"), "user@example.com", dbmock.AnyArg{},
+	).WillReturnRows(sqlmock.NewRows(testShortlinkColumns).AddRow("a", "/test", "user@example.com", now, now.Add(time.Hour), 0, nil))
+
+	shortlink, err := slClient.createShortlinkWithRetries(context.TODO(), "/test", []byte("state"), "user@example.com", time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, "a", shortlink.Hash)
+	assert.NotNil(t, shortlink.ExpiresAt)
+	assert.Nil(t, shortlink.LastAccessedAt)
+
+	m.Mock.ExpectQuery("INSERT INTO shortlink").WithArgs(
+		"a", "/test", []byte("state"), nil, nil,
+	).WillReturnError(&pq.Error{Code: pgUniqueErrorCode})
+	m.Mock.ExpectQuery("INSERT INTO shortlink").WithArgs(
+		"a", "/test", []byte("state"), nil, nil,
+	).WillReturnRows(sqlmock.NewRows(testShortlinkColumns).AddRow("a", "/test", nil, now, nil, 0, nil))
+
+	shortlink, err = slClient.createShortlinkWithRetries(context.TODO(), "/test", []byte("state"), "", 0)
+	assert.NoError(t, err)
+	assert.Empty(t, shortlink.CreatedBy)
+	assert.Nil(t, shortlink.ExpiresAt)
+
+	m.MustMeetExpectations()
+}
+
+func contextWithSubject(subject string, groups ...string) context.Context {
+	return authn.ContextWithClaims(context.Background(), &authn.Claims{
+		StandardClaims: &jwt.StandardClaims{Subject: subject},
+		Groups:         groups,
+	})
+}
+
+func TestList(t *testing.T) {
+	m := dbmock.NewMockDB()
+	slClient := &client{db: m.DB(), log: zap.NewNop(), adminGroups: map[string]bool{"admins": true}}
+
+	now := time.Now()
+	m.Mock.ExpectQuery(`SELECT .* FROM shortlink WHERE created_by = \$1 AND \(expires_at IS NULL OR expires_at > now\(\)\) ORDER BY created_at DESC, slhash LIMIT 2 OFFSET 2`).
+		WithArgs("user@example.com").
+		WillReturnRows(sqlmock.NewRows(testShortlinkColumns).
+			AddRow("a", "/a", "user@example.com", now, nil, 0, nil).
+			AddRow("b", "/b", "user@example.com", now, nil, 0, nil))
+
+	shortlinks, nextPageToken, err := slClient.List(contextWithSubject("user@example.com"), "", "2", 2)
+	assert.NoError(t, err)
+	assert.Len(t, shortlinks, 2)
+	assert.Equal(t, "4", nextPageToken)
+
+	m.Mock.ExpectQuery("SELECT .* FROM shortlink").
+		WithArgs("other@example.com").
+		WillReturnRows(sqlmock.NewRows(testShortlinkColumns).AddRow("c", "/c", "other@example.com", now, nil, 0, nil))
+
+	shortlinks, nextPageToken, err = slClient.List(contextWithSubject("admin@example.com", "admins"), "other@example.com", "", 0)
+	assert.NoError(t, err)
+	assert.Len(t, shortlinks, 1)
+	assert.Empty(t, nextPageToken)
+
+	// Only admins can list the shortlinks of other users.
+	_, _, err = slClient.List(contextWithSubject("user@example.com"), "other@example.com", "", 0)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, _, err = slClient.List(context.Background(), "other@example.com", "", 0)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, _, err = slClient.List(authn.ContextWithAnonymousClaims(context.Background()), "", "", 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, _, err = slClient.List(contextWithSubject("other@example.com"), "", "notanumber", 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	m.MustMeetExpectations()
+}
+
+func TestDelete(t *testing.T) {
+	m := dbmock.NewMockDB()
+	slClient := &client{db: m.DB(), log: zap.NewNop(), adminGroups: map[string]bool{"admins": true}}
+	ctx := contextWithSubject("user@example.com")
+
+	deleteQuery := `DELETE FROM shortlink WHERE slhash = \$1 AND created_by = \$2`
+	m.Mock.ExpectExec(deleteQuery).WithArgs("a", "user@example.com").WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, slClient.Delete(ctx, "a"))
+
+	// Owned by another user.
+	m.Mock.ExpectExec(deleteQuery).WithArgs("b", "user@example.com").WillReturnResult(sqlmock.NewResult(0, 0))
+	m.Mock.ExpectQuery("SELECT created_by FROM shortlink WHERE slhash = .*").
+		WithArgs("b").
+		WillReturnRows(sqlmock.NewRows([]string{"created_by"}).AddRow("other@example.com"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(slClient.Delete(ctx, "b")))
+
+	// Does not exist.
+	m.Mock.ExpectExec(deleteQuery).WithArgs("c", "user@example.com").WillReturnResult(sqlmock.NewResult(0, 0))
+	m.Mock.ExpectQuery("SELECT created_by FROM shortlink").WithArgs("c").WillReturnRows(sqlmock.NewRows([]string{"created_by"}))
+	assert.Equal(t, codes.NotFound, status.Code(slClient.Delete(ctx, "c")))
+
+	// Shortlinks created without a known user can only be deleted by admins.
+	m.Mock.ExpectExec(deleteQuery).WithArgs("d", "user@example.com").WillReturnResult(sqlmock.NewResult(0, 0))
+	m.Mock.ExpectQuery("SELECT created_by FROM shortlink").WithArgs("d").WillReturnRows(sqlmock.NewRows([]string{"created_by"}).AddRow(nil))
+	err := slClient.Delete(ctx, "d")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Contains(t, err.Error(), "only be modified by admins")
+
+	m.Mock.ExpectExec(`DELETE FROM shortlink WHERE slhash = \$1 AND \(1=1\)`).WithArgs("d").WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, slClient.Delete(contextWithSubject("admin@example.com", "admins"), "d"))
+
+	// Users that aren't known can't delete any shortlinks.
+	assert.Equal(t, codes.PermissionDenied, status.Code(slClient.Delete(context.Background(), "e")))
+
+	m.MustMeetExpectations()
+}
 
-	hash, err := slClient.createShortlinkWithRetries(context.TODO(), "/test", []byte("state"))
+func TestExtend(t *testing.T) {
+	m := dbmock.NewMockDB()
+	slClient := &client{db: m.DB(), log: zap.NewNop()}
+	ctx := contextWithSubject("user@example.com")
+
+	expiresAt := time.Now().Add(time.Hour)
+	m.Mock.ExpectQuery(`UPDATE shortlink SET expires_at = \$1 WHERE slhash = \$2 AND .* RETURNING`).
+		WithArgs(dbmock.AnyArg{}, "a", "user@example.com").
+		WillReturnRows(sqlmock.NewRows(testShortlinkColumns).AddRow("a", "/a", "user@example.com", time.Now(), expiresAt, 0, nil))
+
+	shortlink, err := slClient.Extend(ctx, "a", time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, expiresAt.Unix(), shortlink.ExpiresAt.AsTime().Unix())
+
+	m.Mock.ExpectQuery("UPDATE shortlink").WillReturnRows(sqlmock.NewRows(testShortlinkColumns))
+	m.Mock.ExpectQuery("SELECT created_by FROM shortlink").WithArgs("b").WillReturnRows(sqlmock.NewRows([]string{"created_by"}))
+	_, err = slClient.Extend(ctx, "b", time.Hour)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	m.MustMeetExpectations()
+}
+
+func TestDeleteExpired(t *testing.T) {
+	m := dbmock.NewMockDB()
+	slClient := &client{db: m.DB(), log: zap.NewNop()}
+
+	m.Mock.ExpectExec(`DELETE FROM shortlink WHERE expires_at <= now\(\)`).WillReturnResult(sqlmock.NewResult(0, 5))
+	deleted, err := slClient.deleteExpired(context.Background())
 	assert.NoError(t, err)
-	assert.NotNil(t, hash)
+	assert.Equal(t, int64(5), deleted)
+
 	m.MustMeetExpectations()
 }
 
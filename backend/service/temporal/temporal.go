@@ -5,6 +5,7 @@ package temporal
 // <!-- END clutchdoc -->
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
@@ -72,6 +73,24 @@ type clientManagerImpl struct {
 	logger         log.Logger
 	metricsHandler temporalclient.MetricsHandler
 	copts          temporalclient.ConnectionOptions
+
+	healthOnce   sync.Once
+	healthClient Client
+}
+
+// Check connects to the server on the first call and performs a gRPC health check. Failed connections are retried on
+// subsequent calls.
+func (c *clientManagerImpl) Check(ctx context.Context) error {
+	c.healthOnce.Do(func() {
+		c.healthClient, _ = c.GetNamespaceClient("")
+	})
+
+	conn, err := c.healthClient.GetConnection()
+	if err != nil {
+		return err
+	}
+	_, err = conn.CheckHealth(ctx, &temporalclient.CheckHealthRequest{})
+	return err
 }
 
 func (c *clientManagerImpl) GetNamespaceClient(namespace string) (Client, error) {
@@ -1,6 +1,8 @@
 package temporal
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -62,3 +64,28 @@ func TestGetNamespaceClient(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, mc, ret)
 }
+
+type healthMockClient struct {
+	temporalclient.Client
+
+	err error
+}
+
+func (m *healthMockClient) CheckHealth(context.Context, *temporalclient.CheckHealthRequest) (*temporalclient.CheckHealthResponse, error) {
+	return &temporalclient.CheckHealthResponse{}, m.err
+}
+
+func TestCheck(t *testing.T) {
+	cfg := &temporalv1.Config{Host: "example.com", Port: 9233}
+	c, _ := newClient(cfg, zap.NewNop(), tally.NoopScope)
+	impl := c.(*clientManagerImpl)
+
+	mc := &healthMockClient{}
+	impl.healthOnce.Do(func() {
+		impl.healthClient = &lazyClientImpl{cachedClient: mc}
+	})
+	assert.NoError(t, impl.Check(context.Background()))
+
+	mc.err = errors.New("unavailable")
+	assert.EqualError(t, impl.Check(context.Background()), "unavailable")
+}
@@ -78,6 +78,8 @@ func (c *client) tryAdvisoryLock(ctx context.Context, conn *sql.Conn, lockId uin
 	return lock
 }
 
+// The ID is taken from the start of the name rather than its hash, but it can't change without replicas of different
+// versions both becoming the cache leader during a deploy.
 func convertLockIdToAdvisoryLockId(lockID string) uint32 {
 	x := sha256.New().Sum([]byte(lockID))
 	return binary.BigEndian.Uint32(x)